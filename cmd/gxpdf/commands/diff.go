@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/coregx/gxpdf"
+	"github.com/spf13/cobra"
+)
+
+var diffTolerance float64
+
+var diffCmd = &cobra.Command{
+	Use:   "diff FILE1 FILE2",
+	Short: "Compare the page contents of two PDFs",
+	Long: `Compare the decoded page content streams of two PDF files.
+
+Reports text runs, positions and shape operations present in only one
+file, page by page. Timestamps, file IDs, object numbering and stream
+compression are ignored, so regenerated files with the same pages compare
+equal. Exits with status 1 when the contents differ.
+
+Examples:
+  gxpdf diff golden.pdf output.pdf
+  gxpdf diff golden.pdf output.pdf --tolerance 0.1
+  gxpdf diff golden.pdf output.pdf --format json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	diffCmd.Flags().Float64Var(&diffTolerance, "tolerance", 0.01, "Round numbers to this precision before comparing")
+}
+
+func runDiff(_ *cobra.Command, args []string) error {
+	docA, err := gxpdf.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", args[0], err)
+	}
+	defer func() { _ = docA.Close() }()
+
+	docB, err := gxpdf.Open(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", args[1], err)
+	}
+	defer func() { _ = docB.Close() }()
+
+	changes, err := gxpdf.DiffContent(docA, docB, diffTolerance)
+	if err != nil {
+		return fmt.Errorf("failed to compare: %w", err)
+	}
+
+	switch outputFormat {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(changes); err != nil {
+			return err
+		}
+	default:
+		outputDiffText(changes)
+	}
+
+	if len(changes) > 0 {
+		return fmt.Errorf("contents differ: %d changes", len(changes))
+	}
+	return nil
+}
+
+func outputDiffText(changes []gxpdf.ContentChange) {
+	if len(changes) == 0 {
+		fmt.Println("No differences")
+		return
+	}
+
+	page := -1
+	for _, ch := range changes {
+		if ch.Page != page {
+			page = ch.Page
+			fmt.Printf("Page %d:\n", page+1)
+		}
+		sign := "-"
+		if ch.Added {
+			sign = "+"
+		}
+		fmt.Printf("  %s %-8s %s\n", sign, ch.Kind, ch.Operator)
+	}
+}
@@ -63,6 +63,7 @@ func init() {
 	rootCmd.AddCommand(splitCmd)
 	rootCmd.AddCommand(encryptCmd)
 	rootCmd.AddCommand(decryptCmd)
+	rootCmd.AddCommand(diffCmd)
 }
 
 // printVerbosef prints a message if verbose mode is enabled.
@@ -16,6 +16,7 @@
 //	split       Split PDF into separate files
 //	encrypt     Encrypt PDF with password
 //	decrypt     Decrypt password-protected PDF
+//	diff        Compare the page contents of two PDFs
 //	version     Print version information
 //
 // Use "gxpdf [command] --help" for more information about a command.
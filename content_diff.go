@@ -0,0 +1,75 @@
+package gxpdf
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// ContentChange is a difference between the page contents of two
+// documents: a content stream operator present in only one of them.
+type ContentChange struct {
+	// Page is the 0-based page index.
+	Page int
+
+	// Added is true for an operator only in the second document, false
+	// for an operator only in the first.
+	Added bool
+
+	// Kind classifies the operator: "text", "position", "shape",
+	// "xobject" or "state".
+	Kind string
+
+	// Operator is the normalized operator with its operands,
+	// e.g. "100 700 Td" or "(Total) Tj".
+	Operator string
+}
+
+// DiffContent compares the decoded page content streams of two documents
+// and returns the operators that differ, page by page.
+//
+// Unlike a byte comparison, it ignores what changes from run to run
+// without changing the pages (timestamps, file IDs, object numbering,
+// compression) as well as number formatting. Numbers are rounded to
+// tolerance before comparison (0 = compared exactly). Pages present in
+// only one document are reported as wholly added or removed.
+//
+// Example:
+//
+//	changes, err := gxpdf.DiffContent(golden, current, 0.01)
+//	for _, ch := range changes {
+//	    fmt.Printf("page %d: %v %s %s\n", ch.Page+1, ch.Added, ch.Kind, ch.Operator)
+//	}
+func DiffContent(a, b *Document, tolerance float64) ([]ContentChange, error) {
+	pages := max(a.PageCount(), b.PageCount())
+
+	var changes []ContentChange
+	for i := 0; i < pages; i++ {
+		opsA, err := pageOperators(a, i)
+		if err != nil {
+			return nil, err
+		}
+		opsB, err := pageOperators(b, i)
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range extractor.DiffOperators(i, opsA, opsB, tolerance) {
+			changes = append(changes, ContentChange{Page: ch.Page, Added: ch.Added, Kind: ch.Kind, Operator: ch.Operator})
+		}
+	}
+
+	return changes, nil
+}
+
+// pageOperators returns the content operators of a page, or none if the
+// document has no such page.
+func pageOperators(d *Document, pageIndex int) ([]*extractor.Operator, error) {
+	if pageIndex >= d.PageCount() {
+		return nil, nil
+	}
+	ops, err := extractor.PageOperators(d.reader, pageIndex)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: %s: %w", d.path, err)
+	}
+	return ops, nil
+}
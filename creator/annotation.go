@@ -17,12 +17,13 @@ import (
 //	note.SetOpen(true)
 //	page.AddTextAnnotation(note)
 type TextAnnotation struct {
-	x        float64 // X coordinate (from left)
-	y        float64 // Y coordinate (from bottom)
-	contents string  // Pop-up text
-	author   string  // Author name
-	color    Color   // Annotation color
-	open     bool    // Open by default?
+	x        float64                  // X coordinate (from left)
+	y        float64                  // Y coordinate (from bottom)
+	contents string                   // Pop-up text
+	author   string                   // Author name
+	color    Color                    // Annotation color
+	open     bool                     // Open by default?
+	flags    document.AnnotationFlags // Annotation flags (/F)
 }
 
 // NewTextAnnotation creates a new text annotation (sticky note).
@@ -93,6 +94,47 @@ func (a *TextAnnotation) SetOpen(open bool) *TextAnnotation {
 	return a
 }
 
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	note.SetPrintable(true)
+func (a *TextAnnotation) SetPrintable(printable bool) *TextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	note.SetHidden(true)
+func (a *TextAnnotation) SetHidden(hidden bool) *TextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	note.SetReadOnly(true)
+func (a *TextAnnotation) SetReadOnly(readOnly bool) *TextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	note.SetNoView(true).SetPrintable(true)
+func (a *TextAnnotation) SetNoView(noView bool) *TextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
 // toDomain converts the Creator API annotation to a domain annotation.
 func (a *TextAnnotation) toDomain() *document.TextAnnotation {
 	// Icon size is typically 20x20 points.
@@ -108,6 +150,7 @@ func (a *TextAnnotation) toDomain() *document.TextAnnotation {
 	domainAnnot := document.NewTextAnnotation(rect, a.contents, a.author)
 	domainAnnot.SetColor([3]float64{a.color.R, a.color.G, a.color.B})
 	domainAnnot.SetOpen(a.open)
+	domainAnnot.Flags = a.flags
 
 	return domainAnnot
 }
@@ -1,9 +1,12 @@
 package creator
 
 import (
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/coregx/gxpdf/internal/document"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -247,3 +250,79 @@ func TestAnnotationChaining(t *testing.T) {
 
 	assert.Equal(t, 1, page.page.AnnotationCount())
 }
+
+func TestAnnotationFlags(t *testing.T) {
+	stamp := NewStampAnnotation(300, 700, 100, 50, StampApproved)
+	assert.Zero(t, stamp.toDomain().Flags, "no flags by default")
+
+	stamp.SetPrintable(true).SetReadOnly(true)
+	assert.Equal(t, document.AnnotationFlagPrint|document.AnnotationFlagReadOnly, stamp.toDomain().Flags)
+
+	stamp.SetReadOnly(false)
+	assert.Equal(t, document.AnnotationFlagPrint, stamp.toDomain().Flags)
+
+	note := NewTextAnnotation(100, 700, "Screen only").SetHidden(true).SetHidden(false).SetPrintable(false)
+	assert.Zero(t, note.toDomain().Flags)
+
+	highlight := NewHighlightAnnotation(100, 650, 300, 670).SetNoView(true).SetPrintable(true)
+	assert.Equal(t, document.AnnotationFlagNoView|document.AnnotationFlagPrint, highlight.toDomain().Flags)
+
+	underline := NewUnderlineAnnotation(100, 650, 300, 670).SetHidden(true)
+	assert.Equal(t, document.AnnotationFlagHidden, underline.toDomain().Flags)
+
+	strikeout := NewStrikeOutAnnotation(100, 650, 300, 670).SetReadOnly(true)
+	assert.Equal(t, document.AnnotationFlagReadOnly, strikeout.toDomain().Flags)
+
+	// Flags are written as the /F entry.
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddStampAnnotation(stamp))
+	require.NoError(t, page.AddHighlightAnnotation(highlight))
+	require.NoError(t, page.AddTextAnnotation(note))
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+	assert.Contains(t, out, "/Subtype /Stamp /Rect [300.00 700.00 400.00 750.00] /F 4 ")
+	assert.Contains(t, out, "/F 36 ")
+	assert.Equal(t, 2, strings.Count(out, "/F "))
+}
+
+func TestAnnotationPopup(t *testing.T) {
+	popupRect := Rect{X: 320, Y: 600, Width: 180, Height: 80}
+
+	highlight := NewHighlightAnnotation(100, 650, 300, 670).SetNote("Important").SetPopup(true, popupRect)
+	d := highlight.toDomain()
+	require.NotNil(t, d.Popup)
+	assert.Equal(t, [4]float64{320, 600, 500, 680}, d.Popup.Rect)
+	assert.True(t, d.Popup.Open)
+
+	assert.Nil(t, NewUnderlineAnnotation(100, 650, 300, 670).toDomain().Popup, "no popup by default")
+	assert.NotNil(t, NewStrikeOutAnnotation(100, 650, 300, 670).SetPopup(false, popupRect).toDomain().Popup)
+	assert.NotNil(t, NewStampAnnotation(300, 700, 100, 50, StampDraft).SetPopup(false, popupRect).toDomain().Popup)
+	assert.NotNil(t, NewCircleAnnotation(popupRect).SetPopup(false, popupRect).toDomain().Popup)
+
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	// An empty popup rectangle is rejected.
+	bad := NewHighlightAnnotation(100, 650, 300, 670).SetPopup(true, Rect{X: 10, Y: 10})
+	assert.Error(t, page.AddHighlightAnnotation(bad))
+
+	require.NoError(t, page.AddHighlightAnnotation(highlight))
+	require.NoError(t, page.AddUnderlineAnnotation(NewUnderlineAnnotation(100, 620, 300, 640)))
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	// The popup is linked both ways and listed in the page /Annots.
+	assert.Contains(t, out, "/Contents (Important) /Popup 4 0 R >>")
+	assert.Contains(t, out, "<< /Type /Annot /Subtype /Popup /Rect [320.00 600.00 500.00 680.00] /Parent 3 0 R /Open true >>")
+	assert.Contains(t, out, "/Annots [3 0 R 4 0 R 5 0 R]")
+	assert.Equal(t, 1, strings.Count(out, "/Subtype /Popup"))
+}
@@ -75,6 +75,12 @@ type Appender struct {
 
 	// Track fields to flatten when writing.
 	flattenedFields []*forms.FlattenInfo
+
+	// Track metadata changes for incremental updates.
+	metadataChanged bool
+
+	// Signature field to add in an incremental update.
+	signature *SignatureField
 }
 
 // NewAppender opens an existing PDF file for modification.
@@ -382,8 +388,8 @@ func (a *Appender) AddPage(size PageSize) (*Page, error) {
 // This creates a new PDF file with all modifications applied.
 // The original file is not modified.
 //
-// For large PDFs, consider using WriteToFileIncremental() instead,
-// which appends only the changes (not yet implemented).
+// To keep the original bytes, e.g. of signed documents, use
+// WriteToFileIncremental instead, which appends only the changes.
 //
 // Example:
 //
@@ -414,6 +420,10 @@ func (a *Appender) WriteToFileContext(ctx context.Context, path string) error {
 	default:
 	}
 
+	if a.signature != nil {
+		return fmt.Errorf("signature fields can only be written as an incremental update")
+	}
+
 	// Create PDF writer.
 	w, err := writer.NewPdfWriter(path)
 	if err != nil {
@@ -443,6 +453,7 @@ func (a *Appender) WriteToFileContext(ctx context.Context, path string) error {
 func (a *Appender) collectPageContents(pages []*Page) (map[int][]writer.TextOp, map[int][]writer.GraphicsOp) {
 	textContents := make(map[int][]writer.TextOp)
 	graphicsContents := make(map[int][]writer.GraphicsOp)
+	subsets := make(fontSubsets)
 
 	for i, page := range pages {
 		// Convert text operations.
@@ -462,25 +473,8 @@ func (a *Appender) collectPageContents(pages []*Page) (map[int][]writer.TextOp,
 		}
 
 		// Convert graphics operations.
-		if len(page.graphicsOps) > 0 {
-			graphicsOps := make([]writer.GraphicsOp, 0, len(page.graphicsOps))
-			for _, op := range page.graphicsOps {
-				gop := writer.GraphicsOp{
-					Type:   int(op.Type),
-					X:      op.X,
-					Y:      op.Y,
-					X2:     op.X2,
-					Y2:     op.Y2,
-					Width:  op.Width,
-					Height: op.Height,
-					Radius: op.Radius,
-				}
-
-				// Convert options.
-				convertGraphicsOptions(&gop, &op)
-				graphicsOps = append(graphicsOps, gop)
-			}
-			graphicsContents[i] = graphicsOps
+		if ops := page.GraphicsOperations(); len(ops) > 0 {
+			graphicsContents[i] = convertGraphicsOps(ops, subsets)
 		}
 	}
 
@@ -522,6 +516,7 @@ func (a *Appender) Document() *document.Document {
 //	app.SetMetadata("Modified Document", "John Doe", "Updated Report")
 func (a *Appender) SetMetadata(title, author, subject string) {
 	a.doc.SetMetadata(title, author, subject)
+	a.metadataChanged = true
 }
 
 // SetKeywords sets document keywords for search/indexing.
@@ -531,6 +526,7 @@ func (a *Appender) SetMetadata(title, author, subject string) {
 //	app.SetKeywords("modified", "watermarked", "confidential")
 func (a *Appender) SetKeywords(keywords ...string) {
 	a.doc.SetMetadata("", "", "", keywords...)
+	a.metadataChanged = true
 }
 
 // SetFieldValue sets a form field value by name.
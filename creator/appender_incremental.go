@@ -0,0 +1,470 @@
+package creator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/coregx/gxpdf/internal/application/rewrite"
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/coregx/gxpdf/internal/writer"
+)
+
+// WriteToFileIncremental writes the changes as an incremental update: the
+// original file, byte for byte, followed by the changed and new objects.
+//
+// Unlike WriteToFile, the original content is kept as is, so digital
+// signatures covering it stay valid and earlier revisions remain
+// recoverable. path may be the file the Appender was opened from.
+//
+// Supported changes:
+//   - Form field values (SetFieldValue)
+//   - Metadata (SetMetadata, SetKeywords)
+//   - Text and vector graphics added to existing pages, e.g. watermarks
+//   - New pages (AddPage)
+//   - A signature field to sign externally (AddSignatureField)
+//
+// Content using images, transparency or custom fonts, flattened form
+// fields and page rotations are not supported in incremental updates; use
+// WriteToFile for those. Encrypted documents cannot be updated.
+//
+// Example:
+//
+//	app, err := creator.NewAppender("signed.pdf")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer app.Close()
+//
+//	app.SetFieldValue("approved", true)
+//	err = app.WriteToFileIncremental("signed.pdf")
+func (a *Appender) WriteToFileIncremental(path string) error {
+	return rewrite.WriteFile(path, a.WriteIncremental)
+}
+
+// WriteIncremental writes the original document followed by an
+// incremental update holding the changes to w (see WriteToFileIncremental).
+func (a *Appender) WriteIncremental(w io.Writer) error {
+	if len(a.flattenedFields) > 0 {
+		return fmt.Errorf("flattened form fields cannot be written as an incremental update")
+	}
+
+	pdfReader := a.pdfReader.GetParserReader()
+	update := rewrite.NewUpdate(pdfReader)
+
+	if err := a.updateFormFields(pdfReader, update); err != nil {
+		return fmt.Errorf("failed to update form fields: %w", err)
+	}
+	if a.metadataChanged {
+		a.updateInfo(pdfReader, update)
+	}
+	if err := a.updatePages(pdfReader, update); err != nil {
+		return fmt.Errorf("failed to update pages: %w", err)
+	}
+	if a.signature != nil {
+		if err := a.updateSignatureField(pdfReader, update); err != nil {
+			return fmt.Errorf("failed to add signature field: %w", err)
+		}
+	}
+
+	if err := update.Write(w); err != nil {
+		return fmt.Errorf("failed to write incremental update: %w", err)
+	}
+	return nil
+}
+
+// updateFormFields replaces the dictionaries of fields with new values.
+func (a *Appender) updateFormFields(pdfReader *parser.Reader, update *rewrite.Update) error {
+	if a.formWriter == nil || !a.formWriter.HasUpdates() {
+		return nil
+	}
+
+	fields, err := a.formWriter.GetFieldsToUpdate()
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if field.Ref == nil {
+			return fmt.Errorf("field %q is a direct object", field.Name)
+		}
+		obj, err := pdfReader.GetObject(field.Ref.Number)
+		if err != nil {
+			return fmt.Errorf("failed to read field %q: %w", field.Name, err)
+		}
+		dict, ok := obj.(*parser.Dictionary)
+		if !ok {
+			return fmt.Errorf("field %q is not a dictionary", field.Name)
+		}
+		update.Set(field.Ref.Number, field.Ref.Generation, a.formWriter.ApplyUpdatesToDict(field, dict))
+	}
+
+	// Have viewers regenerate the appearances of the changed fields.
+	catalog, err := pdfReader.GetCatalog()
+	if err != nil {
+		return err
+	}
+	switch acroForm := catalog.Get("AcroForm").(type) {
+	case *parser.IndirectReference:
+		obj, err := pdfReader.GetObject(acroForm.Number)
+		if err != nil {
+			return fmt.Errorf("failed to read AcroForm: %w", err)
+		}
+		if dict, ok := obj.(*parser.Dictionary); ok {
+			dict = dict.Clone()
+			dict.SetBoolean("NeedAppearances", true)
+			update.Set(acroForm.Number, acroForm.Generation, dict)
+		}
+	case *parser.Dictionary:
+		root, ok := pdfReader.Trailer().Get("Root").(*parser.IndirectReference)
+		if !ok {
+			return fmt.Errorf("trailer has no catalog reference")
+		}
+		dict := acroForm.Clone()
+		dict.SetBoolean("NeedAppearances", true)
+		catalog = catalog.Clone()
+		catalog.Set("AcroForm", dict)
+		update.Set(root.Number, root.Generation, catalog)
+	}
+	return nil
+}
+
+// updateInfo writes the document information dictionary with the new
+// metadata, keeping the other entries of the original.
+func (a *Appender) updateInfo(pdfReader *parser.Reader, update *rewrite.Update) {
+	info := parser.NewDictionary()
+	ref := update.Info()
+	if ref != nil {
+		if obj, err := pdfReader.GetObject(ref.Number); err == nil {
+			if dict, ok := obj.(*parser.Dictionary); ok {
+				info = dict.Clone()
+			}
+		}
+	}
+
+	if title := a.doc.Title(); title != "" {
+		info.Set("Title", parser.NewTextString(title))
+	}
+	if author := a.doc.Author(); author != "" {
+		info.Set("Author", parser.NewTextString(author))
+	}
+	if subject := a.doc.Subject(); subject != "" {
+		info.Set("Subject", parser.NewTextString(subject))
+	}
+	if keywords := a.doc.Keywords(); len(keywords) > 0 {
+		info.Set("Keywords", parser.NewTextString(strings.Join(keywords, ", ")))
+	}
+	info.SetString("ModDate", parser.TimeToPdfDate(time.Now()))
+
+	if ref != nil {
+		update.Set(ref.Number, ref.Generation, info)
+	} else {
+		update.SetInfo(update.Add(info))
+	}
+}
+
+// updatePages stamps the content added to existing pages and appends the
+// new pages to the page tree.
+func (a *Appender) updatePages(pdfReader *parser.Reader, update *rewrite.Update) error {
+	allPages := make([]*Page, 0, len(a.pages)+len(a.newPages))
+	allPages = append(allPages, a.pages...)
+	allPages = append(allPages, a.newPages...)
+	textContents, graphicsContents := a.collectPageContents(allPages)
+	fonts := make(updateFonts)
+
+	var pageRefs []*parser.IndirectReference
+	for i := range a.pages {
+		if len(textContents[i]) == 0 && len(graphicsContents[i]) == 0 {
+			continue
+		}
+		if pageRefs == nil {
+			refs, err := collectPageRefs(pdfReader)
+			if err != nil {
+				return err
+			}
+			if len(refs) != len(a.pages) {
+				return fmt.Errorf("page tree has %d pages, want %d", len(refs), len(a.pages))
+			}
+			pageRefs = refs
+		}
+		if err := stampPage(pdfReader, update, fonts, pageRefs[i], textContents[i], graphicsContents[i]); err != nil {
+			return fmt.Errorf("page %d: %w", i, err)
+		}
+	}
+
+	if len(a.newPages) == 0 {
+		return nil
+	}
+
+	catalog, err := pdfReader.GetCatalog()
+	if err != nil {
+		return err
+	}
+	pagesRef, ok := catalog.Get("Pages").(*parser.IndirectReference)
+	if !ok {
+		return fmt.Errorf("catalog has no page tree reference")
+	}
+	obj, err := pdfReader.GetObject(pagesRef.Number)
+	if err != nil {
+		return fmt.Errorf("failed to read page tree: %w", err)
+	}
+	pagesDict, ok := obj.(*parser.Dictionary)
+	if !ok {
+		return fmt.Errorf("page tree root is not a dictionary")
+	}
+
+	kids := parser.NewArray()
+	if arr, err := pdfReader.ResolveArray(pagesDict.Get("Kids")); err == nil {
+		kids = arr.Clone()
+	}
+	for i, page := range a.newPages {
+		index := len(a.pages) + i
+		pageDict, err := newPageDict(update, fonts, pagesRef, page, textContents[index], graphicsContents[index])
+		if err != nil {
+			return fmt.Errorf("page %d: %w", index, err)
+		}
+		kids.Append(update.Add(pageDict))
+	}
+
+	pagesDict = pagesDict.Clone()
+	pagesDict.Set("Kids", kids)
+	pagesDict.SetInteger("Count", pagesDict.GetInteger("Count")+int64(len(a.newPages)))
+	update.Set(pagesRef.Number, pagesRef.Generation, pagesDict)
+	return nil
+}
+
+// collectPageRefs returns the references to the page objects of the
+// document, in page order.
+func collectPageRefs(pdfReader *parser.Reader) ([]*parser.IndirectReference, error) {
+	catalog, err := pdfReader.GetCatalog()
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []*parser.IndirectReference
+	visited := make(map[int]bool)
+	var walk func(obj parser.PdfObject) error
+	walk = func(obj parser.PdfObject) error {
+		ref, ok := obj.(*parser.IndirectReference)
+		if !ok {
+			return fmt.Errorf("page tree node is not an indirect object")
+		}
+		if visited[ref.Number] {
+			return fmt.Errorf("page tree cycle at object %d", ref.Number)
+		}
+		visited[ref.Number] = true
+
+		node, err := pdfReader.GetObject(ref.Number)
+		if err != nil {
+			return fmt.Errorf("failed to read page tree node %d: %w", ref.Number, err)
+		}
+		dict, ok := node.(*parser.Dictionary)
+		if !ok {
+			return fmt.Errorf("page tree node %d is not a dictionary", ref.Number)
+		}
+		if name := dict.GetName("Type"); name != nil && name.Value() == "Page" {
+			refs = append(refs, ref)
+			return nil
+		}
+
+		kids, err := pdfReader.ResolveArray(dict.Get("Kids"))
+		if err != nil {
+			return fmt.Errorf("page tree node %d has no kids: %w", ref.Number, err)
+		}
+		for i := 0; i < kids.Len(); i++ {
+			if err := walk(kids.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(catalog.Get("Pages")); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// stampPage draws new content over an existing page.
+//
+// The content goes into a form XObject with its own resources, so its
+// resource names cannot clash with those of the page. The original content
+// is wrapped in q/Q so that graphics state it leaves behind does not
+// affect the stamp.
+func stampPage(
+	pdfReader *parser.Reader,
+	update *rewrite.Update,
+	fonts updateFonts,
+	pageRef *parser.IndirectReference,
+	textOps []writer.TextOp,
+	graphicsOps []writer.GraphicsOp,
+) error {
+	obj, err := pdfReader.GetObject(pageRef.Number)
+	if err != nil {
+		return fmt.Errorf("failed to read page: %w", err)
+	}
+	page, ok := obj.(*parser.Dictionary)
+	if !ok {
+		return fmt.Errorf("page is not a dictionary")
+	}
+
+	content, resources, err := contentForUpdate(update, fonts, textOps, graphicsOps)
+	if err != nil {
+		return err
+	}
+	formDict := parser.NewDictionary()
+	formDict.SetName("Type", "XObject")
+	formDict.SetName("Subtype", "Form")
+	bbox := inheritedPageEntry(pdfReader, page, "MediaBox")
+	if bbox == nil {
+		bbox = parser.NewArrayFromSlice([]parser.PdfObject{
+			parser.NewInteger(0), parser.NewInteger(0), parser.NewInteger(612), parser.NewInteger(792),
+		})
+	}
+	formDict.Set("BBox", bbox)
+	formDict.Set("Resources", resources)
+	form := update.Add(compressedStream(formDict, content))
+
+	// Page resources may be inherited from the page tree; the page gets
+	// its own copy holding the form.
+	pageResources := parser.NewDictionary()
+	if dict, ok := pdfReader.ResolveReferences(inheritedPageEntry(pdfReader, page, "Resources")).(*parser.Dictionary); ok {
+		pageResources = dict.Clone()
+	}
+	xobjects := parser.NewDictionary()
+	if dict, ok := pdfReader.ResolveReferences(pageResources.Get("XObject")).(*parser.Dictionary); ok {
+		xobjects = dict.Clone()
+	}
+	name := "GxStamp1"
+	for n := 2; xobjects.Has(name); n++ {
+		name = fmt.Sprintf("GxStamp%d", n)
+	}
+	xobjects.Set(name, form)
+	pageResources.Set("XObject", xobjects)
+
+	contents := parser.NewArray()
+	contents.Append(update.Add(parser.NewStream(parser.NewDictionary(), []byte("q\n"))))
+	switch v := pdfReader.ResolveReferences(page.Get("Contents")).(type) {
+	case *parser.Stream:
+		contents.Append(page.Get("Contents"))
+	case *parser.Array:
+		for i := 0; i < v.Len(); i++ {
+			contents.Append(v.Get(i))
+		}
+	}
+	stamp := fmt.Sprintf("Q\nq /%s Do Q\n", name)
+	contents.Append(update.Add(parser.NewStream(parser.NewDictionary(), []byte(stamp))))
+
+	page = page.Clone()
+	page.Set("Resources", pageResources)
+	page.Set("Contents", contents)
+	update.Set(pageRef.Number, pageRef.Generation, page)
+	return nil
+}
+
+// newPageDict creates the dictionary of a page added to the page tree
+// node parent.
+func newPageDict(
+	update *rewrite.Update,
+	fonts updateFonts,
+	parent *parser.IndirectReference,
+	page *Page,
+	textOps []writer.TextOp,
+	graphicsOps []writer.GraphicsOp,
+) (*parser.Dictionary, error) {
+	dict := parser.NewDictionary()
+	dict.SetName("Type", "Page")
+	dict.Set("Parent", parent)
+	mediaBox := parser.NewArray()
+	mediaBox.AppendAll(
+		parser.NewInteger(0), parser.NewInteger(0),
+		parser.NewReal(page.page.Width()), parser.NewReal(page.page.Height()),
+	)
+	dict.Set("MediaBox", mediaBox)
+	if rotation := page.page.Rotation(); rotation != 0 {
+		dict.SetInteger("Rotate", int64(rotation))
+	}
+
+	content, resources, err := contentForUpdate(update, fonts, textOps, graphicsOps)
+	if err != nil {
+		return nil, err
+	}
+	dict.Set("Resources", resources)
+	if len(content) > 0 {
+		dict.Set("Contents", update.Add(compressedStream(parser.NewDictionary(), content)))
+	}
+	return dict, nil
+}
+
+// updateFonts maps the standard fonts added to an update to their font
+// dictionaries, so that pages drawn with the same font share one object.
+type updateFonts map[string]*parser.IndirectReference
+
+// contentForUpdate generates a content stream and adds the fonts it uses
+// to the update, returning the content and its resource dictionary.
+func contentForUpdate(
+	update *rewrite.Update,
+	fonts updateFonts,
+	textOps []writer.TextOp,
+	graphicsOps []writer.GraphicsOp,
+) ([]byte, *parser.Dictionary, error) {
+	content, resources, err := writer.GenerateContentStreamWithGraphics(textOps, graphicsOps)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !resources.FontsOnly() {
+		return nil, nil, fmt.Errorf("images and transparency are not supported in incremental updates")
+	}
+
+	fontResources := parser.NewDictionary()
+	for id, name := range resources.GetFontIDMapping() {
+		baseFont, ok := strings.CutPrefix(id, "std:")
+		if !ok {
+			return nil, nil, fmt.Errorf("custom fonts are not supported in incremental updates")
+		}
+		ref, ok := fonts[baseFont]
+		if !ok {
+			font := parser.NewDictionary()
+			font.SetName("Type", "Font")
+			font.SetName("Subtype", "Type1")
+			font.SetName("BaseFont", baseFont)
+			if baseFont != "Symbol" && baseFont != "ZapfDingbats" {
+				font.SetName("Encoding", "WinAnsiEncoding")
+			}
+			ref = update.Add(font)
+			fonts[baseFont] = ref
+		}
+		fontResources.Set(name, ref)
+	}
+
+	dict := parser.NewDictionary()
+	if fontResources.Len() > 0 {
+		dict.Set("Font", fontResources)
+	}
+	return content, dict, nil
+}
+
+// inheritedPageEntry returns an inheritable page entry (such as
+// /Resources or /MediaBox), looking it up in the page tree if the page
+// does not set it.
+func inheritedPageEntry(pdfReader *parser.Reader, page *parser.Dictionary, key string) parser.PdfObject {
+	node := page
+	for depth := 0; node != nil && depth < 64; depth++ {
+		if value := node.Get(key); value != nil {
+			return value
+		}
+		node, _ = pdfReader.ResolveReferences(node.Get("Parent")).(*parser.Dictionary)
+	}
+	return nil
+}
+
+// compressedStream creates a stream of content compressed with
+// FlateDecode.
+func compressedStream(dict *parser.Dictionary, content []byte) *parser.Stream {
+	compressed, err := writer.CompressStream(content, writer.DefaultCompression)
+	if err != nil {
+		return parser.NewStream(dict, content)
+	}
+	dict.SetName("Filter", "FlateDecode")
+	return parser.NewStream(dict, compressed)
+}
@@ -0,0 +1,131 @@
+package creator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageText returns the text extracted from a page, joined by spaces.
+func pageText(t *testing.T, reader *parser.Reader, index int) string {
+	t.Helper()
+
+	elements, err := extractor.NewTextExtractor(reader).ExtractFromPage(index)
+	require.NoError(t, err)
+	var text []string
+	for _, e := range elements {
+		text = append(text, e.Text)
+	}
+	return strings.Join(text, " ")
+}
+
+// stampContent returns the decoded content of the form XObject stamped on
+// a page by an incremental update.
+func stampContent(t *testing.T, reader *parser.Reader, index int) string {
+	t.Helper()
+
+	page, err := reader.GetPage(index)
+	require.NoError(t, err)
+	resources, ok := reader.ResolveReferences(page.Get("Resources")).(*parser.Dictionary)
+	require.True(t, ok, "page has no resources")
+	xobjects, ok := reader.ResolveReferences(resources.Get("XObject")).(*parser.Dictionary)
+	require.True(t, ok, "page has no XObjects")
+	form, ok := reader.ResolveReferences(xobjects.Get("GxStamp1")).(*parser.Stream)
+	require.True(t, ok, "page has no stamp")
+
+	content, err := reader.DecodeStream(form)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestAppender_WriteToFileIncremental(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	require.NoError(t, newSection(t, "Original", 2).WriteToFile(path))
+	original, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	app, err := NewAppender(path)
+	require.NoError(t, err)
+	defer app.Close()
+
+	page, err := app.GetPage(1)
+	require.NoError(t, err)
+	require.NoError(t, page.AddText("CONFIDENTIAL", 200, 400, HelveticaBold, 36))
+	require.NoError(t, page.DrawLine(72, 390, 500, 390, &LineOptions{Color: Red, Width: 2}))
+	newPage, err := app.AddPage(A4)
+	require.NoError(t, err)
+	require.NoError(t, newPage.AddText("Appendix", 72, 720, Helvetica, 12))
+	app.SetMetadata("Отчёт", "", "")
+
+	// Updating the file the appender reads from.
+	require.NoError(t, app.WriteToFileIncremental(path))
+
+	updated, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(updated, original), "original bytes must be kept")
+
+	reader, err := parser.OpenPDF(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.Positive(t, reader.Trailer().GetInteger("Prev"))
+	count, err := reader.GetPageCount()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	assert.Contains(t, pageText(t, reader, 0), "Original 1")
+	assert.NotContains(t, pageText(t, reader, 0), "CONFIDENTIAL")
+	assert.Contains(t, pageText(t, reader, 1), "Original 2")
+	assert.Contains(t, stampContent(t, reader, 1), "(CONFIDENTIAL) Tj")
+	assert.Contains(t, pageText(t, reader, 2), "Appendix")
+	assert.Equal(t, "Отчёт", reader.GetDocumentInfo().Title)
+}
+
+func TestAppender_WriteIncremental_Unchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	require.NoError(t, newSection(t, "Original", 1).WriteToFile(path))
+	original, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	app, err := NewAppender(path)
+	require.NoError(t, err)
+	defer app.Close()
+
+	var buf bytes.Buffer
+	require.NoError(t, app.WriteIncremental(&buf))
+	assert.Equal(t, original, buf.Bytes())
+}
+
+func TestAppender_WriteIncremental_SharesFonts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	require.NoError(t, newSection(t, "Original", 2).WriteToFile(path))
+	original, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	app, err := NewAppender(path)
+	require.NoError(t, err)
+	defer app.Close()
+
+	for i := 0; i < 2; i++ {
+		page, err := app.GetPage(i)
+		require.NoError(t, err)
+		require.NoError(t, page.AddText("DRAFT", 200, 400, Courier, 36))
+	}
+	newPage, err := app.AddPage(A4)
+	require.NoError(t, err)
+	require.NoError(t, newPage.AddText("DRAFT", 200, 400, Courier, 36))
+
+	var buf bytes.Buffer
+	require.NoError(t, app.WriteIncremental(&buf))
+
+	// The three pages share one font object.
+	update := buf.Bytes()[len(original):]
+	assert.Equal(t, 1, bytes.Count(update, []byte("/BaseFont /Courier")))
+}
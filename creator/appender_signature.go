@@ -0,0 +1,226 @@
+package creator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/coregx/gxpdf/internal/application/rewrite"
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/coregx/gxpdf/internal/security"
+)
+
+// DefaultSignatureSize is the room in bytes reserved for the signature of
+// a SignatureField without a Size, enough for a CMS signature with a
+// certificate chain and a timestamp.
+const DefaultSignatureSize = 16384
+
+// SignatureField is an unsigned signature field added with
+// Appender.AddSignatureField.
+type SignatureField struct {
+	// Name is the field name (/T).
+	Name string
+
+	// Page is the 0-based index of the page holding the field's widget.
+	Page int
+
+	// Rect is the widget rectangle [llx lly urx ury] in PDF coordinates.
+	// The zero value makes the signature invisible.
+	Rect [4]float64
+
+	// Size is the room in bytes reserved for the signature. 0 uses
+	// DefaultSignatureSize.
+	Size int
+
+	// Reason is the optional reason for signing (/Reason).
+	Reason string
+}
+
+// AddSignatureField adds a signature field whose signature dictionary
+// holds a /ByteRange and /Contents placeholder, ready for an external
+// signing service (e.g. an HSM).
+//
+// The field is written by WriteIncremental and WriteToFileIncremental, so
+// the signature covers the original document unchanged. Prepare the
+// written file, compute the digest to sign and embed the signature with
+// gxpdf.PrepareSignatureFile and gxpdf.EmbedSignatureFile. One signature
+// field can be added per update.
+//
+// Example:
+//
+//	app.AddSignatureField(creator.SignatureField{Name: "Approval", Reason: "Approved"})
+//	if err := app.WriteToFileIncremental("signed.pdf"); err != nil {
+//	    log.Fatal(err)
+//	}
+//	r, digest, err := gxpdf.PrepareSignatureFile("signed.pdf")
+//	// Have the signing service sign digest...
+//	err = gxpdf.EmbedSignatureFile("signed.pdf", r, cmsSignature)
+func (a *Appender) AddSignatureField(field SignatureField) error {
+	if a.signature != nil {
+		return fmt.Errorf("signature field %q already added", a.signature.Name)
+	}
+	if field.Name == "" {
+		return fmt.Errorf("signature field name is required")
+	}
+	if field.Page < 0 || field.Page >= len(a.pages) {
+		return fmt.Errorf("signature field page %d out of range [0, %d)", field.Page, len(a.pages))
+	}
+	if field.Size < 0 {
+		return fmt.Errorf("signature size must not be negative: %d", field.Size)
+	}
+	if field.Size == 0 {
+		field.Size = DefaultSignatureSize
+	}
+	a.signature = &field
+	return nil
+}
+
+// updateSignatureField adds the signature field to the update: the
+// signature dictionary, the widget on its page and the field in the
+// AcroForm.
+func (a *Appender) updateSignatureField(pdfReader *parser.Reader, update *rewrite.Update) error {
+	field := a.signature
+	pageRefs, err := collectPageRefs(pdfReader)
+	if err != nil {
+		return err
+	}
+	if len(pageRefs) != len(a.pages) {
+		return fmt.Errorf("page tree has %d pages, want %d", len(pageRefs), len(a.pages))
+	}
+	pageRef := pageRefs[field.Page]
+
+	sig := parser.NewDictionary()
+	sig.SetName("Type", "Sig")
+	sig.SetName("Filter", "Adobe.PPKLite")
+	sig.SetName("SubFilter", "adbe.pkcs7.detached")
+	sig.SetString("M", parser.TimeToPdfDate(time.Now()))
+	if field.Reason != "" {
+		sig.Set("Reason", parser.NewTextString(field.Reason))
+	}
+	sigRef := update.Add(signatureDict(sig, field.Size))
+
+	rect := parser.NewArray()
+	for _, v := range field.Rect {
+		rect.Append(parser.NewReal(v))
+	}
+	appearance := parser.NewDictionary()
+	appearance.SetName("Type", "XObject")
+	appearance.SetName("Subtype", "Form")
+	bbox := parser.NewArray()
+	bbox.AppendAll(
+		parser.NewInteger(0), parser.NewInteger(0),
+		parser.NewReal(field.Rect[2]-field.Rect[0]), parser.NewReal(field.Rect[3]-field.Rect[1]),
+	)
+	appearance.Set("BBox", bbox)
+	ap := parser.NewDictionary()
+	ap.Set("N", update.Add(parser.NewStream(appearance, nil)))
+
+	widget := parser.NewDictionary()
+	widget.SetName("Type", "Annot")
+	widget.SetName("Subtype", "Widget")
+	widget.SetName("FT", "Sig")
+	widget.Set("T", parser.NewTextString(field.Name))
+	widget.Set("V", sigRef)
+	widget.Set("Rect", rect)
+	widget.SetInteger("F", 4) // Print
+	widget.Set("P", pageRef)
+	widget.Set("AP", ap)
+	widgetRef := update.Add(widget)
+
+	page, err := updatedDict(pdfReader, update, pageRef)
+	if err != nil {
+		return fmt.Errorf("failed to read page: %w", err)
+	}
+	annots := parser.NewArray()
+	if arr, err := pdfReader.ResolveArray(page.Get("Annots")); err == nil {
+		annots = arr.Clone()
+	}
+	annots.Append(widgetRef)
+	page.Set("Annots", annots)
+	update.Set(pageRef.Number, pageRef.Generation, page)
+
+	return a.addToAcroForm(pdfReader, update, widgetRef)
+}
+
+// addToAcroForm adds a signature field to the document's AcroForm,
+// creating the AcroForm if the document has none.
+func (a *Appender) addToAcroForm(pdfReader *parser.Reader, update *rewrite.Update, fieldRef *parser.IndirectReference) error {
+	root, ok := pdfReader.Trailer().Get("Root").(*parser.IndirectReference)
+	if !ok {
+		return fmt.Errorf("trailer has no catalog reference")
+	}
+	catalog, err := updatedDict(pdfReader, update, root)
+	if err != nil {
+		return fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	acroFormRef, _ := catalog.Get("AcroForm").(*parser.IndirectReference)
+	acroForm := parser.NewDictionary()
+	switch v := catalog.Get("AcroForm").(type) {
+	case *parser.IndirectReference:
+		if acroForm, err = updatedDict(pdfReader, update, v); err != nil {
+			return fmt.Errorf("failed to read AcroForm: %w", err)
+		}
+	case *parser.Dictionary:
+		acroForm = v.Clone()
+	}
+
+	fields := parser.NewArray()
+	if arr, err := pdfReader.ResolveArray(acroForm.Get("Fields")); err == nil {
+		fields = arr.Clone()
+	}
+	fields.Append(fieldRef)
+	acroForm.Set("Fields", fields)
+	acroForm.SetInteger("SigFlags", 3) // SignaturesExist | AppendOnly
+
+	if acroFormRef != nil {
+		update.Set(acroFormRef.Number, acroFormRef.Generation, acroForm)
+		return nil
+	}
+	catalog.Set("AcroForm", update.Add(acroForm))
+	update.Set(root.Number, root.Generation, catalog)
+	return nil
+}
+
+// updatedDict returns a copy of the dictionary ref points to, as changed
+// by the update or else as read from the document.
+func updatedDict(pdfReader *parser.Reader, update *rewrite.Update, ref *parser.IndirectReference) (*parser.Dictionary, error) {
+	obj := update.Object(ref.Number)
+	if obj == nil {
+		var err error
+		if obj, err = pdfReader.GetObject(ref.Number); err != nil {
+			return nil, err
+		}
+	}
+	dict, ok := obj.(*parser.Dictionary)
+	if !ok {
+		return nil, fmt.Errorf("object %d is not a dictionary", ref.Number)
+	}
+	return dict.Clone(), nil
+}
+
+// signatureDict returns the signature dictionary sig followed by the
+// /ByteRange and /Contents placeholder for a signature of up to size
+// bytes. The placeholder is written verbatim so that PrepareSignature
+// finds it.
+func signatureDict(sig *parser.Dictionary, size int) rawObject {
+	var buf bytes.Buffer
+	_, _ = sig.WriteTo(&buf)
+	return rawObject(strings.TrimSuffix(buf.String(), ">>") + " " + security.SignaturePlaceholder(size) + " >>")
+}
+
+// rawObject is a PDF object written verbatim.
+type rawObject string
+
+// String returns the PDF representation of the object.
+func (r rawObject) String() string {
+	return string(r)
+}
+
+// WriteTo writes the object to w.
+func (r rawObject) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, string(r))
+	return int64(n), err
+}
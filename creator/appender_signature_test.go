@@ -0,0 +1,119 @@
+package creator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/coregx/gxpdf/internal/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppender_AddSignatureField_SignExternally(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "contract.pdf")
+	require.NoError(t, newSection(t, "Contract", 2).WriteToFile(path))
+	original, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	app, err := NewAppender(path)
+	require.NoError(t, err)
+	page, err := app.GetPage(1)
+	require.NoError(t, err)
+	require.NoError(t, page.AddText("Signed copy", 72, 72, Helvetica, 10))
+	require.NoError(t, app.AddSignatureField(SignatureField{
+		Name:   "Approval",
+		Page:   1,
+		Rect:   [4]float64{72, 100, 272, 150},
+		Size:   64,
+		Reason: "Approved",
+	}))
+	signed := filepath.Join(t.TempDir(), "signed.pdf")
+	require.NoError(t, app.WriteToFileIncremental(signed))
+	require.NoError(t, app.Close())
+
+	// Prepare the written file, digest it and embed a signature.
+	f, err := os.OpenFile(signed, os.O_RDWR, 0)
+	require.NoError(t, err)
+	info, err := f.Stat()
+	require.NoError(t, err)
+	r, err := security.PrepareSignature(f, info.Size())
+	require.NoError(t, err)
+	digest, err := r.Digest(f)
+	require.NoError(t, err)
+	signature := []byte("CMS signature of the digest")
+	require.NoError(t, r.Embed(f, signature))
+	require.NoError(t, f.Close())
+
+	data, err := os.ReadFile(signed)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, original), "original bytes must be kept")
+
+	// The digest covers everything but the /Contents hex string.
+	br := r.ByteRange
+	assert.Equal(t, int64(len(data)), br[2]+br[3])
+	h := sha256.New()
+	h.Write(data[br[0]:br[1]])
+	h.Write(data[br[2] : br[2]+br[3]])
+	assert.Equal(t, h.Sum(nil), digest)
+
+	reader, err := parser.OpenPDF(signed)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	catalog, err := reader.GetCatalog()
+	require.NoError(t, err)
+	acroForm, ok := reader.ResolveReferences(catalog.Get("AcroForm")).(*parser.Dictionary)
+	require.True(t, ok, "catalog has no AcroForm")
+	assert.Equal(t, int64(3), acroForm.GetInteger("SigFlags"))
+	fields, err := reader.ResolveArray(acroForm.Get("Fields"))
+	require.NoError(t, err)
+	require.Equal(t, 1, fields.Len())
+	field, ok := reader.ResolveReferences(fields.Get(0)).(*parser.Dictionary)
+	require.True(t, ok, "field is not a dictionary")
+	assert.Equal(t, "Sig", field.GetName("FT").Value())
+	assert.Equal(t, "Approval", field.GetString("T"))
+
+	pageDict, err := reader.GetPage(1)
+	require.NoError(t, err)
+	annots, err := reader.ResolveArray(pageDict.Get("Annots"))
+	require.NoError(t, err)
+	require.Equal(t, 1, annots.Len())
+	assert.Equal(t, fields.Get(0), annots.Get(0))
+	assert.Contains(t, stampContent(t, reader, 1), "(Signed copy) Tj")
+
+	sig, ok := reader.ResolveReferences(field.Get("V")).(*parser.Dictionary)
+	require.True(t, ok, "field has no signature dictionary")
+	assert.Equal(t, "Approved", sig.GetString("Reason"))
+	byteRange := sig.GetArray("ByteRange")
+	require.NotNil(t, byteRange)
+	for i, want := range br {
+		got, ok := byteRange.Get(i).(*parser.Integer)
+		require.True(t, ok, "/ByteRange entry %d is not an integer", i)
+		assert.Equal(t, want, got.Value())
+	}
+	contents := hex.EncodeToString(signature)
+	assert.True(t, strings.HasPrefix(strings.ToLower(string(data[br[1]+1:br[2]-1])), contents))
+}
+
+func TestAppender_AddSignatureField_Errors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	require.NoError(t, newSection(t, "Original", 1).WriteToFile(path))
+
+	app, err := NewAppender(path)
+	require.NoError(t, err)
+	defer app.Close()
+
+	assert.Error(t, app.AddSignatureField(SignatureField{}))
+	assert.Error(t, app.AddSignatureField(SignatureField{Name: "Sig", Page: 1}))
+	require.NoError(t, app.AddSignatureField(SignatureField{Name: "Sig"}))
+	assert.Error(t, app.AddSignatureField(SignatureField{Name: "Other"}))
+
+	// The placeholder only survives an incremental update.
+	assert.Error(t, app.WriteToFile(filepath.Join(t.TempDir(), "out.pdf")))
+}
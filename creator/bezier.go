@@ -116,6 +116,7 @@ func (p *Page) DrawBezierCurve(segments []BezierSegment, opts *BezierOptions) er
 	if opts == nil {
 		return errors.New("bezier curve options cannot be nil")
 	}
+	opts = p.defaults.bezier(opts)
 
 	// Validate segments
 	if len(segments) == 0 {
@@ -141,7 +142,7 @@ func (p *Page) DrawBezierCurve(segments []BezierSegment, opts *BezierOptions) er
 	// Store graphics operation
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:       GraphicsOpBezier,
-		BezierSegs: segments,
+		BezierSegs: p.pdfSegments(segments),
 		BezierOpts: opts,
 	})
 
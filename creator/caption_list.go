@@ -0,0 +1,212 @@
+package creator
+
+import (
+	"fmt"
+	"slices"
+)
+
+// CaptionList is a list of figures or a list of tables: the captions of
+// the document's figures of one kind with their page numbers.
+//
+// Like the TOC, the list is generated when the document is written, on a
+// page inserted at the beginning of the document (after the TOC), and its
+// entries link to the figures' pages.
+//
+// Example:
+//
+//	c.EnableListOfFigures()
+//	c.ListOfFigures().SetTitle("Illustrations")
+type CaptionList struct {
+	// Title of the list (default: "List of Figures" or "List of Tables")
+	title string
+
+	// Kind of figures listed
+	kind CaptionKind
+
+	// Entries in document order (set during rendering)
+	entries []CaptionEntry
+
+	// Style options (the TOC style)
+	style TOCStyle
+
+	// Leader character between caption and page number (default: ".")
+	leader string
+}
+
+// CaptionEntry is a single entry of a CaptionList.
+type CaptionEntry struct {
+	// Label is the caption label with the number, e.g. "Figure 3"
+	Label string
+
+	// Caption is the caption text
+	Caption string
+
+	// PageIndex where the figure is drawn (0-based, -1 if not drawn)
+	PageIndex int
+}
+
+// newCaptionList creates an empty list of the given kind.
+func newCaptionList(kind CaptionKind) *CaptionList {
+	title := "List of Figures"
+	if kind == CaptionTable {
+		title = "List of Tables"
+	}
+	return &CaptionList{
+		title:  title,
+		kind:   kind,
+		style:  DefaultTOCStyle(),
+		leader: ".",
+	}
+}
+
+// SetTitle sets the list heading title.
+func (l *CaptionList) SetTitle(title string) {
+	l.title = title
+}
+
+// Title returns the list heading title.
+func (l *CaptionList) Title() string {
+	return l.title
+}
+
+// Kind returns the kind of figures listed.
+func (l *CaptionList) Kind() CaptionKind {
+	return l.kind
+}
+
+// SetStyle sets the list visual style. IndentPerLevel is not used.
+func (l *CaptionList) SetStyle(style TOCStyle) {
+	l.style = style
+}
+
+// Style returns the current list style.
+func (l *CaptionList) Style() TOCStyle {
+	return l.style
+}
+
+// SetLeader sets the leader character (default: ".").
+func (l *CaptionList) SetLeader(leader string) {
+	l.leader = leader
+}
+
+// Entries returns the list entries in document order.
+//
+// Entries are collected when the document is written.
+func (l *CaptionList) Entries() []CaptionEntry {
+	return l.entries
+}
+
+// Height calculates the total height needed for the list.
+func (l *CaptionList) Height(_ *LayoutContext) float64 {
+	height := l.style.TitleSize*1.2 + l.style.SpaceAfterTitle
+	return height + float64(len(l.entries))*l.style.EntrySize*l.style.LineSpacing
+}
+
+// Draw renders the list.
+func (l *CaptionList) Draw(ctx *LayoutContext, page *Page) error {
+	if err := l.toc().drawTitle(ctx, page); err != nil {
+		return fmt.Errorf("failed to draw %s title: %w", l.title, err)
+	}
+
+	for _, entry := range l.entries {
+		if err := l.drawEntry(ctx, page, entry); err != nil {
+			return fmt.Errorf("failed to draw %s entry: %w", l.title, err)
+		}
+	}
+
+	return nil
+}
+
+// drawEntry renders a single entry with a link to the figure's page.
+func (l *CaptionList) drawEntry(ctx *LayoutContext, page *Page, entry CaptionEntry) error {
+	x := ctx.ContentLeft()
+	y := ctx.CurrentPDFY()
+	size := l.style.EntrySize
+
+	text := entry.Label
+	if entry.Caption != "" {
+		text += ": " + entry.Caption
+	}
+
+	if entry.PageIndex >= 0 {
+		text = l.toc().buildEntryWithLeader(text, entry.PageIndex+1, size, 0, ctx)
+		style := LinkStyle{Font: l.style.EntryFont, Size: size, Color: l.style.EntryColor}
+		if err := page.addLinkWithStyle(text, "", entry.PageIndex, true, x, y, style); err != nil {
+			return err
+		}
+	} else if err := page.addTextColor(text, x, y, l.style.EntryFont, size, l.style.EntryColor); err != nil {
+		return err
+	}
+
+	ctx.MoveCursor(0, size*l.style.LineSpacing)
+	return nil
+}
+
+// toc returns a TOC with the list's title, style and leader, whose title
+// and leader rendering the list shares.
+func (l *CaptionList) toc() *TOC {
+	return &TOC{title: l.title, style: l.style, leader: l.leader}
+}
+
+// EnableListOfFigures enables a list of figures: the captions of figures
+// created with NewFigure and NewImageFigure, with page numbers.
+//
+// The list is inserted at the beginning of the document (after the TOC)
+// when it is written, if the document has figures.
+//
+// Example:
+//
+//	c.EnableListOfFigures()
+//	c.Draw(creator.NewImageFigure(chart, 300, 200, "Revenue by region"))
+//	c.WriteToFile("report.pdf") // Page 1 lists "Figure 1: Revenue by region ... 2"
+func (c *Creator) EnableListOfFigures() {
+	c.lofEnabled = true
+}
+
+// EnableListOfTables enables a list of tables: the captions of figures
+// created with NewTableFigure, with page numbers.
+//
+// The list is inserted at the beginning of the document (after the TOC
+// and the list of figures) when it is written, if the document has tables.
+func (c *Creator) EnableListOfTables() {
+	c.lotEnabled = true
+}
+
+// ListOfFigures returns the list of figures for customization.
+func (c *Creator) ListOfFigures() *CaptionList {
+	return c.lof
+}
+
+// ListOfTables returns the list of tables for customization.
+func (c *Creator) ListOfTables() *CaptionList {
+	return c.lot
+}
+
+// numberFigure assigns the next document-wide number of its kind to a
+// figure drawn in the document.
+func (c *Creator) numberFigure(d Drawable) {
+	f := figureOf(d)
+	if f == nil || f.number != 0 {
+		return
+	}
+	c.figureCounts[f.kind]++
+	f.number = c.figureCounts[f.kind]
+	c.figures = append(c.figures, f)
+}
+
+// captionEntries returns the entries of the figures of a kind, with their
+// current page indices.
+func (c *Creator) captionEntries(kind CaptionKind) []CaptionEntry {
+	var entries []CaptionEntry
+	for _, f := range c.figures {
+		if f.kind != kind {
+			continue
+		}
+		entries = append(entries, CaptionEntry{
+			Label:     f.Label(),
+			Caption:   f.caption,
+			PageIndex: slices.Index(c.pages, f.page),
+		})
+	}
+	return entries
+}
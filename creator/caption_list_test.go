@@ -0,0 +1,94 @@
+package creator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreator_ListOfFigures(t *testing.T) {
+	c := New()
+	c.EnableListOfFigures()
+	c.EnableListOfTables()
+
+	_ = c.Draw(NewFigure(NewParagraph("Chart"), "Revenue"))
+	_ = c.Draw(NewPageBreak())
+	_ = c.Draw(NewTableFigure(NewParagraph("Cells"), "Results"))
+	_ = c.Draw(NewFigure(NewParagraph("Map"), "Regions"))
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+
+	// Lists of figures and tables, then the two content pages.
+	if c.PageCount() != 4 || len(c.pages) != 4 {
+		t.Fatalf("pages = %d (document %d), want 4", len(c.pages), c.PageCount())
+	}
+
+	figures := c.ListOfFigures().Entries()
+	if len(figures) != 2 {
+		t.Fatalf("figure entries = %d, want 2", len(figures))
+	}
+	verifyCaptionEntry(t, figures[0], "Figure 1", "Revenue", 2)
+	verifyCaptionEntry(t, figures[1], "Figure 2", "Regions", 3)
+
+	tables := c.ListOfTables().Entries()
+	if len(tables) != 1 {
+		t.Fatalf("table entries = %d, want 1", len(tables))
+	}
+	verifyCaptionEntry(t, tables[0], "Table 1", "Results", 3)
+
+	// The list pages show the title and the entries with page numbers.
+	ops := c.pages[0].TextOperations()
+	if ops[0].Text != "List of Figures" {
+		t.Errorf("title = %q, want %q", ops[0].Text, "List of Figures")
+	}
+	if !strings.HasPrefix(ops[1].Text, "Figure 1: Revenue .") || !strings.HasSuffix(ops[1].Text, " 3") {
+		t.Errorf("entry = %q, want Figure 1 on page 3", ops[1].Text)
+	}
+	if c.pages[1].TextOperations()[0].Text != "List of Tables" {
+		t.Error("second page is not the list of tables")
+	}
+}
+
+func TestCreator_ListOfFigures_NoFigures(t *testing.T) {
+	c := New()
+	c.EnableListOfFigures()
+	_ = c.Draw(NewParagraph("Text"))
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+	if c.PageCount() != 1 {
+		t.Errorf("PageCount() = %d, want 1 (no empty list)", c.PageCount())
+	}
+}
+
+func TestCreator_ListOfFigures_Chapters(t *testing.T) {
+	c := New()
+	c.EnableTOC()
+	c.EnableListOfFigures()
+
+	ch := NewChapter("Results")
+	ch.Add(NewFigure(NewParagraph("Chart"), "Growth"))
+	_ = c.AddChapter(ch)
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+
+	// TOC, list of figures, chapter.
+	if len(c.pages) != 3 || c.PageCount() != 3 {
+		t.Fatalf("pages = %d (document %d), want 3", len(c.pages), c.PageCount())
+	}
+	if ch.PageIndex() != 2 {
+		t.Errorf("chapter page index = %d, want 2", ch.PageIndex())
+	}
+	verifyCaptionEntry(t, c.ListOfFigures().Entries()[0], "Figure 1", "Growth", 2)
+}
+
+func verifyCaptionEntry(t *testing.T, entry CaptionEntry, label, caption string, pageIndex int) {
+	t.Helper()
+	if entry.Label != label || entry.Caption != caption || entry.PageIndex != pageIndex {
+		t.Errorf("entry = %+v, want %s: %s on page index %d", entry, label, caption, pageIndex)
+	}
+}
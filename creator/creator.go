@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"time"
 
 	"github.com/coregx/gxpdf/internal/document"
 	"github.com/coregx/gxpdf/internal/fonts"
@@ -67,6 +69,11 @@ type Creator struct {
 	footerHeight    float64
 	skipHeaderFirst bool
 	skipFooterFirst bool
+	headerSeparator *SeparatorLine
+	footerSeparator *SeparatorLine
+
+	// Content repeated on every page (see AddRunningElement)
+	runningElements []RunningElementFunc
 
 	// Encryption options (set via SetEncryption)
 	encryptionOpts *EncryptionOptions
@@ -78,8 +85,55 @@ type Creator struct {
 	tocEnabled bool
 	toc        *TOC
 
+	// Lists of figures and tables
+	lofEnabled   bool
+	lotEnabled   bool
+	lof          *CaptionList
+	lot          *CaptionList
+	figures      []*Figure // Numbered figures in document order
+	figureCounts [2]int    // Figures numbered per CaptionKind
+
+	// Cross-references (see Ref)
+	references       []*Reference
+	referenceTargets []referenceTarget
+
+	// Back-of-book index (see GenerateIndex)
+	indexEnabled bool
+	index        *Index
+	indexMarks   []*IndexMark
+
 	// Chapters (document structure)
 	chapters []*Chapter
+
+	// Document flow state (used by Draw)
+	flowPage      *Page
+	flowCtx       *LayoutContext
+	flowFootnotes []*Footnote
+	blockSpacing  float64 // Space between drawables (see SetBlockSpacing)
+	onNewPage     func(page *Page, pageNum int)
+
+	// Footnotes
+	footnoteFont  FontName
+	footnoteSize  float64
+	footnoteCount int
+
+	// Active theme (nil = default theme)
+	theme *Theme
+
+	// Decimal places for numbers in content streams
+	precision int
+
+	// Custom fonts registered with RegisterFont
+	registeredFonts []fontRegistration
+
+	// Reject custom font text with missing glyphs (see SetStrictFonts)
+	strictFonts bool
+
+	// Draw debug grids requested with Page.DrawDebugGrid (see SetDebugMode)
+	debugMode bool
+
+	// Measure page coordinates from the top-left corner (see SetOriginTopLeft)
+	originTopLeft bool
 }
 
 // Margins represents page margins in points (1 point = 1/72 inch).
@@ -117,7 +171,11 @@ func New() *Creator {
 		bookmarks:    make([]Bookmark, 0),
 		tocEnabled:   false,
 		toc:          NewTOC(),
+		lof:          newCaptionList(CaptionFigure),
+		lot:          newCaptionList(CaptionTable),
+		index:        newIndex(),
 		chapters:     make([]*Chapter, 0),
+		precision:    writer.DefaultPrecision,
 	}
 }
 
@@ -139,12 +197,7 @@ func (c *Creator) NewPage() (*Page, error) {
 	}
 
 	// Wrap domain page in creator page
-	creatorPage := &Page{
-		page:        domainPage,
-		margins:     c.defaultMargins,
-		textOps:     make([]TextOperation, 0),
-		graphicsOps: make([]GraphicsOperation, 0),
-	}
+	creatorPage := c.newCreatorPage(domainPage)
 
 	// Track creator page
 	c.pages = append(c.pages, creatorPage)
@@ -166,12 +219,7 @@ func (c *Creator) NewPageWithSize(size PageSize) (*Page, error) {
 		return nil, fmt.Errorf("failed to add page: %w", err)
 	}
 
-	creatorPage := &Page{
-		page:        domainPage,
-		margins:     c.defaultMargins,
-		textOps:     make([]TextOperation, 0),
-		graphicsOps: make([]GraphicsOperation, 0),
-	}
+	creatorPage := c.newCreatorPage(domainPage)
 
 	// Track creator page
 	c.pages = append(c.pages, creatorPage)
@@ -179,6 +227,35 @@ func (c *Creator) NewPageWithSize(size PageSize) (*Page, error) {
 	return creatorPage, nil
 }
 
+// newCreatorPage wraps a domain page in a creator page with the creator's
+// page settings.
+func (c *Creator) newCreatorPage(domainPage *document.Page) *Page {
+	return &Page{
+		page:          domainPage,
+		margins:       c.defaultMargins,
+		theme:         c.theme,
+		strictFonts:   c.strictFonts,
+		originTopLeft: c.originTopLeft,
+		blockSpacing:  c.blockSpacing,
+		textOps:       make([]TextOperation, 0),
+		graphicsOps:   make([]GraphicsOperation, 0),
+	}
+}
+
+// insertPage inserts a page with the default size at index, moving the
+// pages from index on back by one.
+func (c *Creator) insertPage(index int) (*Page, error) {
+	domainPage, err := c.doc.InsertPage(index, c.defaultPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert page: %w", err)
+	}
+
+	creatorPage := c.newCreatorPage(domainPage)
+	c.pages = slices.Insert(c.pages, index, creatorPage)
+
+	return creatorPage, nil
+}
+
 // SetPageSize sets the default page size for new pages.
 //
 // This affects all pages added after calling this method.
@@ -259,6 +336,100 @@ func (c *Creator) SetKeywords(keywords ...string) {
 	c.doc.SetMetadata("", "", "", keywords...)
 }
 
+// SetCreator sets the application that created the original content, e.g.
+// the name of your product (default "gxpdf"). An empty string leaves the
+// entry out of the document.
+//
+// Example:
+//
+//	c.SetCreator("Acme Invoicing")
+func (c *Creator) SetCreator(creator string) {
+	c.doc.SetCreator(creator)
+}
+
+// SetProducer sets the application that produced the PDF (default
+// "gxpdf (github.com/coregx/gxpdf)"). An empty string leaves the entry out
+// of the document.
+//
+// Example:
+//
+//	c.SetProducer("") // Do not name the library.
+func (c *Creator) SetProducer(producer string) {
+	c.doc.SetProducer(producer)
+}
+
+// SetCreationDate sets the document creation date (default: the time the
+// creator was made). Useful for reproducible output.
+//
+// Example:
+//
+//	c.SetCreationDate(time.Date(2025, 1, 27, 12, 30, 0, 0, time.UTC))
+func (c *Creator) SetCreationDate(t time.Time) {
+	c.doc.SetCreationDate(t)
+}
+
+// SetPrecision sets the number of decimal places written for coordinates
+// and lengths in page content (default: 2, range: 0 to 6).
+//
+// Two decimals (1/7200 inch) is finer than any output device; lower values
+// shrink graphics-heavy pages, higher values suit very small scales. Colors
+// and the scale, rotation and skew terms of transformations are always
+// written with at least 4 decimals.
+//
+// Example:
+//
+//	c.SetPrecision(1)
+func (c *Creator) SetPrecision(digits int) {
+	c.precision = max(0, min(digits, writer.MaxPrecision))
+}
+
+// Precision returns the number of decimal places written in page content.
+func (c *Creator) Precision() int {
+	return c.precision
+}
+
+// SetStrictFonts sets whether text in custom fonts must have a glyph for
+// every character.
+//
+// By default, characters missing from a font are drawn as the font's
+// missing-glyph box. In strict mode, AddTextCustomFont, AddTextCustomFontColor
+// and DrawTextClipped return a *MissingGlyphsError listing the characters
+// instead, and draw nothing. The setting applies to all pages.
+//
+// Example:
+//
+//	c.SetStrictFonts(true)
+//	err := page.AddTextCustomFont("Total: 42 €", 100, 700, font, 12)
+//	var missing *creator.MissingGlyphsError
+//	if errors.As(err, &missing) {
+//	    log.Printf("cannot render %q", string(missing.Runes))
+//	}
+func (c *Creator) SetStrictFonts(strict bool) {
+	c.strictFonts = strict
+	for _, page := range c.pages {
+		page.strictFonts = strict
+	}
+}
+
+// SetDebugMode sets whether layout debugging aids are drawn.
+//
+// In debug mode, pages draw the coordinate grids requested with
+// Page.DrawDebugGrid. Debug mode is off by default, so grids can stay in
+// the code and be switched on while working on a layout.
+//
+// Example:
+//
+//	c.SetDebugMode(os.Getenv("PDF_DEBUG") != "")
+//	page.DrawDebugGrid(50)
+func (c *Creator) SetDebugMode(enabled bool) {
+	c.debugMode = enabled
+}
+
+// DebugMode reports whether layout debugging aids are drawn.
+func (c *Creator) DebugMode() bool {
+	return c.debugMode
+}
+
 // SetHeaderFunc sets the function to render headers on each page.
 //
 // The function is called once for each page during PDF generation.
@@ -292,6 +463,34 @@ func (c *Creator) SetFooterFunc(f FooterFunc) {
 	c.footerFunc = f
 }
 
+// SetFooterText sets a centered footer line built from a template.
+//
+// The template may contain these tokens, substituted on every page:
+//   - {page}: the current page number (1-based)
+//   - {pages}: the total number of pages
+//   - {title}: the document title
+//
+// Zero style fields use defaults (Helvetica, 10pt). This replaces any
+// footer function; use SetFooterFunc for custom layouts.
+//
+// Example:
+//
+//	c.SetFooterText("Page {page} of {pages}", TextStyle{Size: 9, Color: Gray})
+func (c *Creator) SetFooterText(template string, style TextStyle) {
+	if style.Font == "" {
+		style.Font = Helvetica
+	}
+	if style.Size <= 0 {
+		style.Size = 10
+	}
+
+	c.footerFunc = func(args FooterFunctionArgs) {
+		text := expandPageTokens(template, args.PageNum, args.TotalPages, c.doc.Title())
+		p := NewParagraph(text).SetFont(style.Font, style.Size).SetColor(style.Color).SetAlignment(AlignCenter)
+		_ = args.Block.Draw(p)
+	}
+}
+
 // SetHeaderHeight sets the height reserved for headers in points.
 //
 // Default: 50 points.
@@ -314,6 +513,32 @@ func (c *Creator) SetFooterHeight(h float64) {
 	c.footerHeight = h
 }
 
+// SetHeaderSeparator sets a rule drawn along the bottom of the header area.
+//
+// The rule is drawn on every page that has a header area, i.e. all pages
+// except the first when SetSkipHeaderOnFirstPage is enabled.
+// Pass nil to remove it.
+//
+// Example:
+//
+//	c.SetHeaderSeparator(&SeparatorLine{Color: LightGray, Width: 0.5})
+func (c *Creator) SetHeaderSeparator(line *SeparatorLine) {
+	c.headerSeparator = line
+}
+
+// SetFooterSeparator sets a rule drawn along the top of the footer area.
+//
+// The rule is drawn on every page that has a footer area, i.e. all pages
+// except the first when SetSkipFooterOnFirstPage is enabled.
+// Pass nil to remove it.
+//
+// Example:
+//
+//	c.SetFooterSeparator(&SeparatorLine{Color: LightGray, Width: 0.5})
+func (c *Creator) SetFooterSeparator(line *SeparatorLine) {
+	c.footerSeparator = line
+}
+
 // HeaderHeight returns the current header height in points.
 func (c *Creator) HeaderHeight() float64 {
 	return c.headerHeight
@@ -361,6 +586,15 @@ func (c *Creator) PageCount() int {
 	return c.doc.PageCount()
 }
 
+// CurrentPage returns the page that content is currently added to (the
+// last page). If the document has no pages yet, a new page is created.
+func (c *Creator) CurrentPage() (*Page, error) {
+	if len(c.pages) == 0 {
+		return c.NewPage()
+	}
+	return c.pages[len(c.pages)-1], nil
+}
+
 // EnableTOC enables automatic Table of Contents generation.
 //
 // When enabled, the TOC will be inserted at the beginning of the document
@@ -498,6 +732,11 @@ func (c *Creator) WriteToFileContext(ctx context.Context, path string) error {
 		return fmt.Errorf("context canceled before PDF generation: %w", err)
 	}
 
+	// Complete the document flow (pending footnotes).
+	if err := c.finishFlow(); err != nil {
+		return fmt.Errorf("failed to finish document flow: %w", err)
+	}
+
 	// Render TOC and chapters if enabled.
 	if err := c.renderTOCAndChapters(); err != nil {
 		return fmt.Errorf("failed to render TOC and chapters: %w", err)
@@ -529,6 +768,8 @@ func (c *Creator) WriteToFileContext(ctx context.Context, path string) error {
 		}
 	}()
 
+	w.SetPrecision(c.precision)
+
 	// Write document with page content (text and graphics).
 	textContents, graphicsContents := c.collectAllPageContents()
 	if err := w.WriteWithAllContent(c.doc, textContents, graphicsContents); err != nil {
@@ -576,6 +817,11 @@ func (c *Creator) WriteToContext(ctx context.Context, w io.Writer) (int64, error
 		return 0, fmt.Errorf("context canceled before PDF generation: %w", err)
 	}
 
+	// Complete the document flow (pending footnotes).
+	if err := c.finishFlow(); err != nil {
+		return 0, fmt.Errorf("failed to finish document flow: %w", err)
+	}
+
 	// Render TOC and chapters if enabled.
 	if err := c.renderTOCAndChapters(); err != nil {
 		return 0, fmt.Errorf("failed to render TOC and chapters: %w", err)
@@ -602,6 +848,7 @@ func (c *Creator) WriteToContext(ctx context.Context, w io.Writer) (int64, error
 	// Create PDF writer for io.Writer.
 	pdfWriter := writer.NewPdfWriterFromWriter(cw)
 	defer pdfWriter.Close()
+	pdfWriter.SetPrecision(c.precision)
 
 	// Write document with page content.
 	textContents, graphicsContents := c.collectAllPageContents()
@@ -650,40 +897,83 @@ func (c *Creator) collectAllPageContents() (map[int][]writer.TextOp, map[int][]w
 	textContents := make(map[int][]writer.TextOp)
 	graphicsContents := make(map[int][]writer.GraphicsOp)
 	totalPages := len(c.pages)
+	subsets := c.newFontSubsets()
 
 	for i, creatorPage := range c.pages {
-		pageNum := i + 1 // 1-based page number
-
-		// Collect page text/graphics operations.
-		var pageTextOps []TextOperation
-		var pageGraphicsOps []GraphicsOperation
-
-		// Add header content.
-		if c.headerFunc != nil && !c.shouldSkipHeader(pageNum) {
-			headerOps := c.renderHeader(creatorPage, pageNum, totalPages)
-			pageTextOps = append(pageTextOps, headerOps...)
+		textOps, graphicsOps := c.pageContents(creatorPage, i+1, totalPages, subsets)
+		if len(textOps) > 0 {
+			textContents[i] = textOps
 		}
-
-		// Add main page content.
-		pageTextOps = append(pageTextOps, creatorPage.textOps...)
-		pageGraphicsOps = append(pageGraphicsOps, creatorPage.graphicsOps...)
-
-		// Add footer content.
-		if c.footerFunc != nil && !c.shouldSkipFooter(pageNum) {
-			footerOps := c.renderFooter(creatorPage, pageNum, totalPages)
-			pageTextOps = append(pageTextOps, footerOps...)
+		if len(graphicsOps) > 0 {
+			graphicsContents[i] = graphicsOps
 		}
+	}
+
+	return textContents, graphicsContents
+}
 
-		// Convert to writer operations.
-		if len(pageTextOps) > 0 {
-			textContents[i] = convertTextOps(pageTextOps)
+// pageContents renders the header, content, running elements, separators,
+// footer and debug grid of a page as writer operations. pageNum is
+// 1-based; totalPages is passed to the header and footer functions.
+func (c *Creator) pageContents(creatorPage *Page, pageNum, totalPages int, subsets fontSubsets) ([]writer.TextOp, []writer.GraphicsOp) {
+	// Collect page text/graphics operations.
+	var pageTextOps []TextOperation
+	var pageGraphicsOps []GraphicsOperation
+
+	// Add header content.
+	if c.headerFunc != nil && !c.shouldSkipHeader(pageNum) {
+		headerOps := c.renderHeader(creatorPage, pageNum, totalPages)
+		pageTextOps = append(pageTextOps, headerOps...)
+	}
+
+	// Add main page content.
+	mainTextStart := len(pageTextOps)
+	pageTextOps = append(pageTextOps, creatorPage.textOps...)
+	pageGraphicsOps = append(pageGraphicsOps, creatorPage.GraphicsOperations()...)
+	mainTextEnd, mainGraphicsEnd := len(pageTextOps), len(pageGraphicsOps)
+
+	// Add running elements over the page content.
+	runningText, runningGraphics := c.renderRunningElements(creatorPage, pageNum, totalPages)
+	pageTextOps = append(pageTextOps, runningText...)
+	pageGraphicsOps = append(pageGraphicsOps, runningGraphics...)
+
+	// Add header and footer separators on top of the page content.
+	if c.headerSeparator != nil && !c.shouldSkipHeader(pageNum) {
+		y := creatorPage.Height() - creatorPage.margins.Top - c.headerHeight
+		pageGraphicsOps = append(pageGraphicsOps, c.headerSeparator.lineOp(creatorPage, y))
+	}
+	if c.footerSeparator != nil && !c.shouldSkipFooter(pageNum) {
+		y := creatorPage.margins.Bottom + c.footerHeight
+		pageGraphicsOps = append(pageGraphicsOps, c.footerSeparator.lineOp(creatorPage, y))
+	}
+
+	// Add footer content.
+	if c.footerFunc != nil && !c.shouldSkipFooter(pageNum) {
+		footerOps := c.renderFooter(creatorPage, pageNum, totalPages)
+		pageTextOps = append(pageTextOps, footerOps...)
+	}
+
+	// Add the debug grid over all content.
+	if c.debugMode && creatorPage.debugGrid > 0 {
+		gridGraphics, gridText := creatorPage.debugGridOps()
+		pageGraphicsOps = append(pageGraphicsOps, gridGraphics...)
+		pageTextOps = append(pageTextOps, gridText...)
+	}
+
+	// Convert to writer operations, transforming the main page content
+	// (see AppendDocumentWithTransform).
+	textOps := convertTextOps(pageTextOps, subsets)
+	graphicsOps := convertGraphicsOps(pageGraphicsOps, subsets)
+	if m := creatorPage.contentMatrix(); m != nil {
+		for j := mainTextStart; j < mainTextEnd; j++ {
+			textOps[j].Matrix = m
 		}
-		if len(pageGraphicsOps) > 0 {
-			graphicsContents[i] = convertGraphicsOps(pageGraphicsOps)
+		for j := 0; j < mainGraphicsEnd; j++ {
+			graphicsOps[j].Matrix = m
 		}
 	}
 
-	return textContents, graphicsContents
+	return textOps, graphicsOps
 }
 
 // shouldSkipHeader returns true if header should be skipped for the given page.
@@ -813,7 +1103,9 @@ func measureLineWidth(p *Paragraph, line string) float64 {
 }
 
 // convertTextOps converts creator text operations to writer text operations.
-func convertTextOps(ops []TextOperation) []writer.TextOp {
+//
+// Characters drawn with custom fonts are added to the document's subsets.
+func convertTextOps(ops []TextOperation, subsets fontSubsets) []writer.TextOp {
 	textOps := make([]writer.TextOp, 0, len(ops))
 	for _, op := range ops {
 		textOp := writer.TextOp{
@@ -827,11 +1119,7 @@ func convertTextOps(ops []TextOperation) []writer.TextOp {
 
 		// Handle custom embedded font.
 		if op.CustomFont != nil {
-			textOp.CustomFont = &writer.EmbeddedFont{
-				TTF:    op.CustomFont.GetTTF(),
-				Subset: op.CustomFont.GetSubset(),
-				ID:     op.CustomFont.ID(),
-			}
+			textOp.CustomFont = subsets.embed(op.CustomFont, op.Text)
 			textOp.Font = "" // Clear standard font when using custom.
 		}
 
@@ -851,7 +1139,9 @@ func convertTextOps(ops []TextOperation) []writer.TextOp {
 }
 
 // convertGraphicsOps converts creator graphics operations to writer graphics operations.
-func convertGraphicsOps(ops []GraphicsOperation) []writer.GraphicsOp {
+//
+// Characters drawn with custom fonts are added to the document's subsets.
+func convertGraphicsOps(ops []GraphicsOperation, subsets fontSubsets) []writer.GraphicsOp {
 	graphicsOps := make([]writer.GraphicsOp, 0, len(ops))
 	for _, op := range ops {
 		gop := writer.GraphicsOp{
@@ -888,14 +1178,33 @@ func convertGraphicsOps(ops []GraphicsOperation) []writer.GraphicsOp {
 			}
 		}
 
+		// Convert path (transform applied to coordinates)
+		if op.Path != nil {
+			var t *Transform
+			if op.PathOpts != nil {
+				t = op.PathOpts.Transform
+			}
+			gop.PathSegments = convertPath(op.Path, t)
+		}
+
+		if op.Image != nil {
+			gop.Image = op.Image.embedded()
+			if box := op.ImageBox; box != nil {
+				gop.ImageBox = &writer.ImageBox{
+					X:      box.X,
+					Y:      box.Y,
+					Width:  box.Width,
+					Height: box.Height,
+					Angle:  box.Angle,
+					Clip:   box.Clip,
+				}
+			}
+		}
+
 		// Convert TextBlock fields
 		if op.Type == GraphicsOpTextBlock && op.TextFont != nil {
 			gop.Text = op.Text
-			gop.TextFont = &writer.EmbeddedFont{
-				TTF:    op.TextFont.GetTTF(),
-				Subset: op.TextFont.GetSubset(),
-				ID:     op.TextFont.ID(),
-			}
+			gop.TextFont = subsets.embed(op.TextFont, op.Text)
 			gop.TextSize = op.TextSize
 			if op.TextColor != nil {
 				gop.TextColorR = op.TextColor.R
@@ -953,6 +1262,11 @@ func convertGraphicsOptions(gop *writer.GraphicsOp, op *GraphicsOperation) {
 	if op.BezierOpts != nil {
 		convertBezierOptions(gop, op.BezierOpts)
 	}
+
+	// Path options
+	if op.PathOpts != nil {
+		convertPathOptions(gop, op.PathOpts)
+	}
 }
 
 // convertRectOptions converts rectangle options.
@@ -969,6 +1283,9 @@ func convertRectOptions(gop *writer.GraphicsOp, opts *RectOptions) {
 	if opts.FillColorCMYK != nil {
 		gop.FillColorCMYK = &writer.CMYK{C: opts.FillColorCMYK.C, M: opts.FillColorCMYK.M, Y: opts.FillColorCMYK.Y, K: opts.FillColorCMYK.K}
 	}
+	if opts.FillColorIndexed != nil {
+		gop.FillColorIndexed = writerIndexedColor(opts.FillColorIndexed)
+	}
 	if opts.FillGradient != nil {
 		gop.FillGradient = convertGradient(opts.FillGradient)
 	}
@@ -992,6 +1309,9 @@ func convertCircleOptions(gop *writer.GraphicsOp, opts *CircleOptions) {
 	if opts.FillColorCMYK != nil {
 		gop.FillColorCMYK = &writer.CMYK{C: opts.FillColorCMYK.C, M: opts.FillColorCMYK.M, Y: opts.FillColorCMYK.Y, K: opts.FillColorCMYK.K}
 	}
+	if opts.FillColorIndexed != nil {
+		gop.FillColorIndexed = writerIndexedColor(opts.FillColorIndexed)
+	}
 	if opts.FillGradient != nil {
 		gop.FillGradient = convertGradient(opts.FillGradient)
 	}
@@ -1043,6 +1363,9 @@ func convertPolygonOptions(gop *writer.GraphicsOp, opts *PolygonOptions) {
 	if opts.FillColorCMYK != nil {
 		gop.FillColorCMYK = &writer.CMYK{C: opts.FillColorCMYK.C, M: opts.FillColorCMYK.M, Y: opts.FillColorCMYK.Y, K: opts.FillColorCMYK.K}
 	}
+	if opts.FillColorIndexed != nil {
+		gop.FillColorIndexed = writerIndexedColor(opts.FillColorIndexed)
+	}
 	if opts.FillGradient != nil {
 		gop.FillGradient = convertGradient(opts.FillGradient)
 	}
@@ -1078,6 +1401,9 @@ func convertEllipseOptions(gop *writer.GraphicsOp, opts *EllipseOptions) {
 	if opts.FillColorCMYK != nil {
 		gop.FillColorCMYK = &writer.CMYK{C: opts.FillColorCMYK.C, M: opts.FillColorCMYK.M, Y: opts.FillColorCMYK.Y, K: opts.FillColorCMYK.K}
 	}
+	if opts.FillColorIndexed != nil {
+		gop.FillColorIndexed = writerIndexedColor(opts.FillColorIndexed)
+	}
 	if opts.FillGradient != nil {
 		gop.FillGradient = convertGradient(opts.FillGradient)
 	}
@@ -1103,44 +1429,139 @@ func convertBezierOptions(gop *writer.GraphicsOp, opts *BezierOptions) {
 	}
 }
 
-// renderTOCAndChapters renders the Table of Contents and all chapters.
+// convertPathOptions converts path options.
+func convertPathOptions(gop *writer.GraphicsOp, opts *PathOptions) {
+	if opts.StrokeColor != nil {
+		gop.StrokeColor = &writer.RGB{R: opts.StrokeColor.R, G: opts.StrokeColor.G, B: opts.StrokeColor.B}
+	}
+	if opts.StrokeColorCMYK != nil {
+		gop.StrokeColorCMYK = &writer.CMYK{C: opts.StrokeColorCMYK.C, M: opts.StrokeColorCMYK.M, Y: opts.StrokeColorCMYK.Y, K: opts.StrokeColorCMYK.K}
+	}
+	if opts.FillColor != nil {
+		gop.FillColor = &writer.RGB{R: opts.FillColor.R, G: opts.FillColor.G, B: opts.FillColor.B}
+	}
+	if opts.FillColorCMYK != nil {
+		gop.FillColorCMYK = &writer.CMYK{C: opts.FillColorCMYK.C, M: opts.FillColorCMYK.M, Y: opts.FillColorCMYK.Y, K: opts.FillColorCMYK.K}
+	}
+	if opts.FillColorIndexed != nil {
+		gop.FillColorIndexed = writerIndexedColor(opts.FillColorIndexed)
+	}
+	gop.StrokeWidth = opts.StrokeWidth
+	gop.EvenOdd = opts.FillRule == FillRuleEvenOdd
+	gop.Dashed = opts.Dashed
+	gop.DashArray = opts.DashArray
+	gop.DashPhase = opts.DashPhase
+}
+
+// convertPath converts path commands to writer path segments, mapping
+// coordinates through t (nil = identity).
+func convertPath(path *Path, t *Transform) []writer.PathSegment {
+	point := func(x, y float64) writer.Point {
+		if t != nil {
+			x, y = t.TransformPoint(x, y)
+		}
+		return writer.Point{X: x, Y: y}
+	}
+
+	segs := make([]writer.PathSegment, 0, len(path.commands))
+	for _, cmd := range path.commands {
+		a := cmd.args
+		switch cmd.op {
+		case pathOpMoveTo:
+			segs = append(segs, writer.PathSegment{Op: 'm', Points: []writer.Point{point(a[0], a[1])}})
+		case pathOpLineTo:
+			segs = append(segs, writer.PathSegment{Op: 'l', Points: []writer.Point{point(a[0], a[1])}})
+		case pathOpCubicTo:
+			segs = append(segs, writer.PathSegment{Op: 'c', Points: []writer.Point{
+				point(a[0], a[1]), point(a[2], a[3]), point(a[4], a[5]),
+			}})
+		case pathOpClose:
+			segs = append(segs, writer.PathSegment{Op: 'h'})
+		case pathOpRect:
+			// Expanded so that rotating transforms stay correct.
+			x, y, w, h := a[0], a[1], a[2], a[3]
+			segs = append(segs,
+				writer.PathSegment{Op: 'm', Points: []writer.Point{point(x, y)}},
+				writer.PathSegment{Op: 'l', Points: []writer.Point{point(x+w, y)}},
+				writer.PathSegment{Op: 'l', Points: []writer.Point{point(x+w, y+h)}},
+				writer.PathSegment{Op: 'l', Points: []writer.Point{point(x, y+h)}},
+				writer.PathSegment{Op: 'h'},
+			)
+		}
+	}
+	return segs
+}
+
+// renderTOCAndChapters renders the Table of Contents, the lists of figures
+// and tables, all chapters, the index, and the cross-references.
 //
 // This is called automatically before writing the PDF.
 // It performs a two-pass rendering:
 // 1. First pass: Render all chapters and record page indices
-// 2. Second pass: Render TOC with correct page numbers
+// 2. Second pass: Insert the front matter pages (TOC, lists of figures
+// and tables) at the beginning and render them with the final page numbers
+// 3. Append the index (see GenerateIndex)
+// 4. Resolve cross-references (see Ref)
 func (c *Creator) renderTOCAndChapters() error {
-	// Nothing to do if no chapters
-	if len(c.chapters) == 0 {
-		return nil
-	}
-
 	// First pass: Render all chapters and record page indices
-	chapterPages := make([]*Page, 0)
 	for _, ch := range c.chapters {
-		pages, err := c.renderChapter(ch)
-		if err != nil {
+		if _, err := c.renderChapter(ch); err != nil {
 			return fmt.Errorf("failed to render chapter: %w", err)
 		}
-		chapterPages = append(chapterPages, pages...)
 	}
 
-	// Second pass: Render TOC if enabled
-	if c.tocEnabled {
-		tocPages, err := c.renderTOC()
+	// Second pass: Render front matter
+	if err := c.renderFrontMatter(); err != nil {
+		return err
+	}
+
+	// Append the index
+	if err := c.renderIndex(); err != nil {
+		return fmt.Errorf("failed to render index: %w", err)
+	}
+
+	// Resolve cross-references with the final page numbers
+	return c.resolveReferences()
+}
+
+// renderFrontMatter inserts the TOC and the lists of figures and tables at
+// the beginning of the document.
+func (c *Creator) renderFrontMatter() error {
+	var front []Drawable
+	if c.tocEnabled && len(c.chapters) > 0 {
+		c.toc.setChapters(c.chapters)
+		front = append(front, c.toc)
+	}
+	if c.lofEnabled && c.figureCounts[CaptionFigure] > 0 {
+		front = append(front, c.lof)
+	}
+	if c.lotEnabled && c.figureCounts[CaptionTable] > 0 {
+		front = append(front, c.lot)
+	}
+	if len(front) == 0 {
+		return nil
+	}
+
+	// Insert the front matter pages first, so that chapter and figure
+	// page numbers account for them.
+	pages := make([]*Page, len(front))
+	for i := range front {
+		page, err := c.insertPage(i)
 		if err != nil {
-			return fmt.Errorf("failed to render TOC: %w", err)
+			return fmt.Errorf("failed to create front matter page: %w", err)
 		}
+		pages[i] = page
+	}
+	for _, ch := range c.chapters {
+		c.updateChapterPageIndices(ch, len(front))
+	}
+	c.lof.entries = c.captionEntries(CaptionFigure)
+	c.lot.entries = c.captionEntries(CaptionTable)
 
-		// Insert TOC pages at the beginning
-		// Update chapter page indices to account for TOC pages
-		tocPageCount := len(tocPages)
-		for _, ch := range c.chapters {
-			c.updateChapterPageIndices(ch, tocPageCount)
+	for i, d := range front {
+		if err := d.Draw(pages[i].GetLayoutContext(), pages[i]); err != nil {
+			return fmt.Errorf("failed to draw front matter: %w", err)
 		}
-
-		// Prepend TOC pages to the pages list
-		c.pages = append(tocPages, c.pages...)
 	}
 
 	return nil
@@ -1157,6 +1578,14 @@ func (c *Creator) renderChapter(ch *Chapter) ([]*Page, error) {
 	// Record page index for this chapter
 	ch.setPageIndex(len(c.pages) - 1)
 
+	// Number the chapter's figures in document order and record its
+	// cross-references and index marks
+	for _, chapter := range ch.GetAllChapters() {
+		for _, d := range chapter.content {
+			c.trackDrawable(d)
+		}
+	}
+
 	// Get layout context
 	ctx := page.GetLayoutContext()
 
@@ -1169,28 +1598,6 @@ func (c *Creator) renderChapter(ch *Chapter) ([]*Page, error) {
 	return []*Page{page}, nil
 }
 
-// renderTOC renders the Table of Contents.
-func (c *Creator) renderTOC() ([]*Page, error) {
-	// Set chapters in TOC
-	c.toc.setChapters(c.chapters)
-
-	// Create new page for TOC
-	page, err := c.NewPage()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create page for TOC: %w", err)
-	}
-
-	// Get layout context
-	ctx := page.GetLayoutContext()
-
-	// Draw TOC
-	if err := c.toc.Draw(ctx, page); err != nil {
-		return nil, fmt.Errorf("failed to draw TOC: %w", err)
-	}
-
-	return []*Page{page}, nil
-}
-
 // updateChapterPageIndices updates page indices for chapter and sub-chapters.
 func (c *Creator) updateChapterPageIndices(ch *Chapter, offset int) {
 	if ch.PageIndex() >= 0 {
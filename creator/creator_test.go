@@ -1,8 +1,14 @@
 package creator
 
 import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/coregx/gxpdf/internal/parser"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -180,3 +186,132 @@ func TestCreator_MultiplePages(t *testing.T) {
 
 	assert.Equal(t, 3, c.PageCount())
 }
+
+func TestCreator_SetPrecision(t *testing.T) {
+	c := New()
+	assert.Equal(t, 2, c.Precision())
+
+	c.SetPrecision(9)
+	assert.Equal(t, 6, c.Precision())
+	c.SetPrecision(-1)
+	assert.Equal(t, 0, c.Precision())
+
+	render := func(digits int) int {
+		c := New()
+		c.SetPrecision(digits)
+		page, err := c.NewPage()
+		require.NoError(t, err)
+		for i := 0; i < 200; i++ {
+			x := float64(i) * 1.23456789
+			require.NoError(t, page.DrawLine(x, x/3, x+7.777, x/7, &LineOptions{Color: Black, Width: 0.333}))
+		}
+		data, err := c.Bytes()
+		require.NoError(t, err)
+		return len(data)
+	}
+
+	assert.Less(t, render(0), render(6), "lower precision should produce smaller output")
+}
+
+func TestCreator_UnicodeMetadataRoundTrip(t *testing.T) {
+	c := New()
+	c.SetTitle("Годовой отчёт")
+	c.SetAuthor("山田太郎")
+	c.SetSubject("Plain (ASCII) subject")
+	c.SetKeywords("финансы", "2025")
+	_, err := c.NewPage()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "unicode.pdf")
+	require.NoError(t, c.WriteToFile(path))
+
+	reader, err := parser.OpenPDF(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	info := reader.GetDocumentInfo()
+	assert.Equal(t, "Годовой отчёт", info.Title)
+	assert.Equal(t, "山田太郎", info.Author)
+	assert.Equal(t, "Plain (ASCII) subject", info.Subject)
+	assert.Equal(t, "финансы, 2025", info.Keywords)
+}
+
+func TestCreator_SetCreationDateRoundTrip(t *testing.T) {
+	created := time.Date(2024, 2, 29, 8, 15, 30, 0, time.FixedZone("", -5*3600))
+
+	c := New()
+	c.SetCreationDate(created)
+	c.SetCreationDate(time.Time{}) // Ignored.
+	_, err := c.NewPage()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "dates.pdf")
+	require.NoError(t, c.WriteToFile(path))
+
+	reader, err := parser.OpenPDF(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	info := reader.GetDocumentInfo()
+	assert.True(t, created.Equal(info.CreationDate), "CreationDate = %v, want %v", info.CreationDate, created)
+	assert.False(t, info.ModDate.IsZero())
+}
+
+func TestCreator_SetCreatorAndProducerRoundTrip(t *testing.T) {
+	c := New()
+	c.SetCreator("Acme Invoicing")
+	c.SetProducer("")
+	_, err := c.NewPage()
+	require.NoError(t, err)
+
+	data, err := c.Bytes()
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "gxpdf")
+
+	path := filepath.Join(t.TempDir(), "branding.pdf")
+	require.NoError(t, c.WriteToFile(path))
+
+	reader, err := parser.OpenPDF(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	info := reader.GetDocumentInfo()
+	assert.Equal(t, "Acme Invoicing", info.Creator)
+	assert.Empty(t, info.Producer)
+}
+
+func TestCreator_SetPrecision_ColorsAndMatrices(t *testing.T) {
+	img, err := LoadImageFromReader(bytes.NewReader(createJPEGData(t, 10, 10, color.RGBA{0, 0, 255, 255})))
+	require.NoError(t, err)
+
+	for digits, matrix := range map[int]string{
+		0: "86.6025 50 -50 86.6025 300 300 cm",
+		1: "86.6025 50.0 -50.0 86.6025 300.0 300.0 cm",
+	} {
+		t.Run(fmt.Sprintf("precision %d", digits), func(t *testing.T) {
+			c := New()
+			c.SetPrecision(digits)
+			page, err := c.NewPage()
+			require.NoError(t, err)
+			require.NoError(t, page.DrawRect(100, 100, 50, 50, &RectOptions{FillColor: &Color{0.5, 0.25, 0.75}}))
+			require.NoError(t, page.DrawRect(200, 100, 50, 50, &RectOptions{FillColor: &Color{0.3, 0.6, 0.9}}))
+			require.NoError(t, page.DrawImageWithOptions(img, 300, 300, 100, 100, &ImageOptions{Angle: 30}))
+
+			data, err := c.Bytes()
+			require.NoError(t, err)
+			reader, err := parser.OpenPDFFrom(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			defer reader.Close()
+			pageDict, err := reader.GetPage(0)
+			require.NoError(t, err)
+			stream, ok := reader.ResolveReferences(pageDict.Get("Contents")).(*parser.Stream)
+			require.True(t, ok, "page has no content stream")
+			content, err := reader.DecodeStream(stream)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(content), "0.5 0.25 0.75 rg")
+			assert.Contains(t, string(content), "0.3 0.6 0.9 rg")
+			assert.Contains(t, string(content), matrix)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package creator
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/coregx/gxpdf/internal/fonts"
+	"github.com/coregx/gxpdf/internal/writer"
 )
 
 // CustomFont represents an embedded TrueType/OpenType font.
@@ -14,6 +17,11 @@ import (
 // The font is embedded as a subset containing only the glyphs
 // used in the document, reducing file size.
 //
+// A CustomFont holds only the parsed font program and is never modified
+// after loading, so one font can be shared by any number of Creators,
+// including from several goroutines. Each Creator collects the
+// characters it draws into its own subset when the document is written.
+//
 // Example:
 //
 //	font, err := LoadFont("fonts/OpenSans-Regular.ttf")
@@ -24,14 +32,26 @@ import (
 //	p := NewParagraph("Текст на русском")
 //	p.SetCustomFont(font, 12)
 type CustomFont struct {
-	// ttfFont is the parsed TrueType font.
+	// ttfFont is the parsed TrueType font (read-only).
 	ttfFont *fonts.TTFFont
 
-	// subset is the font subset containing only used glyphs.
-	subset *fonts.FontSubset
+	// full embeds the complete font instead of a subset.
+	full bool
+
+	// Synthetic styles (see Bold and Italic).
+	fauxBold   bool
+	fauxItalic bool
+}
 
-	// isBuilt indicates whether the subset has been built.
-	isBuilt bool
+// LoadFontOptions configures how a font is loaded and embedded.
+type LoadFontOptions struct {
+	// EmbedFull embeds the complete font program with all glyph widths
+	// and Unicode mappings, instead of a subset of the characters used.
+	//
+	// Use it for documents that will be edited later (e.g. form templates):
+	// editors cannot add characters that are missing from a subset font.
+	// The file is larger, especially for CJK fonts.
+	EmbedFull bool
 }
 
 // LoadFont loads a TrueType/OpenType font file.
@@ -39,10 +59,10 @@ type CustomFont struct {
 // Supported formats:
 //   - TrueType (.ttf)
 //   - OpenType with TrueType outlines (.otf)
+//   - TrueType Collections (.ttc); the first font is loaded, see LoadFontIndex
 //
 // Not yet supported:
 //   - OpenType with CFF outlines (.otf with PostScript outlines)
-//   - TrueType Collections (.ttc)
 //
 // Returns an error if the file cannot be read or is not a valid font.
 func LoadFont(path string) (*CustomFont, error) {
@@ -51,53 +71,168 @@ func LoadFont(path string) (*CustomFont, error) {
 		return nil, fmt.Errorf("load TTF: %w", err)
 	}
 
-	return &CustomFont{
-		ttfFont: ttf,
-		subset:  fonts.NewFontSubset(ttf),
-		isBuilt: false,
-	}, nil
+	return newCustomFont(ttf), nil
 }
 
-// UseChar marks a character as used (for subsetting).
+// LoadFontIndex loads one font from a TrueType Collection (.ttc).
 //
-// This is called automatically by text rendering functions.
-// You don't need to call this manually.
-func (f *CustomFont) UseChar(ch rune) {
-	f.subset.UseChar(ch)
-	f.isBuilt = false // Invalidate built subset.
+// A collection holds several fonts, typically the weights of a family.
+// Index 0 is the font LoadFont returns. Use LoadFontCollection to list
+// the fonts by name.
+//
+// Example:
+//
+//	bold, err := creator.LoadFontIndex("C:/Windows/Fonts/msyh.ttc", 1)
+func LoadFontIndex(path string, index int) (*CustomFont, error) {
+	ttf, err := fonts.LoadTTFIndex(path, index)
+	if err != nil {
+		return nil, fmt.Errorf("load TTF: %w", err)
+	}
+
+	return newCustomFont(ttf), nil
 }
 
-// UseString marks all characters in a string as used.
+// LoadFontCollection loads all fonts from a TrueType Collection (.ttc),
+// in collection order. A single font file returns one font.
+//
+// Example:
 //
-// This is called automatically by text rendering functions.
-// You don't need to call this manually.
-func (f *CustomFont) UseString(text string) {
-	f.subset.UseString(text)
-	f.isBuilt = false // Invalidate built subset.
+//	faces, err := creator.LoadFontCollection("/System/Library/Fonts/PingFang.ttc")
+//	for i, face := range faces {
+//	    fmt.Println(i, face.PostScriptName())
+//	}
+func LoadFontCollection(path string) ([]*CustomFont, error) {
+	ttfs, err := fonts.LoadTTFCollection(path)
+	if err != nil {
+		return nil, fmt.Errorf("load TTF: %w", err)
+	}
+
+	faces := make([]*CustomFont, len(ttfs))
+	for i, ttf := range ttfs {
+		faces[i] = newCustomFont(ttf)
+	}
+	return faces, nil
+}
+
+// LoadFontWithOptions loads a TrueType/OpenType font file with options.
+//
+// Example:
+//
+//	// Embed the complete font so users can edit the generated form.
+//	font, err := creator.LoadFontWithOptions("fonts/OpenSans-Regular.ttf",
+//	    creator.LoadFontOptions{EmbedFull: true})
+func LoadFontWithOptions(path string, opts LoadFontOptions) (*CustomFont, error) {
+	font, err := LoadFont(path)
+	if err != nil {
+		return nil, err
+	}
+
+	font.full = opts.EmbedFull
+	return font, nil
+}
+
+// newCustomFont creates a CustomFont for a parsed font.
+func newCustomFont(ttf *fonts.TTFFont) *CustomFont {
+	return &CustomFont{ttfFont: ttf}
 }
 
 // MeasureString returns the width of a string in points at the given size.
 //
 // This is used for layout calculations (word wrapping, alignment, etc.).
 func (f *CustomFont) MeasureString(text string, size float64) float64 {
-	return f.subset.MeasureString(text, size)
+	var totalWidth int
+	for _, ch := range text {
+		if glyphID, ok := f.ttfFont.CharToGlyph[ch]; ok {
+			totalWidth += int(f.ttfFont.GlyphWidths[glyphID])
+		}
+	}
+
+	// Convert from font units to points.
+	unitsPerEm := float64(f.ttfFont.UnitsPerEm)
+	if unitsPerEm == 0 {
+		unitsPerEm = 1000 // Fallback.
+	}
+
+	return float64(totalWidth) * size / unitsPerEm
 }
 
-// Build builds the font subset.
+// HasGlyph reports whether the font has a glyph for r.
+//
+// Characters without a glyph are drawn as the font's missing-glyph box
+// (.notdef). Use HasGlyph to pick a fallback font before drawing.
 //
-// This must be called before writing the PDF.
-// It's automatically called by the Creator when finalizing the document.
-func (f *CustomFont) Build() error {
-	if f.isBuilt {
-		return nil
+// Example:
+//
+//	if !font.HasGlyph('€') {
+//	    font = fallback
+//	}
+func (f *CustomFont) HasGlyph(r rune) bool {
+	glyphID, ok := f.ttfFont.CharToGlyph[r]
+	return ok && glyphID != 0
+}
+
+// MissingGlyphs returns the characters in text that the font has no glyph
+// for, each once, in order of appearance. Control characters are ignored.
+func (f *CustomFont) MissingGlyphs(text string) []rune {
+	var missing []rune
+	seen := make(map[rune]bool)
+	for _, r := range text {
+		if seen[r] || unicode.IsControl(r) {
+			continue
+		}
+		seen[r] = true
+		if !f.HasGlyph(r) {
+			missing = append(missing, r)
+		}
 	}
+	return missing
+}
+
+// MissingGlyphsError is returned in strict font mode (see
+// Creator.SetStrictFonts) when text contains characters the font cannot
+// draw.
+type MissingGlyphsError struct {
+	Font  string // PostScript name of the font
+	Runes []rune // Characters without a glyph, in order of appearance
+}
 
-	if err := f.subset.Build(); err != nil {
-		return fmt.Errorf("build subset: %w", err)
+// Error implements the error interface.
+func (e *MissingGlyphsError) Error() string {
+	chars := make([]string, len(e.Runes))
+	for i, r := range e.Runes {
+		chars[i] = fmt.Sprintf("%U %q", r, r)
 	}
+	return fmt.Sprintf("font %s has no glyph for %s", e.Font, strings.Join(chars, ", "))
+}
+
+// Bold returns a synthetic bold variant of the font.
+//
+// The glyph outlines are stroked in the text color, which thickens them.
+// Use it when no real bold face is available, e.g. for many CJK fonts;
+// a real bold font looks better. The variant shares the font program, so
+// it is embedded only once alongside the regular style. Text measurements
+// do not include the extra stroke width.
+//
+// Example:
+//
+//	bold := font.Bold()
+//	page.AddTextCustomFont("重要", 100, 700, bold, 14)
+func (f *CustomFont) Bold() *CustomFont {
+	variant := *f
+	variant.fauxBold = true
+	return &variant
+}
 
-	f.isBuilt = true
-	return nil
+// Italic returns a synthetic italic (oblique) variant of the font.
+//
+// The text is slanted by about 12 degrees. Like Bold, it is meant for
+// fonts without a real italic face and can be combined with it:
+//
+//	boldItalic := font.Bold().Italic()
+func (f *CustomFont) Italic() *CustomFont {
+	variant := *f
+	variant.fauxItalic = true
+	return &variant
 }
 
 // PostScriptName returns the PostScript name of the font.
@@ -112,11 +247,6 @@ func (f *CustomFont) UnitsPerEm() uint16 {
 	return f.ttfFont.UnitsPerEm
 }
 
-// GetSubset returns the font subset (for internal use).
-func (f *CustomFont) GetSubset() *fonts.FontSubset {
-	return f.subset
-}
-
 // GetTTF returns the parsed TrueType font (for internal use).
 func (f *CustomFont) GetTTF() *fonts.TTFFont {
 	return f.ttfFont
@@ -132,3 +262,55 @@ func (f *CustomFont) ID() string {
 	}
 	return f.ttfFont.FilePath
 }
+
+// fontSubsets collects the characters drawn with each custom font while a
+// document is written, keyed by font ID.
+//
+// Subsets belong to the document rather than to the CustomFont, so fonts
+// shared between Creators do not leak glyphs into each other's output.
+type fontSubsets map[string]*fonts.FontSubset
+
+// subset returns the subset for font, creating it if needed.
+func (s fontSubsets) subset(font *CustomFont) *fonts.FontSubset {
+	id := font.ID()
+	subset, ok := s[id]
+	if !ok {
+		subset = fonts.NewFontSubset(font.ttfFont)
+		if font.full {
+			for ch := range font.ttfFont.CharToGlyph {
+				subset.UseChar(ch)
+			}
+		}
+		s[id] = subset
+	}
+	return subset
+}
+
+// embed marks text as used in font's subset and returns the font for the
+// writer.
+func (s fontSubsets) embed(font *CustomFont, text string) *writer.EmbeddedFont {
+	subset := s.subset(font)
+	subset.UseString(text)
+
+	return &writer.EmbeddedFont{
+		TTF:    font.ttfFont,
+		Subset: subset,
+		ID:     font.ID(),
+		Full:   font.full,
+
+		FauxBold:   font.fauxBold,
+		FauxItalic: font.fauxItalic,
+	}
+}
+
+// include marks the characters in ranges that font has glyphs for as used.
+func (s fontSubsets) include(font *CustomFont, ranges []RuneRange) {
+	subset := s.subset(font)
+	for _, r := range ranges {
+		for ch := r.First; ch <= r.Last; ch++ {
+			if _, ok := font.ttfFont.CharToGlyph[ch]; ok {
+				subset.UseChar(ch)
+			}
+		}
+	}
+}
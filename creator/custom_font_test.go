@@ -0,0 +1,225 @@
+package creator
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/fonts"
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/coregx/gxpdf/internal/writer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCustomFont returns a font with glyphs for 'A' and 'B' only.
+func newTestCustomFont() *CustomFont {
+	return newCustomFont(&fonts.TTFFont{
+		PostScriptName: "TestFont",
+		UnitsPerEm:     1000,
+		CharToGlyph:    map[rune]uint16{'A': 1, 'B': 2},
+		GlyphWidths:    map[uint16]uint16{1: 600, 2: 700},
+		FontData:       []byte("mock font data"),
+	})
+}
+
+func TestCustomFont_MeasureString(t *testing.T) {
+	font := newTestCustomFont()
+
+	assert.InDelta(t, 13.0, font.MeasureString("AB", 10), 0.001)
+	assert.InDelta(t, 6.0, font.MeasureString("A?", 10), 0.001, "unknown characters have no width")
+}
+
+func TestCustomFont_SubsetPerDocument(t *testing.T) {
+	font := newTestCustomFont()
+
+	c1 := New()
+	p1, err := c1.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, p1.AddTextCustomFont("A", 100, 700, font, 12))
+
+	c2 := New()
+	p2, err := c2.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, p2.AddTextCustomFont("B", 100, 700, font, 12))
+	require.NoError(t, p2.AddTextCustomFont("BA", 100, 680, font, 12))
+
+	text1, _ := c1.collectAllPageContents()
+	text2, _ := c2.collectAllPageContents()
+
+	subset1 := text1[0][0].CustomFont.Subset
+	assert.Equal(t, map[rune]bool{'A': true}, subset1.UsedChars)
+
+	subset2 := text2[0][0].CustomFont.Subset
+	assert.Equal(t, map[rune]bool{'A': true, 'B': true}, subset2.UsedChars)
+	assert.Same(t, subset2, text2[0][1].CustomFont.Subset, "one subset per font in a document")
+}
+
+func TestCustomFont_EmbedFull(t *testing.T) {
+	font := newTestCustomFont()
+	font.full = true
+
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddTextCustomFont("A", 100, 700, font, 12))
+
+	text, _ := c.collectAllPageContents()
+	embedded := text[0][0].CustomFont
+	assert.True(t, embedded.Full)
+	assert.Equal(t, map[rune]bool{'A': true, 'B': true}, embedded.Subset.UsedChars, "all characters are included")
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "/BaseFont /TestFont\n")
+	assert.NotContains(t, buf.String(), "+TestFont")
+}
+
+func TestLoadFontWithOptions_MissingFile(t *testing.T) {
+	_, err := LoadFontWithOptions("testdata/missing.ttf", LoadFontOptions{EmbedFull: true})
+	assert.Error(t, err)
+}
+
+func TestLoadFontIndex_MissingFile(t *testing.T) {
+	_, err := LoadFontIndex("testdata/missing.ttc", 1)
+	assert.Error(t, err)
+
+	_, err = LoadFontCollection("testdata/missing.ttc")
+	assert.Error(t, err)
+}
+
+func TestCustomFont_SyntheticStyles(t *testing.T) {
+	font := newTestCustomFont()
+	boldItalic := font.Bold().Italic()
+
+	assert.False(t, font.fauxBold, "Bold returns a variant")
+	assert.Equal(t, font.ID(), boldItalic.ID(), "variants share the embedded font")
+
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddTextCustomFont("A", 100, 700, boldItalic, 10))
+	require.NoError(t, page.AddTextCustomFont("B", 100, 680, font, 10))
+
+	text, _ := c.collectAllPageContents()
+	content, _, err := writer.GenerateContentStream(text[0])
+	require.NoError(t, err)
+
+	styled, plain, found := strings.Cut(string(content), "Q\n")
+	require.True(t, found, "styled text is wrapped in q/Q")
+	assert.Contains(t, styled, "2 Tr")
+	assert.Contains(t, styled, "0.30 w")
+	assert.Contains(t, styled, "1.00 0.00 0.2126 1.00 100.00 700.00 Tm")
+	assert.NotContains(t, plain, "Tr")
+	assert.Contains(t, plain, "100.00 680.00 Td")
+}
+
+func TestCustomFont_HasGlyph(t *testing.T) {
+	font := newTestCustomFont()
+	font.ttfFont.CharToGlyph['C'] = 0 // Mapped to .notdef.
+
+	assert.True(t, font.HasGlyph('A'))
+	assert.True(t, font.HasGlyph('B'))
+	assert.False(t, font.HasGlyph('C'))
+	assert.False(t, font.HasGlyph('Ж'))
+}
+
+func TestCustomFont_MissingGlyphs(t *testing.T) {
+	font := newTestCustomFont()
+
+	assert.Nil(t, font.MissingGlyphs("ABBA"))
+	assert.Equal(t, []rune{'é', 'Ж'}, font.MissingGlyphs("AéBЖé\t"))
+}
+
+func TestCreator_SetStrictFonts(t *testing.T) {
+	font := newTestCustomFont()
+
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	// Lenient by default.
+	require.NoError(t, page.AddTextCustomFont("AéB", 100, 700, font, 12))
+
+	// Applies to existing pages.
+	c.SetStrictFonts(true)
+	err = page.AddTextCustomFont("AéB€", 100, 680, font, 12)
+	var missing *MissingGlyphsError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, []rune{'é', '€'}, missing.Runes)
+	assert.Equal(t, "font TestFont has no glyph for U+00E9 'é', U+20AC '€'", err.Error())
+
+	err = page.DrawTextClipped("é", 100, 660, 100, 650, 50, 20, font, 12, Black)
+	assert.ErrorAs(t, err, &missing)
+	assert.Len(t, page.textOps, 1, "rejected text is not drawn")
+
+	// Applies to new pages.
+	page2, err := c.NewPage()
+	require.NoError(t, err)
+	assert.Error(t, page2.AddTextCustomFont("é", 100, 700, font, 12))
+	assert.NoError(t, page2.AddTextCustomFont("BA", 100, 700, font, 12))
+}
+
+// loadSystemFont loads a TrueType font installed on the machine, skipping
+// the test when none is available.
+func loadSystemFont(t *testing.T) *CustomFont {
+	t.Helper()
+
+	for _, path := range []string{
+		"/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf",
+		"/Library/Fonts/Arial.ttf",
+		"C:/Windows/Fonts/arial.ttf",
+	} {
+		if font, err := LoadFont(path); err == nil {
+			return font
+		}
+	}
+	t.Skip("test font not available")
+	return nil
+}
+
+func TestCustomFont_ConcurrentCreators(t *testing.T) {
+	font := loadSystemFont(t)
+
+	const pages = 20
+	texts := []string{"Hello world", "Quarterly totals"}
+	pdfs := make([][]byte, len(texts))
+	errs := make([]error, len(texts))
+
+	// Both creators start together so that their use of the shared font
+	// overlaps.
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, text := range texts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			c := New()
+			for n := 0; n < pages && errs[i] == nil; n++ {
+				var page *Page
+				page, errs[i] = c.NewPage()
+				if errs[i] == nil {
+					errs[i] = page.AddTextCustomFont(text, 100, 700, font, 12)
+				}
+			}
+			if errs[i] == nil {
+				pdfs[i], errs[i] = c.Bytes()
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for i, text := range texts {
+		require.NoError(t, errs[i])
+		reader, err := parser.OpenPDFFrom(bytes.NewReader(pdfs[i]), int64(len(pdfs[i])))
+		require.NoError(t, err)
+		count, err := reader.GetPageCount()
+		require.NoError(t, err)
+		require.Equal(t, pages, count)
+		assert.Contains(t, pageText(t, reader, pages-1), text)
+	}
+}
@@ -0,0 +1,70 @@
+package creator
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDrawDebugGrid(t *testing.T) {
+	c := New()
+	c.SetPageSize(A4) // 595 x 842
+	page, _ := c.NewPage()
+	if err := page.DrawDebugGrid(100); err != nil {
+		t.Fatalf("DrawDebugGrid failed: %v", err)
+	}
+
+	// Off by default.
+	text, graphics := c.collectAllPageContents()
+	if len(text[0]) != 0 || len(graphics[0]) != 0 {
+		t.Errorf("grid drawn without debug mode: %d text ops, %d graphics ops", len(text[0]), len(graphics[0]))
+	}
+
+	c.SetDebugMode(true)
+	lines, labels := page.debugGridOps()
+
+	// Vertical lines at 0..500, horizontal lines at 0..800.
+	if len(lines) != 6+9 {
+		t.Errorf("got %d grid lines, want 15", len(lines))
+	}
+	// Labels for every line, with the origin labeled once.
+	if len(labels) != 6+8 {
+		t.Errorf("got %d labels, want 14", len(labels))
+	}
+	if labels[1].Text != "100" || labels[1].X != 101.5 {
+		t.Errorf("label = %q at x %v, want \"100\" at 101.5", labels[1].Text, labels[1].X)
+	}
+	last := lines[len(lines)-1]
+	if last.Y != 800 || last.X2 != 595 {
+		t.Errorf("last line = %+v, want horizontal line at 800 across the page", last)
+	}
+
+	text, graphics = c.collectAllPageContents()
+	if len(text[0]) != len(labels) || len(graphics[0]) != len(lines) {
+		t.Errorf("got %d text ops, %d graphics ops, want the grid", len(text[0]), len(graphics[0]))
+	}
+
+	pdf, err := c.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	if !bytes.Contains(pdf, []byte("/BaseFont /Helvetica")) {
+		t.Error("grid labels are not written")
+	}
+}
+
+func TestDrawDebugGrid_LabelSpacing(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.DrawDebugGrid(10); err != nil {
+		t.Fatalf("DrawDebugGrid failed: %v", err)
+	}
+
+	// Lines 10 points apart are labeled every third line.
+	_, labels := page.debugGridOps()
+	if labels[1].Text != "30" {
+		t.Errorf("second label = %q, want \"30\"", labels[1].Text)
+	}
+
+	if err := page.DrawDebugGrid(0); err == nil {
+		t.Error("expected error for zero spacing")
+	}
+}
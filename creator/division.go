@@ -1,20 +1,55 @@
 package creator
 
+import "slices"
+
 // Border defines the border style for a division.
 //
 // Border controls the appearance of a division's outline, including
-// width and color. Individual borders can be set per side.
+// width, color and dash pattern. Individual borders can be set per side.
 //
 // Example:
 //
 //	border := Border{Width: 1.0, Color: Black}
 //	div.SetBorder(border)
+//
+//	dashed := Border{Width: 0.5, Color: Gray, Dashed: true, DashArray: []float64{2, 2}}
 type Border struct {
 	// Width is the border line width in points.
 	Width float64
 
 	// Color is the border color (RGB, 0.0 to 1.0 range).
 	Color Color
+
+	// Dashed enables dashed border rendering.
+	Dashed bool
+
+	// DashArray defines the dash pattern (e.g., [3, 1] for "3 on, 1 off").
+	// Only used when Dashed is true. Defaults to [3, 3] if empty.
+	DashArray []float64
+}
+
+// lineOptions returns the line options for drawing the border.
+func (b *Border) lineOptions() *LineOptions {
+	opts := &LineOptions{Width: b.Width, Color: b.Color}
+	if b.Dashed {
+		opts.Dashed = true
+		opts.DashArray = b.dashArray()
+	}
+	return opts
+}
+
+// dashArray returns the dash pattern, applying the default if unset.
+func (b *Border) dashArray() []float64 {
+	if len(b.DashArray) == 0 {
+		return []float64{3, 3}
+	}
+	return b.DashArray
+}
+
+// sameStyle reports whether two borders are drawn identically.
+func (b *Border) sameStyle(other *Border) bool {
+	return b.Width == other.Width && b.Color == other.Color &&
+		b.Dashed == other.Dashed && slices.Equal(b.DashArray, other.DashArray)
 }
 
 // Division is a container for grouping multiple Drawables.
@@ -365,7 +400,7 @@ func (d *Division) drawBackground(page *Page, x, y, width, height float64) error
 	opts := &RectOptions{
 		FillColor: d.background,
 	}
-	return page.DrawRect(x, y, width, height, opts)
+	return page.drawRect(x, y, width, height, opts)
 }
 
 // drawBorders draws all borders if set.
@@ -400,11 +435,7 @@ func (d *Division) drawBorderTop(page *Page, x, y, width, height float64) error
 		return nil
 	}
 
-	lineOpts := &LineOptions{
-		Width: border.Width,
-		Color: border.Color,
-	}
-	return page.DrawLine(x, y+height, x+width, y+height, lineOpts)
+	return page.drawLine(x, y+height, x+width, y+height, border.lineOptions())
 }
 
 // drawBorderRight draws the right border if set.
@@ -414,11 +445,7 @@ func (d *Division) drawBorderRight(page *Page, x, y, width, height float64) erro
 		return nil
 	}
 
-	lineOpts := &LineOptions{
-		Width: border.Width,
-		Color: border.Color,
-	}
-	return page.DrawLine(x+width, y, x+width, y+height, lineOpts)
+	return page.drawLine(x+width, y, x+width, y+height, border.lineOptions())
 }
 
 // drawBorderBottom draws the bottom border if set.
@@ -428,11 +455,7 @@ func (d *Division) drawBorderBottom(page *Page, x, y, width, _ float64) error {
 		return nil
 	}
 
-	lineOpts := &LineOptions{
-		Width: border.Width,
-		Color: border.Color,
-	}
-	return page.DrawLine(x, y, x+width, y, lineOpts)
+	return page.drawLine(x, y, x+width, y, border.lineOptions())
 }
 
 // drawBorderLeft draws the left border if set.
@@ -442,11 +465,7 @@ func (d *Division) drawBorderLeft(page *Page, x, y, _ float64, height float64) e
 		return nil
 	}
 
-	lineOpts := &LineOptions{
-		Width: border.Width,
-		Color: border.Color,
-	}
-	return page.DrawLine(x, y, x, y+height, lineOpts)
+	return page.drawLine(x, y, x, y+height, border.lineOptions())
 }
 
 // drawContent draws all content elements with padding applied.
@@ -482,6 +501,7 @@ func (d *Division) createInnerContext(ctx *LayoutContext) *LayoutContext {
 		},
 		CursorX: ctx.ContentLeft() + d.margins.Left + d.padding.Left + d.getBorderLeftWidth(),
 		CursorY: ctx.CursorY,
+		theme:   ctx.theme,
 	}
 	return innerCtx
 }
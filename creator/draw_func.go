@@ -0,0 +1,52 @@
+package creator
+
+import "errors"
+
+// FuncDrawable is a drawable of a fixed height whose content is drawn by
+// a function. Create it with DrawFunc.
+type FuncDrawable struct {
+	height float64
+	fn     func(page *Page, x, y float64) error
+}
+
+// DrawFunc creates a drawable that reserves a block of the given height
+// across the content area and calls fn to draw into it.
+//
+// It lets custom content, such as a chart drawn with the page's drawing
+// methods, take part in the document flow: Creator.Draw moves it to a new
+// page when the block does not fit. The block is not split.
+//
+// fn receives the block's corner as DrawRect takes it: the lower-left
+// corner, or the top-left corner with SetOriginTopLeft. The block width
+// is the content area width.
+//
+// Example:
+//
+//	c.Draw(creator.DrawFunc(120, func(page *creator.Page, x, y float64) error {
+//		return page.DrawRect(x, y, 200, 120, &creator.RectOptions{FillColor: &creator.Gray})
+//	}))
+func DrawFunc(height float64, fn func(page *Page, x, y float64) error) *FuncDrawable {
+	if height < 0 {
+		height = 0
+	}
+	return &FuncDrawable{height: height, fn: fn}
+}
+
+// Height returns the block height.
+func (d *FuncDrawable) Height(_ *LayoutContext) float64 {
+	return d.height
+}
+
+// Draw calls the function with the block's corner and advances the cursor
+// past the block.
+func (d *FuncDrawable) Draw(ctx *LayoutContext, page *Page) error {
+	if d.fn == nil {
+		return errors.New("draw function cannot be nil")
+	}
+	y := page.pdfBoxY(ctx.CurrentPDFY()-d.height, d.height)
+	if err := d.fn(page, ctx.ContentLeft(), y); err != nil {
+		return err
+	}
+	ctx.CursorY += d.height
+	return nil
+}
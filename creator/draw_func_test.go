@@ -0,0 +1,89 @@
+package creator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDrawFunc_Draw(t *testing.T) {
+	page := createTestPage(t)
+	ctx := page.GetLayoutContext()
+	ctx.CursorY = 10
+
+	var gotX, gotY float64
+	d := DrawFunc(50, func(p *Page, x, y float64) error {
+		if p != page {
+			t.Error("function should receive the page")
+		}
+		gotX, gotY = x, y
+		return nil
+	})
+	if h := d.Height(ctx); h != 50 {
+		t.Errorf("Height() = %v, want 50", h)
+	}
+	if err := d.Draw(ctx, page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if gotX != ctx.ContentLeft() || gotY != ctx.ContentTop()-60 {
+		t.Errorf("corner = (%v, %v), want (%v, %v)", gotX, gotY, ctx.ContentLeft(), ctx.ContentTop()-60)
+	}
+	if ctx.CursorY != 60 {
+		t.Errorf("CursorY = %v, want 60", ctx.CursorY)
+	}
+}
+
+func TestDrawFunc_OriginTopLeft(t *testing.T) {
+	page := createTestPage(t)
+	page.originTopLeft = true
+	ctx := page.GetLayoutContext()
+
+	var gotY float64
+	d := DrawFunc(50, func(_ *Page, _, y float64) error {
+		gotY = y
+		return nil
+	})
+	if err := d.Draw(ctx, page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if gotY != page.margins.Top {
+		t.Errorf("y = %v, want %v (top of the block)", gotY, page.margins.Top)
+	}
+}
+
+func TestDrawFunc_Errors(t *testing.T) {
+	page := createTestPage(t)
+
+	if err := DrawFunc(10, nil).Draw(page.GetLayoutContext(), page); err == nil {
+		t.Error("Draw() with nil function should fail")
+	}
+
+	errBoom := errors.New("boom")
+	d := DrawFunc(10, func(*Page, float64, float64) error { return errBoom })
+	if err := d.Draw(page.GetLayoutContext(), page); !errors.Is(err, errBoom) {
+		t.Errorf("Draw() error = %v, want %v", err, errBoom)
+	}
+}
+
+func TestDrawFunc_InFlow(t *testing.T) {
+	c := New()
+
+	tall := DrawFunc(600, func(*Page, float64, float64) error { return nil })
+	if err := c.Draw(tall); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	var page *Page
+	next := DrawFunc(600, func(p *Page, _, _ float64) error {
+		page = p
+		return nil
+	})
+	if err := c.Draw(next); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() != 2 || page != c.pages[1] {
+		t.Errorf("block that does not fit should move to a new page, PageCount() = %d", c.PageCount())
+	}
+}
@@ -27,6 +27,11 @@ type EllipseOptions struct {
 	// Mutually exclusive with FillGradient.
 	FillColorCMYK *ColorCMYK
 
+	// FillColorIndexed is the fill color as a palette entry of an Indexed
+	// color space (nil = no fill).
+	// If set, this takes precedence over FillColorCMYK and FillColor.
+	FillColorIndexed *IndexedColor
+
 	// FillGradient is the gradient fill (nil = no gradient fill).
 	// Mutually exclusive with FillColor and FillColorCMYK.
 	FillGradient *Gradient
@@ -63,6 +68,7 @@ func (p *Page) DrawEllipse(cx, cy, rx, ry float64, opts *EllipseOptions) error {
 	if opts == nil {
 		return errors.New("ellipse options cannot be nil")
 	}
+	opts = p.defaults.ellipse(opts)
 
 	// Validate radii
 	if rx < 0 {
@@ -81,7 +87,7 @@ func (p *Page) DrawEllipse(cx, cy, rx, ry float64, opts *EllipseOptions) error {
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:        GraphicsOpEllipse,
 		X:           cx,
-		Y:           cy,
+		Y:           p.pdfY(cy),
 		RX:          rx,
 		RY:          ry,
 		EllipseOpts: opts,
@@ -106,13 +112,20 @@ func validateEllipseOptions(opts *EllipseOptions) error {
 		}
 	}
 
+	// Validate indexed fill color if provided
+	if opts.FillColorIndexed != nil {
+		if err := validateIndexedColor(*opts.FillColorIndexed); err != nil {
+			return errors.New("fill " + err.Error())
+		}
+	}
+
 	// Validate stroke width
 	if opts.StrokeWidth < 0 {
 		return errors.New("stroke width must be non-negative")
 	}
 
 	// At least one of stroke or fill must be set
-	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillGradient == nil {
+	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillColorIndexed == nil && opts.FillGradient == nil {
 		return errors.New("ellipse must have at least stroke, fill color, or gradient")
 	}
 
@@ -0,0 +1,190 @@
+package creator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CaptionKind selects how a Figure is labeled and which list it appears in.
+type CaptionKind int
+
+const (
+	// CaptionFigure labels the caption "Figure N" and lists it in the
+	// list of figures. The caption is drawn below the content.
+	CaptionFigure CaptionKind = iota
+
+	// CaptionTable labels the caption "Table N" and lists it in the list
+	// of tables. The caption is drawn above the content.
+	CaptionTable
+)
+
+// String returns the caption label ("Figure" or "Table").
+func (k CaptionKind) String() string {
+	if k == CaptionTable {
+		return "Table"
+	}
+	return "Figure"
+}
+
+// Figure is a drawable with a numbered caption, such as a chart with
+// "Figure 3: Revenue by region" below it or a table with "Table 1: Results"
+// above it.
+//
+// Figures drawn with Creator.Draw or added to a chapter are numbered in
+// document order, separately for figures and tables, and are listed with
+// their page numbers by EnableListOfFigures and EnableListOfTables.
+// Figures drawn with Page.Draw are not numbered.
+//
+// Example:
+//
+//	c.EnableListOfFigures()
+//	chart, _ := creator.LoadImage("revenue.png")
+//	c.Draw(creator.NewImageFigure(chart, 300, 200, "Revenue by region"))
+//	c.Draw(creator.NewTableFigure(results, "Measurement results"))
+type Figure struct {
+	content Drawable
+	caption string
+	kind    CaptionKind
+	id      string // ID for references (see Ref)
+
+	// Caption appearance.
+	style   TextStyle
+	spacing float64 // space between content and caption
+
+	// Set when the figure is drawn in the document.
+	number int   // 0 = not numbered
+	page   *Page // page the figure was drawn on
+}
+
+// NewFigure creates a figure of the given content with a caption below it.
+func NewFigure(content Drawable, caption string) *Figure {
+	return &Figure{
+		content: content,
+		caption: caption,
+		kind:    CaptionFigure,
+		style:   TextStyle{Font: HelveticaOblique, Size: 10, Color: Black},
+		spacing: 6,
+	}
+}
+
+// NewImageFigure creates a figure of an image drawn at the given size,
+// centered in the content area, with a caption below it.
+func NewImageFigure(img *Image, width, height float64, caption string) *Figure {
+	return NewFigure(&imageBlock{image: img, width: width, height: height}, caption)
+}
+
+// NewTableFigure creates a figure of a table (or other content) with a
+// caption above it, listed in the list of tables.
+func NewTableFigure(content Drawable, caption string) *Figure {
+	f := NewFigure(content, caption)
+	f.kind = CaptionTable
+	return f
+}
+
+// SetCaptionStyle sets the caption font, size and color.
+// Returns the figure for method chaining.
+func (f *Figure) SetCaptionStyle(style TextStyle) *Figure {
+	f.style = style
+	return f
+}
+
+// SetCaptionSpacing sets the space between the content and the caption.
+// Returns the figure for method chaining.
+func (f *Figure) SetCaptionSpacing(spacing float64) *Figure {
+	f.spacing = spacing
+	return f
+}
+
+// Caption returns the caption text without the label.
+func (f *Figure) Caption() string {
+	return f.caption
+}
+
+// Kind returns the caption kind.
+func (f *Figure) Kind() CaptionKind {
+	return f.kind
+}
+
+// Number returns the figure number, or 0 if the figure is not numbered.
+func (f *Figure) Number() int {
+	return f.number
+}
+
+// Label returns the caption label with the number, e.g. "Figure 3".
+func (f *Figure) Label() string {
+	if f.number == 0 {
+		return f.kind.String()
+	}
+	return fmt.Sprintf("%s %d", f.kind, f.number)
+}
+
+// Height returns the height of the content and the caption.
+func (f *Figure) Height(ctx *LayoutContext) float64 {
+	return f.content.Height(ctx) + f.spacing + f.captionParagraph().Height(ctx)
+}
+
+// Draw renders the content and the caption at the current cursor position.
+func (f *Figure) Draw(ctx *LayoutContext, page *Page) error {
+	if f.content == nil {
+		return errors.New("figure content cannot be nil")
+	}
+	f.page = page
+
+	caption := f.captionParagraph()
+	if f.kind == CaptionTable {
+		if err := caption.Draw(ctx, page); err != nil {
+			return err
+		}
+		ctx.CursorY += f.spacing
+		return f.content.Draw(ctx, page)
+	}
+
+	if err := f.content.Draw(ctx, page); err != nil {
+		return err
+	}
+	ctx.CursorY += f.spacing
+	return caption.Draw(ctx, page)
+}
+
+// captionParagraph builds the centered caption paragraph.
+func (f *Figure) captionParagraph() *Paragraph {
+	text := f.Label()
+	if f.caption != "" {
+		text += ": " + f.caption
+	}
+	return NewParagraph(text).
+		SetFont(f.style.Font, f.style.Size).
+		SetColor(f.style.Color).
+		SetAlignment(AlignCenter)
+}
+
+// figureOf returns d as a figure, or nil if it is not one.
+func figureOf(d Drawable) *Figure {
+	f, _ := d.(*Figure)
+	return f
+}
+
+// imageBlock is an image drawn centered in the content area.
+type imageBlock struct {
+	image         *Image
+	width, height float64
+}
+
+// Height returns the image height.
+func (b *imageBlock) Height(_ *LayoutContext) float64 {
+	return b.height
+}
+
+// Draw renders the image centered below the cursor.
+func (b *imageBlock) Draw(ctx *LayoutContext, page *Page) error {
+	if b.image == nil {
+		return errors.New("image cannot be nil")
+	}
+	x := ctx.ContentLeft() + (ctx.AvailableWidth()-b.width)/2
+	y := ctx.CurrentPDFY() - b.height
+	if err := page.drawImage(b.image, x, y, b.width, b.height); err != nil {
+		return err
+	}
+	ctx.CursorY += b.height
+	return nil
+}
@@ -0,0 +1,81 @@
+package creator
+
+import "testing"
+
+func TestFigure_Draw(t *testing.T) {
+	page := createTestPage(t)
+	ctx := page.GetLayoutContext()
+
+	fig := NewFigure(NewParagraph("Chart"), "Revenue")
+	if err := fig.Draw(ctx, page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	ops := page.TextOperations()
+	if len(ops) != 2 {
+		t.Fatalf("text ops = %d, want 2", len(ops))
+	}
+	if ops[0].Text != "Chart" || ops[1].Text != "Figure: Revenue" {
+		t.Errorf("texts = %q, %q, want content then caption", ops[0].Text, ops[1].Text)
+	}
+	if ops[1].Font != HelveticaOblique {
+		t.Errorf("caption font = %v, want %v", ops[1].Font, HelveticaOblique)
+	}
+	if ctx.CursorY != fig.Height(ctx) {
+		t.Errorf("CursorY = %v, want Height() = %v", ctx.CursorY, fig.Height(ctx))
+	}
+}
+
+func TestTableFigure_CaptionAbove(t *testing.T) {
+	page := createTestPage(t)
+
+	fig := NewTableFigure(NewParagraph("Cells"), "Results")
+	if err := fig.Draw(page.GetLayoutContext(), page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	ops := page.TextOperations()
+	if ops[0].Text != "Table: Results" || ops[1].Text != "Cells" {
+		t.Errorf("texts = %q, %q, want caption then content", ops[0].Text, ops[1].Text)
+	}
+}
+
+func TestImageFigure_Centered(t *testing.T) {
+	page := createTestPage(t)
+	ctx := page.GetLayoutContext()
+
+	fig := NewImageFigure(&Image{width: 10, height: 10}, 100, 50, "Logo")
+	if err := fig.Draw(ctx, page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	op := page.GraphicsOperations()[0]
+	if want := ctx.ContentLeft() + (ctx.AvailableWidth()-100)/2; op.X != want {
+		t.Errorf("image X = %v, want %v", op.X, want)
+	}
+	if want := ctx.ContentTop() - 50; op.Y != want {
+		t.Errorf("image Y = %v, want %v", op.Y, want)
+	}
+}
+
+func TestCreator_Draw_NumbersFigures(t *testing.T) {
+	c := New()
+
+	figures := []*Figure{
+		NewFigure(NewParagraph("A"), "First"),
+		NewTableFigure(NewParagraph("B"), "Data"),
+		NewFigure(NewParagraph("C"), "Second"),
+	}
+	for _, f := range figures {
+		if err := c.Draw(f); err != nil {
+			t.Fatalf("Draw() error = %v", err)
+		}
+	}
+
+	want := []string{"Figure 1", "Table 1", "Figure 2"}
+	for i, f := range figures {
+		if f.Label() != want[i] {
+			t.Errorf("figure %d label = %q, want %q", i, f.Label(), want[i])
+		}
+	}
+}
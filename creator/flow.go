@@ -0,0 +1,287 @@
+package creator
+
+import (
+	"fmt"
+	"math"
+)
+
+// SplittableDrawable is a Drawable that can be broken across pages.
+//
+// When Creator.Draw encounters an element that does not fit in the space
+// left on the current page, it asks the element to split itself. The head
+// is drawn on the current page and the tail continues on the next one.
+type SplittableDrawable interface {
+	Drawable
+
+	// Split divides the element so that head fits within the given height.
+	//
+	// head is nil when nothing can be placed in the available space
+	// (the whole element moves to the next page). tail is nil when the
+	// whole element fits.
+	Split(ctx *LayoutContext, height float64) (head, tail Drawable)
+}
+
+// forcedSplitter is a SplittableDrawable that can also split where its
+// own rules (keep-together, widows, orphans) forbid it. Creator.Draw uses
+// it for elements taller than an empty page.
+type forcedSplitter interface {
+	// splitForced divides the element at the last break that fits within
+	// the given height, ignoring the element's rules for splitting.
+	splitForced(ctx *LayoutContext, height float64) (head, tail Drawable)
+}
+
+// Draw renders a Drawable in the document flow, adding pages as needed.
+//
+// Unlike Page.Draw, the flow keeps its cursor between calls: consecutive
+// drawables are stacked below each other on the last page of the document.
+// When an element does not fit in the remaining space, a new page is added.
+// Elements implementing SplittableDrawable (such as Paragraph) are split
+// across the page boundary instead of being moved as a whole. An element
+// taller than a whole page is split even if it is set to keep together or
+// its widow and orphan settings forbid it. A PageBreak starts a new page.
+//
+// If the document has no pages yet, one is created.
+//
+// Example:
+//
+//	c := creator.New()
+//	for _, text := range sections {
+//	    c.Draw(creator.NewParagraph(text))
+//	}
+func (c *Creator) Draw(d Drawable) error {
+	if err := c.ensureFlowPage(); err != nil {
+		return err
+	}
+	if _, ok := d.(*PageBreak); ok {
+		if c.flowPageEmpty() {
+			return nil
+		}
+		return c.flowNewPage()
+	}
+	c.numberFootnotes(footnotesOf(d))
+	c.trackDrawable(d)
+	if !c.flowPageEmpty() {
+		c.flowCtx.CursorY += c.blockSpacing
+	}
+
+	for d != nil {
+		ctx := c.flowCtx
+
+		// Space left after reserving room for this page's footnotes.
+		notes := make([]*Footnote, 0, len(c.flowFootnotes))
+		notes = append(notes, c.flowFootnotes...)
+		notes = append(notes, footnotesOf(d)...)
+		available := ctx.AvailableHeight() - c.footnoteAreaHeight(ctx, notes)
+
+		if d.Height(ctx) <= available {
+			return c.flowDraw(d)
+		}
+
+		var head, tail Drawable
+		if s, ok := d.(SplittableDrawable); ok {
+			head, tail = s.Split(ctx, available)
+		} else {
+			tail = d
+		}
+
+		// Nothing fits on an empty page, so waiting for the next one does
+		// not help: split at the last line that fits, or draw as-is if
+		// even that fails, to avoid looping forever.
+		if head == nil && c.flowPageEmpty() {
+			if f, ok := d.(forcedSplitter); ok {
+				head, tail = f.splitForced(ctx, available)
+			}
+			if head == nil {
+				return c.flowDraw(d)
+			}
+		}
+
+		if head != nil {
+			if err := c.flowDraw(head); err != nil {
+				return err
+			}
+		}
+
+		if tail != nil {
+			if err := c.flowNewPage(); err != nil {
+				return err
+			}
+		}
+		d = tail
+	}
+
+	return nil
+}
+
+// SetOnNewPage sets a function called whenever the document flow (Draw)
+// adds a page, with the page and its number (1-based). Pass nil to remove it.
+//
+// The function runs before any flowed content is drawn on the page, so
+// what it draws (section tabs, background art) lies under the content. It
+// must not draw in the document flow itself. Pages added with NewPage are
+// not reported; use AddRunningElement for content on every page.
+//
+// Example:
+//
+//	c.SetOnNewPage(func(page *creator.Page, pageNum int) {
+//		_ = page.DrawRect(page.Width()-20, 600, 20, 80, &creator.RectOptions{FillColor: &creator.Blue})
+//	})
+func (c *Creator) SetOnNewPage(fn func(page *Page, pageNum int)) {
+	c.onNewPage = fn
+}
+
+// SetBlockSpacing sets the vertical space, in points, inserted between
+// consecutive drawables drawn with Creator.Draw and Page.Draw.
+//
+// The space is not added at the top of a page. Drawables that have spacing
+// of their own (such as Body and Heading) get the block spacing in
+// addition. The setting applies to existing and new pages; negative values
+// are treated as 0.
+//
+// Example:
+//
+//	c.SetBlockSpacing(10)
+//	c.Draw(creator.NewParagraph("First"))
+//	c.Draw(creator.NewParagraph("Second")) // 10pt below the first
+func (c *Creator) SetBlockSpacing(spacing float64) {
+	c.blockSpacing = math.Max(spacing, 0)
+	for _, page := range c.pages {
+		page.blockSpacing = c.blockSpacing
+	}
+}
+
+// BlockSpacing returns the space between drawables (see SetBlockSpacing).
+func (c *Creator) BlockSpacing() float64 {
+	return c.blockSpacing
+}
+
+// FlowContext returns the layout context used by Creator.Draw.
+//
+// The context is the persistent layout context of the last page of the
+// document (see Page.LayoutContext), shared with Page.Draw. It can be used
+// to inspect or adjust the flow cursor between Draw calls.
+// Returns nil if the document has no pages.
+func (c *Creator) FlowContext() *LayoutContext {
+	if len(c.pages) == 0 {
+		return nil
+	}
+	if err := c.syncFlowPage(); err != nil {
+		return nil
+	}
+	return c.flowCtx
+}
+
+// RemainingHeight returns the height left for drawables on the current
+// flow page, excluding the space reserved for the page's footnotes.
+//
+// Returns the full content height if the document has no pages yet.
+func (c *Creator) RemainingHeight() (float64, error) {
+	if err := c.ensureFlowPage(); err != nil {
+		return 0, err
+	}
+	return c.flowCtx.AvailableHeight() - c.footnoteAreaHeight(c.flowCtx, c.flowFootnotes), nil
+}
+
+// EnsureSpace starts a new flow page unless at least height points remain
+// on the current one (including the block spacing before the next
+// drawable). Use it to keep a group of drawables, such as an invoice's
+// totals block, together on one page.
+//
+// Nothing happens on an empty page, where the space cannot grow.
+//
+// Example:
+//
+//	totals := []creator.Drawable{subtotal, tax, total}
+//	height := 0.0
+//	for _, d := range totals {
+//	    height += d.Height(c.FlowContext())
+//	}
+//	if err := c.EnsureSpace(height); err != nil {
+//	    return err
+//	}
+//	for _, d := range totals {
+//	    c.Draw(d)
+//	}
+func (c *Creator) EnsureSpace(height float64) error {
+	remaining, err := c.RemainingHeight()
+	if err != nil {
+		return err
+	}
+	if c.flowPageEmpty() {
+		return nil
+	}
+	if remaining-c.blockSpacing >= height {
+		return nil
+	}
+	return c.flowNewPage()
+}
+
+// ensureFlowPage makes sure the flow has a page to draw on.
+func (c *Creator) ensureFlowPage() error {
+	if len(c.pages) == 0 {
+		return c.flowNewPage()
+	}
+	return c.syncFlowPage()
+}
+
+// syncFlowPage binds the flow to the last page of the document.
+//
+// The flow uses the page's persistent layout context, so it continues
+// below content drawn with Page.Draw, and Page.Draw continues below the
+// flow. Pages added with NewPage after the last Draw call take over the
+// flow.
+func (c *Creator) syncFlowPage() error {
+	last := c.pages[len(c.pages)-1]
+	if c.flowPage != last {
+		if err := c.flushFootnotes(); err != nil {
+			return err
+		}
+		c.flowPage = last
+	}
+	c.flowCtx = last.LayoutContext()
+	return nil
+}
+
+// flowDraw draws d at the flow cursor and registers its footnotes.
+func (c *Creator) flowDraw(d Drawable) error {
+	if err := d.Draw(c.flowCtx, c.flowPage); err != nil {
+		return err
+	}
+	c.flowFootnotes = append(c.flowFootnotes, footnotesOf(d)...)
+	return nil
+}
+
+// trackDrawable numbers figures and records the cross-references and
+// index marks drawn in the document.
+func (c *Creator) trackDrawable(d Drawable) {
+	c.numberFigure(d)
+	c.registerReferences(d)
+	c.registerIndexMark(d)
+}
+
+// finishFlow completes the current flow page before the document is written.
+func (c *Creator) finishFlow() error {
+	return c.flushFootnotes()
+}
+
+// flowNewPage adds a page and moves the flow to it.
+func (c *Creator) flowNewPage() error {
+	if err := c.flushFootnotes(); err != nil {
+		return err
+	}
+	page, err := c.NewPage()
+	if err != nil {
+		return fmt.Errorf("failed to add flow page: %w", err)
+	}
+	if c.onNewPage != nil {
+		c.onNewPage(page, len(c.pages))
+	}
+	c.flowPage = page
+	c.flowCtx = page.LayoutContext()
+	return nil
+}
+
+// flowPageEmpty reports whether the flow cursor is still at the top of the page.
+func (c *Creator) flowPageEmpty() bool {
+	return c.flowCtx.CursorY <= 0
+}
@@ -0,0 +1,269 @@
+package creator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreator_Draw_CreatesFirstPage(t *testing.T) {
+	c := New()
+
+	if err := c.Draw(NewParagraph("Hello")); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() != 1 {
+		t.Errorf("PageCount() = %d, want 1", c.PageCount())
+	}
+}
+
+func TestCreator_Draw_KeepsCursor(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("First"))
+	_ = c.Draw(NewParagraph("Second"))
+
+	ops := c.pages[0].TextOperations()
+	if len(ops) != 2 {
+		t.Fatalf("text ops = %d, want 2", len(ops))
+	}
+	if ops[1].Y >= ops[0].Y {
+		t.Errorf("second paragraph Y = %v, want below first (%v)", ops[1].Y, ops[0].Y)
+	}
+}
+
+func TestCreator_Draw_SplitsAcrossPages(t *testing.T) {
+	c := New()
+	text := strings.TrimSpace(strings.Repeat("word ", 2000))
+	if err := c.Draw(NewParagraph(text)); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() < 2 {
+		t.Fatalf("PageCount() = %d, want at least 2", c.PageCount())
+	}
+
+	total := 0
+	for _, page := range c.pages {
+		for _, op := range page.TextOperations() {
+			total += len(strings.Fields(op.Text))
+		}
+	}
+	if total != 2000 {
+		t.Errorf("words drawn = %d, want 2000", total)
+	}
+}
+
+func TestCreator_Draw_MovesUnsplittableToNextPage(t *testing.T) {
+	c := New()
+
+	// Fill most of the first page.
+	_ = c.Draw(NewParagraph("Top"))
+	ctx := c.FlowContext()
+	ctx.CursorY += ctx.AvailableHeight() - 5
+
+	table := NewTableLayout(1).AddRow("Cell")
+	if err := c.Draw(table); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() != 2 {
+		t.Fatalf("PageCount() = %d, want 2", c.PageCount())
+	}
+	if len(c.pages[1].TextOperations()) != 1 {
+		t.Errorf("table should be drawn on the second page")
+	}
+}
+
+func TestCreator_Draw_OversizedOnEmptyPage(t *testing.T) {
+	c := New()
+	_, _ = c.NewPage()
+
+	p := NewParagraph("Big").SetFont(Helvetica, 2000).SetKeepTogether(true)
+	if err := c.Draw(p); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() != 1 {
+		t.Errorf("PageCount() = %d, want 1", c.PageCount())
+	}
+}
+
+func TestCreator_Draw_SplitsTallerThanPage(t *testing.T) {
+	text := strings.TrimSpace(strings.Repeat("Keep these words together. ", 800))
+	tests := []struct {
+		name string
+		d    Drawable
+	}{
+		{"keep together", NewParagraph(text).SetKeepTogether(true)},
+		{"orphans and widows", NewParagraph(text).SetOrphans(1000).SetWidows(1000)},
+		{"rich text", NewRichText(Run(text, TextStyle{})).SetKeepTogether(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			if err := c.Draw(tt.d); err != nil {
+				t.Fatalf("Draw() error = %v", err)
+			}
+
+			if c.PageCount() < 2 {
+				t.Fatalf("PageCount() = %d, want at least 2", c.PageCount())
+			}
+			words := 0
+			for i, page := range c.pages {
+				bottom := page.Margins().Bottom
+				for _, op := range page.TextOperations() {
+					if op.Y < bottom {
+						t.Errorf("page %d: line at y = %.2f below the bottom margin %.2f", i+1, op.Y, bottom)
+					}
+					words += len(strings.Fields(op.Text))
+				}
+			}
+			if words != 4*800 {
+				t.Errorf("words drawn = %d, want %d", words, 4*800)
+			}
+		})
+	}
+}
+
+func TestCreator_Draw_FollowsNewPage(t *testing.T) {
+	c := New()
+	_ = c.Draw(NewParagraph("First"))
+
+	page, _ := c.NewPage()
+	_ = c.Draw(NewParagraph("Second"))
+
+	if len(page.TextOperations()) != 1 {
+		t.Errorf("flow should continue on the page added with NewPage")
+	}
+}
+
+func TestCreator_Draw_SharesPageCursor(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	_ = page.Draw(NewParagraph("First"))
+	_ = c.Draw(NewParagraph("Second"))
+	_ = page.Draw(NewParagraph("Third"))
+
+	ops := page.TextOperations()
+	if len(ops) != 3 {
+		t.Fatalf("text ops = %d, want 3", len(ops))
+	}
+	for i := 1; i < len(ops); i++ {
+		if ops[i].Y >= ops[i-1].Y {
+			t.Errorf("%q Y = %v, want below %q (%v)", ops[i].Text, ops[i].Y, ops[i-1].Text, ops[i-1].Y)
+		}
+	}
+	if c.FlowContext() != page.LayoutContext() {
+		t.Error("FlowContext() should be the page's layout context")
+	}
+}
+
+func TestCreator_SetBlockSpacing(t *testing.T) {
+	c := New()
+	c.SetBlockSpacing(10)
+
+	_ = c.Draw(NewParagraph("First"))
+	_ = c.Draw(NewParagraph("Second"))
+
+	ops := c.pages[0].TextOperations()
+	top := c.pages[0].Height() - c.pages[0].Margins().Top - 12
+	if ops[0].Y != top {
+		t.Errorf("first paragraph Y = %v, want %v (no spacing at the top)", ops[0].Y, top)
+	}
+	// One 14.4pt line plus 10pt of spacing.
+	if got := ops[0].Y - ops[1].Y; got < 24.39 || got > 24.41 {
+		t.Errorf("paragraph distance = %v, want 24.4", got)
+	}
+
+	// Page.Draw uses the spacing too.
+	page, _ := c.NewPage()
+	_ = page.Draw(NewParagraph("First"))
+	_ = page.Draw(NewParagraph("Second"))
+	ops = page.TextOperations()
+	if got := ops[0].Y - ops[1].Y; got < 24.39 || got > 24.41 {
+		t.Errorf("Page.Draw paragraph distance = %v, want 24.4", got)
+	}
+}
+
+func TestCreator_RemainingHeight(t *testing.T) {
+	c := New()
+
+	full, err := c.RemainingHeight()
+	if err != nil {
+		t.Fatalf("RemainingHeight() error = %v", err)
+	}
+	if want := c.FlowContext().AvailableHeight(); full != want {
+		t.Errorf("RemainingHeight() = %v, want %v", full, want)
+	}
+
+	_ = c.Draw(NewParagraph("One line"))
+	left, _ := c.RemainingHeight()
+	if got := full - left; got < 14.39 || got > 14.41 {
+		t.Errorf("used height = %v, want 14.4", got)
+	}
+}
+
+func TestCreator_EnsureSpace(t *testing.T) {
+	c := New()
+	_ = c.Draw(NewParagraph("Items"))
+
+	remaining, _ := c.RemainingHeight()
+	if err := c.EnsureSpace(remaining - 1); err != nil {
+		t.Fatalf("EnsureSpace() error = %v", err)
+	}
+	if c.PageCount() != 1 {
+		t.Errorf("PageCount() = %d, want 1 when the space fits", c.PageCount())
+	}
+
+	if err := c.EnsureSpace(remaining + 1); err != nil {
+		t.Fatalf("EnsureSpace() error = %v", err)
+	}
+	if c.PageCount() != 2 {
+		t.Fatalf("PageCount() = %d, want 2", c.PageCount())
+	}
+
+	// A new page is not broken again.
+	if err := c.EnsureSpace(100000); err != nil {
+		t.Fatalf("EnsureSpace() error = %v", err)
+	}
+	if c.PageCount() != 2 {
+		t.Errorf("PageCount() = %d, want 2 on an empty page", c.PageCount())
+	}
+}
+
+func TestCreator_SetOnNewPage(t *testing.T) {
+	c := New()
+
+	var nums []int
+	c.SetOnNewPage(func(page *Page, pageNum int) {
+		nums = append(nums, pageNum)
+		_ = page.AddText("Tab", 10, 10, Helvetica, 8)
+	})
+
+	text := strings.TrimSpace(strings.Repeat("word ", 2000))
+	if err := c.Draw(NewParagraph(text)); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+	_, _ = c.NewPage() // Not created by the flow.
+
+	if len(nums) != c.PageCount()-1 {
+		t.Fatalf("hook called %d times, want %d", len(nums), c.PageCount()-1)
+	}
+	for i, n := range nums {
+		if n != i+1 {
+			t.Errorf("call %d pageNum = %d, want %d", i, n, i+1)
+		}
+		if ops := c.pages[i].TextOperations(); ops[0].Text != "Tab" {
+			t.Errorf("page %d should start with the hook's content", i+1)
+		}
+	}
+
+	c.SetOnNewPage(nil)
+	_ = c.Draw(NewParagraph("Last"))
+	if err := c.Draw(NewPageBreak()); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package creator
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/coregx/gxpdf/internal/fonts"
+)
+
+// FontCache shares parsed font files between documents.
+//
+// Parsing a TrueType font is much more expensive than generating a small
+// document with it. FontCache parses each file once and returns the same
+// CustomFont for every Load call with that path. Fonts can be shared
+// between Creators; each document still embeds only the glyphs it uses.
+//
+// FontCache is safe for concurrent use.
+//
+// Example:
+//
+//	cache := creator.NewFontCache()
+//	for _, invoice := range invoices {
+//	    font, err := cache.Load("fonts/OpenSans-Regular.ttf")
+//	    if err != nil {
+//	        return err
+//	    }
+//	    c := creator.New()
+//	    // ... draw with font ...
+//	}
+type FontCache struct {
+	mu      sync.Mutex
+	entries map[string]*fontCacheEntry
+
+	// loadTTF parses a font file (fonts.LoadTTF, replaced in tests).
+	loadTTF func(path string) (*fonts.TTFFont, error)
+}
+
+// fontCacheEntry holds the result of parsing one font file.
+type fontCacheEntry struct {
+	once sync.Once
+	font *CustomFont
+	err  error
+}
+
+// NewFontCache creates an empty font cache.
+func NewFontCache() *FontCache {
+	return &FontCache{
+		entries: make(map[string]*fontCacheEntry),
+		loadTTF: fonts.LoadTTF,
+	}
+}
+
+// Load returns the CustomFont for the font file at path.
+//
+// The file is parsed on the first call for a path; later calls reuse the
+// parsed font. Concurrent first calls for the same path wait for a single
+// parse. Failed loads are not cached, so a later call retries.
+func (c *FontCache) Load(path string) (*CustomFont, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[path]
+	if !ok {
+		entry = &fontCacheEntry{}
+		c.entries[path] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		ttf, err := c.loadTTF(path)
+		if err != nil {
+			entry.err = err
+			return
+		}
+		entry.font = newCustomFont(ttf)
+	})
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[path] == entry {
+			delete(c.entries, path)
+		}
+		c.mu.Unlock()
+		return nil, fmt.Errorf("load TTF: %w", entry.err)
+	}
+
+	return entry.font, nil
+}
+
+// Len returns the number of cached font files.
+func (c *FontCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
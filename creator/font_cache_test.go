@@ -0,0 +1,81 @@
+package creator
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/fonts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestFontCache returns a cache that counts loads instead of parsing files.
+func newTestFontCache(loads *int32) *FontCache {
+	cache := NewFontCache()
+	cache.loadTTF = func(path string) (*fonts.TTFFont, error) {
+		atomic.AddInt32(loads, 1)
+		return &fonts.TTFFont{
+			FilePath:    path,
+			UnitsPerEm:  1000,
+			CharToGlyph: map[rune]uint16{'A': 1, 'B': 2},
+			GlyphWidths: map[uint16]uint16{1: 600, 2: 700},
+		}, nil
+	}
+	return cache
+}
+
+func TestFontCache_LoadParsesOnce(t *testing.T) {
+	var loads int32
+	cache := newTestFontCache(&loads)
+
+	f1, err := cache.Load("fonts/Test.ttf")
+	require.NoError(t, err)
+	f2, err := cache.Load("fonts/Test.ttf")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), loads)
+	assert.Equal(t, 1, cache.Len())
+	assert.Same(t, f1, f2)
+}
+
+func TestFontCache_ErrorNotCached(t *testing.T) {
+	cache := NewFontCache()
+	calls := 0
+	cache.loadTTF = func(string) (*fonts.TTFFont, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("read failed")
+		}
+		return &fonts.TTFFont{}, nil
+	}
+
+	_, err := cache.Load("fonts/Test.ttf")
+	require.Error(t, err)
+	assert.Equal(t, 0, cache.Len())
+
+	_, err = cache.Load("fonts/Test.ttf")
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestFontCache_Concurrent(t *testing.T) {
+	var loads int32
+	cache := newTestFontCache(&loads)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			font, err := cache.Load("fonts/Test.ttf")
+			if assert.NoError(t, err) {
+				assert.Equal(t, 1300.0, font.MeasureString("AB", 1000))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), loads)
+}
@@ -0,0 +1,85 @@
+package creator
+
+import (
+	"github.com/coregx/gxpdf/internal/fonts"
+)
+
+// FontMetrics contains the vertical metrics of a font at a given size.
+//
+// All values are in points, relative to the baseline: Ascent is positive,
+// Descent is negative. Use them to align baselines across fonts and sizes,
+// or to compute line heights.
+type FontMetrics struct {
+	// Ascent is the maximum height of glyphs above the baseline.
+	Ascent float64
+
+	// Descent is the maximum depth of glyphs below the baseline (negative).
+	Descent float64
+
+	// CapHeight is the height of flat capital letters (e.g. "H").
+	// Zero if the font does not provide it.
+	CapHeight float64
+
+	// XHeight is the height of flat lowercase letters (e.g. "x").
+	// Zero if the font does not provide it.
+	XHeight float64
+
+	// LineGap is the extra spacing between lines recommended by the font.
+	// Zero for the standard 14 fonts, whose metrics do not define it.
+	LineGap float64
+}
+
+// LineHeight returns the recommended distance between baselines:
+// Ascent - Descent + LineGap.
+func (m FontMetrics) LineHeight() float64 {
+	return m.Ascent - m.Descent + m.LineGap
+}
+
+// Metrics returns the vertical metrics of the font at the given size.
+//
+// Returns zero metrics if the font is not a standard 14 font.
+//
+// Example:
+//
+//	m := creator.Helvetica.Metrics(12)
+//	baseline := top - m.Ascent
+func (f FontName) Metrics(size float64) FontMetrics {
+	m := fonts.GetMetrics(string(f))
+	if m == nil {
+		return FontMetrics{}
+	}
+
+	// Standard 14 metrics use 1000 units per em.
+	scale := size / 1000.0
+	return FontMetrics{
+		Ascent:    float64(m.GetAscender()) * scale,
+		Descent:   float64(m.GetDescender()) * scale,
+		CapHeight: float64(m.GetCapHeight()) * scale,
+		XHeight:   float64(m.GetXHeight()) * scale,
+	}
+}
+
+// Metrics returns the vertical metrics of the font at the given size.
+//
+// Ascent, Descent and LineGap come from the hhea table; CapHeight and
+// XHeight from the OS/2 table.
+//
+// Example:
+//
+//	m := font.Metrics(12)
+//	lineHeight := m.LineHeight()
+func (f *CustomFont) Metrics(size float64) FontMetrics {
+	unitsPerEm := float64(f.ttfFont.UnitsPerEm)
+	if unitsPerEm == 0 {
+		unitsPerEm = 1000 // Fallback.
+	}
+
+	scale := size / unitsPerEm
+	return FontMetrics{
+		Ascent:    float64(f.ttfFont.Ascender) * scale,
+		Descent:   float64(f.ttfFont.Descender) * scale,
+		CapHeight: float64(f.ttfFont.CapHeight) * scale,
+		XHeight:   float64(f.ttfFont.XHeight) * scale,
+		LineGap:   float64(f.ttfFont.LineGap) * scale,
+	}
+}
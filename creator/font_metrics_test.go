@@ -0,0 +1,41 @@
+package creator
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/fonts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFontName_Metrics(t *testing.T) {
+	m := Helvetica.Metrics(10)
+
+	assert.InDelta(t, 7.18, m.Ascent, 0.001)
+	assert.InDelta(t, -2.07, m.Descent, 0.001)
+	assert.InDelta(t, 7.18, m.CapHeight, 0.001)
+	assert.InDelta(t, 5.23, m.XHeight, 0.001)
+	assert.Zero(t, m.LineGap)
+	assert.InDelta(t, 9.25, m.LineHeight(), 0.001)
+
+	assert.Equal(t, FontMetrics{}, FontName("Unknown").Metrics(10))
+}
+
+func TestCustomFont_Metrics(t *testing.T) {
+	font := newCustomFont(&fonts.TTFFont{
+		UnitsPerEm: 2048,
+		Ascender:   1854,
+		Descender:  -434,
+		LineGap:    67,
+		CapHeight:  1467,
+		XHeight:    1062,
+	})
+
+	m := font.Metrics(20.48)
+
+	assert.InDelta(t, 18.54, m.Ascent, 0.001)
+	assert.InDelta(t, -4.34, m.Descent, 0.001)
+	assert.InDelta(t, 0.67, m.LineGap, 0.001)
+	assert.InDelta(t, 14.67, m.CapHeight, 0.001)
+	assert.InDelta(t, 10.62, m.XHeight, 0.001)
+	assert.InDelta(t, 23.55, m.LineHeight(), 0.001)
+}
@@ -0,0 +1,72 @@
+package creator
+
+import (
+	"errors"
+)
+
+// RuneRange is an inclusive range of Unicode code points.
+type RuneRange struct {
+	First rune
+	Last  rune
+}
+
+// Common Unicode blocks for RegisterFont.
+var (
+	// RangeBasicLatin covers printable ASCII.
+	RangeBasicLatin = RuneRange{First: 0x0020, Last: 0x007E}
+
+	// RangeLatin1 covers the Latin-1 Supplement (accented Western European letters).
+	RangeLatin1 = RuneRange{First: 0x00A0, Last: 0x00FF}
+
+	// RangeLatinExtendedA covers Central and Eastern European letters.
+	RangeLatinExtendedA = RuneRange{First: 0x0100, Last: 0x017F}
+
+	// RangeGreek covers Greek and Coptic.
+	RangeGreek = RuneRange{First: 0x0370, Last: 0x03FF}
+
+	// RangeCyrillic covers Cyrillic.
+	RangeCyrillic = RuneRange{First: 0x0400, Last: 0x04FF}
+)
+
+// fontRegistration is a font registered with Creator.RegisterFont.
+type fontRegistration struct {
+	font   *CustomFont
+	ranges []RuneRange
+}
+
+// RegisterFont registers a custom font with the document.
+//
+// Fonts are registered automatically when text is drawn with them, so
+// registration is only needed to include glyphs the document does not
+// draw itself, e.g. for characters users will type into form fields.
+// Glyphs in ranges that the font does not contain are skipped.
+//
+// A font is embedded once and shared by all pages that use it.
+//
+// Example:
+//
+//	font, _ := creator.LoadFont("fonts/OpenSans-Regular.ttf")
+//	err := c.RegisterFont(font, creator.RangeBasicLatin, creator.RangeCyrillic)
+func (c *Creator) RegisterFont(font *CustomFont, ranges ...RuneRange) error {
+	if font == nil {
+		return errors.New("font cannot be nil")
+	}
+	for _, r := range ranges {
+		if r.First < 0 || r.Last < r.First {
+			return errors.New("rune range must be non-negative with First <= Last")
+		}
+	}
+
+	c.registeredFonts = append(c.registeredFonts, fontRegistration{font: font, ranges: ranges})
+	return nil
+}
+
+// newFontSubsets creates the font subsets for one write of the document,
+// including the glyphs of registered fonts.
+func (c *Creator) newFontSubsets() fontSubsets {
+	subsets := make(fontSubsets)
+	for _, reg := range c.registeredFonts {
+		subsets.include(reg.font, reg.ranges)
+	}
+	return subsets
+}
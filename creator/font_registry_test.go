@@ -0,0 +1,52 @@
+package creator
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreator_RegisterFont(t *testing.T) {
+	font := newTestCustomFont()
+
+	c := New()
+	require.NoError(t, c.RegisterFont(font, RuneRange{First: 'A', Last: 'C'}))
+
+	page, err := c.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddTextCustomFont("A", 100, 700, font, 12))
+
+	text, _ := c.collectAllPageContents()
+	subset := text[0][0].CustomFont.Subset
+
+	// 'B' is pre-included; 'C' is skipped because the font has no glyph for it.
+	assert.Equal(t, map[rune]bool{'A': true, 'B': true}, subset.UsedChars)
+}
+
+func TestCreator_RegisterFont_Invalid(t *testing.T) {
+	c := New()
+
+	assert.Error(t, c.RegisterFont(nil))
+	assert.Error(t, c.RegisterFont(newTestCustomFont(), RuneRange{First: 'Z', Last: 'A'}))
+	assert.NoError(t, c.RegisterFont(newTestCustomFont(), RangeBasicLatin, RangeCyrillic))
+}
+
+func TestCreator_CustomFontWrittenOnce(t *testing.T) {
+	font := newTestCustomFont()
+
+	c := New()
+	for i := 0; i < 3; i++ {
+		page, err := c.NewPage()
+		require.NoError(t, err)
+		require.NoError(t, page.AddTextCustomFont("AB", 100, 700, font, 12))
+	}
+
+	var buf bytes.Buffer
+	_, err := c.WriteTo(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, strings.Count(buf.String(), "/Type /FontDescriptor"))
+}
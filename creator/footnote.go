@@ -0,0 +1,160 @@
+package creator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Footnote layout defaults (in points).
+const (
+	// DefaultFootnoteFontSize is the default font size for footnote text.
+	DefaultFootnoteFontSize = 8.0
+
+	// footnoteSeparatorGap is the space between the separator rule and the first note.
+	footnoteSeparatorGap = 6.0
+
+	// footnoteSeparatorRatio is the separator rule length relative to the content width.
+	footnoteSeparatorRatio = 1.0 / 3.0
+
+	// footnoteMarkerScale is the size of reference markers relative to the text size.
+	footnoteMarkerScale = 0.6
+
+	// footnoteMarkerRise is the superscript offset of reference markers
+	// above the baseline, relative to the text size.
+	footnoteMarkerRise = 0.35
+)
+
+// Footnote is a note rendered at the bottom of the page where its
+// reference marker appears.
+//
+// Footnotes are attached to paragraphs with Paragraph.AddFootnote and are
+// laid out by Creator.Draw, which reserves space for them at the page bottom.
+type Footnote struct {
+	// Marker is the reference mark shown in the text and before the note.
+	// Empty means the footnote is numbered automatically.
+	Marker string
+
+	// Text is the footnote content.
+	Text string
+
+	// number is the automatic number assigned by the flow (0 = unassigned).
+	number int
+}
+
+// Label returns the marker displayed for the footnote.
+//
+// For automatically numbered footnotes this is the assigned number,
+// or an empty string if the footnote has not been placed yet.
+func (f *Footnote) Label() string {
+	if f.Marker != "" {
+		return f.Marker
+	}
+	if f.number > 0 {
+		return strconv.Itoa(f.number)
+	}
+	return ""
+}
+
+// footnoteCarrier is implemented by drawables that carry footnotes.
+type footnoteCarrier interface {
+	Footnotes() []*Footnote
+}
+
+// SetFootnoteFont sets the font and size used to render footnotes.
+//
+// Default: Helvetica, 8pt.
+func (c *Creator) SetFootnoteFont(font FontName, size float64) {
+	c.footnoteFont = font
+	c.footnoteSize = size
+}
+
+// footnotesOf returns the footnotes carried by a drawable, if any.
+func footnotesOf(d Drawable) []*Footnote {
+	if fc, ok := d.(footnoteCarrier); ok {
+		return fc.Footnotes()
+	}
+	return nil
+}
+
+// numberFootnotes assigns document-wide numbers to automatic footnotes.
+func (c *Creator) numberFootnotes(notes []*Footnote) {
+	for _, fn := range notes {
+		if fn.Marker == "" && fn.number == 0 {
+			c.footnoteCount++
+			fn.number = c.footnoteCount
+		}
+	}
+}
+
+// footnoteParagraph builds the paragraph used to render a footnote.
+func (c *Creator) footnoteParagraph(fn *Footnote) *Paragraph {
+	font, size := c.footnoteFont, c.footnoteSize
+	if font == "" {
+		font = Helvetica
+	}
+	if size <= 0 {
+		size = DefaultFootnoteFontSize
+	}
+	text := fn.Text
+	if label := fn.Label(); label != "" {
+		text = label + " " + text
+	}
+	return NewParagraph(text).SetFont(font, size)
+}
+
+// footnoteAreaHeight returns the height needed at the page bottom for the
+// given footnotes, including the separator. Returns 0 for no footnotes.
+func (c *Creator) footnoteAreaHeight(ctx *LayoutContext, notes []*Footnote) float64 {
+	if len(notes) == 0 {
+		return 0
+	}
+	height := footnoteSeparatorGap
+	for _, fn := range notes {
+		height += c.footnoteParagraph(fn).Height(ctx)
+	}
+	return height
+}
+
+// flushFootnotes renders the footnotes collected for the current flow page.
+func (c *Creator) flushFootnotes() error {
+	notes := c.flowFootnotes
+	c.flowFootnotes = nil
+	if len(notes) == 0 || c.flowPage == nil {
+		return nil
+	}
+
+	page := c.flowPage
+	ctx := page.GetLayoutContext()
+	contentHeight := ctx.PageHeight - ctx.Margins.Top - ctx.Margins.Bottom
+	ctx.CursorY = contentHeight - c.footnoteAreaHeight(ctx, notes)
+
+	// Separator rule.
+	y := ctx.CurrentPDFY()
+	ruleWidth := ctx.AvailableWidth() * footnoteSeparatorRatio
+	if err := page.drawLine(ctx.ContentLeft(), y, ctx.ContentLeft()+ruleWidth, y, &LineOptions{
+		Color: Black,
+		Width: 0.5,
+	}); err != nil {
+		return err
+	}
+	ctx.CursorY += footnoteSeparatorGap
+
+	for _, fn := range notes {
+		if err := c.footnoteParagraph(fn).Draw(ctx, page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// footnoteMarkers returns the reference markers drawn after a paragraph's text.
+func footnoteMarkers(notes []*Footnote) string {
+	labels := make([]string, 0, len(notes))
+	for _, fn := range notes {
+		if label := fn.Label(); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return strings.Join(labels, ",")
+}
@@ -0,0 +1,154 @@
+package creator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParagraph_AddFootnote(t *testing.T) {
+	p := NewParagraph("Text")
+
+	result := p.AddFootnote("*", "Note")
+	if result != p {
+		t.Error("AddFootnote should return the paragraph for chaining")
+	}
+
+	if len(p.Footnotes()) != 1 {
+		t.Fatalf("Footnotes() count = %d, want 1", len(p.Footnotes()))
+	}
+	if p.Footnotes()[0].Label() != "*" {
+		t.Errorf("Label() = %q, want %q", p.Footnotes()[0].Label(), "*")
+	}
+}
+
+func TestCreator_Draw_NumbersFootnotes(t *testing.T) {
+	c := New()
+
+	p1 := NewParagraph("First").AddFootnote("", "One")
+	p2 := NewParagraph("Second").AddFootnote("", "Two").AddFootnote("", "Three")
+	_ = c.Draw(p1)
+	_ = c.Draw(p2)
+
+	if got := p2.Footnotes()[1].Label(); got != "3" {
+		t.Errorf("third footnote label = %q, want %q", got, "3")
+	}
+
+	ops := c.pages[0].TextOperations()
+	if len(ops) != 4 {
+		t.Fatalf("text ops = %d, want 4", len(ops))
+	}
+	text, markers := ops[2], ops[3]
+	if text.Text != "Second" || markers.Text != "2,3" {
+		t.Errorf("reference text = %q + %q, want %q + %q", text.Text, markers.Text, "Second", "2,3")
+	}
+}
+
+func TestParagraph_Draw_FootnoteMarkersSuperscript(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	p := NewParagraph("Revenue grew.").AddFootnote("*", "Unaudited.")
+	if err := p.Draw(page.GetLayoutContext(), page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	ops := page.TextOperations()
+	if len(ops) != 2 {
+		t.Fatalf("text ops = %d, want 2", len(ops))
+	}
+	text, marker := ops[0], ops[1]
+	if marker.Text != "*" {
+		t.Errorf("marker text = %q, want %q", marker.Text, "*")
+	}
+	if marker.Size >= text.Size {
+		t.Errorf("marker size = %v, want smaller than text size %v", marker.Size, text.Size)
+	}
+	if marker.Y <= text.Y {
+		t.Errorf("marker Y = %v, want raised above baseline %v", marker.Y, text.Y)
+	}
+	if marker.X <= text.X {
+		t.Errorf("marker X = %v, want after the text at %v", marker.X, text.X)
+	}
+}
+
+func TestCreator_FootnoteParagraph_NoLabel(t *testing.T) {
+	c := New()
+
+	if got := c.footnoteParagraph(&Footnote{Text: "Note"}).Text(); got != "Note" {
+		t.Errorf("text = %q, want %q", got, "Note")
+	}
+	if got := c.footnoteParagraph(&Footnote{Marker: "*", Text: "Note"}).Text(); got != "* Note" {
+		t.Errorf("text = %q, want %q", got, "* Note")
+	}
+}
+
+func TestCreator_Draw_RendersFootnotesAtPageBottom(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("Body").AddFootnote("", "The note"))
+	if err := c.finishFlow(); err != nil {
+		t.Fatalf("finishFlow() error = %v", err)
+	}
+
+	page := c.pages[0]
+	ops := page.TextOperations()
+	if len(ops) != 3 {
+		t.Fatalf("text ops = %d, want 3 (body, marker, note)", len(ops))
+	}
+
+	note := ops[2]
+	if note.Text != "1 The note" {
+		t.Errorf("footnote text = %q, want %q", note.Text, "1 The note")
+	}
+	if note.Size != DefaultFootnoteFontSize {
+		t.Errorf("footnote size = %v, want %v", note.Size, DefaultFootnoteFontSize)
+	}
+	if note.Y < page.margins.Bottom || note.Y > page.margins.Bottom+20 {
+		t.Errorf("footnote Y = %v, want just above bottom margin %v", note.Y, page.margins.Bottom)
+	}
+
+	if len(page.GraphicsOperations()) != 1 {
+		t.Errorf("expected separator line, got %d graphics ops", len(page.GraphicsOperations()))
+	}
+}
+
+func TestCreator_Draw_FootnoteReservesSpace(t *testing.T) {
+	c := New()
+
+	// Fill the page so that the paragraph fits but its footnote would not.
+	_ = c.Draw(NewParagraph("Top"))
+	ctx := c.FlowContext()
+	ctx.CursorY += ctx.AvailableHeight() - 20
+
+	p := NewParagraph("Body").AddFootnote("", strings.Repeat("long note ", 20))
+	_ = c.Draw(p)
+
+	if c.PageCount() != 2 {
+		t.Fatalf("PageCount() = %d, want 2 (paragraph should move with its footnote)", c.PageCount())
+	}
+	if len(c.pages[1].TextOperations()) != 2 {
+		t.Errorf("paragraph should be drawn on the second page")
+	}
+}
+
+func TestParagraph_Split_FootnotesFollowLastLine(t *testing.T) {
+	ctx := narrowContext()
+	p := tenLineParagraph().AddFootnote("*", "Note")
+
+	head, tail := p.Split(ctx, 35)
+
+	if len(head.(*Paragraph).Footnotes()) != 0 {
+		t.Error("head should not carry footnotes")
+	}
+	tp := tail.(*Paragraph)
+	if len(tp.Footnotes()) != 1 {
+		t.Fatal("tail should carry the footnote")
+	}
+	if strings.HasSuffix(tp.Text(), "*") {
+		t.Errorf("tail text %q should not contain the marker", tp.Text())
+	}
+	lines := tp.WrapTextLines(ctx.AvailableWidth())
+	if lines[len(lines)-1] != "Line" {
+		t.Errorf("last line = %q, want %q", lines[len(lines)-1], "Line")
+	}
+}
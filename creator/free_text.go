@@ -0,0 +1,166 @@
+package creator
+
+import (
+	"github.com/coregx/gxpdf/internal/document"
+)
+
+// FreeTextAnnotation represents a free text annotation (text box).
+//
+// Free text annotations display their text directly on the page, without
+// a pop-up, which makes them suitable for callouts on drawings. The
+// appearance (background, border and wrapped text) is generated when the
+// document is written.
+//
+// Example:
+//
+//	box := creator.NewFreeTextAnnotation(creator.Rect{X: 100, Y: 600, Width: 200, Height: 50}, "Check this dimension")
+//	box.SetFont(creator.HelveticaBold, 10).SetTextColor(creator.Red)
+//	box.SetBackground(creator.Yellow)
+//	page.AddFreeTextAnnotation(box)
+type FreeTextAnnotation struct {
+	rect        Rect                     // Text box (from bottom-left)
+	text        string                   // Displayed text
+	author      string                   // Author name
+	font        FontName                 // Standard 14 font
+	fontSize    float64                  // Font size in points
+	textColor   Color                    // Text color
+	borderWidth float64                  // Border width (0 = none)
+	borderColor Color                    // Border color
+	background  *Color                   // Background color (nil = none)
+	flags       document.AnnotationFlags // Annotation flags (/F)
+}
+
+// NewFreeTextAnnotation creates a new free text annotation.
+//
+// Defaults: Helvetica 12pt black text, 1pt black border, no background.
+// Text wraps to the box width; newlines start new lines.
+//
+// Example:
+//
+//	box := creator.NewFreeTextAnnotation(creator.Rect{X: 100, Y: 600, Width: 200, Height: 50}, "Note")
+func NewFreeTextAnnotation(rect Rect, text string) *FreeTextAnnotation {
+	return &FreeTextAnnotation{
+		rect:        rect,
+		text:        text,
+		font:        Helvetica,
+		fontSize:    12,
+		textColor:   Black,
+		borderWidth: 1,
+		borderColor: Black,
+	}
+}
+
+// SetFont sets the font and size.
+//
+// Example:
+//
+//	box.SetFont(creator.TimesRoman, 10)
+func (a *FreeTextAnnotation) SetFont(font FontName, size float64) *FreeTextAnnotation {
+	a.font = font
+	a.fontSize = size
+	return a
+}
+
+// SetTextColor sets the text color.
+//
+// Example:
+//
+//	box.SetTextColor(creator.Red)
+func (a *FreeTextAnnotation) SetTextColor(color Color) *FreeTextAnnotation {
+	a.textColor = color
+	return a
+}
+
+// SetBorder sets the border width and color. A width of 0 removes the border.
+//
+// Example:
+//
+//	box.SetBorder(2, creator.Blue)
+func (a *FreeTextAnnotation) SetBorder(width float64, color Color) *FreeTextAnnotation {
+	a.borderWidth = width
+	a.borderColor = color
+	return a
+}
+
+// SetBackground sets the background color.
+//
+// Example:
+//
+//	box.SetBackground(creator.Yellow)
+func (a *FreeTextAnnotation) SetBackground(color Color) *FreeTextAnnotation {
+	a.background = &color
+	return a
+}
+
+// SetAuthor sets the author name.
+//
+// Example:
+//
+//	box.SetAuthor("John Doe")
+func (a *FreeTextAnnotation) SetAuthor(author string) *FreeTextAnnotation {
+	a.author = author
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	box.SetPrintable(true)
+func (a *FreeTextAnnotation) SetPrintable(printable bool) *FreeTextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	box.SetHidden(true)
+func (a *FreeTextAnnotation) SetHidden(hidden bool) *FreeTextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	box.SetReadOnly(true)
+func (a *FreeTextAnnotation) SetReadOnly(readOnly bool) *FreeTextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	box.SetNoView(true).SetPrintable(true)
+func (a *FreeTextAnnotation) SetNoView(noView bool) *FreeTextAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
+// toDomain converts the Creator API annotation to a domain annotation.
+func (a *FreeTextAnnotation) toDomain() *document.FreeTextAnnotation {
+	rect := [4]float64{
+		a.rect.X,                 // x1 (left)
+		a.rect.Y,                 // y1 (bottom)
+		a.rect.X + a.rect.Width,  // x2 (right)
+		a.rect.Y + a.rect.Height, // y2 (top)
+	}
+
+	domainAnnot := document.NewFreeTextAnnotation(rect, a.text)
+	domainAnnot.SetFont(string(a.font), a.fontSize)
+	domainAnnot.SetTextColor([3]float64{a.textColor.R, a.textColor.G, a.textColor.B})
+	domainAnnot.SetBorder(a.borderWidth, [3]float64{a.borderColor.R, a.borderColor.G, a.borderColor.B})
+	if a.background != nil {
+		domainAnnot.SetBackgroundColor([3]float64{a.background.R, a.background.G, a.background.B})
+	}
+	domainAnnot.SetAuthor(a.author)
+	domainAnnot.Flags = a.flags
+
+	return domainAnnot
+}
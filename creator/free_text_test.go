@@ -0,0 +1,117 @@
+package creator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/document"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreeTextAnnotation_Defaults(t *testing.T) {
+	box := NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 200, Height: 50}, "Callout")
+	d := box.toDomain()
+
+	assert.Equal(t, [4]float64{100, 600, 300, 650}, d.Rect)
+	assert.Equal(t, "Callout", d.Contents)
+	assert.Equal(t, "Helvetica", d.FontName)
+	assert.Equal(t, 12.0, d.FontSize)
+	assert.Equal(t, [3]float64{0, 0, 0}, d.TextColor)
+	assert.Equal(t, 1.0, d.BorderWidth)
+	assert.Nil(t, d.BackgroundColor)
+	assert.Zero(t, d.Flags)
+}
+
+func TestFreeTextAnnotation_Setters(t *testing.T) {
+	box := NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 200, Height: 50}, "Callout").
+		SetFont(TimesBold, 9).
+		SetTextColor(Red).
+		SetBorder(2, Blue).
+		SetBackground(Yellow).
+		SetAuthor("Alice").
+		SetPrintable(true)
+	d := box.toDomain()
+
+	assert.Equal(t, "Times-Bold", d.FontName)
+	assert.Equal(t, 9.0, d.FontSize)
+	assert.Equal(t, [3]float64{Red.R, Red.G, Red.B}, d.TextColor)
+	assert.Equal(t, 2.0, d.BorderWidth)
+	assert.Equal(t, [3]float64{Blue.R, Blue.G, Blue.B}, d.BorderColor)
+	require.NotNil(t, d.BackgroundColor)
+	assert.Equal(t, [3]float64{Yellow.R, Yellow.G, Yellow.B}, *d.BackgroundColor)
+	assert.Equal(t, "Alice", d.Title)
+	assert.Equal(t, document.AnnotationFlagPrint, d.Flags)
+}
+
+func TestFreeTextAnnotation_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		box  *FreeTextAnnotation
+	}{
+		{"zero size", NewFreeTextAnnotation(Rect{X: 100, Y: 600}, "x")},
+		{"zero font size", NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 50, Height: 20}, "x").SetFont(Helvetica, 0)},
+		{"negative border", NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 50, Height: 20}, "x").SetBorder(-1, Black)},
+		{"invalid color", NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 50, Height: 20}, "x").SetBackground(Color{R: 2})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			page, err := c.NewPage()
+			require.NoError(t, err)
+			assert.Error(t, page.AddFreeTextAnnotation(tt.box))
+		})
+	}
+}
+
+func TestFreeTextAnnotation_Output(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	box := NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 120, Height: 60},
+		"Check this dimension against the drawing\nRev B").
+		SetBackground(Yellow).
+		SetBorder(1.5, Red)
+	require.NoError(t, page.AddFreeTextAnnotation(box))
+	assert.Equal(t, 1, page.page.AnnotationCount())
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	// Annotation dictionary.
+	assert.Contains(t, out, "/Subtype /FreeText /Rect [100.00 600.00 220.00 660.00]")
+	assert.Contains(t, out, "/DA (/Helv 12.00 Tf 0.00 0.00 0.00 rg)")
+	assert.Contains(t, out, "/BS << /W 1.50 /S /S >>")
+	assert.Contains(t, out, "/AP << /N ")
+
+	// Appearance stream.
+	assert.Contains(t, out, "/Subtype /Form /BBox [0.00 0.00 120.00 60.00]")
+	assert.Contains(t, out, "/BaseFont /Helvetica /Encoding /WinAnsiEncoding")
+	assert.Contains(t, out, "0.00 0.00 120.00 60.00 re\nf\n")
+	assert.Contains(t, out, "0.75 0.75 118.50 58.50 re\nS\n")
+
+	// Text is wrapped to the box width; the newline starts a new line.
+	assert.Contains(t, out, "(Check this) Tj")
+	assert.Contains(t, out, "(Rev B) Tj")
+	assert.NotContains(t, out, "(Check this dimension against the drawing) Tj")
+	assert.Contains(t, out, "(Rev B) Tj\nET\nQ\nendstream")
+}
+
+func TestFreeTextAnnotation_LongWord(t *testing.T) {
+	// A single word wider than the box stays on its own line.
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+	box := NewFreeTextAnnotation(Rect{X: 100, Y: 600, Width: 30, Height: 40}, "Supercalifragilistic ok")
+	require.NoError(t, page.AddFreeTextAnnotation(box))
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "(Supercalifragilistic) Tj")
+	assert.Contains(t, buf.String(), "(ok) Tj")
+}
@@ -31,7 +31,10 @@ const (
 	// GraphicsOpBezier draws a complex curve composed of Bézier segments.
 	GraphicsOpBezier
 
-	// Reserved 9-19 for future graphics ops.
+	// GraphicsOpPath draws an arbitrary path (see Page.DrawPath).
+	GraphicsOpPath
+
+	// Reserved 10-19 for future graphics ops.
 
 	// GraphicsOpBeginClip begins a rectangular clipping region.
 	// All subsequent drawing is clipped to the rectangle (X, Y, Width, Height).
@@ -99,6 +102,11 @@ type RectOptions struct {
 	// Mutually exclusive with FillGradient.
 	FillColorCMYK *ColorCMYK
 
+	// FillColorIndexed is the fill color as a palette entry of an Indexed
+	// color space (nil = no fill).
+	// If set, this takes precedence over FillColorCMYK and FillColor.
+	FillColorIndexed *IndexedColor
+
 	// FillGradient is the gradient fill (nil = no gradient fill).
 	// Mutually exclusive with FillColor and FillColorCMYK.
 	FillGradient *Gradient
@@ -144,6 +152,11 @@ type CircleOptions struct {
 	// Mutually exclusive with FillGradient.
 	FillColorCMYK *ColorCMYK
 
+	// FillColorIndexed is the fill color as a palette entry of an Indexed
+	// color space (nil = no fill).
+	// If set, this takes precedence over FillColorCMYK and FillColor.
+	FillColorIndexed *IndexedColor
+
 	// FillGradient is the gradient fill (nil = no gradient fill).
 	// Mutually exclusive with FillColor and FillColorCMYK.
 	FillGradient *Gradient
@@ -161,12 +174,13 @@ type CircleOptions struct {
 // - GraphicsOpLine: X, Y, X2, Y2, LineOpts.
 // - GraphicsOpRect: X, Y, Width, Height, RectOpts.
 // - GraphicsOpCircle: X, Y, Radius, CircleOpts.
-// - GraphicsOpImage: X, Y, Width, Height, Image.
+// - GraphicsOpImage: X, Y, Width, Height, Image, ImageBox.
 // - GraphicsOpWatermark: X, Y, WatermarkOp.
 // - GraphicsOpPolygon: Vertices, PolygonOpts.
 // - GraphicsOpPolyline: Vertices, PolylineOpts.
 // - GraphicsOpEllipse: X, Y, RX, RY, EllipseOpts.
 // - GraphicsOpBezier: BezierSegs, BezierOpts.
+// - GraphicsOpPath: Path, PathOpts.
 type GraphicsOperation struct {
 	// Type is the graphics operation type.
 	Type GraphicsOpType
@@ -204,6 +218,9 @@ type GraphicsOperation struct {
 	// BezierSegs is the array of Bézier segments (only for bezier).
 	BezierSegs []BezierSegment
 
+	// Path is the path to draw (only for path).
+	Path *Path
+
 	// LineOpts are line options (only for line).
 	LineOpts *LineOptions
 
@@ -225,9 +242,16 @@ type GraphicsOperation struct {
 	// BezierOpts are Bézier curve options (only for bezier).
 	BezierOpts *BezierOptions
 
+	// PathOpts are path options (only for path).
+	PathOpts *PathOptions
+
 	// Image is the image to draw (only for image).
 	Image *Image
 
+	// ImageBox is the box the image was placed in, which the image is
+	// rotated around and clipped to (only for image, nil = none).
+	ImageBox *ImageBox
+
 	// WatermarkOp is the watermark operation (only for watermark).
 	WatermarkOp *TextWatermark
 
@@ -1,5 +1,10 @@
 package creator
 
+import (
+	"strconv"
+	"strings"
+)
+
 // HeaderFunctionArgs contains information passed to the header function.
 //
 // This struct provides context about the current page and a Block to draw
@@ -93,6 +98,65 @@ type HeaderFunc func(args HeaderFunctionArgs)
 //	}
 type FooterFunc func(args FooterFunctionArgs)
 
+// SeparatorLine describes a horizontal rule drawn under the header or
+// above the footer.
+//
+// Example:
+//
+//	c.SetHeaderSeparator(&SeparatorLine{Color: Gray, Width: 0.5})
+//	c.SetFooterSeparator(&SeparatorLine{Color: Gray, Width: 0.5, Inset: 20})
+type SeparatorLine struct {
+	// Color is the line color.
+	Color Color
+
+	// Width is the line width in points (default: 0.5).
+	Width float64
+
+	// Inset shortens the line on both sides, measured from the page
+	// margins in points (0 = full content width).
+	Inset float64
+
+	// Dashed enables dashed line rendering.
+	Dashed bool
+
+	// DashArray defines the dash pattern (e.g., [3, 1] for "3 on, 1 off").
+	// Only used when Dashed is true.
+	DashArray []float64
+}
+
+// lineOp returns the graphics operation drawing the separator at y across
+// the content width of the page.
+func (s *SeparatorLine) lineOp(page *Page, y float64) GraphicsOperation {
+	width := s.Width
+	if width <= 0 {
+		width = 0.5
+	}
+	opts := &LineOptions{
+		Color:     s.Color,
+		Width:     width,
+		Dashed:    s.Dashed,
+		DashArray: s.DashArray,
+	}
+	return GraphicsOperation{
+		Type:     GraphicsOpLine,
+		X:        page.margins.Left + s.Inset,
+		Y:        y,
+		X2:       page.Width() - page.margins.Right - s.Inset,
+		Y2:       y,
+		LineOpts: opts,
+	}
+}
+
+// expandPageTokens substitutes the {page}, {pages} and {title} tokens of a
+// header or footer template.
+func expandPageTokens(template string, pageNum, totalPages int, title string) string {
+	return strings.NewReplacer(
+		"{page}", strconv.Itoa(pageNum),
+		"{pages}", strconv.Itoa(totalPages),
+		"{title}", title,
+	).Replace(template)
+}
+
 // Default header and footer heights in points.
 const (
 	// DefaultHeaderHeight is the default height for headers (50 points).
@@ -336,6 +336,70 @@ func TestCreator_HeaderFooter_SkipFirst(t *testing.T) {
 	assert.Equal(t, []int{2, 3}, footerPages)
 }
 
+func TestCreator_HeaderFooterSeparators(t *testing.T) {
+	c := New()
+	c.SetHeaderHeight(40)
+	c.SetFooterHeight(20)
+	c.SetHeaderSeparator(&SeparatorLine{Color: Gray, Width: 1})
+	c.SetFooterSeparator(&SeparatorLine{Color: Gray, Inset: 10, Dashed: true, DashArray: []float64{2, 2}})
+	c.SetSkipHeaderOnFirstPage(true)
+
+	for i := 0; i < 2; i++ {
+		_, err := c.NewPage()
+		require.NoError(t, err)
+	}
+	page := c.pages[0]
+
+	_, graphics := c.collectAllPageContents()
+
+	// Page 1: footer rule only.
+	require.Len(t, graphics[0], 1)
+	footer := graphics[0][0]
+	assert.Equal(t, page.margins.Bottom+20, footer.Y)
+	assert.Equal(t, page.margins.Left+10, footer.X)
+	assert.Equal(t, page.Width()-page.margins.Right-10, footer.X2)
+	assert.Equal(t, 0.5, footer.StrokeWidth)
+	assert.True(t, footer.Dashed)
+
+	// Page 2: header and footer rules.
+	require.Len(t, graphics[1], 2)
+	header := graphics[1][0]
+	assert.Equal(t, page.Height()-page.margins.Top-40, header.Y)
+	assert.Equal(t, page.margins.Left, header.X)
+	assert.Equal(t, 1.0, header.StrokeWidth)
+
+	// Removing the separators removes the rules.
+	c.SetHeaderSeparator(nil)
+	c.SetFooterSeparator(nil)
+	_, graphics = c.collectAllPageContents()
+	assert.Empty(t, graphics)
+}
+
+func TestCreator_SetFooterText(t *testing.T) {
+	c := New()
+	c.SetTitle("Annual Report")
+	c.SetFooterText("{title} - Page {page} of {pages}", TextStyle{Color: Gray})
+
+	for i := 0; i < 2; i++ {
+		_, err := c.NewPage()
+		require.NoError(t, err)
+	}
+
+	texts, _ := c.collectAllPageContents()
+
+	require.Len(t, texts[1], 1)
+	op := texts[1][0]
+	assert.Equal(t, "Annual Report - Page 2 of 2", op.Text)
+	assert.Equal(t, string(Helvetica), op.Font)
+	assert.Equal(t, 10.0, op.Size)
+	assert.Equal(t, Gray.R, op.Color.R)
+}
+
+func TestExpandPageTokens(t *testing.T) {
+	got := expandPageTokens("{page}/{pages} {title} {unknown}", 3, 12, "Guide")
+	assert.Equal(t, "3/12 Guide {unknown}", got)
+}
+
 func TestCreator_HeaderFooter_TotalPages(t *testing.T) {
 	c := New()
 
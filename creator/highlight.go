@@ -15,13 +15,15 @@ import (
 //	highlight.SetAuthor("John Doe")
 //	page.AddHighlightAnnotation(highlight)
 type HighlightAnnotation struct {
-	x1     float64 // Left X coordinate
-	y1     float64 // Bottom Y coordinate
-	x2     float64 // Right X coordinate
-	y2     float64 // Top Y coordinate
-	color  Color   // Highlight color
-	author string  // Author name
-	note   string  // Optional note text
+	x1     float64                  // Left X coordinate
+	y1     float64                  // Bottom Y coordinate
+	x2     float64                  // Right X coordinate
+	y2     float64                  // Top Y coordinate
+	color  Color                    // Highlight color
+	author string                   // Author name
+	note   string                   // Optional note text
+	flags  document.AnnotationFlags // Annotation flags (/F)
+	popup  *document.Popup          // Pop-up window (nil = none)
 }
 
 // NewHighlightAnnotation creates a new highlight annotation.
@@ -89,6 +91,59 @@ func (a *HighlightAnnotation) SetNote(note string) *HighlightAnnotation {
 	return a
 }
 
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Some viewers only display the note of a highlight through its pop-up.
+//
+// Example:
+//
+//	highlight.SetNote("Important point").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *HighlightAnnotation) SetPopup(open bool, rect Rect) *HighlightAnnotation {
+	a.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	highlight.SetPrintable(true)
+func (a *HighlightAnnotation) SetPrintable(printable bool) *HighlightAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	highlight.SetHidden(true)
+func (a *HighlightAnnotation) SetHidden(hidden bool) *HighlightAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	highlight.SetReadOnly(true)
+func (a *HighlightAnnotation) SetReadOnly(readOnly bool) *HighlightAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	highlight.SetNoView(true).SetPrintable(true)
+func (a *HighlightAnnotation) SetNoView(noView bool) *HighlightAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
 // toDomain converts the Creator API annotation to a domain annotation.
 func (a *HighlightAnnotation) toDomain() *document.MarkupAnnotation {
 	rect := [4]float64{a.x1, a.y1, a.x2, a.y2}
@@ -108,6 +163,8 @@ func (a *HighlightAnnotation) toDomain() *document.MarkupAnnotation {
 	domainAnnot.SetColor([3]float64{a.color.R, a.color.G, a.color.B})
 	domainAnnot.SetAuthor(a.author)
 	domainAnnot.SetContents(a.note)
+	domainAnnot.Flags = a.flags
+	domainAnnot.Popup = a.popup
 
 	return domainAnnot
 }
@@ -122,13 +179,15 @@ func (a *HighlightAnnotation) toDomain() *document.MarkupAnnotation {
 //	underline.SetColor(creator.Blue)
 //	page.AddUnderlineAnnotation(underline)
 type UnderlineAnnotation struct {
-	x1     float64 // Left X coordinate
-	y1     float64 // Bottom Y coordinate
-	x2     float64 // Right X coordinate
-	y2     float64 // Top Y coordinate
-	color  Color   // Underline color
-	author string  // Author name
-	note   string  // Optional note text
+	x1     float64                  // Left X coordinate
+	y1     float64                  // Bottom Y coordinate
+	x2     float64                  // Right X coordinate
+	y2     float64                  // Top Y coordinate
+	color  Color                    // Underline color
+	author string                   // Author name
+	note   string                   // Optional note text
+	flags  document.AnnotationFlags // Annotation flags (/F)
+	popup  *document.Popup          // Pop-up window (nil = none)
 }
 
 // NewUnderlineAnnotation creates a new underline annotation.
@@ -187,6 +246,57 @@ func (a *UnderlineAnnotation) SetNote(note string) *UnderlineAnnotation {
 	return a
 }
 
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	underline.SetNote("Check wording").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *UnderlineAnnotation) SetPopup(open bool, rect Rect) *UnderlineAnnotation {
+	a.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	underline.SetPrintable(true)
+func (a *UnderlineAnnotation) SetPrintable(printable bool) *UnderlineAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	underline.SetHidden(true)
+func (a *UnderlineAnnotation) SetHidden(hidden bool) *UnderlineAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	underline.SetReadOnly(true)
+func (a *UnderlineAnnotation) SetReadOnly(readOnly bool) *UnderlineAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	underline.SetNoView(true).SetPrintable(true)
+func (a *UnderlineAnnotation) SetNoView(noView bool) *UnderlineAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
 // toDomain converts the Creator API annotation to a domain annotation.
 func (a *UnderlineAnnotation) toDomain() *document.MarkupAnnotation {
 	rect := [4]float64{a.x1, a.y1, a.x2, a.y2}
@@ -203,6 +313,8 @@ func (a *UnderlineAnnotation) toDomain() *document.MarkupAnnotation {
 	domainAnnot.SetColor([3]float64{a.color.R, a.color.G, a.color.B})
 	domainAnnot.SetAuthor(a.author)
 	domainAnnot.SetContents(a.note)
+	domainAnnot.Flags = a.flags
+	domainAnnot.Popup = a.popup
 
 	return domainAnnot
 }
@@ -217,13 +329,15 @@ func (a *UnderlineAnnotation) toDomain() *document.MarkupAnnotation {
 //	strikeout.SetColor(creator.Red)
 //	page.AddStrikeOutAnnotation(strikeout)
 type StrikeOutAnnotation struct {
-	x1     float64 // Left X coordinate
-	y1     float64 // Bottom Y coordinate
-	x2     float64 // Right X coordinate
-	y2     float64 // Top Y coordinate
-	color  Color   // StrikeOut color
-	author string  // Author name
-	note   string  // Optional note text
+	x1     float64                  // Left X coordinate
+	y1     float64                  // Bottom Y coordinate
+	x2     float64                  // Right X coordinate
+	y2     float64                  // Top Y coordinate
+	color  Color                    // StrikeOut color
+	author string                   // Author name
+	note   string                   // Optional note text
+	flags  document.AnnotationFlags // Annotation flags (/F)
+	popup  *document.Popup          // Pop-up window (nil = none)
 }
 
 // NewStrikeOutAnnotation creates a new strikeout annotation.
@@ -282,6 +396,57 @@ func (a *StrikeOutAnnotation) SetNote(note string) *StrikeOutAnnotation {
 	return a
 }
 
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	strikeout.SetNote("Remove this").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *StrikeOutAnnotation) SetPopup(open bool, rect Rect) *StrikeOutAnnotation {
+	a.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	strikeout.SetPrintable(true)
+func (a *StrikeOutAnnotation) SetPrintable(printable bool) *StrikeOutAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	strikeout.SetHidden(true)
+func (a *StrikeOutAnnotation) SetHidden(hidden bool) *StrikeOutAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	strikeout.SetReadOnly(true)
+func (a *StrikeOutAnnotation) SetReadOnly(readOnly bool) *StrikeOutAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	strikeout.SetNoView(true).SetPrintable(true)
+func (a *StrikeOutAnnotation) SetNoView(noView bool) *StrikeOutAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
 // toDomain converts the Creator API annotation to a domain annotation.
 func (a *StrikeOutAnnotation) toDomain() *document.MarkupAnnotation {
 	rect := [4]float64{a.x1, a.y1, a.x2, a.y2}
@@ -298,6 +463,8 @@ func (a *StrikeOutAnnotation) toDomain() *document.MarkupAnnotation {
 	domainAnnot.SetColor([3]float64{a.color.R, a.color.G, a.color.B})
 	domainAnnot.SetAuthor(a.author)
 	domainAnnot.SetContents(a.note)
+	domainAnnot.Flags = a.flags
+	domainAnnot.Popup = a.popup
 
 	return domainAnnot
 }
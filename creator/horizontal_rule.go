@@ -0,0 +1,65 @@
+package creator
+
+// HorizontalRule is a drawable divider line across the content area.
+//
+// The rule takes its thickness plus the space above and below it in the
+// layout. Use NewSpacer for vertical space without a line.
+//
+// Example:
+//
+//	c.Draw(creator.NewParagraph("Section one"))
+//	c.Draw(creator.NewHorizontalRule(0.5, creator.Gray).SetSpacing(6, 6))
+//	c.Draw(creator.NewParagraph("Section two"))
+type HorizontalRule struct {
+	thickness   float64
+	color       Color
+	spaceBefore float64
+	spaceAfter  float64
+}
+
+// NewHorizontalRule creates a horizontal rule with the given line
+// thickness in points and color, without space above or below.
+func NewHorizontalRule(thickness float64, color Color) *HorizontalRule {
+	return &HorizontalRule{
+		thickness: thickness,
+		color:     color,
+	}
+}
+
+// SetSpacing sets the space above and below the line.
+// Returns the rule for method chaining.
+func (r *HorizontalRule) SetSpacing(before, after float64) *HorizontalRule {
+	r.spaceBefore = before
+	r.spaceAfter = after
+	return r
+}
+
+// Thickness returns the line thickness.
+func (r *HorizontalRule) Thickness() float64 {
+	return r.thickness
+}
+
+// Color returns the line color.
+func (r *HorizontalRule) Color() Color {
+	return r.color
+}
+
+// Height returns the rule height including the space around the line.
+func (r *HorizontalRule) Height(_ *LayoutContext) float64 {
+	return r.spaceBefore + r.thickness + r.spaceAfter
+}
+
+// Draw renders the rule from the left to the right content edge at the
+// current cursor position.
+func (r *HorizontalRule) Draw(ctx *LayoutContext, page *Page) error {
+	ctx.CursorY += r.spaceBefore
+	if r.thickness > 0 {
+		y := ctx.CurrentPDFY() - r.thickness/2
+		opts := &LineOptions{Color: r.color, Width: r.thickness}
+		if err := page.drawLine(ctx.ContentLeft(), y, ctx.ContentRight(), y, opts); err != nil {
+			return err
+		}
+	}
+	ctx.CursorY += r.thickness + r.spaceAfter
+	return nil
+}
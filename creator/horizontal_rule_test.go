@@ -0,0 +1,51 @@
+package creator
+
+import "testing"
+
+func TestHorizontalRule_Draw(t *testing.T) {
+	page := createTestPage(t)
+	ctx := page.GetLayoutContext()
+
+	rule := NewHorizontalRule(2, Gray).SetSpacing(6, 4)
+	if h := rule.Height(ctx); h != 12 {
+		t.Errorf("Height() = %v, want 12", h)
+	}
+	if err := rule.Draw(ctx, page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if ctx.CursorY != 12 {
+		t.Errorf("CursorY = %v, want 12", ctx.CursorY)
+	}
+
+	ops := page.GraphicsOperations()
+	if len(ops) != 1 {
+		t.Fatalf("graphics ops = %d, want 1", len(ops))
+	}
+	op := ops[0]
+	wantY := ctx.ContentTop() - 7
+	if op.X != ctx.ContentLeft() || op.X2 != ctx.ContentRight() || op.Y != wantY || op.Y2 != wantY {
+		t.Errorf("line = (%v, %v)-(%v, %v), want (%v, %v)-(%v, %v)",
+			op.X, op.Y, op.X2, op.Y2, ctx.ContentLeft(), wantY, ctx.ContentRight(), wantY)
+	}
+	if op.LineOpts.Width != 2 || op.LineOpts.Color != Gray {
+		t.Errorf("line options = %+v", op.LineOpts)
+	}
+}
+
+func TestHorizontalRule_InFlow(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("Above"))
+	if err := c.Draw(NewHorizontalRule(1, Black)); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+	_ = c.Draw(NewParagraph("Below"))
+
+	page := c.pages[0]
+	text := page.TextOperations()
+	line := page.GraphicsOperations()[0]
+	if line.Y >= text[0].Y || line.Y <= text[1].Y {
+		t.Errorf("rule at y=%v, want between %v and %v", line.Y, text[0].Y, text[1].Y)
+	}
+}
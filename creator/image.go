@@ -2,6 +2,8 @@ package creator
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"image"
@@ -9,20 +11,23 @@ import (
 	_ "image/jpeg" // Import JPEG decoder
 	_ "image/png"  // Import PNG decoder
 	"io"
+	"math"
 	"os"
 
 	"github.com/coregx/gxpdf/internal/encoding"
+	"github.com/coregx/gxpdf/internal/writer"
 )
 
 // Image represents an image that can be embedded in a PDF document.
 //
 // Currently supports:
-//   - JPEG images (RGB and CMYK color spaces)
-//   - PNG images (RGB, RGBA, grayscale, paletted)
+//   - JPEG images (grayscale, RGB and CMYK color spaces)
+//   - PNG images (RGB, RGBA, grayscale, paletted; 8 or 16 bits)
 //
 // The image data is stored as:
 //   - JPEG: Raw JPEG bytes (DCTDecode)
-//   - PNG: Raw pixel data compressed with FlateDecode
+//   - PNG: Raw pixel data compressed with FlateDecode (palette indices
+//     for paletted PNGs)
 //
 // For RGBA PNG with transparency, the alpha channel is stored separately
 // as an SMask (soft mask) for proper PDF rendering.
@@ -56,6 +61,15 @@ type Image struct {
 
 	// Bits per component (8 for most images).
 	bitsPerComponent int
+
+	// Decode array mapping samples to color values (nil = default).
+	decode []float64
+
+	// RGB palette of an Indexed image (3 bytes per entry).
+	palette []byte
+
+	// Content hash identifying the image when it is written (see id).
+	contentID string
 }
 
 // ColorSpace represents the image color space.
@@ -70,13 +84,22 @@ const (
 
 	// ColorSpaceGray is grayscale (1 component).
 	ColorSpaceGray ColorSpace = "DeviceGray"
+
+	// ColorSpaceIndexed is a palette of RGB colors (1 component: the
+	// palette index).
+	ColorSpaceIndexed ColorSpace = "Indexed"
 )
 
 // LoadImage loads an image from a file.
 //
 // Supported formats: JPEG, PNG.
-// For JPEG: RGB and CMYK color spaces.
-// For PNG: RGB, RGBA (with alpha mask), grayscale, paletted.
+// For JPEG: grayscale, RGB and CMYK color spaces. JPEG data is embedded
+// without recompression; CMYK JPEGs from Adobe applications (APP14
+// marker) are marked as inverted so they do not render as a negative.
+// Progressive JPEGs are re-encoded as baseline, which many viewers
+// require; progressive CMYK and other codings return ErrUnsupportedJPEG.
+// For PNG: RGB, RGBA (with alpha mask), grayscale and paletted (Indexed
+// color space), at 8 or 16 bits per component.
 //
 // Example:
 //
@@ -151,7 +174,27 @@ func detectImageFormat(data []byte) string {
 }
 
 // loadJPEG loads a JPEG image from raw data.
+//
+// The JPEG bytes are embedded as-is (DCTDecode); only the headers are read
+// to get the dimensions and color space. Progressive JPEGs are the
+// exception: they are re-encoded as baseline (see baselineJPEG).
 func loadJPEG(data []byte) (*Image, error) {
+	info, err := scanJPEG(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JPEG: %w", err)
+	}
+
+	data, err = baselineJPEG(data, info)
+	if err != nil {
+		return nil, err
+	}
+	if info.progressive() {
+		// Re-encoded as baseline: read the new markers.
+		if info, err = scanJPEG(data); err != nil {
+			return nil, fmt.Errorf("failed to decode JPEG: %w", err)
+		}
+	}
+
 	// Decode config to get dimensions.
 	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
 	if err != nil {
@@ -163,9 +206,10 @@ func loadJPEG(data []byte) (*Image, error) {
 		data:             data,
 		width:            cfg.Width,
 		height:           cfg.Height,
-		colorSpace:       ColorSpaceRGB, // JPEG defaults to RGB.
-		components:       3,
+		colorSpace:       info.colorSpace(),
+		components:       info.components,
 		bitsPerComponent: 8,
+		decode:           info.decode(),
 	}, nil
 }
 
@@ -191,11 +235,18 @@ func decodePNGImage(data []byte) (image.Image, error) {
 }
 
 // convertPNGToImage converts a PNG image to our Image structure.
+//
+// Grayscale and paletted images keep their compact form (DeviceGray and
+// Indexed), and 16-bit images keep their 16-bit samples.
 func convertPNGToImage(img image.Image) (*Image, error) {
 	bounds := img.Bounds()
 	width := bounds.Dx()
 	height := bounds.Dy()
 
+	if paletted, ok := img.(*image.Paletted); ok {
+		return convertPalettedPNG(paletted, width, height)
+	}
+
 	// Detect color model and convert accordingly.
 	switch img.ColorModel() {
 	case color.RGBAModel:
@@ -204,8 +255,12 @@ func convertPNGToImage(img image.Image) (*Image, error) {
 		return convertRGBAPNG(img, width, height)
 	case color.GrayModel:
 		return convertGrayPNG(img, width, height)
+	case color.Gray16Model:
+		return convertGray16PNG(img, width, height)
+	case color.RGBA64Model, color.NRGBA64Model:
+		return convertRGB16PNG(img, width, height)
 	default:
-		// For paletted and other formats, convert to RGB.
+		// For other formats, convert to RGB.
 		return convertGenericPNG(img, width, height)
 	}
 }
@@ -263,7 +318,153 @@ func convertGrayPNG(img image.Image, width, height int) (*Image, error) {
 	}, nil
 }
 
-// convertGenericPNG converts paletted and other PNG formats to RGB.
+// convertGray16PNG converts a 16-bit grayscale PNG image.
+func convertGray16PNG(img image.Image, width, height int) (*Image, error) {
+	grayData := make([]byte, 0, width*height*2)
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			gray := color.Gray16Model.Convert(img.At(x, y)).(color.Gray16)
+			grayData = append(grayData, byte(gray.Y>>8), byte(gray.Y))
+		}
+	}
+
+	compressed, err := compressData(grayData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress grayscale data: %w", err)
+	}
+
+	return &Image{
+		format:           "png",
+		data:             compressed,
+		width:            width,
+		height:           height,
+		colorSpace:       ColorSpaceGray,
+		components:       1,
+		bitsPerComponent: 16,
+	}, nil
+}
+
+// convertRGB16PNG converts a 16-bit RGB PNG image. Transparency is stored
+// as an 8-bit alpha mask.
+func convertRGB16PNG(img image.Image, width, height int) (*Image, error) {
+	rgbData := make([]byte, 0, width*height*6)
+	alphaData := make([]byte, 0, width*height)
+	hasAlpha := false
+
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBA64Model.Convert(img.At(x, y)).(color.NRGBA64)
+			rgbData = append(rgbData,
+				byte(c.R>>8), byte(c.R),
+				byte(c.G>>8), byte(c.G),
+				byte(c.B>>8), byte(c.B))
+
+			alpha := byte(c.A >> 8)
+			alphaData = append(alphaData, alpha)
+			if alpha != 255 {
+				hasAlpha = true
+			}
+		}
+	}
+
+	compressedRGB, err := compressData(rgbData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress RGB data: %w", err)
+	}
+
+	var compressedAlpha []byte
+	if hasAlpha {
+		compressedAlpha, err = compressData(alphaData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress alpha data: %w", err)
+		}
+	}
+
+	return &Image{
+		format:           "png",
+		data:             compressedRGB,
+		alphaMask:        compressedAlpha,
+		width:            width,
+		height:           height,
+		colorSpace:       ColorSpaceRGB,
+		components:       3,
+		bitsPerComponent: 16,
+	}, nil
+}
+
+// convertPalettedPNG converts a paletted PNG image to an Indexed image.
+//
+// Indices are packed with the fewest bits (1, 2, 4 or 8) that fit the
+// palette. Transparent palette entries are stored as an alpha mask.
+func convertPalettedPNG(img *image.Paletted, width, height int) (*Image, error) {
+	bits := indexBits(len(img.Palette))
+
+	palette := make([]byte, 0, len(img.Palette)*3)
+	alphas := make([]byte, len(img.Palette))
+	hasAlpha := false
+	for i, c := range img.Palette {
+		nc := color.NRGBAModel.Convert(c).(color.NRGBA)
+		palette = append(palette, nc.R, nc.G, nc.B)
+		alphas[i] = nc.A
+		if nc.A != 255 {
+			hasAlpha = true
+		}
+	}
+
+	// Rows start on a byte boundary.
+	rowBytes := (width*bits + 7) / 8
+	indexData := make([]byte, rowBytes*height)
+	var alphaData []byte
+	if hasAlpha {
+		alphaData = make([]byte, 0, width*height)
+	}
+
+	bounds := img.Bounds()
+	for y := 0; y < height; y++ {
+		row := indexData[y*rowBytes : (y+1)*rowBytes]
+		for x := 0; x < width; x++ {
+			index := img.ColorIndexAt(bounds.Min.X+x, bounds.Min.Y+y)
+			bit := x * bits
+			row[bit/8] |= index << (8 - bits - bit%8)
+			if hasAlpha {
+				alpha := byte(0) // Out-of-range indices are transparent.
+				if int(index) < len(alphas) {
+					alpha = alphas[index]
+				}
+				alphaData = append(alphaData, alpha)
+			}
+		}
+	}
+
+	compressed, err := compressData(indexData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress index data: %w", err)
+	}
+
+	var compressedAlpha []byte
+	if hasAlpha {
+		compressedAlpha, err = compressData(alphaData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress alpha data: %w", err)
+		}
+	}
+
+	return &Image{
+		format:           "png",
+		data:             compressed,
+		alphaMask:        compressedAlpha,
+		width:            width,
+		height:           height,
+		colorSpace:       ColorSpaceIndexed,
+		components:       1,
+		bitsPerComponent: bits,
+		palette:          palette,
+	}, nil
+}
+
+// convertGenericPNG converts other PNG formats to RGB.
 func convertGenericPNG(img image.Image, width, height int) (*Image, error) {
 	// Convert to RGB.
 	rgbData := extractRGB(img, width, height)
@@ -366,6 +567,44 @@ func compressData(data []byte) ([]byte, error) {
 	return compressed, nil
 }
 
+// id returns an identifier derived from the image content, so the same
+// image drawn several times, or loaded twice, is embedded once.
+func (img *Image) id() string {
+	if img.contentID == "" {
+		h := sha256.New()
+		h.Write([]byte(img.format))
+		h.Write(img.data)
+		h.Write(img.palette)
+		h.Write(img.alphaMask)
+		img.contentID = hex.EncodeToString(h.Sum(nil)[:16])
+	}
+	return img.contentID
+}
+
+// embedded returns the writer representation of the image.
+func (img *Image) embedded() *writer.EmbeddedImage {
+	filter := "FlateDecode"
+	if img.format == "jpeg" {
+		filter = "DCTDecode"
+	}
+	colorSpace := string(img.colorSpace)
+	if img.colorSpace == ColorSpaceIndexed {
+		colorSpace = string(ColorSpaceRGB) // Base color space of the palette.
+	}
+	return &writer.EmbeddedImage{
+		ID:               img.id(),
+		Data:             img.data,
+		Filter:           filter,
+		Width:            img.width,
+		Height:           img.height,
+		ColorSpace:       colorSpace,
+		BitsPerComponent: img.bitsPerComponent,
+		Decode:           img.decode,
+		Palette:          img.palette,
+		AlphaMask:        img.alphaMask,
+	}
+}
+
 // Width returns the image width in pixels.
 func (img *Image) Width() int {
 	return img.width
@@ -416,7 +655,14 @@ func (img *Image) Components() int {
 	return img.components
 }
 
-// BitsPerComponent returns the bits per component (typically 8).
+// Palette returns the RGB palette of an Indexed image (3 bytes per entry),
+// or nil for other color spaces.
+func (img *Image) Palette() []byte {
+	return img.palette
+}
+
+// BitsPerComponent returns the bits per component: 8 for most images,
+// 16 for 16-bit PNGs and 1, 2, 4 or 8 for paletted PNGs.
 func (img *Image) BitsPerComponent() int {
 	return img.bitsPerComponent
 }
@@ -438,6 +684,11 @@ func (img *Image) BitsPerComponent() int {
 //	img, _ := creator.LoadImage("photo.jpg")
 //	page.DrawImage(img, 100, 500, 200, 150)
 func (p *Page) DrawImage(img *Image, x, y, width, height float64) error {
+	return p.drawImage(img, x, p.pdfBoxY(y, height), width, height)
+}
+
+// drawImage draws an image with its lower-left corner at PDF coordinates.
+func (p *Page) drawImage(img *Image, x, y, width, height float64) error {
 	// Validate dimensions.
 	if width <= 0 || height <= 0 {
 		return errors.New("image dimensions must be positive")
@@ -478,19 +729,131 @@ func (p *Page) DrawImageFit(img *Image, x, y, maxWidth, maxHeight float64) error
 		return errors.New("image max dimensions must be positive")
 	}
 
-	// Calculate scaled dimensions.
-	scaledW, scaledH := calculateFitDimensions(
-		float64(img.width),
-		float64(img.height),
-		maxWidth,
-		maxHeight,
-	)
+	return p.DrawImageWithOptions(img, x, y, maxWidth, maxHeight, &ImageOptions{Fit: FitContain})
+}
+
+// FitMode controls how an image is sized to the box it is drawn in.
+type FitMode int
 
-	// Center the image in available space.
-	centerX := x + (maxWidth-scaledW)/2
-	centerY := y + (maxHeight-scaledH)/2
+const (
+	// FitStretch scales the image to fill the box exactly, ignoring its
+	// aspect ratio.
+	FitStretch FitMode = iota
+
+	// FitContain scales the image to the largest size that fits in the box
+	// while maintaining its aspect ratio. The image is centered; parts of
+	// the box may stay empty.
+	FitContain
+
+	// FitCover scales the image to the smallest size that covers the box
+	// while maintaining its aspect ratio. The image is centered and clipped
+	// to the box.
+	FitCover
+)
 
-	return p.DrawImage(img, centerX, centerY, scaledW, scaledH)
+// ImageOptions configures image drawing.
+type ImageOptions struct {
+	// Fit controls how the image is sized to the box (default: FitStretch).
+	Fit FitMode
+
+	// Angle rotates the box and the image in degrees, counter-clockwise,
+	// around the placement point (the box's lower-left corner, or its
+	// top-left corner with Creator.SetOriginTopLeft).
+	// For example, 90 turns a landscape photo to stand on a portrait page;
+	// the rotated box extends to the left of the placement point.
+	Angle float64
+}
+
+// ImageBox is the box an image is placed in with DrawImageWithOptions.
+type ImageBox struct {
+	// X, Y is the lower-left corner of the box (the placement point).
+	X, Y float64
+
+	// Width and Height are the box dimensions before rotation.
+	Width, Height float64
+
+	// Angle is the rotation in degrees, counter-clockwise, around (X, Y).
+	Angle float64
+
+	// Clip clips the image to the box (FitCover).
+	Clip bool
+}
+
+// DrawImageWithOptions draws an image in the box at (x, y) with the given
+// width and height, sized and rotated according to the options.
+//
+// The image's intrinsic size (in pixels) only determines its aspect ratio;
+// the box determines its size on the page.
+//
+// Parameters:
+//   - img: The image to draw
+//   - x: Horizontal position of the box in points (from left edge)
+//   - y: Vertical position of the box in points (from bottom edge)
+//   - width: Box width in points
+//   - height: Box height in points
+//   - opts: Drawing options (nil = stretch to the box, like DrawImage)
+//
+// Example:
+//
+//	logo, _ := creator.LoadImage("logo.png")
+//	page.DrawImageWithOptions(logo, 50, 750, 120, 40, &creator.ImageOptions{Fit: creator.FitContain})
+//
+//	// Landscape photo turned to fill a 400x600 area at (500, 100).
+//	page.DrawImageWithOptions(photo, 500, 100, 600, 400, &creator.ImageOptions{Fit: creator.FitCover, Angle: 90})
+func (p *Page) DrawImageWithOptions(img *Image, x, y, width, height float64, opts *ImageOptions) error {
+	// Validate dimensions.
+	if width <= 0 || height <= 0 {
+		return errors.New("image dimensions must be positive")
+	}
+
+	var options ImageOptions
+	if opts != nil {
+		options = *opts
+	}
+
+	// With the top-left origin the box turns around its top-left corner;
+	// find the rotated lower-left corner the box is placed by.
+	if p.originTopLeft {
+		rad := options.Angle * math.Pi / 180
+		x, y = x+height*math.Sin(rad), p.pdfY(y)-height*math.Cos(rad)
+	}
+
+	scaledW, scaledH := width, height
+	switch options.Fit {
+	case FitStretch:
+	case FitContain:
+		scaledW, scaledH = calculateFitDimensions(float64(img.width), float64(img.height), width, height)
+	case FitCover:
+		scaledW, scaledH = calculateCoverDimensions(float64(img.width), float64(img.height), width, height)
+	default:
+		return fmt.Errorf("unknown image fit mode: %d", options.Fit)
+	}
+
+	// A covering image overflows the box on one side and is clipped to it.
+	var box *ImageBox
+	if options.Angle != 0 || options.Fit == FitCover {
+		box = &ImageBox{
+			X:      x,
+			Y:      y,
+			Width:  width,
+			Height: height,
+			Angle:  options.Angle,
+			Clip:   options.Fit == FitCover,
+		}
+	}
+
+	// Store image operation, centered in the box.
+	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
+		Type:     GraphicsOpImage,
+		X:        x + (width-scaledW)/2,
+		Y:        y + (height-scaledH)/2,
+		Width:    scaledW,
+		Height:   scaledH,
+		Image:    img,
+		ImageBox: box,
+	})
+
+	return nil
 }
 
 // calculateFitDimensions calculates dimensions to fit within max bounds.
@@ -510,11 +873,27 @@ func calculateFitDimensions(imgW, imgH, maxW, maxH float64) (float64, float64) {
 	return imgW * scale, imgH * scale
 }
 
+// calculateCoverDimensions calculates dimensions to cover the given bounds.
+//
+// Maintains aspect ratio by scaling to the larger dimension.
+func calculateCoverDimensions(imgW, imgH, boxW, boxH float64) (float64, float64) {
+	scale := math.Max(boxW/imgW, boxH/imgH)
+	return imgW * scale, imgH * scale
+}
+
 // Errors.
 var (
 	// ErrUnsupportedImageFormat is returned for unsupported image formats.
 	ErrUnsupportedImageFormat = errors.New("unsupported image format (supported: JPEG, PNG)")
 
+	// ErrUnsupportedJPEG is returned for JPEG codings that cannot be
+	// embedded, such as arithmetic coding or progressive CMYK.
+	ErrUnsupportedJPEG = errors.New("unsupported JPEG coding")
+
 	// ErrInvalidImageDimensions is returned for zero/negative dimensions.
 	ErrInvalidImageDimensions = errors.New("image dimensions must be positive")
+
+	// ErrInvalidPalette is returned for an Indexed color space palette
+	// that is empty, too large or has invalid colors.
+	ErrInvalidPalette = errors.New("invalid indexed color palette")
 )
@@ -8,6 +8,9 @@ import (
 	"image/png"
 	"os"
 	"testing"
+
+	"github.com/coregx/gxpdf/internal/encoding"
+	"github.com/coregx/gxpdf/internal/writer"
 )
 
 const (
@@ -100,6 +103,76 @@ func TestDrawImage(t *testing.T) {
 	verifyImageOperation(t, page, img)
 }
 
+// TestDrawImage_Written tests that drawn images are written as image
+// XObjects, once per document.
+func TestDrawImage_Written(t *testing.T) {
+	data := createJPEGData(t, 100, 80, color.RGBA{255, 0, 0, 255})
+	img, err := LoadImageFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	c := New()
+	for i := 0; i < 2; i++ {
+		page, err := c.NewPage()
+		if err != nil {
+			t.Fatalf("failed to create page: %v", err)
+		}
+		if err := page.DrawImage(img, 100, 500, 200, 160); err != nil {
+			t.Fatalf("DrawImage failed: %v", err)
+		}
+	}
+
+	_, graphics := c.collectAllPageContents()
+	content, _, err := writer.GenerateContentStreamWithGraphics(nil, graphics[0])
+	if err != nil {
+		t.Fatalf("GenerateContentStreamWithGraphics failed: %v", err)
+	}
+	if want := "q\n200.00 0.00 0.00 160.00 100.00 500.00 cm\n/Im1 Do\nQ\n"; string(content) != want {
+		t.Errorf("content = %q, want %q", content, want)
+	}
+
+	pdf, err := c.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	if n := bytes.Count(pdf, []byte("/Subtype /Image")); n != 1 {
+		t.Errorf("image written %d times, want 1", n)
+	}
+	for _, want := range []string{"/Width 100 /Height 80", "/ColorSpace /DeviceRGB", "/Filter /DCTDecode", "/XObject << /Im1 "} {
+		if !bytes.Contains(pdf, []byte(want)) {
+			t.Errorf("PDF does not contain %q", want)
+		}
+	}
+	if !bytes.Contains(pdf, data) {
+		t.Error("JPEG data was not embedded as-is")
+	}
+}
+
+// TestDrawImage_AdobeCMYKDecode tests that Adobe CMYK JPEGs are written
+// with an inverting Decode array.
+func TestDrawImage_AdobeCMYKDecode(t *testing.T) {
+	img, err := LoadImageFromReader(bytes.NewReader(jpegHeader(0xC0, 4, true)))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	c := New()
+	page, _ := c.NewPage()
+	if err := page.DrawImage(img, 100, 500, 16, 8); err != nil {
+		t.Fatalf("DrawImage failed: %v", err)
+	}
+
+	pdf, err := c.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	want := "/ColorSpace /DeviceCMYK /BitsPerComponent 8 /Decode [1 0 1 0 1 0 1 0]"
+	if !bytes.Contains(pdf, []byte(want)) {
+		t.Errorf("PDF does not contain %q", want)
+	}
+}
+
 // Helper: createTestPage creates a page for testing.
 func createTestPage(t *testing.T) *Page {
 	t.Helper()
@@ -218,6 +291,130 @@ func TestDrawImageFit(t *testing.T) {
 	}
 }
 
+// TestDrawImageWithOptions tests the image fit modes and rotation.
+func TestDrawImageWithOptions(t *testing.T) {
+	// 200x100 image in a 100x100 box.
+	data := createJPEGData(t, 200, 100, color.RGBA{0, 0, 255, 255})
+	img, err := LoadImageFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    *ImageOptions
+		wantX   float64
+		wantY   float64
+		wantW   float64
+		wantH   float64
+		wantBox *ImageBox
+	}{
+		{"default", nil, 100, 500, 100, 100, nil},
+		{"stretch", &ImageOptions{Fit: FitStretch}, 100, 500, 100, 100, nil},
+		{"contain", &ImageOptions{Fit: FitContain}, 100, 525, 100, 50, nil},
+		{"cover", &ImageOptions{Fit: FitCover}, 50, 500, 200, 100,
+			&ImageBox{X: 100, Y: 500, Width: 100, Height: 100, Clip: true}},
+		{"rotated", &ImageOptions{Fit: FitContain, Angle: 90}, 100, 525, 100, 50,
+			&ImageBox{X: 100, Y: 500, Width: 100, Height: 100, Angle: 90}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := createTestPage(t)
+			if err := page.DrawImageWithOptions(img, 100, 500, 100, 100, tt.opts); err != nil {
+				t.Fatalf("DrawImageWithOptions failed: %v", err)
+			}
+
+			ops := page.GraphicsOperations()
+			if len(ops) != 1 {
+				t.Fatalf("got %d graphics operations, want 1", len(ops))
+			}
+			op := ops[0]
+			if op.Type != GraphicsOpImage {
+				t.Fatalf("expected image operation, got type %v", op.Type)
+			}
+			if op.X != tt.wantX || op.Y != tt.wantY || op.Width != tt.wantW || op.Height != tt.wantH {
+				t.Errorf("image at (%v, %v) size %vx%v, want (%v, %v) size %vx%v",
+					op.X, op.Y, op.Width, op.Height, tt.wantX, tt.wantY, tt.wantW, tt.wantH)
+			}
+			switch {
+			case tt.wantBox == nil && op.ImageBox != nil:
+				t.Errorf("ImageBox = %+v, want nil", *op.ImageBox)
+			case tt.wantBox != nil && (op.ImageBox == nil || *op.ImageBox != *tt.wantBox):
+				t.Errorf("ImageBox = %+v, want %+v", op.ImageBox, *tt.wantBox)
+			}
+		})
+	}
+}
+
+// TestDrawImageWithOptions_Written tests the content stream of rotated and
+// clipped images.
+func TestDrawImageWithOptions_Written(t *testing.T) {
+	data := createJPEGData(t, 200, 100, color.RGBA{0, 0, 255, 255})
+	img, err := LoadImageFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts *ImageOptions
+		want string
+	}{
+		{
+			"rotated",
+			&ImageOptions{Angle: 90},
+			"q\n0.00 100.00 -100.00 0.00 100.00 500.00 cm\n/Im1 Do\nQ\n",
+		},
+		{
+			"cover",
+			&ImageOptions{Fit: FitCover},
+			"q\n100.00 500.00 100.00 100.00 re\nW\nn\n200.00 0.00 0.00 100.00 50.00 500.00 cm\n/Im1 Do\nQ\n",
+		},
+		{
+			"rotated cover",
+			&ImageOptions{Fit: FitCover, Angle: 180},
+			"q\n100.00 500.00 m\n0.00 500.00 l\n0.00 400.00 l\n100.00 400.00 l\nh\nW\nn\n" +
+				"-200.00 0.00 0.00 -100.00 150.00 500.00 cm\n/Im1 Do\nQ\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			page, _ := c.NewPage()
+			if err := page.DrawImageWithOptions(img, 100, 500, 100, 100, tt.opts); err != nil {
+				t.Fatalf("DrawImageWithOptions failed: %v", err)
+			}
+
+			_, graphics := c.collectAllPageContents()
+			content, _, err := writer.GenerateContentStreamWithGraphics(nil, graphics[0])
+			if err != nil {
+				t.Fatalf("GenerateContentStreamWithGraphics failed: %v", err)
+			}
+			if string(content) != tt.want {
+				t.Errorf("content = %q, want %q", content, tt.want)
+			}
+		})
+	}
+}
+
+// TestDrawImageWithOptions_Invalid tests invalid boxes and fit modes.
+func TestDrawImageWithOptions_Invalid(t *testing.T) {
+	img, err := LoadImageFromReader(bytes.NewReader(createJPEGData(t, 10, 10, color.RGBA{0, 0, 0, 255})))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+	page := createTestPage(t)
+
+	if err := page.DrawImageWithOptions(img, 0, 0, 0, 10, &ImageOptions{Fit: FitContain}); err == nil {
+		t.Error("expected error for zero width")
+	}
+	if err := page.DrawImageWithOptions(img, 0, 0, 10, 10, &ImageOptions{Fit: FitMode(9)}); err == nil {
+		t.Error("expected error for unknown fit mode")
+	}
+}
+
 // TestCalculateFitDimensions tests aspect ratio calculations.
 func TestCalculateFitDimensions(t *testing.T) {
 	tests := []struct {
@@ -445,15 +642,161 @@ func TestLoadPNGPaletted(t *testing.T) {
 		t.Errorf("expected format png, got %s", img.Format())
 	}
 
-	// Paletted PNG should be converted to RGB.
-	if img.ColorSpace() != ColorSpaceRGB {
-		t.Errorf("expected RGB color space (converted), got %s", img.ColorSpace())
+	// Paletted PNG should keep its palette.
+	if img.ColorSpace() != ColorSpaceIndexed {
+		t.Errorf("expected Indexed color space, got %s", img.ColorSpace())
 	}
 
-	// Verify components.
-	if img.Components() != 3 {
-		t.Errorf("expected 3 components (converted to RGB), got %d", img.Components())
+	// Verify components: one palette index per pixel, packed in 2 bits
+	// for a 3-color palette.
+	if img.Components() != 1 {
+		t.Errorf("expected 1 component, got %d", img.Components())
+	}
+	if img.BitsPerComponent() != 2 {
+		t.Errorf("expected 2 bits per component, got %d", img.BitsPerComponent())
+	}
+	wantPalette := []byte{255, 0, 0, 0, 255, 0, 0, 0, 255}
+	if !bytes.Equal(img.Palette(), wantPalette) {
+		t.Errorf("expected palette %v, got %v", wantPalette, img.Palette())
+	}
+	if img.HasAlpha() {
+		t.Error("opaque palette should not have alpha mask")
+	}
+}
+
+// TestLoadPNGPaletted_Packing tests that palette indices are packed with
+// rows starting on a byte boundary.
+func TestLoadPNGPaletted_Packing(t *testing.T) {
+	palette := color.Palette{color.Black, color.White}
+	src := image.NewPaletted(image.Rect(0, 0, 10, 2), palette)
+	for x := 0; x < 10; x += 3 {
+		src.SetColorIndex(x, 0, 1) // Columns 0, 3, 6, 9.
+	}
+	src.SetColorIndex(9, 1, 1)
+
+	img := loadTestPNG(t, src)
+	if img.BitsPerComponent() != 1 {
+		t.Fatalf("expected 1 bit per component, got %d", img.BitsPerComponent())
+	}
+
+	got := decompressTestData(t, img.Data())
+	want := []byte{0b10010010, 0b01000000, 0b00000000, 0b01000000}
+	if !bytes.Equal(got, want) {
+		t.Errorf("index data = %08b, want %08b", got, want)
+	}
+}
+
+// TestLoadPNGPaletted_Transparency tests that transparent palette entries
+// produce an alpha mask.
+func TestLoadPNGPaletted_Transparency(t *testing.T) {
+	palette := color.Palette{color.NRGBA{255, 0, 0, 255}, color.NRGBA{0, 0, 255, 0}}
+	src := image.NewPaletted(image.Rect(0, 0, 2, 1), palette)
+	src.SetColorIndex(1, 0, 1)
+
+	img := loadTestPNG(t, src)
+	if !img.HasAlpha() {
+		t.Fatal("expected alpha mask for transparent palette entry")
+	}
+	if got := decompressTestData(t, img.AlphaMask()); !bytes.Equal(got, []byte{255, 0}) {
+		t.Errorf("alpha = %v, want [255 0]", got)
+	}
+}
+
+// TestLoadPNG16Bit tests that 16-bit PNGs keep 16-bit samples.
+func TestLoadPNG16Bit(t *testing.T) {
+	gray := image.NewGray16(image.Rect(0, 0, 2, 1))
+	gray.SetGray16(0, 0, color.Gray16{Y: 0x1234})
+	gray.SetGray16(1, 0, color.Gray16{Y: 0xFEDC})
+
+	rgb := image.NewRGBA64(image.Rect(0, 0, 1, 1))
+	rgb.SetRGBA64(0, 0, color.RGBA64{R: 0x0102, G: 0x0304, B: 0x0506, A: 0xFFFF})
+
+	translucent := image.NewNRGBA64(image.Rect(0, 0, 1, 1))
+	translucent.SetNRGBA64(0, 0, color.NRGBA64{R: 0xFFFF, G: 0x8000, B: 0, A: 0x8000})
+
+	tests := []struct {
+		name      string
+		src       image.Image
+		wantSpace ColorSpace
+		wantData  []byte
+		wantAlpha []byte
+	}{
+		{"gray", gray, ColorSpaceGray, []byte{0x12, 0x34, 0xFE, 0xDC}, nil},
+		{"rgb", rgb, ColorSpaceRGB, []byte{1, 2, 3, 4, 5, 6}, nil},
+		{"rgb with alpha", translucent, ColorSpaceRGB, []byte{0xFF, 0xFF, 0x80, 0x00, 0, 0}, []byte{0x80}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := loadTestPNG(t, tt.src)
+			if img.ColorSpace() != tt.wantSpace {
+				t.Errorf("ColorSpace() = %s, want %s", img.ColorSpace(), tt.wantSpace)
+			}
+			if img.BitsPerComponent() != 16 {
+				t.Errorf("BitsPerComponent() = %d, want 16", img.BitsPerComponent())
+			}
+			if got := decompressTestData(t, img.Data()); !bytes.Equal(got, tt.wantData) {
+				t.Errorf("data = %x, want %x", got, tt.wantData)
+			}
+			if tt.wantAlpha == nil {
+				if img.HasAlpha() {
+					t.Error("opaque image should not have alpha mask")
+				}
+			} else if got := decompressTestData(t, img.AlphaMask()); !bytes.Equal(got, tt.wantAlpha) {
+				t.Errorf("alpha = %x, want %x", got, tt.wantAlpha)
+			}
+		})
+	}
+}
+
+// TestDrawImage_IndexedWritten tests that paletted images are written
+// with an Indexed color space.
+func TestDrawImage_IndexedWritten(t *testing.T) {
+	img, err := LoadImageFromReader(bytes.NewReader(createPalettedPNGData(t, 4, 4)))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	c := New()
+	page, _ := c.NewPage()
+	if err := page.DrawImage(img, 100, 500, 40, 40); err != nil {
+		t.Fatalf("DrawImage failed: %v", err)
+	}
+
+	pdf, err := c.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	want := "/ColorSpace [/Indexed /DeviceRGB 2 <FF000000FF000000FF>] /BitsPerComponent 2"
+	if !bytes.Contains(pdf, []byte(want)) {
+		t.Errorf("PDF does not contain %q", want)
+	}
+}
+
+// Helper: loadTestPNG encodes an image as PNG and loads it.
+func loadTestPNG(t *testing.T, src image.Image) *Image {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatalf("failed to encode PNG: %v", err)
+	}
+	img, err := LoadImageFromReader(&buf)
+	if err != nil {
+		t.Fatalf("LoadImageFromReader failed: %v", err)
+	}
+	return img
+}
+
+// Helper: decompressTestData decompresses FlateDecode image data.
+func decompressTestData(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	decoded, err := encoding.NewFlateDecoder().Decode(data)
+	if err != nil {
+		t.Fatalf("failed to decompress data: %v", err)
 	}
+	return decoded
 }
 
 // TestDetectImageFormat tests format detection.
@@ -0,0 +1,321 @@
+package creator
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Index is a back-of-book index: the terms marked in the document with
+// their page numbers, sorted alphabetically and grouped by initial letter
+// in columns.
+//
+// The index is generated when the document is written, on pages appended
+// at the end of the document.
+//
+// Example:
+//
+//	c.GenerateIndex()
+//	c.Draw(creator.NewParagraph("Gradients fill shapes with blended colors."))
+//	c.MarkIndexTerm("gradient")
+//	c.WriteToFile("manual.pdf") // Last page: "gradient, 1"
+type Index struct {
+	// Title of the index (default: "Index")
+	title string
+
+	// Number of columns (default: 2)
+	columns int
+
+	// Space between columns in points (default: 18)
+	columnGap float64
+
+	// Style options (the TOC style; letter headings use the title font
+	// at the entry size)
+	style TOCStyle
+}
+
+// IndexEntry is a single term of the index.
+type IndexEntry struct {
+	// Term is the index term
+	Term string
+
+	// Pages are the page numbers where the term is marked (1-based, sorted)
+	Pages []int
+}
+
+// IndexMark is a drawable that marks index terms at its position in the
+// document. It takes no space.
+//
+// Use it to mark terms in chapters; in the document flow,
+// Creator.MarkIndexTerm draws one.
+//
+// Example:
+//
+//	ch.Add(creator.NewParagraph("The writer compresses streams with Flate."))
+//	ch.Add(creator.NewIndexMark("compression", "Flate"))
+type IndexMark struct {
+	terms []string
+	page  *Page // page the mark was drawn on
+}
+
+// NewIndexMark creates a mark for the given index terms.
+func NewIndexMark(terms ...string) *IndexMark {
+	return &IndexMark{terms: terms}
+}
+
+// Terms returns the marked terms.
+func (m *IndexMark) Terms() []string {
+	return m.terms
+}
+
+// Height returns 0; the mark takes no space.
+func (m *IndexMark) Height(_ *LayoutContext) float64 {
+	return 0
+}
+
+// Draw records the page the terms are marked on.
+func (m *IndexMark) Draw(_ *LayoutContext, page *Page) error {
+	m.page = page
+	return nil
+}
+
+// newIndex creates an index with default settings.
+func newIndex() *Index {
+	return &Index{
+		title:     "Index",
+		columns:   2,
+		columnGap: 18,
+		style:     DefaultTOCStyle(),
+	}
+}
+
+// SetTitle sets the index heading title.
+func (x *Index) SetTitle(title string) {
+	x.title = title
+}
+
+// Title returns the index heading title.
+func (x *Index) Title() string {
+	return x.title
+}
+
+// SetColumns sets the number of columns and the space between them.
+// Values below 1 column are treated as 1.
+func (x *Index) SetColumns(columns int, gap float64) {
+	x.columns = max(columns, 1)
+	x.columnGap = gap
+}
+
+// Columns returns the number of columns.
+func (x *Index) Columns() int {
+	return x.columns
+}
+
+// SetStyle sets the index visual style. IndentPerLevel and the leader
+// settings are not used.
+func (x *Index) SetStyle(style TOCStyle) {
+	x.style = style
+}
+
+// Style returns the current index style.
+func (x *Index) Style() TOCStyle {
+	return x.style
+}
+
+// GenerateIndex enables the back-of-book index of the terms marked with
+// MarkIndexTerm and NewIndexMark.
+//
+// The index is appended to the document when it is written, if any terms
+// are marked. Use Index to customize it.
+func (c *Creator) GenerateIndex() {
+	c.indexEnabled = true
+}
+
+// Index returns the index for customization.
+func (c *Creator) Index() *Index {
+	return c.index
+}
+
+// MarkIndexTerm marks index terms at the current position of the document
+// flow (see Draw), so the index lists the flow's current page for them.
+//
+// Example:
+//
+//	c.Draw(creator.NewParagraph("Tables can span pages and repeat headers."))
+//	c.MarkIndexTerm("tables", "headers")
+func (c *Creator) MarkIndexTerm(terms ...string) error {
+	return c.Draw(NewIndexMark(terms...))
+}
+
+// IndexEntries returns the index entries in index order, with the current
+// page numbers of the marks drawn so far.
+func (c *Creator) IndexEntries() []IndexEntry {
+	pages := make(map[string][]int)
+	for _, m := range c.indexMarks {
+		pageIndex := slices.Index(c.pages, m.page)
+		if pageIndex < 0 {
+			continue
+		}
+		for _, term := range m.terms {
+			if term = strings.TrimSpace(term); term != "" {
+				pages[term] = append(pages[term], pageIndex+1)
+			}
+		}
+	}
+
+	entries := make([]IndexEntry, 0, len(pages))
+	for term, nums := range pages {
+		slices.Sort(nums)
+		entries = append(entries, IndexEntry{Term: term, Pages: slices.Compact(nums)})
+	}
+	slices.SortFunc(entries, func(a, b IndexEntry) int {
+		if n := strings.Compare(strings.ToLower(a.Term), strings.ToLower(b.Term)); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Term, b.Term)
+	})
+	return entries
+}
+
+// registerIndexMark records an index mark drawn in the document.
+func (c *Creator) registerIndexMark(d Drawable) {
+	if m, ok := d.(*IndexMark); ok {
+		c.indexMarks = append(c.indexMarks, m)
+	}
+}
+
+// renderIndex appends the index pages to the document.
+func (c *Creator) renderIndex() error {
+	if !c.indexEnabled {
+		return nil
+	}
+	entries := c.IndexEntries()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	x := c.index
+	page, err := c.NewPage()
+	if err != nil {
+		return fmt.Errorf("failed to create index page: %w", err)
+	}
+	ctx := page.GetLayoutContext()
+	if err := (&TOC{title: x.title, style: x.style}).drawTitle(ctx, page); err != nil {
+		return fmt.Errorf("failed to draw index title: %w", err)
+	}
+
+	layout := &indexLayout{index: x, page: page, ctx: ctx, top: ctx.CursorY, y: ctx.CursorY, c: c}
+	letter := ""
+	for _, entry := range entries {
+		entryPara := x.entryParagraph(entry)
+		if l := indexLetter(entry.Term); l != letter {
+			letter = l
+			// Keep the letter heading with the first entry.
+			if err := layout.place(x.letterParagraph(l), entryPara); err != nil {
+				return err
+			}
+		}
+		if err := layout.place(entryPara); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// letterParagraph builds the heading of an initial-letter group.
+func (x *Index) letterParagraph(letter string) *Paragraph {
+	return NewParagraph(letter).
+		SetFont(x.style.TitleFont, x.style.EntrySize).
+		SetColor(x.style.TitleColor).
+		SetLineSpacing(x.style.LineSpacing)
+}
+
+// entryParagraph builds an entry: the term and its page numbers.
+func (x *Index) entryParagraph(entry IndexEntry) *Paragraph {
+	nums := make([]string, len(entry.Pages))
+	for i, n := range entry.Pages {
+		nums[i] = strconv.Itoa(n)
+	}
+	return NewParagraph(entry.Term+", "+strings.Join(nums, ", ")).
+		SetFont(x.style.EntryFont, x.style.EntrySize).
+		SetColor(x.style.EntryColor).
+		SetLineSpacing(x.style.LineSpacing)
+}
+
+// indexLetter returns the group heading of a term: its upper-case initial
+// letter, or "#" for terms starting with another character.
+func indexLetter(term string) string {
+	r := []rune(term)[0]
+	if !unicode.IsLetter(r) {
+		return "#"
+	}
+	return string(unicode.ToUpper(r))
+}
+
+// indexLayout places paragraphs in the index columns, adding pages as
+// the columns fill up.
+type indexLayout struct {
+	index  *Index
+	c      *Creator
+	page   *Page
+	ctx    *LayoutContext // page context
+	top    float64        // cursor Y where the columns start
+	column int
+	y      float64 // cursor Y in the current column
+}
+
+// columnContext returns a layout context narrowed to the current column.
+func (l *indexLayout) columnContext() *LayoutContext {
+	x := l.index
+	width := (l.ctx.AvailableWidth() - x.columnGap*float64(x.columns-1)) / float64(x.columns)
+	col := *l.ctx
+	col.Margins.Left = l.ctx.Margins.Left + float64(l.column)*(width+x.columnGap)
+	col.Margins.Right = l.ctx.PageWidth - col.Margins.Left - width
+	col.CursorY = l.y
+	return &col
+}
+
+// place draws first in the current column, moving to the next column or
+// page if first and the paragraphs kept with it do not fit.
+func (l *indexLayout) place(first *Paragraph, keepWith ...*Paragraph) error {
+	ctx := l.columnContext()
+	height := first.Height(ctx)
+	for _, p := range keepWith {
+		height += p.Height(ctx)
+	}
+
+	if height > ctx.AvailableHeight() && l.y > l.top {
+		if err := l.nextColumn(); err != nil {
+			return err
+		}
+		ctx = l.columnContext()
+	}
+
+	if err := first.Draw(ctx, l.page); err != nil {
+		return fmt.Errorf("failed to draw index entry: %w", err)
+	}
+	l.y = ctx.CursorY
+	return nil
+}
+
+// nextColumn moves to the top of the next column, on a new page after
+// the last column.
+func (l *indexLayout) nextColumn() error {
+	l.column++
+	if l.column < l.index.columns {
+		l.y = l.top
+		return nil
+	}
+
+	page, err := l.c.NewPage()
+	if err != nil {
+		return fmt.Errorf("failed to create index page: %w", err)
+	}
+	l.page = page
+	l.ctx = page.GetLayoutContext()
+	l.column, l.top, l.y = 0, 0, 0
+	return nil
+}
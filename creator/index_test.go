@@ -0,0 +1,108 @@
+package creator
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestCreator_IndexEntries(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("First page"))
+	_ = c.MarkIndexTerm("tables", "Fonts")
+	_ = c.Draw(NewPageBreak())
+	_ = c.Draw(NewParagraph("Second page"))
+	_ = c.MarkIndexTerm("fonts", "tables", "tables", " ")
+
+	entries := c.IndexEntries()
+	want := []IndexEntry{
+		{Term: "Fonts", Pages: []int{1}},
+		{Term: "fonts", Pages: []int{2}},
+		{Term: "tables", Pages: []int{1, 2}},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("entries = %+v, want %+v", entries, want)
+	}
+	for i := range want {
+		if entries[i].Term != want[i].Term || !slices.Equal(entries[i].Pages, want[i].Pages) {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
+func TestCreator_GenerateIndex(t *testing.T) {
+	c := New()
+	c.GenerateIndex()
+
+	_ = c.Draw(NewParagraph("Content"))
+	_ = c.MarkIndexTerm("zebra", "apple", "42")
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+	if c.PageCount() != 2 {
+		t.Fatalf("PageCount() = %d, want 2", c.PageCount())
+	}
+
+	var texts []string
+	for _, op := range c.pages[1].TextOperations() {
+		texts = append(texts, op.Text)
+	}
+	want := []string{"Index", "#", "42, 1", "A", "apple, 1", "Z", "zebra, 1"}
+	if !slices.Equal(texts, want) {
+		t.Errorf("index texts = %q, want %q", texts, want)
+	}
+}
+
+func TestCreator_GenerateIndex_Columns(t *testing.T) {
+	c := New()
+	c.GenerateIndex()
+	c.Index().SetColumns(2, 20)
+
+	for i := range 200 {
+		_ = c.MarkIndexTerm(fmt.Sprintf("term %03d", i))
+	}
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+
+	// 200 entries at 18pt do not fit in two columns of one page.
+	if c.PageCount() < 3 {
+		t.Fatalf("PageCount() = %d, want at least 3", c.PageCount())
+	}
+
+	ops := c.pages[1].TextOperations()
+	left := c.pages[1].Margins().Left
+	var second bool
+	for _, op := range ops {
+		if op.X > left+100 {
+			second = true
+		}
+		if op.Y < c.pages[1].Margins().Bottom {
+			t.Errorf("entry %q below the bottom margin (y=%v)", op.Text, op.Y)
+		}
+	}
+	if !second {
+		t.Error("no entries in the second column")
+	}
+}
+
+func TestIndexMark_InChapter(t *testing.T) {
+	c := New()
+	c.GenerateIndex()
+
+	ch := NewChapter("Fonts")
+	_ = ch.Add(NewIndexMark("kerning"))
+	_ = c.AddChapter(ch)
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+
+	entries := c.IndexEntries()
+	if len(entries) != 1 || entries[0].Term != "kerning" || !slices.Equal(entries[0].Pages, []int{1}) {
+		t.Errorf("entries = %+v, want kerning on page 1", entries)
+	}
+}
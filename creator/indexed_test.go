@@ -0,0 +1,138 @@
+package creator
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/writer"
+)
+
+func TestNewIndexedColorSpace(t *testing.T) {
+	cs, err := NewIndexedColorSpace([]Color{Red, RGB(0, 128, 255), White})
+	if err != nil {
+		t.Fatalf("NewIndexedColorSpace failed: %v", err)
+	}
+	if cs.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", cs.Len())
+	}
+	if want := []byte{255, 0, 0, 0, 128, 255, 255, 255, 255}; !bytes.Equal(cs.palette, want) {
+		t.Errorf("palette = %v, want %v", cs.palette, want)
+	}
+	if got := cs.RGB(1); got != RGB(0, 128, 255) {
+		t.Errorf("RGB(1) = %v, want %v", got, RGB(0, 128, 255))
+	}
+	if c := cs.Color(2); c.Space != cs || c.Index != 2 {
+		t.Errorf("Color(2) = %+v", c)
+	}
+}
+
+func TestNewIndexedColorSpace_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		colors []Color
+	}{
+		{"empty", nil},
+		{"too many", make([]Color, 257)},
+		{"out of range", []Color{{R: 1.5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewIndexedColorSpace(tt.colors); !errors.Is(err, ErrInvalidPalette) {
+				t.Errorf("error = %v, want ErrInvalidPalette", err)
+			}
+		})
+	}
+}
+
+func TestNewIndexedImage(t *testing.T) {
+	cs, _ := NewIndexedColorSpace([]Color{White, Red, Green, Blue})
+
+	img, err := NewIndexedImage(cs, 3, 2, []byte{0, 1, 2, 3, 2, 1})
+	if err != nil {
+		t.Fatalf("NewIndexedImage failed: %v", err)
+	}
+	if img.ColorSpace() != ColorSpaceIndexed || img.BitsPerComponent() != 2 {
+		t.Errorf("color space = %s, bits = %d, want Indexed, 2", img.ColorSpace(), img.BitsPerComponent())
+	}
+	if !bytes.Equal(img.Palette(), cs.palette) {
+		t.Errorf("Palette() = %v, want %v", img.Palette(), cs.palette)
+	}
+
+	// Rows of 3 2-bit samples are padded to a byte.
+	want := []byte{0x18, 0xE4}
+	if got := decompressTestData(t, img.Data()); !bytes.Equal(got, want) {
+		t.Errorf("samples = %#v, want %#v", got, want)
+	}
+}
+
+func TestNewIndexedImage_Invalid(t *testing.T) {
+	cs, _ := NewIndexedColorSpace([]Color{Black, White})
+
+	tests := []struct {
+		name    string
+		space   *IndexedColorSpace
+		width   int
+		indices []byte
+		wantErr string
+	}{
+		{"nil space", nil, 2, []byte{0, 1}, "nil color space"},
+		{"zero width", cs, 0, nil, "dimensions"},
+		{"wrong length", cs, 2, []byte{0}, "got 1 indices"},
+		{"index out of range", cs, 2, []byte{0, 2}, "out of range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewIndexedImage(tt.space, tt.width, 1, tt.indices)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDrawRect_IndexedFill(t *testing.T) {
+	cs, _ := NewIndexedColorSpace([]Color{Red, Blue})
+	blue := cs.Color(1)
+
+	c := New()
+	page, _ := c.NewPage()
+	if err := page.DrawRect(100, 600, 50, 20, &RectOptions{FillColorIndexed: &blue}); err != nil {
+		t.Fatalf("DrawRect failed: %v", err)
+	}
+	if err := page.DrawCircle(300, 600, 10, &CircleOptions{FillColorIndexed: &blue}); err != nil {
+		t.Fatalf("DrawCircle failed: %v", err)
+	}
+
+	_, graphics := c.collectAllPageContents()
+	content, _, err := writer.GenerateContentStreamWithGraphics(nil, graphics[0])
+	if err != nil {
+		t.Fatalf("GenerateContentStreamWithGraphics failed: %v", err)
+	}
+	if n := strings.Count(string(content), "/CS1 cs\n1 sc\n"); n != 2 {
+		t.Errorf("content has %d indexed fills, want 2:\n%s", n, content)
+	}
+
+	pdf, err := c.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	want := "/ColorSpace << /CS1 [/Indexed /DeviceRGB 1 <FF00000000FF>] >>"
+	if !bytes.Contains(pdf, []byte(want)) {
+		t.Errorf("PDF does not contain %q", want)
+	}
+}
+
+func TestDrawRect_IndexedFillOutOfRange(t *testing.T) {
+	cs, _ := NewIndexedColorSpace([]Color{Red, Blue})
+	missing := cs.Color(2)
+
+	page := createTestPage(t)
+	err := page.DrawRect(100, 600, 50, 20, &RectOptions{FillColorIndexed: &missing})
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("error = %v, want out of range", err)
+	}
+}
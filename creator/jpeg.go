@@ -0,0 +1,159 @@
+package creator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image/jpeg"
+)
+
+// jpegInfo describes the encoding of a JPEG image, as read from its
+// markers.
+type jpegInfo struct {
+	// frame is the start of frame marker, which identifies the coding
+	// process: 0xC0 (baseline), 0xC1 (extended sequential), 0xC2
+	// (progressive) and others (lossless, hierarchical, arithmetic).
+	frame byte
+
+	// components is the number of color components (1, 3 or 4).
+	components int
+
+	// adobe is true if the image has an Adobe APP14 marker. Adobe
+	// applications write CMYK JPEGs with inverted component values. The
+	// marker's color transform (YCbCr, YCCK) is applied by DCTDecode.
+	adobe bool
+}
+
+// scanJPEG reads the markers of a JPEG image up to the start of the scan
+// data, without decoding the image.
+//
+// Reference: ITU T.81 (JPEG), Annex B, and Adobe Technical Note #5116
+// (APP14 marker).
+func scanJPEG(data []byte) (jpegInfo, error) {
+	var info jpegInfo
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return info, errors.New("missing JPEG start of image marker")
+	}
+
+	for pos := 2; ; {
+		// Markers may be preceded by any number of 0xFF fill bytes.
+		for pos < len(data) && data[pos] == 0xFF {
+			pos++
+		}
+		if pos >= len(data) {
+			return info, errors.New("truncated JPEG: no start of scan")
+		}
+		marker := data[pos]
+		pos++
+
+		// Markers without a segment.
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			continue
+		}
+		if marker == 0xD9 {
+			return info, errors.New("truncated JPEG: no start of scan")
+		}
+
+		if pos+2 > len(data) {
+			return info, errors.New("truncated JPEG marker segment")
+		}
+		length := int(binary.BigEndian.Uint16(data[pos:]))
+		if length < 2 || pos+length > len(data) {
+			return info, errors.New("truncated JPEG marker segment")
+		}
+		segment := data[pos+2 : pos+length]
+		pos += length
+
+		switch {
+		case marker == 0xDA: // Start of scan: the markers are complete.
+			if info.components == 0 {
+				return info, errors.New("JPEG has no frame header")
+			}
+			return info, nil
+
+		case isJPEGFrameMarker(marker):
+			if len(segment) < 6 {
+				return info, errors.New("truncated JPEG frame header")
+			}
+			info.frame = marker
+			info.components = int(segment[5])
+			if info.components != 1 && info.components != 3 && info.components != 4 {
+				return info, errors.New("unsupported number of JPEG color components")
+			}
+
+		case marker == 0xEE: // APP14
+			if len(segment) >= 12 && string(segment[:5]) == "Adobe" {
+				info.adobe = true
+			}
+		}
+	}
+}
+
+// isJPEGFrameMarker reports whether marker is a start of frame (SOFn)
+// marker. DHT (C4), JPG (C8) and DAC (CC) share the range but are not
+// frame headers.
+func isJPEGFrameMarker(marker byte) bool {
+	return marker >= 0xC0 && marker <= 0xCF &&
+		marker != 0xC4 && marker != 0xC8 && marker != 0xCC
+}
+
+// progressive reports whether the image uses progressive Huffman coding
+// (SOF2).
+func (info jpegInfo) progressive() bool {
+	return info.frame == 0xC2
+}
+
+// colorSpace returns the PDF color space of the image.
+func (info jpegInfo) colorSpace() ColorSpace {
+	switch info.components {
+	case 1:
+		return ColorSpaceGray
+	case 4:
+		return ColorSpaceCMYK
+	default:
+		return ColorSpaceRGB
+	}
+}
+
+// decode returns the /Decode array for the image, or nil for the default.
+//
+// CMYK JPEGs written by Adobe applications store inverted values; without
+// a [1 0 1 0 1 0 1 0] Decode array they render as a negative.
+func (info jpegInfo) decode() []float64 {
+	if info.components == 4 && info.adobe {
+		return []float64{1, 0, 1, 0, 1, 0, 1, 0}
+	}
+	return nil
+}
+
+// baselineJPEG returns JPEG data that DCTDecode can display: baseline and
+// extended sequential JPEGs unchanged, progressive JPEGs re-encoded as
+// baseline.
+//
+// Progressive JPEGs are valid in PDF 1.3 and later, but many viewers
+// render them as a gray box. Re-encoding is lossy, so it uses a high
+// quality; progressive CMYK JPEGs cannot be re-encoded and are rejected.
+func baselineJPEG(data []byte, info jpegInfo) ([]byte, error) {
+	switch {
+	case info.frame == 0xC0 || info.frame == 0xC1:
+		return data, nil
+	case !info.progressive():
+		return nil, fmt.Errorf("%w: SOF%d", ErrUnsupportedJPEG, info.frame-0xC0)
+	case info.components == 4:
+		return nil, fmt.Errorf("%w: progressive CMYK", ErrUnsupportedJPEG)
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode progressive JPEG: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: transcodeJPEGQuality}); err != nil {
+		return nil, fmt.Errorf("failed to re-encode progressive JPEG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// transcodeJPEGQuality is the quality used to re-encode progressive JPEGs.
+const transcodeJPEGQuality = 95
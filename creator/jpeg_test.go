@@ -0,0 +1,219 @@
+package creator
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"os"
+	"strings"
+	"testing"
+)
+
+// jpegHeader builds the markers of a JPEG image up to and including the
+// start of scan, with the given frame marker, number of components and
+// optional Adobe APP14 marker. The scan data is not included.
+func jpegHeader(sof byte, components int, adobe bool) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0xFF, 0xD8})
+
+	if adobe {
+		// APP14: "Adobe", version 100, flags, transform 2 (YCCK).
+		buf.Write([]byte{0xFF, 0xEE, 0x00, 0x0E})
+		buf.WriteString("Adobe")
+		buf.Write([]byte{0x00, 0x64, 0x00, 0x00, 0x00, 0x00, 0x02})
+	}
+
+	// SOFn: precision 8, 16x8 pixels, components with 1x1 sampling.
+	length := 8 + 3*components
+	buf.Write([]byte{0xFF, sof, 0x00, byte(length), 8, 0x00, 0x08, 0x00, 0x10, byte(components)})
+	for i := 1; i <= components; i++ {
+		buf.Write([]byte{byte(i), 0x11, 0x00})
+	}
+
+	// SOS with a minimal segment.
+	buf.Write([]byte{0xFF, 0xDA, 0x00, 0x02})
+	return buf.Bytes()
+}
+
+func TestScanJPEG(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           []byte
+		wantComponents int
+		wantAdobe      bool
+	}{
+		{"gray", jpegHeader(0xC0, 1, false), 1, false},
+		{"rgb", jpegHeader(0xC0, 3, false), 3, false},
+		{"cmyk", jpegHeader(0xC0, 4, false), 4, false},
+		{"adobe cmyk", jpegHeader(0xC0, 4, true), 4, true},
+		{"extended sequential", jpegHeader(0xC1, 3, false), 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := scanJPEG(tt.data)
+			if err != nil {
+				t.Fatalf("scanJPEG failed: %v", err)
+			}
+			if info.components != tt.wantComponents {
+				t.Errorf("components = %d, want %d", info.components, tt.wantComponents)
+			}
+			if info.adobe != tt.wantAdobe {
+				t.Errorf("adobe = %v, want %v", info.adobe, tt.wantAdobe)
+			}
+		})
+	}
+}
+
+func TestScanJPEG_EncodedImage(t *testing.T) {
+	data := createJPEGData(t, 8, 8, color.RGBA{0, 128, 255, 255})
+
+	info, err := scanJPEG(data)
+	if err != nil {
+		t.Fatalf("scanJPEG failed: %v", err)
+	}
+	if info.components != 3 || info.adobe {
+		t.Errorf("info = %+v, want 3 components without Adobe marker", info)
+	}
+}
+
+func TestScanJPEG_Invalid(t *testing.T) {
+	header := jpegHeader(0xC0, 3, false)
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr string
+	}{
+		{"not a JPEG", []byte("\x89PNG\r\n\x1a\n"), "start of image"},
+		{"truncated", header[:len(header)-4], "no start of scan"},
+		{"truncated segment", header[:8], "truncated JPEG marker segment"},
+		{"no frame", []byte{0xFF, 0xD8, 0xFF, 0xDA, 0x00, 0x02}, "no frame header"},
+		{"two components", jpegHeader(0xC0, 2, false), "color components"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := scanJPEG(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("scanJPEG error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadJPEG_ColorSpace(t *testing.T) {
+	var gray bytes.Buffer
+	if err := jpeg.Encode(&gray, image.NewGray(image.Rect(0, 0, 8, 8)), nil); err != nil {
+		t.Fatalf("failed to encode JPEG: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantSpace  ColorSpace
+		wantComps  int
+		wantDecode []float64
+	}{
+		{"rgb", createJPEGData(t, 8, 8, color.RGBA{255, 0, 0, 255}), ColorSpaceRGB, 3, nil},
+		{"gray", gray.Bytes(), ColorSpaceGray, 1, nil},
+		{"cmyk", jpegHeader(0xC0, 4, false), ColorSpaceCMYK, 4, nil},
+		{"adobe cmyk", jpegHeader(0xC0, 4, true), ColorSpaceCMYK, 4, []float64{1, 0, 1, 0, 1, 0, 1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := LoadImageFromReader(bytes.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("LoadImageFromReader failed: %v", err)
+			}
+			if img.ColorSpace() != tt.wantSpace {
+				t.Errorf("ColorSpace() = %s, want %s", img.ColorSpace(), tt.wantSpace)
+			}
+			if img.Components() != tt.wantComps {
+				t.Errorf("Components() = %d, want %d", img.Components(), tt.wantComps)
+			}
+			if len(img.decode) != len(tt.wantDecode) {
+				t.Errorf("decode = %v, want %v", img.decode, tt.wantDecode)
+			}
+			if !bytes.Equal(img.Data(), tt.data) {
+				t.Error("JPEG data was not embedded as-is")
+			}
+		})
+	}
+}
+
+func TestLoadJPEG_Progressive(t *testing.T) {
+	tests := []struct {
+		file      string
+		wantSpace ColorSpace
+	}{
+		{"../testdata/images/progressive.jpg", ColorSpaceRGB},
+		{"../testdata/images/progressive-gray.jpg", ColorSpaceGray},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			orig, err := jpeg.Decode(openTestFile(t, tt.file))
+			if err != nil {
+				t.Fatalf("failed to decode test image: %v", err)
+			}
+
+			img, err := LoadImage(tt.file)
+			if err != nil {
+				t.Fatalf("LoadImage failed: %v", err)
+			}
+
+			info, err := scanJPEG(img.Data())
+			if err != nil {
+				t.Fatalf("scanJPEG failed: %v", err)
+			}
+			if info.frame != 0xC0 {
+				t.Errorf("embedded JPEG frame = %#x, want baseline (0xc0)", info.frame)
+			}
+			if img.ColorSpace() != tt.wantSpace {
+				t.Errorf("ColorSpace() = %s, want %s", img.ColorSpace(), tt.wantSpace)
+			}
+			bounds := orig.Bounds()
+			if img.Width() != bounds.Dx() || img.Height() != bounds.Dy() {
+				t.Errorf("size = %dx%d, want %dx%d", img.Width(), img.Height(), bounds.Dx(), bounds.Dy())
+			}
+		})
+	}
+}
+
+func TestLoadJPEG_Unsupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr string
+	}{
+		{"progressive cmyk", jpegHeader(0xC2, 4, true), "progressive CMYK"},
+		{"lossless", jpegHeader(0xC3, 3, false), "SOF3"},
+		{"arithmetic", jpegHeader(0xC9, 3, false), "SOF9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadImageFromReader(bytes.NewReader(tt.data))
+			if !errors.Is(err, ErrUnsupportedJPEG) {
+				t.Fatalf("error = %v, want ErrUnsupportedJPEG", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// openTestFile opens a test file, closed when the test ends.
+func openTestFile(t *testing.T, path string) *os.File {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", path, err)
+	}
+	t.Cleanup(func() { _ = f.Close() })
+	return f
+}
@@ -43,6 +43,9 @@ type LayoutContext struct {
 	// 0 = top of content area (below top margin)
 	// Increases downward.
 	CursorY float64
+
+	// theme is the active document theme (nil = default theme).
+	theme *Theme
 }
 
 // Drawable is an interface for elements that can be drawn on a page.
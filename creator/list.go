@@ -255,7 +255,7 @@ func (l *List) draw(ctx *LayoutContext, page *Page, level int) error {
 		markerX := ctx.ContentLeft() + currentIndent
 		markerY := ctx.CurrentPDFY() - l.fontSize
 
-		err := page.AddTextColor(marker, markerX, markerY, l.font, l.fontSize, l.color)
+		err := page.addTextColor(marker, markerX, markerY, l.font, l.fontSize, l.color)
 		if err != nil {
 			return fmt.Errorf("failed to draw list marker: %w", err)
 		}
@@ -266,7 +266,7 @@ func (l *List) draw(ctx *LayoutContext, page *Page, level int) error {
 
 		for lineIdx, line := range lines {
 			textY := ctx.CurrentPDFY() - l.fontSize
-			err := page.AddTextColor(line, textX, textY, l.font, l.fontSize, l.color)
+			err := page.addTextColor(line, textX, textY, l.font, l.fontSize, l.color)
 			if err != nil {
 				return fmt.Errorf("failed to draw list item text: %w", err)
 			}
@@ -0,0 +1,62 @@
+package markdown
+
+import (
+	"github.com/coregx/gxpdf/creator"
+)
+
+// Code block layout (in points).
+const (
+	codePadding     = 6.0
+	codeLineSpacing = 1.3
+)
+
+// codeBackground is the fill color behind code blocks.
+var codeBackground = creator.Color{R: 0.95, G: 0.95, B: 0.95}
+
+// codeBlock draws preformatted lines in a monospace font on a shaded
+// background. Whitespace is preserved and lines are not wrapped.
+type codeBlock struct {
+	lines    []string
+	fontSize float64
+	color    creator.Color
+}
+
+// Height returns the block height including padding.
+func (b *codeBlock) Height(_ *creator.LayoutContext) float64 {
+	return float64(len(b.lines))*b.lineHeight() + codePadding*2
+}
+
+// Draw renders the code block at the cursor position.
+func (b *codeBlock) Draw(ctx *creator.LayoutContext, page *creator.Page) error {
+	height := b.Height(ctx)
+	top := ctx.CurrentPDFY()
+
+	// Positions are computed in PDF coordinates and converted to the
+	// page's origin mode, where boxes are placed by their top edge.
+	boxY := top - height
+	if page.OriginTopLeft() {
+		boxY = page.UserY(top)
+	}
+	if err := page.DrawRectFilled(ctx.ContentLeft(), boxY, ctx.AvailableWidth(), height, codeBackground); err != nil {
+		return err
+	}
+
+	y := top - codePadding
+	for _, line := range b.lines {
+		y -= b.lineHeight()
+		if line == "" {
+			continue
+		}
+		if err := page.AddTextColor(line, ctx.ContentLeft()+codePadding, page.UserY(y), creator.Courier, b.fontSize, b.color); err != nil {
+			return err
+		}
+	}
+
+	ctx.CursorY += height
+	return nil
+}
+
+// lineHeight returns the height of one code line.
+func (b *codeBlock) lineHeight() float64 {
+	return b.fontSize * codeLineSpacing
+}
@@ -0,0 +1,178 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+// span is a run of inline text with uniform formatting.
+type span struct {
+	text   string
+	bold   bool
+	italic bool
+	code   bool
+	link   string // target URL for link text
+}
+
+// parseInline splits text into formatted spans.
+//
+// Recognized markup: **bold** / __bold__, *italic* / _italic_,
+// ***bold italic***, `code` and [text](url). Underscores inside words
+// (snake_case) are kept as literal text.
+func parseInline(text string) []span {
+	p := &inlineParser{}
+	p.parse(text)
+	return p.spans
+}
+
+// inlineParser tracks emphasis state while scanning inline text.
+type inlineParser struct {
+	spans  []span
+	buf    strings.Builder
+	bold   bool
+	italic bool
+}
+
+// parse scans text and emits spans.
+func (p *inlineParser) parse(text string) {
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		ch := runes[i]
+
+		switch {
+		case ch == '`':
+			if end := indexRune(runes, '`', i+1); end > i {
+				p.flush()
+				p.spans = append(p.spans, span{text: string(runes[i+1 : end]), code: true})
+				i = end + 1
+				continue
+			}
+
+		case ch == '[':
+			if label, url, end, ok := parseLink(runes, i); ok {
+				p.flush()
+				for _, s := range parseInline(label) {
+					s.link = url
+					s.bold = s.bold || p.bold
+					s.italic = s.italic || p.italic
+					p.spans = append(p.spans, s)
+				}
+				i = end
+				continue
+			}
+
+		case ch == '*' || ch == '_':
+			n := runLength(runes, i, ch)
+			if ch == '_' && !isEmphasisBoundary(runes, i, n) {
+				break
+			}
+			p.flush()
+			switch {
+			case n >= 3:
+				p.bold, p.italic = !p.bold, !p.italic
+			case n == 2:
+				p.bold = !p.bold
+			default:
+				p.italic = !p.italic
+			}
+			i += min(n, 3)
+			continue
+		}
+
+		p.buf.WriteRune(ch)
+		i++
+	}
+	p.flush()
+}
+
+// flush emits the buffered text as a span with the current emphasis.
+func (p *inlineParser) flush() {
+	if p.buf.Len() == 0 {
+		return
+	}
+	p.spans = append(p.spans, span{text: p.buf.String(), bold: p.bold, italic: p.italic})
+	p.buf.Reset()
+}
+
+// parseLink parses [label](url) starting at runes[start] == '['.
+// Returns the index just past the closing parenthesis.
+func parseLink(runes []rune, start int) (label, url string, end int, ok bool) {
+	closeBracket := indexRune(runes, ']', start+1)
+	if closeBracket < 0 || closeBracket+1 >= len(runes) || runes[closeBracket+1] != '(' {
+		return "", "", 0, false
+	}
+	closeParen := indexRune(runes, ')', closeBracket+2)
+	if closeParen < 0 {
+		return "", "", 0, false
+	}
+	label = string(runes[start+1 : closeBracket])
+	url = strings.TrimSpace(string(runes[closeBracket+2 : closeParen]))
+	return label, url, closeParen + 1, true
+}
+
+// indexRune returns the index of ch in runes at or after from, or -1.
+func indexRune(runes []rune, ch rune, from int) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == ch {
+			return i
+		}
+	}
+	return -1
+}
+
+// runLength counts consecutive occurrences of ch starting at i.
+func runLength(runes []rune, i int, ch rune) int {
+	n := 0
+	for i+n < len(runes) && runes[i+n] == ch {
+		n++
+	}
+	return n
+}
+
+// isEmphasisBoundary reports whether an underscore run at runes[i:i+n]
+// can open or close emphasis, i.e. it is not inside a word.
+func isEmphasisBoundary(runes []rune, i, n int) bool {
+	before := i == 0 || !isWordRune(runes[i-1])
+	after := i+n >= len(runes) || !isWordRune(runes[i+n])
+	return before || after
+}
+
+// isWordRune reports whether r is a letter or digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// plainText returns text with inline markup removed.
+func plainText(text string) string {
+	var sb strings.Builder
+	for _, s := range parseInline(text) {
+		sb.WriteString(s.text)
+	}
+	return sb.String()
+}
+
+// fontVariant returns the bold/italic variant of a standard font family.
+func fontVariant(base creator.FontName, bold, italic bool) creator.FontName {
+	var family [4]creator.FontName // regular, bold, italic, bold italic
+	switch base {
+	case creator.TimesRoman, creator.TimesBold, creator.TimesItalic, creator.TimesBoldItalic:
+		family = [4]creator.FontName{creator.TimesRoman, creator.TimesBold, creator.TimesItalic, creator.TimesBoldItalic}
+	case creator.Courier, creator.CourierBold, creator.CourierOblique, creator.CourierBoldOblique:
+		family = [4]creator.FontName{creator.Courier, creator.CourierBold, creator.CourierOblique, creator.CourierBoldOblique}
+	case creator.Helvetica, creator.HelveticaBold, creator.HelveticaOblique, creator.HelveticaBoldOblique:
+		family = [4]creator.FontName{creator.Helvetica, creator.HelveticaBold, creator.HelveticaOblique, creator.HelveticaBoldOblique}
+	default:
+		return base
+	}
+
+	idx := 0
+	if bold {
+		idx++
+	}
+	if italic {
+		idx += 2
+	}
+	return family[idx]
+}
@@ -0,0 +1,320 @@
+// Package markdown renders a subset of Markdown into creator drawables.
+//
+// Supported syntax:
+//   - ATX headings (# to ######)
+//   - Paragraphs with **bold**, *italic*, ***bold italic***, `code` and [links](url)
+//   - Bullet (-, *, +) and numbered (1.) lists, nested by indentation
+//   - Fenced code blocks (```)
+//   - Pipe tables with a header separator row
+//
+// Styling comes from the Creator's active theme (see creator.Theme).
+//
+// Example:
+//
+//	c := creator.New()
+//	if err := markdown.RenderMarkdown(c, "# Report\n\nSales are **up**."); err != nil {
+//	    log.Fatal(err)
+//	}
+//	c.WriteToFile("report.pdf")
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+// RenderMarkdown parses md and flows the resulting drawables into the
+// document using Creator.Draw, adding pages as needed.
+func RenderMarkdown(c *creator.Creator, md string) error {
+	for _, d := range ToDrawables(md, c.Theme()) {
+		if err := c.Draw(d); err != nil {
+			return fmt.Errorf("markdown: %w", err)
+		}
+	}
+	return nil
+}
+
+// ToDrawables parses md into creator drawables styled with theme.
+//
+// A nil theme uses creator.DefaultTheme(). The drawables can be drawn
+// with Creator.Draw or placed individually.
+func ToDrawables(md string, theme *creator.Theme) []creator.Drawable {
+	if theme == nil {
+		theme = creator.DefaultTheme()
+	}
+	r := &renderer{theme: theme}
+	return r.render(splitLines(md))
+}
+
+// renderer converts Markdown blocks into drawables.
+type renderer struct {
+	theme *creator.Theme
+	out   []creator.Drawable
+}
+
+// render processes all lines block by block.
+func (r *renderer) render(lines []string) []creator.Drawable {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+		case strings.HasPrefix(trimmed, "```"):
+			i = r.codeBlock(lines, i)
+		case headingLevel(trimmed) > 0:
+			r.heading(trimmed)
+			i++
+		case isListItem(line):
+			i = r.list(lines, i)
+		case isTableStart(lines, i):
+			i = r.table(lines, i)
+		default:
+			i = r.paragraph(lines, i)
+		}
+	}
+	return r.out
+}
+
+// add appends a drawable followed by the theme's paragraph spacing.
+func (r *renderer) add(d creator.Drawable) {
+	r.out = append(r.out, d)
+	if r.theme.ParagraphSpacing > 0 {
+		r.out = append(r.out, creator.NewSpacer(r.theme.ParagraphSpacing))
+	}
+}
+
+// heading renders an ATX heading line.
+func (r *renderer) heading(line string) {
+	level := headingLevel(line)
+	text := strings.TrimSpace(strings.TrimRight(line[level:], "#"))
+	r.out = append(r.out, creator.NewHeading(plainText(text), level))
+}
+
+// paragraph collects consecutive text lines into one rich text paragraph.
+func (r *renderer) paragraph(lines []string, start int) int {
+	var parts []string
+	i := start
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "```") || headingLevel(trimmed) > 0 ||
+			isListItem(lines[i]) || (i > start && isTableStart(lines, i)) {
+			break
+		}
+		parts = append(parts, trimmed)
+	}
+
+	rt := creator.NewRichText().SetLineSpacing(r.theme.LineSpacing)
+	for _, s := range parseInline(strings.Join(parts, " ")) {
+		if s.link != "" {
+			rt.AddRun(creator.LinkRun(s.text, s.link, r.spanStyle(s)))
+			continue
+		}
+		rt.Add(s.text, r.spanStyle(s))
+	}
+	r.add(rt)
+	return i
+}
+
+// spanStyle returns the text style for an inline span.
+func (r *renderer) spanStyle(s span) creator.TextStyle {
+	style := r.theme.Body
+	base := style.Font
+	if s.code {
+		base = creator.Courier
+	}
+	style.Font = fontVariant(base, s.bold, s.italic)
+	if s.link != "" {
+		style.Color = r.theme.Accent
+	}
+	return style
+}
+
+// codeBlock renders a fenced code block and returns the next line index.
+func (r *renderer) codeBlock(lines []string, start int) int {
+	var code []string
+	i := start + 1
+	for ; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+
+	r.add(&codeBlock{
+		lines:    code,
+		fontSize: r.theme.Body.Size * 0.9,
+		color:    r.theme.Body.Color,
+	})
+	return i
+}
+
+// list renders a bullet or numbered list and returns the next line index.
+func (r *renderer) list(lines []string, start int) int {
+	list, next := r.buildList(lines, start, indentOf(lines[start]))
+	r.add(list)
+	return next
+}
+
+// buildList parses list items at the given indentation, recursing into
+// more deeply indented items as sublists.
+func (r *renderer) buildList(lines []string, start, indent int) (*creator.List, int) {
+	_, numbered := listMarker(lines[start])
+	list := creator.NewList()
+	if numbered {
+		list = creator.NewNumberedList()
+	}
+	list.SetFont(r.theme.Body.Font, r.theme.Body.Size).
+		SetColor(r.theme.Body.Color).
+		SetLineSpacing(r.theme.LineSpacing)
+
+	i := start
+	for i < len(lines) {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" || !isListItem(line) {
+			break
+		}
+
+		ind := indentOf(line)
+		switch {
+		case ind < indent:
+			return list, i
+		case ind > indent:
+			sub, next := r.buildList(lines, i, ind)
+			list.AddSubList(sub)
+			i = next
+		default:
+			text, _ := listMarker(line)
+			list.Add(plainText(text))
+			i++
+		}
+	}
+	return list, i
+}
+
+// table renders a pipe table and returns the next line index.
+func (r *renderer) table(lines []string, start int) int {
+	header := tableCells(lines[start])
+	table := creator.NewTableLayout(len(header)).
+		SetBorder(0.5, r.theme.Muted).
+		SetRepeatHeader(true)
+
+	row := table.NewHeaderRow()
+	for _, cell := range header {
+		row.AddCell(plainText(cell))
+	}
+
+	i := start + 2 // skip the separator row
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || !strings.Contains(trimmed, "|") {
+			break
+		}
+		row := table.NewRow()
+		for _, cell := range tableCells(trimmed) {
+			row.AddCell(plainText(cell))
+		}
+	}
+
+	r.add(table)
+	return i
+}
+
+// splitLines normalizes line endings and splits md into lines.
+func splitLines(md string) []string {
+	md = strings.ReplaceAll(md, "\r\n", "\n")
+	return strings.Split(md, "\n")
+}
+
+// headingLevel returns the ATX heading level of a line, or 0.
+func headingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level == len(line) || line[level] != ' ' {
+		return 0
+	}
+	return level
+}
+
+// indentOf returns the number of leading spaces (tabs count as 4).
+func indentOf(line string) int {
+	n := 0
+	for _, ch := range line {
+		switch ch {
+		case ' ':
+			n++
+		case '\t':
+			n += 4
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// isListItem reports whether a line starts a list item.
+func isListItem(line string) bool {
+	_, ok := listItemText(strings.TrimSpace(line))
+	return ok
+}
+
+// listMarker returns the item text of a list line and whether the list is numbered.
+func listMarker(line string) (text string, numbered bool) {
+	trimmed := strings.TrimSpace(line)
+	text, _ = listItemText(trimmed)
+	return text, trimmed != "" && trimmed[0] >= '0' && trimmed[0] <= '9'
+}
+
+// listItemText strips a bullet or number marker from a trimmed line.
+func listItemText(trimmed string) (string, bool) {
+	for _, bullet := range []string{"- ", "* ", "+ "} {
+		if strings.HasPrefix(trimmed, bullet) {
+			return strings.TrimSpace(trimmed[len(bullet):]), true
+		}
+	}
+
+	digits := 0
+	for digits < len(trimmed) && trimmed[digits] >= '0' && trimmed[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && strings.HasPrefix(trimmed[digits:], ". ") {
+		return strings.TrimSpace(trimmed[digits+2:]), true
+	}
+	return "", false
+}
+
+// isTableStart reports whether lines[i] begins a pipe table, which
+// requires a separator row such as |---|:---:| on the next line.
+func isTableStart(lines []string, i int) bool {
+	if i+1 >= len(lines) || !strings.Contains(lines[i], "|") {
+		return false
+	}
+	sep := strings.TrimSpace(lines[i+1])
+	if !strings.Contains(sep, "-") {
+		return false
+	}
+	for _, cell := range tableCells(sep) {
+		if strings.Trim(cell, "-: ") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// tableCells splits a pipe table row into trimmed cell texts.
+func tableCells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+	cells := strings.Split(line, "|")
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
@@ -0,0 +1,204 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+func TestParseInline(t *testing.T) {
+	spans := parseInline("plain **bold** *italic* ***both*** `code` [site](https://example.com) snake_case")
+
+	want := []span{
+		{text: "plain "},
+		{text: "bold", bold: true},
+		{text: " "},
+		{text: "italic", italic: true},
+		{text: " "},
+		{text: "both", bold: true, italic: true},
+		{text: " "},
+		{text: "code", code: true},
+		{text: " "},
+		{text: "site", link: "https://example.com"},
+		{text: " snake_case"},
+	}
+
+	if len(spans) != len(want) {
+		t.Fatalf("spans = %+v, want %+v", spans, want)
+	}
+	for i := range want {
+		if spans[i] != want[i] {
+			t.Errorf("span %d = %+v, want %+v", i, spans[i], want[i])
+		}
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	if got := plainText("**Total** for [Q1](x)"); got != "Total for Q1" {
+		t.Errorf("plainText() = %q, want %q", got, "Total for Q1")
+	}
+}
+
+func TestFontVariant(t *testing.T) {
+	tests := []struct {
+		base         creator.FontName
+		bold, italic bool
+		want         creator.FontName
+	}{
+		{creator.Helvetica, true, false, creator.HelveticaBold},
+		{creator.Helvetica, true, true, creator.HelveticaBoldOblique},
+		{creator.TimesRoman, false, true, creator.TimesItalic},
+		{creator.CourierBold, false, false, creator.Courier},
+		{creator.Symbol, true, true, creator.Symbol},
+	}
+	for _, tt := range tests {
+		if got := fontVariant(tt.base, tt.bold, tt.italic); got != tt.want {
+			t.Errorf("fontVariant(%s, %v, %v) = %s, want %s", tt.base, tt.bold, tt.italic, got, tt.want)
+		}
+	}
+}
+
+func TestToDrawables_Blocks(t *testing.T) {
+	md := strings.Join([]string{
+		"# Title",
+		"",
+		"Some **bold** text",
+		"continues here.",
+		"",
+		"- one",
+		"- two",
+		"  - nested",
+		"",
+		"1. first",
+		"2. second",
+		"",
+		"```",
+		"func main() {}",
+		"```",
+		"",
+		"| Name | Qty |",
+		"|------|----:|",
+		"| Apple | 3 |",
+	}, "\n")
+
+	var kinds []string
+	for _, d := range ToDrawables(md, nil) {
+		switch v := d.(type) {
+		case *creator.Heading:
+			kinds = append(kinds, "heading")
+		case *creator.RichText:
+			kinds = append(kinds, "paragraph")
+		case *creator.List:
+			kinds = append(kinds, "list")
+		case *codeBlock:
+			kinds = append(kinds, "code")
+		case *creator.TableLayout:
+			kinds = append(kinds, "table")
+			if v.RowCount() != 2 || v.HeaderRowCount() != 1 {
+				t.Errorf("table rows = %d (header %d), want 2 (1)", v.RowCount(), v.HeaderRowCount())
+			}
+		case *creator.Spacer:
+		default:
+			t.Errorf("unexpected drawable %T", d)
+		}
+	}
+
+	want := []string{"heading", "paragraph", "list", "list", "code", "table"}
+	if strings.Join(kinds, ",") != strings.Join(want, ",") {
+		t.Errorf("blocks = %v, want %v", kinds, want)
+	}
+}
+
+func TestHeadingLevel(t *testing.T) {
+	tests := map[string]int{
+		"# H1":     1,
+		"### H3":   3,
+		"#NoSpace": 0,
+		"####### ": 0,
+		"text":     0,
+	}
+	for line, want := range tests {
+		if got := headingLevel(line); got != want {
+			t.Errorf("headingLevel(%q) = %d, want %d", line, got, want)
+		}
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	c := creator.New()
+
+	md := "# Report\n\n" + strings.Repeat("A long paragraph of text. ", 40) + "\n\n```\n  indented code\n```\n"
+	if err := RenderMarkdown(c, md); err != nil {
+		t.Fatalf("RenderMarkdown() error = %v", err)
+	}
+
+	if c.PageCount() != 1 {
+		t.Fatalf("PageCount() = %d, want 1", c.PageCount())
+	}
+
+	data, err := c.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+	if len(data) == 0 {
+		t.Error("expected PDF output")
+	}
+}
+
+func TestCodeBlock_PreservesWhitespace(t *testing.T) {
+	c := creator.New()
+	page, _ := c.NewPage()
+
+	block := &codeBlock{lines: []string{"  indented"}, fontSize: 10, color: creator.Black}
+	if err := block.Draw(page.GetLayoutContext(), page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	ops := page.TextOperations()
+	if len(ops) != 1 || ops[0].Text != "  indented" {
+		t.Errorf("code text = %+v, want leading spaces preserved", ops)
+	}
+}
+
+func TestCodeBlock_OriginTopLeft(t *testing.T) {
+	draw := func(topLeft bool) *creator.Page {
+		c := creator.New()
+		c.SetOriginTopLeft(topLeft)
+		page, _ := c.NewPage()
+
+		block := &codeBlock{lines: []string{"code"}, fontSize: 10, color: creator.Black}
+		if err := block.Draw(page.GetLayoutContext(), page); err != nil {
+			t.Fatalf("Draw() error = %v", err)
+		}
+		return page
+	}
+
+	// The block lands at the same place whatever the origin mode.
+	want, got := draw(false), draw(true)
+	if w, g := want.TextOperations()[0].Y, got.TextOperations()[0].Y; w != g {
+		t.Errorf("text Y = %.2f, want %.2f", g, w)
+	}
+	if w, g := want.GraphicsOperations()[0].Y, got.GraphicsOperations()[0].Y; w != g {
+		t.Errorf("background Y = %.2f, want %.2f", g, w)
+	}
+}
+
+func TestToDrawables_LinkRuns(t *testing.T) {
+	drawables := ToDrawables("Read the [docs](https://example.com/docs) first.", nil)
+
+	rt, ok := drawables[0].(*creator.RichText)
+	if !ok {
+		t.Fatalf("drawable = %T, want *creator.RichText", drawables[0])
+	}
+
+	var urls []string
+	for _, run := range rt.Runs() {
+		if run.URL != "" {
+			urls = append(urls, run.Text+"="+run.URL)
+		}
+	}
+	if len(urls) != 1 || urls[0] != "docs=https://example.com/docs" {
+		t.Errorf("link runs = %v, want [docs=https://example.com/docs]", urls)
+	}
+}
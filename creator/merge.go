@@ -0,0 +1,107 @@
+package creator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AppendDocument appends the pages of another Creator to this document.
+//
+// This allows building sections independently, e.g. in concurrent
+// workers, and merging them in memory without writing them to disk.
+//
+// other's generated content is rendered into its pages first: pending
+// footnotes, chapters, table of contents, lists of figures and tables,
+// index and cross-references. The pages then move to this document with
+// their content, annotations, form fields and fonts; internal links and
+// bookmarks are shifted to keep pointing to the same pages.
+//
+// Headers, footers and running elements are those of this document;
+// other's are not applied to its pages. other is consumed and must not be
+// used afterwards.
+//
+// Example:
+//
+//	sections := make([]*creator.Creator, 4)
+//	// Build each section in its own goroutine...
+//
+//	c := creator.New()
+//	for _, section := range sections {
+//	    if err := c.AppendDocument(section); err != nil {
+//	        return err
+//	    }
+//	}
+//	err := c.WriteToFile("report.pdf")
+func (c *Creator) AppendDocument(other *Creator) error {
+	return c.AppendDocumentWithTransform(other, Identity())
+}
+
+// AppendDocumentWithTransform is like AppendDocument, but transforms the
+// content of the appended pages, e.g. to shrink them to fit a margin or to
+// move them on the page. Annotations and form fields are transformed with
+// the content. Headers, footers and running elements of this document are
+// not transformed.
+//
+// Example:
+//
+//	// Shrink to 90% around the center of an A4 page.
+//	t := creator.Scale(0.9, 0.9).Then(creator.Translate(29.75, 42.1))
+//	err := c.AppendDocumentWithTransform(section, t)
+func (c *Creator) AppendDocumentWithTransform(other *Creator, t Transform) error {
+	if other == c {
+		return errors.New("cannot append a document to itself")
+	}
+
+	if err := other.finishFlow(); err != nil {
+		return fmt.Errorf("failed to finish document flow: %w", err)
+	}
+	if err := other.renderTOCAndChapters(); err != nil {
+		return fmt.Errorf("failed to render TOC and chapters: %w", err)
+	}
+
+	if t != Identity() {
+		for _, page := range other.pages {
+			page.transformContent(t)
+		}
+	}
+
+	offset := len(c.pages)
+	if err := c.doc.AppendDocument(other.doc); err != nil {
+		return fmt.Errorf("failed to append pages: %w", err)
+	}
+	c.pages = append(c.pages, other.pages...)
+	for _, b := range other.bookmarks {
+		b.PageIndex += offset
+		c.bookmarks = append(c.bookmarks, b)
+	}
+	// Keep the characters other reserved in its registered fonts.
+	c.registeredFonts = append(c.registeredFonts, other.registeredFonts...)
+
+	// The pages now belong to this document.
+	other.pages = nil
+	other.bookmarks = nil
+	other.chapters = nil
+	other.flowPage = nil
+
+	return nil
+}
+
+// transformContent applies t to the page content and annotations, after
+// any transformation applied before.
+func (p *Page) transformContent(t Transform) {
+	if p.contentTransform != nil {
+		t = p.contentTransform.Then(t)
+	}
+	p.contentTransform = &t
+	p.page.TransformAnnotations(t.TransformPoint)
+}
+
+// contentMatrix returns the transformation of the page content as a PDF
+// matrix, or nil if the content is not transformed.
+func (p *Page) contentMatrix() *[6]float64 {
+	if p.contentTransform == nil {
+		return nil
+	}
+	m := p.contentTransform.ToPDFMatrix()
+	return &m
+}
@@ -0,0 +1,129 @@
+package creator
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSection creates a document whose pages show "<name> <page>".
+func newSection(t *testing.T, name string, pages int) *Creator {
+	t.Helper()
+
+	c := New()
+	for i := 0; i < pages; i++ {
+		page, err := c.NewPage()
+		require.NoError(t, err)
+		require.NoError(t, page.AddText(name+" "+string(rune('1'+i)), 72, 720, Helvetica, 12))
+	}
+	return c
+}
+
+func TestCreator_AppendDocument(t *testing.T) {
+	c := newSection(t, "Intro", 1)
+
+	other := newSection(t, "Body", 2)
+	page, err := other.CurrentPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddInternalLink("Back to start", 0, 72, 700, Helvetica, 12))
+	require.NoError(t, other.AddBookmark("Body", 0, 0))
+
+	require.NoError(t, c.AppendDocument(other))
+
+	assert.Equal(t, 3, c.PageCount())
+	assert.Equal(t, 0, other.PageCount())
+	require.Len(t, c.Bookmarks(), 1)
+	assert.Equal(t, 1, c.Bookmarks()[0].PageIndex)
+
+	links := c.pages[2].page.LinkAnnotations()
+	require.Len(t, links, 1)
+	assert.Equal(t, 1, links[0].DestPage, "internal link should follow its page")
+
+	path := filepath.Join(t.TempDir(), "merged.pdf")
+	require.NoError(t, c.WriteToFile(path))
+
+	reader, err := parser.OpenPDF(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	count, err := reader.GetPageCount()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	for i, want := range []string{"Intro 1", "Body 1", "Body 2"} {
+		elements, err := extractor.NewTextExtractor(reader).ExtractFromPage(i)
+		require.NoError(t, err)
+		var text []string
+		for _, e := range elements {
+			text = append(text, e.Text)
+		}
+		assert.Contains(t, strings.Join(text, " "), want, "page %d", i)
+	}
+}
+
+func TestCreator_AppendDocument_Concurrent(t *testing.T) {
+	sections := make([]*Creator, 4)
+	var wg sync.WaitGroup
+	for i := range sections {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := New()
+			page, err := c.NewPage()
+			if err == nil {
+				err = page.AddText("Section", 72, 720, Helvetica, 12)
+			}
+			assert.NoError(t, err)
+			sections[i] = c
+		}()
+	}
+	wg.Wait()
+
+	c := New()
+	for _, section := range sections {
+		require.NoError(t, c.AppendDocument(section))
+	}
+	assert.Equal(t, 4, c.PageCount())
+
+	_, err := c.Bytes()
+	require.NoError(t, err)
+}
+
+func TestCreator_AppendDocument_Self(t *testing.T) {
+	c := newSection(t, "Only", 1)
+	assert.Error(t, c.AppendDocument(c))
+	assert.Equal(t, 1, c.PageCount())
+}
+
+func TestCreator_AppendDocumentWithTransform(t *testing.T) {
+	c := newSection(t, "Intro", 1)
+
+	other := newSection(t, "Body", 1)
+	page, err := other.CurrentPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddLink("Site", "https://example.com", 100, 200, Helvetica, 12))
+	before := page.page.LinkAnnotations()[0].Rect
+
+	transform := Scale(0.5, 0.5).Then(Translate(20, 30))
+	require.NoError(t, c.AppendDocumentWithTransform(other, transform))
+	require.Equal(t, 2, c.PageCount())
+
+	after := c.pages[1].page.LinkAnnotations()[0].Rect
+	assert.InDelta(t, before[0]*0.5+20, after[0], 1e-9)
+	assert.InDelta(t, before[1]*0.5+30, after[1], 1e-9)
+	assert.InDelta(t, before[2]*0.5+20, after[2], 1e-9)
+	assert.InDelta(t, before[3]*0.5+30, after[3], 1e-9)
+
+	assert.Nil(t, c.pages[0].contentMatrix(), "pages of this document are not transformed")
+	m := c.pages[1].contentMatrix()
+	require.NotNil(t, m)
+	assert.Equal(t, [6]float64{0.5, 0, 0, 0.5, 20, 30}, *m)
+
+	_, err = c.Bytes()
+	require.NoError(t, err)
+}
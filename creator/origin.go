@@ -0,0 +1,116 @@
+package creator
+
+// SetOriginTopLeft sets whether page coordinates are measured from the
+// top-left corner of the page, with y growing downwards, instead of the
+// PDF default bottom-left corner with y growing upwards.
+//
+// With the top-left origin:
+//   - Text methods (AddText, AddTextColor, AddLink, ...) take the baseline
+//     y as the distance from the top edge.
+//   - DrawRect, DrawImage, BeginClipRect and other boxes take the top-left
+//     corner of the box instead of the bottom-left corner.
+//   - Points of lines, circles, ellipses, polygons, polylines, Bézier
+//     curves and paths are measured from the top edge.
+//
+// Coordinates are converted to PDF coordinates when content is added, so
+// the setting applies to content added after the call, on existing and
+// new pages. Layout-based drawing (Draw, DrawAt), which already measures
+// from the top of the content area, annotations, form fields and
+// gradient coordinates are not affected.
+//
+// Example:
+//
+//	c.SetOriginTopLeft(true)
+//	page, _ := c.NewPage()
+//	page.AddText("Title", 72, 72, creator.HelveticaBold, 24) // Baseline 1 inch from the top
+//	page.DrawRect(72, 100, 200, 50, opts)                     // Top edge 100pt from the top
+func (c *Creator) SetOriginTopLeft(enabled bool) {
+	c.originTopLeft = enabled
+	for _, page := range c.pages {
+		page.originTopLeft = enabled
+	}
+}
+
+// OriginTopLeft reports whether page coordinates are measured from the
+// top-left corner (see SetOriginTopLeft).
+func (c *Creator) OriginTopLeft() bool {
+	return c.originTopLeft
+}
+
+// OriginTopLeft reports whether the page measures coordinates from the
+// top-left corner (see Creator.SetOriginTopLeft).
+func (p *Page) OriginTopLeft() bool {
+	return p.originTopLeft
+}
+
+// UserY converts a y coordinate in PDF coordinates, such as
+// LayoutContext.CurrentPDFY, to the page's origin mode.
+//
+// Drawables implemented outside this package use it to position content
+// from the layout cursor with AddText and the other drawing methods.
+func (p *Page) UserY(y float64) float64 {
+	return p.pdfY(y)
+}
+
+// pdfY converts a y coordinate in the page's origin mode to PDF
+// coordinates.
+func (p *Page) pdfY(y float64) float64 {
+	if !p.originTopLeft {
+		return y
+	}
+	_, top := p.page.MediaBox().UpperRight()
+	return top - y
+}
+
+// pdfBoxY converts the y coordinate of a box's corner in the page's origin
+// mode (top-left corner with the top-left origin) to the PDF y coordinate
+// of the box's lower-left corner.
+func (p *Page) pdfBoxY(y, height float64) float64 {
+	if !p.originTopLeft {
+		return y
+	}
+	return p.pdfY(y) - height
+}
+
+// pdfPoints converts points in the page's origin mode to PDF coordinates.
+// The points are copied if they are converted.
+func (p *Page) pdfPoints(points []Point) []Point {
+	if !p.originTopLeft {
+		return points
+	}
+	converted := make([]Point, len(points))
+	for i, pt := range points {
+		converted[i] = Point{X: pt.X, Y: p.pdfY(pt.Y)}
+	}
+	return converted
+}
+
+// pdfSegments converts Bézier segments in the page's origin mode to PDF
+// coordinates. The segments are copied if they are converted.
+func (p *Page) pdfSegments(segments []BezierSegment) []BezierSegment {
+	if !p.originTopLeft {
+		return segments
+	}
+	converted := make([]BezierSegment, len(segments))
+	for i, seg := range segments {
+		pts := p.pdfPoints([]Point{seg.Start, seg.C1, seg.C2, seg.End})
+		converted[i] = BezierSegment{Start: pts[0], C1: pts[1], C2: pts[2], End: pts[3]}
+	}
+	return converted
+}
+
+// pdfPathOptions returns opts with the page's origin mode folded into the
+// path transform. The options are copied if they are changed.
+func (p *Page) pdfPathOptions(opts *PathOptions) *PathOptions {
+	if !p.originTopLeft {
+		return opts
+	}
+	_, top := p.page.MediaBox().UpperRight()
+	flip := Scale(1, -1).Then(Translate(0, top))
+	o := *opts
+	if o.Transform != nil {
+		flip = o.Transform.Then(flip)
+	}
+	o.Transform = &flip
+	return &o
+}
@@ -0,0 +1,101 @@
+package creator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSetOriginTopLeft(t *testing.T) {
+	c := New()
+	existing, _ := c.NewPageWithSize(Letter)
+	c.SetOriginTopLeft(true)
+	page, _ := c.NewPageWithSize(Letter)
+
+	if !c.OriginTopLeft() {
+		t.Error("OriginTopLeft() = false, want true")
+	}
+	if !existing.originTopLeft || !page.originTopLeft {
+		t.Error("origin mode not applied to pages")
+	}
+
+	// Letter is 792pt high.
+	if err := page.AddText("Title", 72, 72, Helvetica, 12); err != nil {
+		t.Fatalf("AddText failed: %v", err)
+	}
+	if err := page.DrawRect(72, 100, 200, 50, &RectOptions{StrokeColor: &Black}); err != nil {
+		t.Fatalf("DrawRect failed: %v", err)
+	}
+	if err := page.DrawLine(72, 200, 300, 250, &LineOptions{Color: Black}); err != nil {
+		t.Fatalf("DrawLine failed: %v", err)
+	}
+	if err := page.DrawPolygon([]Point{{0, 0}, {10, 0}, {0, 10}}, &PolygonOptions{FillColor: &Black}); err != nil {
+		t.Fatalf("DrawPolygon failed: %v", err)
+	}
+
+	if got := page.TextOperations()[0].Y; got != 720 {
+		t.Errorf("text Y = %v, want 720", got)
+	}
+
+	ops := page.GraphicsOperations()
+	if ops[0].X != 72 || ops[0].Y != 642 {
+		t.Errorf("rect corner = (%v, %v), want (72, 642)", ops[0].X, ops[0].Y)
+	}
+	if ops[1].Y != 592 || ops[1].Y2 != 542 {
+		t.Errorf("line Y = %v, %v, want 592, 542", ops[1].Y, ops[1].Y2)
+	}
+	if v := ops[2].Vertices; v[0].Y != 792 || v[2].Y != 782 {
+		t.Errorf("polygon vertices = %v", v)
+	}
+}
+
+func TestSetOriginTopLeft_Default(t *testing.T) {
+	c := New()
+	page, _ := c.NewPageWithSize(Letter)
+
+	if err := page.DrawRect(72, 100, 200, 50, &RectOptions{StrokeColor: &Black}); err != nil {
+		t.Fatalf("DrawRect failed: %v", err)
+	}
+	if op := page.GraphicsOperations()[0]; op.Y != 100 {
+		t.Errorf("rect Y = %v, want 100", op.Y)
+	}
+}
+
+func TestSetOriginTopLeft_Link(t *testing.T) {
+	c := New()
+	c.SetOriginTopLeft(true)
+	page, _ := c.NewPageWithSize(Letter)
+
+	if err := page.AddLink("Home", "https://example.com", 72, 92, Helvetica, 10); err != nil {
+		t.Fatalf("AddLink failed: %v", err)
+	}
+
+	if got := page.TextOperations()[0].Y; got != 700 {
+		t.Errorf("link text Y = %v, want 700", got)
+	}
+	if rect := page.page.Annotations()[0].Rect; rect[1] != 699 || rect[3] != 711 {
+		t.Errorf("link rect = %v, want bottom 699, top 711", rect)
+	}
+	// The underline stays below the baseline.
+	if op := page.GraphicsOperations()[0]; op.Y != 699 {
+		t.Errorf("underline Y = %v, want 699", op.Y)
+	}
+}
+
+func TestSetOriginTopLeft_RotatedImage(t *testing.T) {
+	c := New()
+	c.SetOriginTopLeft(true)
+	page, _ := c.NewPageWithSize(Letter)
+	img := &Image{width: 100, height: 50}
+
+	// Rotated a quarter turn around the top-left corner (100, 692 in PDF
+	// coordinates), the box's lower-left corner lands to its right.
+	err := page.DrawImageWithOptions(img, 100, 100, 100, 50, &ImageOptions{Angle: 90})
+	if err != nil {
+		t.Fatalf("DrawImageWithOptions failed: %v", err)
+	}
+
+	box := page.GraphicsOperations()[0].ImageBox
+	if math.Abs(box.X-150) > 1e-9 || math.Abs(box.Y-692) > 1e-9 {
+		t.Errorf("box corner = (%v, %v), want (150, 692)", box.X, box.Y)
+	}
+}
@@ -21,11 +21,30 @@ type Page struct {
 	page *document.Page
 
 	// Creator settings
-	margins Margins
+	margins     Margins
+	theme       *Theme
+	strictFonts bool // Reject custom font text with missing glyphs
+
+	// Measure coordinates from the top-left corner (see Creator.SetOriginTopLeft)
+	originTopLeft bool
+
+	// Space between drawables drawn with Draw (see Creator.SetBlockSpacing)
+	blockSpacing float64
 
 	// Content operations
 	textOps     []TextOperation     // Text drawing operations
 	graphicsOps []GraphicsOperation // Graphics drawing operations
+	background  *RectOptions        // Full-page fill drawn under all content
+	defaults    shapeDefaults       // Default line width and colors for shapes
+	debugGrid   float64             // Debug grid spacing (0 = none, see DrawDebugGrid)
+	cursor      textCursor          // Position and style for Text (see MoveTo)
+	layout      *LayoutContext      // Persistent layout context (see LayoutContext)
+
+	// Annotations added to the page, for replies
+	annotations map[Annotation]document.Annotation
+
+	// Transformation of the page content (see Creator.AppendDocumentWithTransform)
+	contentTransform *Transform
 }
 
 // SetRotation sets the page rotation.
@@ -122,6 +141,10 @@ func (p *Page) ContentHeight() float64 {
 //   - font: Font to use (one of the Standard 14 fonts)
 //   - size: Font size in points
 //
+// Standard fonts use WinAnsiEncoding, which covers Western European
+// characters (é, ñ, ü, €, “”, …). Other characters are drawn as '?';
+// use AddTextCustomFont with an embedded font for them.
+//
 // Example:
 //
 //	err := page.AddText("Hello World", 100, 700, creator.Helvetica, 24)
@@ -143,6 +166,11 @@ func (p *Page) AddText(text string, x, y float64, font FontName, size float64) e
 //
 //	err := page.AddTextColor("Error!", 100, 700, creator.HelveticaBold, 18, creator.Red)
 func (p *Page) AddTextColor(text string, x, y float64, font FontName, size float64, color Color) error {
+	return p.addTextColor(text, x, p.pdfY(y), font, size, color)
+}
+
+// addTextColor adds colored text with its baseline at PDF coordinates.
+func (p *Page) addTextColor(text string, x, y float64, font FontName, size float64, color Color) error {
 	// Validate font size
 	if size <= 0 {
 		return errors.New("font size must be positive")
@@ -201,7 +229,7 @@ func (p *Page) AddTextColorCMYK(text string, x, y float64, font FontName, size f
 	p.textOps = append(p.textOps, TextOperation{
 		Text:      text,
 		X:         x,
-		Y:         y,
+		Y:         p.pdfY(y),
 		Font:      font,
 		Size:      size,
 		ColorCMYK: &color,
@@ -257,15 +285,15 @@ func (p *Page) AddTextCustomFontColor(text string, x, y float64, font *CustomFon
 	if color.R < 0 || color.R > 1 || color.G < 0 || color.G > 1 || color.B < 0 || color.B > 1 {
 		return errors.New("color components must be in range [0.0, 1.0]")
 	}
-
-	// Mark characters as used for font subsetting.
-	font.UseString(text)
+	if err := p.checkGlyphs(text, font); err != nil {
+		return err
+	}
 
 	// Store text operation with custom font.
 	p.textOps = append(p.textOps, TextOperation{
 		Text:       text,
 		X:          x,
-		Y:          y,
+		Y:          p.pdfY(y),
 		CustomFont: font,
 		Size:       size,
 		Color:      color,
@@ -284,8 +312,67 @@ func (p *Page) TextOperations() []TextOperation {
 // GraphicsOperations returns all graphics operations for this page.
 //
 // This is used by the writer infrastructure to generate the content stream.
+// The page background, if set, comes first.
 func (p *Page) GraphicsOperations() []GraphicsOperation {
-	return p.graphicsOps
+	if p.background == nil {
+		return p.graphicsOps
+	}
+
+	box := p.page.MediaBox()
+	llx, lly := box.LowerLeft()
+	urx, ury := box.UpperRight()
+	bg := GraphicsOperation{
+		Type:     GraphicsOpRect,
+		X:        llx,
+		Y:        lly,
+		Width:    urx - llx,
+		Height:   ury - lly,
+		RectOpts: p.background,
+	}
+	return append([]GraphicsOperation{bg}, p.graphicsOps...)
+}
+
+// SetBackground fills the whole page with a color.
+//
+// The background covers the full media box and is drawn before all other
+// content, regardless of when it is set. Headers, footers, watermarks and
+// page content all appear on top of it.
+//
+// Example:
+//
+//	page.SetBackground(creator.Color{R: 0.1, G: 0.1, B: 0.15})
+func (p *Page) SetBackground(color Color) error {
+	if err := validateColor(color); err != nil {
+		return err
+	}
+	p.background = &RectOptions{FillColor: &color}
+	return nil
+}
+
+// SetBackgroundGradient fills the whole page with a gradient.
+//
+// Gradient coordinates are in page space. See SetBackground.
+//
+// Example:
+//
+//	grad := creator.NewLinearGradient(0, 0, 0, page.Height())
+//	grad.AddColorStop(0, creator.White)
+//	grad.AddColorStop(1, creator.LightGray)
+//	page.SetBackgroundGradient(grad)
+func (p *Page) SetBackgroundGradient(gradient *Gradient) error {
+	if gradient == nil {
+		return errors.New("gradient cannot be nil")
+	}
+	if err := gradient.Validate(); err != nil {
+		return err
+	}
+	p.background = &RectOptions{FillGradient: gradient}
+	return nil
+}
+
+// ClearBackground removes the page background.
+func (p *Page) ClearBackground() {
+	p.background = nil
 }
 
 // DrawLine draws a line from (x1,y1) to (x2,y2).
@@ -303,9 +390,15 @@ func (p *Page) GraphicsOperations() []GraphicsOperation {
 //	}
 //	err := page.DrawLine(100, 700, 500, 700, opts)
 func (p *Page) DrawLine(x1, y1, x2, y2 float64, opts *LineOptions) error {
+	return p.drawLine(x1, p.pdfY(y1), x2, p.pdfY(y2), opts)
+}
+
+// drawLine draws a line between points in PDF coordinates.
+func (p *Page) drawLine(x1, y1, x2, y2 float64, opts *LineOptions) error {
 	if opts == nil {
 		return errors.New("line options cannot be nil")
 	}
+	opts = p.defaults.line(opts)
 
 	// Validate color components.
 	if opts.Color.R < 0 || opts.Color.R > 1 || opts.Color.G < 0 || opts.Color.G > 1 || opts.Color.B < 0 || opts.Color.B > 1 {
@@ -335,7 +428,8 @@ func (p *Page) DrawLine(x1, y1, x2, y2 float64, opts *LineOptions) error {
 // The rectangle can be stroked, filled, or both, depending on the options.
 //
 // Parameters:
-//   - x, y: Lower-left corner coordinates
+//   - x, y: Lower-left corner coordinates (top-left corner with
+//     Creator.SetOriginTopLeft)
 //   - width, height: Rectangle dimensions
 //   - opts: Rectangle options (stroke color, fill color, width, dash pattern)
 //
@@ -348,9 +442,15 @@ func (p *Page) DrawLine(x1, y1, x2, y2 float64, opts *LineOptions) error {
 //	}
 //	err := page.DrawRect(100, 600, 200, 100, opts)
 func (p *Page) DrawRect(x, y, width, height float64, opts *RectOptions) error {
+	return p.drawRect(x, p.pdfBoxY(y, height), width, height, opts)
+}
+
+// drawRect draws a rectangle with its lower-left corner at PDF coordinates.
+func (p *Page) drawRect(x, y, width, height float64, opts *RectOptions) error {
 	if opts == nil {
 		return errors.New("rectangle options cannot be nil")
 	}
+	opts = p.defaults.rect(opts)
 
 	// Validate dimensions.
 	if width < 0 || height < 0 {
@@ -422,7 +522,7 @@ func (p *Page) BeginClipRect(x, y, width, height float64) error {
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:   GraphicsOpBeginClip,
 		X:      x,
-		Y:      y,
+		Y:      p.pdfBoxY(y, height),
 		Width:  width,
 		Height: height,
 	})
@@ -442,6 +542,18 @@ func (p *Page) EndClip() error {
 	return nil
 }
 
+// checkGlyphs returns a *MissingGlyphsError if strict fonts are enabled
+// and font cannot draw all of text.
+func (p *Page) checkGlyphs(text string, font *CustomFont) error {
+	if !p.strictFonts {
+		return nil
+	}
+	if missing := font.MissingGlyphs(text); len(missing) > 0 {
+		return &MissingGlyphsError{Font: font.PostScriptName(), Runes: missing}
+	}
+	return nil
+}
+
 // DrawTextClipped draws text that is clipped to a rectangular region.
 //
 // This is useful for table cells where text should not overflow the cell boundary.
@@ -469,15 +581,15 @@ func (p *Page) DrawTextClipped(text string, textX, textY, clipX, clipY, clipW, c
 	if clipW <= 0 || clipH <= 0 {
 		return errors.New("clipping rectangle must have positive dimensions")
 	}
-
-	// Mark characters as used for font subsetting.
-	font.UseString(text)
+	if err := p.checkGlyphs(text, font); err != nil {
+		return err
+	}
 
 	// Add BeginClip operation.
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:   GraphicsOpBeginClip,
 		X:      clipX,
-		Y:      clipY,
+		Y:      p.pdfBoxY(clipY, clipH),
 		Width:  clipW,
 		Height: clipH,
 	})
@@ -486,7 +598,7 @@ func (p *Page) DrawTextClipped(text string, textX, textY, clipX, clipY, clipW, c
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:      GraphicsOpTextBlock,
 		X:         textX,
-		Y:         textY,
+		Y:         p.pdfY(textY),
 		Text:      text,
 		TextFont:  font,
 		TextSize:  fontSize,
@@ -523,6 +635,7 @@ func (p *Page) DrawCircle(cx, cy, radius float64, opts *CircleOptions) error {
 	if opts == nil {
 		return errors.New("circle options cannot be nil")
 	}
+	opts = p.defaults.circle(opts)
 
 	// Validate radius.
 	if radius < 0 {
@@ -538,7 +651,7 @@ func (p *Page) DrawCircle(cx, cy, radius float64, opts *CircleOptions) error {
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:       GraphicsOpCircle,
 		X:          cx,
-		Y:          cy,
+		Y:          p.pdfY(cy),
 		Radius:     radius,
 		CircleOpts: opts,
 	})
@@ -570,13 +683,20 @@ func validateRectOptions(opts *RectOptions) error {
 		}
 	}
 
+	// Validate indexed fill color if provided.
+	if opts.FillColorIndexed != nil {
+		if err := validateIndexedColor(*opts.FillColorIndexed); err != nil {
+			return errors.New("fill " + err.Error())
+		}
+	}
+
 	// Validate stroke width.
 	if opts.StrokeWidth < 0 {
 		return errors.New("stroke width must be non-negative")
 	}
 
 	// At least one of stroke or fill must be set.
-	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillGradient == nil {
+	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillColorIndexed == nil && opts.FillGradient == nil {
 		return errors.New("rectangle must have at least stroke, fill color, or gradient")
 	}
 
@@ -611,13 +731,20 @@ func validateCircleOptions(opts *CircleOptions) error {
 		}
 	}
 
+	// Validate indexed fill color if provided.
+	if opts.FillColorIndexed != nil {
+		if err := validateIndexedColor(*opts.FillColorIndexed); err != nil {
+			return errors.New("fill " + err.Error())
+		}
+	}
+
 	// Validate stroke width.
 	if opts.StrokeWidth < 0 {
 		return errors.New("stroke width must be non-negative")
 	}
 
 	// At least one of stroke or fill must be set.
-	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillGradient == nil {
+	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillColorIndexed == nil && opts.FillGradient == nil {
 		return errors.New("circle must have at least stroke, fill color, or gradient")
 	}
 
@@ -639,7 +766,7 @@ func validateCircleOptions(opts *CircleOptions) error {
 // GetLayoutContext creates a LayoutContext for this page.
 //
 // The context is initialized with the cursor at the top-left of the content area
-// (inside margins).
+// (inside margins). Use LayoutContext for the page's persistent context.
 //
 // Example:
 //
@@ -653,23 +780,63 @@ func (p *Page) GetLayoutContext() *LayoutContext {
 		Margins:    p.margins,
 		CursorX:    p.margins.Left,
 		CursorY:    0, // Top of content area
+		theme:      p.theme,
 	}
 }
 
 // Draw renders a Drawable element on the page.
 //
 // This uses the page's layout context and automatically positions
-// the element. The cursor advances after drawing.
+// the element. The cursor advances after drawing, so consecutive Draw
+// calls flow down the page, separated by the creator's block spacing.
+// Use MoveCursor to position the cursor.
 //
 // Example:
 //
 //	p := NewParagraph("Hello World")
 //	page.Draw(p)
 func (p *Page) Draw(d Drawable) error {
-	ctx := p.GetLayoutContext()
+	ctx := p.LayoutContext()
+	if ctx.CursorY > 0 {
+		ctx.CursorY += p.blockSpacing
+	}
 	return d.Draw(ctx, p)
 }
 
+// LayoutContext returns the page's persistent layout context, the one
+// Draw and MoveCursor use.
+//
+// Unlike GetLayoutContext, which returns a fresh context at the top of the
+// content area, the cursor of this context is kept between calls: drawing
+// with it directly and then calling Draw continues below the content.
+//
+// Example:
+//
+//	ctx := page.LayoutContext()
+//	if !ctx.CanFit(table.Height(ctx)) {
+//	    page, _ = c.NewPage()
+//	}
+//	page.Draw(table)
+func (p *Page) LayoutContext() *LayoutContext {
+	if p.layout == nil {
+		p.layout = p.GetLayoutContext()
+		return p.layout
+	}
+
+	// Keep the cursor; pick up margin and theme changes.
+	p.layout.PageWidth = p.Width()
+	p.layout.PageHeight = p.Height()
+	p.layout.Margins = p.margins
+	p.layout.theme = p.theme
+	return p.layout
+}
+
+// ResetCursor moves the page's layout cursor back to the top-left of the
+// content area.
+func (p *Page) ResetCursor() {
+	p.layout = nil
+}
+
 // DrawAt renders a Drawable element at a specific position.
 //
 // x is measured from the left edge of the page.
@@ -687,18 +854,18 @@ func (p *Page) DrawAt(d Drawable, x, y float64) error {
 
 // MoveCursor moves the page's layout cursor to the specified position.
 //
-// This affects subsequent Draw() calls that use the default layout context.
-// Note: This creates a new context each time, so for multiple sequential
-// draws, use GetLayoutContext() and pass it to Draw() on the Drawable directly.
+// This positions subsequent Draw() calls. DrawAt and contexts from
+// GetLayoutContext are not affected.
 //
 // x is measured from the left edge of the page.
 // y is measured from the top of the content area (below top margin).
+//
+// Example:
+//
+//	page.MoveCursor(page.Margins().Left, 200)
+//	page.Draw(creator.NewParagraph("Starts 200pt below the top margin"))
 func (p *Page) MoveCursor(x, y float64) {
-	// Note: Since we create a new context each time in Draw(),
-	// this method primarily exists for API consistency.
-	// For efficient multi-draw operations, use GetLayoutContext() directly.
-	_ = x
-	_ = y
+	p.LayoutContext().SetCursor(x, y)
 }
 
 // Surface creates a new drawing surface for this page.
@@ -762,7 +929,7 @@ func (p *Page) AddLink(text, url string, x, y float64, font FontName, size float
 //	}
 //	page.AddLinkStyled("Click here", "https://example.com", 100, 700, style)
 func (p *Page) AddLinkStyled(text, url string, x, y float64, style LinkStyle) error {
-	return p.addLinkWithStyle(text, url, -1, false, x, y, style)
+	return p.addLinkWithStyle(text, url, -1, false, x, p.pdfY(y), style)
 }
 
 // AddInternalLink adds a link to another page in the document.
@@ -784,10 +951,11 @@ func (p *Page) AddInternalLink(text string, destPage int, x, y float64, font Fon
 	style := DefaultLinkStyle()
 	style.Font = font
 	style.Size = size
-	return p.addLinkWithStyle(text, "", destPage, true, x, y, style)
+	return p.addLinkWithStyle(text, "", destPage, true, x, p.pdfY(y), style)
 }
 
-// addLinkWithStyle is the internal implementation for adding links.
+// addLinkWithStyle is the internal implementation for adding links at
+// PDF coordinates.
 //
 // This method:
 // 1. Renders the text at the specified position with the given style.
@@ -801,7 +969,7 @@ func (p *Page) addLinkWithStyle(text, url string, destPage int, isInternal bool,
 	}
 
 	// Render the link text with the specified style.
-	if err := p.AddTextColor(text, x, y, style.Font, style.Size, style.Color); err != nil {
+	if err := p.addTextColor(text, x, y, style.Font, style.Size, style.Color); err != nil {
 		return err
 	}
 
@@ -859,7 +1027,7 @@ func (p *Page) drawUnderline(x, y, width float64, style LinkStyle) error {
 		Width: underlineWidth,
 	}
 
-	return p.DrawLine(x, underlineY, x+width, underlineY, lineOpts)
+	return p.drawLine(x, underlineY, x+width, underlineY, lineOpts)
 }
 
 // calculateLinkRect calculates the bounding rectangle for a link.
@@ -896,7 +1064,11 @@ func measureTextWidth(fontName, text string, size float64) float64 {
 //	page.AddTextAnnotation(note)
 func (p *Page) AddTextAnnotation(annotation *TextAnnotation) error {
 	domainAnnot := annotation.toDomain()
-	return p.page.AddTextAnnotation(domainAnnot)
+	if err := p.page.AddTextAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
 }
 
 // AddHighlightAnnotation adds a highlight annotation to the page.
@@ -910,7 +1082,11 @@ func (p *Page) AddTextAnnotation(annotation *TextAnnotation) error {
 //	page.AddHighlightAnnotation(highlight)
 func (p *Page) AddHighlightAnnotation(annotation *HighlightAnnotation) error {
 	domainAnnot := annotation.toDomain()
-	return p.page.AddMarkupAnnotation(domainAnnot)
+	if err := p.page.AddMarkupAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
 }
 
 // AddUnderlineAnnotation adds an underline annotation to the page.
@@ -924,7 +1100,11 @@ func (p *Page) AddHighlightAnnotation(annotation *HighlightAnnotation) error {
 //	page.AddUnderlineAnnotation(underline)
 func (p *Page) AddUnderlineAnnotation(annotation *UnderlineAnnotation) error {
 	domainAnnot := annotation.toDomain()
-	return p.page.AddMarkupAnnotation(domainAnnot)
+	if err := p.page.AddMarkupAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
 }
 
 // AddStrikeOutAnnotation adds a strikeout annotation to the page.
@@ -938,7 +1118,11 @@ func (p *Page) AddUnderlineAnnotation(annotation *UnderlineAnnotation) error {
 //	page.AddStrikeOutAnnotation(strikeout)
 func (p *Page) AddStrikeOutAnnotation(annotation *StrikeOutAnnotation) error {
 	domainAnnot := annotation.toDomain()
-	return p.page.AddMarkupAnnotation(domainAnnot)
+	if err := p.page.AddMarkupAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
 }
 
 // AddStampAnnotation adds a stamp annotation to the page.
@@ -952,7 +1136,89 @@ func (p *Page) AddStrikeOutAnnotation(annotation *StrikeOutAnnotation) error {
 //	page.AddStampAnnotation(stamp)
 func (p *Page) AddStampAnnotation(annotation *StampAnnotation) error {
 	domainAnnot := annotation.toDomain()
-	return p.page.AddStampAnnotation(domainAnnot)
+	if err := p.page.AddStampAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
+}
+
+// AddFreeTextAnnotation adds a free text annotation (text box) to the page.
+//
+// Example:
+//
+//	box := creator.NewFreeTextAnnotation(creator.Rect{X: 100, Y: 600, Width: 200, Height: 50}, "Callout")
+//	box.SetBackground(creator.Yellow)
+//	page.AddFreeTextAnnotation(box)
+func (p *Page) AddFreeTextAnnotation(annotation *FreeTextAnnotation) error {
+	domainAnnot := annotation.toDomain()
+	if err := p.page.AddFreeTextAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
+}
+
+// AddLineAnnotation adds a line annotation to the page.
+//
+// Example:
+//
+//	line := creator.NewLineAnnotation(100, 600, 300, 650)
+//	line.SetColor(creator.Red)
+//	page.AddLineAnnotation(line)
+func (p *Page) AddLineAnnotation(annotation *LineAnnotation) error {
+	domainAnnot := annotation.toDomain()
+	if err := p.page.AddShapeAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
+}
+
+// AddSquareAnnotation adds a rectangle annotation to the page.
+//
+// Example:
+//
+//	square := creator.NewSquareAnnotation(creator.Rect{X: 100, Y: 600, Width: 200, Height: 50})
+//	square.SetFillColor(creator.Yellow)
+//	page.AddSquareAnnotation(square)
+func (p *Page) AddSquareAnnotation(annotation *SquareAnnotation) error {
+	domainAnnot := annotation.toDomain()
+	if err := p.page.AddShapeAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
+}
+
+// AddCircleAnnotation adds an ellipse annotation to the page.
+//
+// Example:
+//
+//	circle := creator.NewCircleAnnotation(creator.Rect{X: 100, Y: 600, Width: 80, Height: 80})
+//	page.AddCircleAnnotation(circle)
+func (p *Page) AddCircleAnnotation(annotation *CircleAnnotation) error {
+	domainAnnot := annotation.toDomain()
+	if err := p.page.AddShapeAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
+}
+
+// AddInkAnnotation adds a freehand (ink) annotation to the page.
+//
+// Example:
+//
+//	ink := creator.NewInkAnnotation([]creator.Point{{X: 100, Y: 600}, {X: 150, Y: 620}})
+//	page.AddInkAnnotation(ink)
+func (p *Page) AddInkAnnotation(annotation *InkAnnotation) error {
+	domainAnnot := annotation.toDomain()
+	if err := p.page.AddShapeAnnotation(domainAnnot); err != nil {
+		return err
+	}
+	p.recordAnnotation(annotation, domainAnnot)
+	return nil
 }
 
 // AddField adds a form field to the page.
@@ -0,0 +1,32 @@
+package creator
+
+// PageBreak is a drawable that forces the document flow onto a new page.
+//
+// Creator.Draw starts a new page when it draws a page break, unless the
+// current page is still empty. Drawn on a page directly (Page.Draw), the
+// break moves the cursor to the bottom of the content area.
+//
+// Example:
+//
+//	c.Draw(creator.NewHeading("Introduction", 1))
+//	c.Draw(creator.NewBody(intro))
+//	c.Draw(creator.NewPageBreak())
+//	c.Draw(creator.NewHeading("Results", 1)) // Starts on a new page
+type PageBreak struct{}
+
+// NewPageBreak creates a page break.
+func NewPageBreak() *PageBreak {
+	return &PageBreak{}
+}
+
+// Height returns 0; the break takes no space of its own.
+func (b *PageBreak) Height(_ *LayoutContext) float64 {
+	return 0
+}
+
+// Draw moves the cursor to the bottom of the content area so that nothing
+// else fits on the page.
+func (b *PageBreak) Draw(ctx *LayoutContext, _ *Page) error {
+	ctx.CursorY += ctx.AvailableHeight()
+	return nil
+}
@@ -0,0 +1,46 @@
+package creator
+
+import "testing"
+
+func TestCreator_Draw_PageBreak(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("One"))
+	if err := c.Draw(NewPageBreak()); err != nil {
+		t.Fatalf("Draw(page break) error = %v", err)
+	}
+	_ = c.Draw(NewParagraph("Two"))
+
+	if c.PageCount() != 2 {
+		t.Fatalf("PageCount() = %d, want 2", c.PageCount())
+	}
+	if ops := c.pages[1].TextOperations(); len(ops) != 1 || ops[0].Text != "Two" {
+		t.Errorf("second page text = %+v, want \"Two\"", ops)
+	}
+}
+
+func TestCreator_Draw_PageBreakOnEmptyPage(t *testing.T) {
+	c := New()
+
+	// Breaks at the top of a page do not add blank pages.
+	_ = c.Draw(NewPageBreak())
+	_ = c.Draw(NewParagraph("One"))
+	_ = c.Draw(NewPageBreak())
+	_ = c.Draw(NewPageBreak())
+
+	if c.PageCount() != 2 {
+		t.Errorf("PageCount() = %d, want 2", c.PageCount())
+	}
+}
+
+func TestPage_Draw_PageBreak(t *testing.T) {
+	page := createTestPage(t)
+
+	_ = page.Draw(NewParagraph("One"))
+	if err := page.Draw(NewPageBreak()); err != nil {
+		t.Fatalf("Draw(page break) error = %v", err)
+	}
+	if h := page.LayoutContext().AvailableHeight(); h > 0 {
+		t.Errorf("AvailableHeight() = %v, want 0", h)
+	}
+}
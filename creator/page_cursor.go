@@ -0,0 +1,116 @@
+package creator
+
+import (
+	"errors"
+	"strings"
+)
+
+// cursorLineSpacing is the line height of Newline as a multiple of the
+// font size, matching the default paragraph line spacing.
+const cursorLineSpacing = 1.2
+
+// textCursor is a page's position for text drawn with Page.Text.
+type textCursor struct {
+	x, y   float64    // Baseline position (PDF coordinates)
+	left   float64    // X that Newline returns to
+	style  *TextStyle // nil = theme body style
+	placed bool       // Position set by MoveTo or the first Text
+}
+
+// MoveTo moves the text cursor to (x, y), the baseline start of the next
+// Text call. Newline returns to x.
+//
+// Coordinates follow the page's origin mode (bottom-left by default, see
+// Creator.SetOriginTopLeft).
+//
+// Example:
+//
+//	page.MoveTo(72, 700)
+//	page.Text("Name: ")
+//	page.Text("Jane Doe")
+//	page.Newline()
+//	page.Text("Email: jane@example.com")
+func (p *Page) MoveTo(x, y float64) {
+	p.cursor.x = x
+	p.cursor.y = p.pdfY(y)
+	p.cursor.left = x
+	p.cursor.placed = true
+}
+
+// Cursor returns the text cursor position in the page's origin mode.
+func (p *Page) Cursor() (x, y float64) {
+	p.placeCursor()
+	return p.cursor.x, p.pdfY(p.cursor.y)
+}
+
+// SetTextStyle sets the font, size and color of text drawn with Text.
+//
+// By default Text uses the theme's body style.
+func (p *Page) SetTextStyle(style TextStyle) error {
+	if style.Size <= 0 {
+		return errors.New("font size must be positive")
+	}
+	if err := validateColor(style.Color); err != nil {
+		return err
+	}
+	p.cursor.style = &style
+	return nil
+}
+
+// Text draws text at the text cursor and advances the cursor past it.
+//
+// Line breaks ("\n") in the text start new lines as Newline does. Text is
+// not wrapped; use a Paragraph for wrapped text.
+//
+// If MoveTo has not been called, the cursor starts at the top-left of the
+// content area, with the first baseline one font size below the top margin.
+func (p *Page) Text(text string) error {
+	p.placeCursor()
+	style := p.cursorStyle()
+
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			p.Newline()
+		}
+		if line == "" {
+			continue
+		}
+		if err := p.addTextColor(line, p.cursor.x, p.cursor.y, style.Font, style.Size, style.Color); err != nil {
+			return err
+		}
+		p.cursor.x += measureTextWidth(string(style.Font), line, style.Size)
+	}
+
+	return nil
+}
+
+// Newline moves the text cursor to the start of the next line: back to
+// the x of the last MoveTo and down by 1.2 times the font size.
+func (p *Page) Newline() {
+	p.placeCursor()
+	p.cursor.x = p.cursor.left
+	p.cursor.y -= p.cursorStyle().Size * cursorLineSpacing
+}
+
+// placeCursor places the text cursor at the top-left of the content area
+// if it has not been placed yet.
+func (p *Page) placeCursor() {
+	if p.cursor.placed {
+		return
+	}
+	p.cursor.x = p.margins.Left
+	p.cursor.y = p.Height() - p.margins.Top - p.cursorStyle().Size
+	p.cursor.left = p.margins.Left
+	p.cursor.placed = true
+}
+
+// cursorStyle returns the style of text drawn with Text.
+func (p *Page) cursorStyle() TextStyle {
+	if p.cursor.style != nil {
+		return *p.cursor.style
+	}
+	if p.theme != nil {
+		return p.theme.Body
+	}
+	return DefaultTheme().Body
+}
@@ -0,0 +1,95 @@
+package creator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPage_Text(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.SetTextStyle(TextStyle{Font: Helvetica, Size: 10, Color: Red}); err != nil {
+		t.Fatalf("SetTextStyle failed: %v", err)
+	}
+
+	page.MoveTo(72, 700)
+	if err := page.Text("Name: "); err != nil {
+		t.Fatalf("Text failed: %v", err)
+	}
+	if err := page.Text("Jane"); err != nil {
+		t.Fatalf("Text failed: %v", err)
+	}
+	page.Newline()
+	if err := page.Text("a\nb"); err != nil {
+		t.Fatalf("Text failed: %v", err)
+	}
+
+	ops := page.TextOperations()
+	if len(ops) != 4 {
+		t.Fatalf("got %d text operations, want 4", len(ops))
+	}
+
+	nameWidth := measureTextWidth(string(Helvetica), "Name: ", 10)
+	want := []struct{ x, y float64 }{
+		{72, 700},
+		{72 + nameWidth, 700},
+		{72, 688},
+		{72, 676},
+	}
+	for i, w := range want {
+		if math.Abs(ops[i].X-w.x) > 1e-9 || math.Abs(ops[i].Y-w.y) > 1e-9 {
+			t.Errorf("op %d at (%v, %v), want (%v, %v)", i, ops[i].X, ops[i].Y, w.x, w.y)
+		}
+		if ops[i].Color != Red || ops[i].Size != 10 {
+			t.Errorf("op %d style = %v %v, want red 10pt", i, ops[i].Color, ops[i].Size)
+		}
+	}
+
+	x, y := page.Cursor()
+	if wantX := 72 + measureTextWidth(string(Helvetica), "b", 10); math.Abs(x-wantX) > 1e-9 || math.Abs(y-676) > 1e-9 {
+		t.Errorf("Cursor() = (%v, %v), want (%v, 676)", x, y, wantX)
+	}
+}
+
+func TestPage_Text_DefaultPosition(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.Text("Hello"); err != nil {
+		t.Fatalf("Text failed: %v", err)
+	}
+
+	op := page.TextOperations()[0]
+	body := DefaultTheme().Body
+	wantY := page.Height() - page.Margins().Top - body.Size
+	if op.X != page.Margins().Left || op.Y != wantY {
+		t.Errorf("text at (%v, %v), want (%v, %v)", op.X, op.Y, page.Margins().Left, wantY)
+	}
+	if op.Font != body.Font {
+		t.Errorf("font = %v, want %v", op.Font, body.Font)
+	}
+}
+
+func TestPage_Text_OriginTopLeft(t *testing.T) {
+	c := New()
+	c.SetOriginTopLeft(true)
+	page, _ := c.NewPageWithSize(Letter)
+
+	page.MoveTo(72, 72)
+	if err := page.Text("Title"); err != nil {
+		t.Fatalf("Text failed: %v", err)
+	}
+	if got := page.TextOperations()[0].Y; got != 720 {
+		t.Errorf("text Y = %v, want 720", got)
+	}
+	if _, y := page.Cursor(); y != 72 {
+		t.Errorf("Cursor() y = %v, want 72", y)
+	}
+}
+
+func TestPage_SetTextStyle_Invalid(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.SetTextStyle(TextStyle{Font: Helvetica}); err == nil {
+		t.Error("expected error for zero font size")
+	}
+	if err := page.SetTextStyle(TextStyle{Font: Helvetica, Size: 10, Color: Color{R: 2}}); err == nil {
+		t.Error("expected error for invalid color")
+	}
+}
@@ -0,0 +1,173 @@
+package creator
+
+import "errors"
+
+// shapeDefaults holds a page's default line width and colors for shapes.
+//
+// Options are copied when defaults are applied, so the caller's options
+// are not modified; without defaults they are used as-is.
+type shapeDefaults struct {
+	lineWidth   float64 // 0 = writer default (1.0)
+	strokeColor *Color
+	fillColor   *Color
+}
+
+// SetDefaultLineWidth sets the line width used by subsequent shapes on the
+// page that do not set their own (StrokeWidth or Width of 0).
+//
+// Example:
+//
+//	page.SetDefaultLineWidth(0.75)
+//	page.DrawRect(100, 600, 200, 100, &creator.RectOptions{StrokeColor: &creator.Black}) // 0.75pt border
+func (p *Page) SetDefaultLineWidth(width float64) error {
+	if width <= 0 {
+		return errors.New("default line width must be positive")
+	}
+	p.defaults.lineWidth = width
+	return nil
+}
+
+// SetDefaultStrokeColor sets the stroke color used by subsequent shapes
+// (rectangles, circles, ellipses, polygons and paths) whose options set no
+// stroke or fill color at all.
+//
+// Lines, polylines and Bézier curves always have a color of their own and
+// only use the default line width.
+func (p *Page) SetDefaultStrokeColor(color Color) error {
+	if err := validateColor(color); err != nil {
+		return err
+	}
+	p.defaults.strokeColor = &color
+	return nil
+}
+
+// SetDefaultFillColor sets the fill color used by subsequent shapes
+// (rectangles, circles, ellipses, polygons and paths) whose options set no
+// stroke or fill color at all.
+//
+// Example:
+//
+//	page.SetDefaultStrokeColor(creator.DarkGray)
+//	page.SetDefaultFillColor(creator.LightGray)
+//	page.DrawRect(100, 600, 200, 100, &creator.RectOptions{})                         // Default colors
+//	page.DrawRect(100, 450, 200, 100, &creator.RectOptions{FillColor: &creator.Red}) // Red, no border
+func (p *Page) SetDefaultFillColor(color Color) error {
+	if err := validateColor(color); err != nil {
+		return err
+	}
+	p.defaults.fillColor = &color
+	return nil
+}
+
+// ClearDefaults removes the page's default line width and colors.
+func (p *Page) ClearDefaults() {
+	p.defaults = shapeDefaults{}
+}
+
+// width returns width, or the default line width if width is unset.
+func (d shapeDefaults) width(width float64) float64 {
+	if width == 0 {
+		return d.lineWidth
+	}
+	return width
+}
+
+// rect returns opts with the defaults applied.
+func (d shapeDefaults) rect(opts *RectOptions) *RectOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.StrokeWidth = d.width(o.StrokeWidth)
+	if o.StrokeColor == nil && o.StrokeColorCMYK == nil && o.FillColor == nil &&
+		o.FillColorCMYK == nil && o.FillColorIndexed == nil && o.FillGradient == nil {
+		o.StrokeColor, o.FillColor = d.strokeColor, d.fillColor
+	}
+	return &o
+}
+
+// circle returns opts with the defaults applied.
+func (d shapeDefaults) circle(opts *CircleOptions) *CircleOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.StrokeWidth = d.width(o.StrokeWidth)
+	if o.StrokeColor == nil && o.StrokeColorCMYK == nil && o.FillColor == nil &&
+		o.FillColorCMYK == nil && o.FillColorIndexed == nil && o.FillGradient == nil {
+		o.StrokeColor, o.FillColor = d.strokeColor, d.fillColor
+	}
+	return &o
+}
+
+// ellipse returns opts with the defaults applied.
+func (d shapeDefaults) ellipse(opts *EllipseOptions) *EllipseOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.StrokeWidth = d.width(o.StrokeWidth)
+	if o.StrokeColor == nil && o.StrokeColorCMYK == nil && o.FillColor == nil &&
+		o.FillColorCMYK == nil && o.FillColorIndexed == nil && o.FillGradient == nil {
+		o.StrokeColor, o.FillColor = d.strokeColor, d.fillColor
+	}
+	return &o
+}
+
+// polygon returns opts with the defaults applied.
+func (d shapeDefaults) polygon(opts *PolygonOptions) *PolygonOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.StrokeWidth = d.width(o.StrokeWidth)
+	if o.StrokeColor == nil && o.StrokeColorCMYK == nil && o.FillColor == nil &&
+		o.FillColorCMYK == nil && o.FillColorIndexed == nil && o.FillGradient == nil {
+		o.StrokeColor, o.FillColor = d.strokeColor, d.fillColor
+	}
+	return &o
+}
+
+// path returns opts with the defaults applied.
+func (d shapeDefaults) path(opts *PathOptions) *PathOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.StrokeWidth = d.width(o.StrokeWidth)
+	if o.StrokeColor == nil && o.StrokeColorCMYK == nil && o.FillColor == nil &&
+		o.FillColorCMYK == nil && o.FillColorIndexed == nil {
+		o.StrokeColor, o.FillColor = d.strokeColor, d.fillColor
+	}
+	return &o
+}
+
+// line returns opts with the default line width applied.
+func (d shapeDefaults) line(opts *LineOptions) *LineOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.Width = d.width(o.Width)
+	return &o
+}
+
+// polyline returns opts with the default line width applied.
+func (d shapeDefaults) polyline(opts *PolylineOptions) *PolylineOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.Width = d.width(o.Width)
+	return &o
+}
+
+// bezier returns opts with the default line width applied.
+func (d shapeDefaults) bezier(opts *BezierOptions) *BezierOptions {
+	if d == (shapeDefaults{}) {
+		return opts
+	}
+	o := *opts
+	o.Width = d.width(o.Width)
+	return &o
+}
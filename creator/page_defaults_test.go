@@ -0,0 +1,77 @@
+package creator
+
+import "testing"
+
+func TestPage_DefaultLineWidth(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.SetDefaultLineWidth(0.75); err != nil {
+		t.Fatalf("SetDefaultLineWidth failed: %v", err)
+	}
+
+	rect := &RectOptions{StrokeColor: &Black}
+	if err := page.DrawRect(100, 600, 50, 50, rect); err != nil {
+		t.Fatalf("DrawRect failed: %v", err)
+	}
+	if err := page.DrawRect(100, 500, 50, 50, &RectOptions{StrokeColor: &Black, StrokeWidth: 2}); err != nil {
+		t.Fatalf("DrawRect failed: %v", err)
+	}
+	if err := page.DrawLine(100, 400, 200, 400, &LineOptions{Color: Black}); err != nil {
+		t.Fatalf("DrawLine failed: %v", err)
+	}
+
+	ops := page.GraphicsOperations()
+	if got := ops[0].RectOpts.StrokeWidth; got != 0.75 {
+		t.Errorf("rect stroke width = %v, want default 0.75", got)
+	}
+	if got := ops[1].RectOpts.StrokeWidth; got != 2 {
+		t.Errorf("rect stroke width = %v, want own width 2", got)
+	}
+	if got := ops[2].LineOpts.Width; got != 0.75 {
+		t.Errorf("line width = %v, want default 0.75", got)
+	}
+	if rect.StrokeWidth != 0 {
+		t.Error("caller's options were modified")
+	}
+
+	if err := page.SetDefaultLineWidth(0); err == nil {
+		t.Error("expected error for zero default line width")
+	}
+}
+
+func TestPage_DefaultColors(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.SetDefaultStrokeColor(DarkGray); err != nil {
+		t.Fatalf("SetDefaultStrokeColor failed: %v", err)
+	}
+	if err := page.SetDefaultFillColor(LightGray); err != nil {
+		t.Fatalf("SetDefaultFillColor failed: %v", err)
+	}
+
+	// No colors: both defaults apply.
+	if err := page.DrawCircle(300, 600, 20, &CircleOptions{}); err != nil {
+		t.Fatalf("DrawCircle failed: %v", err)
+	}
+	// Own fill color: no default stroke.
+	if err := page.DrawRect(100, 600, 50, 50, &RectOptions{FillColor: &Red}); err != nil {
+		t.Fatalf("DrawRect failed: %v", err)
+	}
+
+	ops := page.GraphicsOperations()
+	circle := ops[0].CircleOpts
+	if circle.StrokeColor == nil || *circle.StrokeColor != DarkGray || circle.FillColor == nil || *circle.FillColor != LightGray {
+		t.Errorf("circle colors = %v, %v, want defaults", circle.StrokeColor, circle.FillColor)
+	}
+	rect := ops[1].RectOpts
+	if rect.StrokeColor != nil || rect.FillColor == nil || *rect.FillColor != Red {
+		t.Errorf("rect colors = %v, %v, want no stroke and red fill", rect.StrokeColor, rect.FillColor)
+	}
+
+	if err := page.SetDefaultFillColor(Color{R: 2}); err == nil {
+		t.Error("expected error for invalid default fill color")
+	}
+
+	page.ClearDefaults()
+	if err := page.DrawCircle(300, 500, 20, &CircleOptions{}); err == nil {
+		t.Error("expected error for circle without colors after ClearDefaults")
+	}
+}
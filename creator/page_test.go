@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/coregx/gxpdf/internal/document"
+	"github.com/coregx/gxpdf/internal/writer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -162,6 +163,53 @@ func TestPage_ContentDimensions(t *testing.T) {
 	assert.Equal(t, 762.0, page.ContentHeight())
 }
 
+func TestPage_SetBackground(t *testing.T) {
+	c := New()
+	page, err := c.NewPage() // A4
+	require.NoError(t, err)
+
+	require.NoError(t, page.DrawLine(10, 10, 100, 100, &LineOptions{Color: Black, Width: 1}))
+	require.NoError(t, page.SetBackground(Blue))
+
+	// Background comes first and covers the full page, even when set last.
+	ops := page.GraphicsOperations()
+	require.Len(t, ops, 2)
+	bg := ops[0]
+	assert.Equal(t, GraphicsOpRect, bg.Type)
+	assert.Equal(t, 0.0, bg.X)
+	assert.Equal(t, 0.0, bg.Y)
+	assert.InDelta(t, 595.0, bg.Width, 1)
+	assert.InDelta(t, 842.0, bg.Height, 1)
+	assert.Equal(t, Blue, *bg.RectOpts.FillColor)
+	assert.Equal(t, GraphicsOpLine, ops[1].Type)
+
+	page.ClearBackground()
+	assert.Len(t, page.GraphicsOperations(), 1)
+
+	assert.Error(t, page.SetBackground(Color{R: 2}))
+}
+
+func TestPage_SetBackgroundGradient(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	grad := NewLinearGradient(0, 0, 0, page.Height())
+	require.NoError(t, grad.AddColorStop(0, White))
+	require.NoError(t, grad.AddColorStop(1, LightGray))
+	require.NoError(t, page.SetBackgroundGradient(grad))
+
+	ops := page.GraphicsOperations()
+	require.Len(t, ops, 1)
+	assert.Same(t, grad, ops[0].RectOpts.FillGradient)
+
+	assert.Error(t, page.SetBackgroundGradient(nil))
+
+	// The background is written into the content stream.
+	_, err = c.Bytes()
+	assert.NoError(t, err)
+}
+
 func TestPageSize_String(t *testing.T) {
 	tests := []struct {
 		size PageSize
@@ -206,3 +254,98 @@ func TestPageSize_ToDomainSize(t *testing.T) {
 		})
 	}
 }
+
+func TestPage_AddText_WinAnsi(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page.AddText("Café señor über", 100, 700, Helvetica, 12))
+	require.NoError(t, page.AddText("abg", 100, 680, Symbol, 12))
+
+	text, _ := c.collectAllPageContents()
+	content, _, err := writer.GenerateContentStream(text[0])
+	require.NoError(t, err)
+
+	// One byte per character in WinAnsiEncoding; symbolic fonts are unchanged.
+	assert.Contains(t, string(content), "(Caf\xe9 se\xf1or \xfcber) Tj")
+	assert.Contains(t, string(content), "(abg) Tj")
+}
+
+func TestPage_Draw_CursorAdvances(t *testing.T) {
+	page := createTestPage(t)
+
+	if err := page.Draw(NewParagraph("First")); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	if err := page.Draw(NewParagraph("Second")); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+
+	ops := page.TextOperations()
+	if len(ops) != 2 {
+		t.Fatalf("got %d text operations, want 2", len(ops))
+	}
+	if ops[1].Y >= ops[0].Y {
+		t.Errorf("second paragraph at y=%v, want below first at y=%v", ops[1].Y, ops[0].Y)
+	}
+}
+
+func TestPage_MoveCursor(t *testing.T) {
+	page := createTestPage(t)
+
+	page.MoveCursor(page.Margins().Left, 200)
+	if err := page.Draw(NewParagraph("Moved")); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+
+	ctx := page.GetLayoutContext()
+	moved := page.TextOperations()[0]
+	if top := ctx.ContentTop() - 200; moved.Y >= top || moved.Y < top-20 {
+		t.Errorf("text Y = %v, want just below %v", moved.Y, top)
+	}
+
+	// DrawAt ignores the page cursor.
+	if err := page.DrawAt(NewParagraph("At"), page.Margins().Left, 0); err != nil {
+		t.Fatalf("DrawAt failed: %v", err)
+	}
+	if at := page.TextOperations()[1]; at.Y <= moved.Y {
+		t.Errorf("DrawAt text at y=%v, want above %v", at.Y, moved.Y)
+	}
+}
+
+func TestPage_LayoutContext(t *testing.T) {
+	page := createTestPage(t)
+
+	ctx := page.LayoutContext()
+	if err := NewParagraph("Direct").Draw(ctx, page); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	if ctx.CursorY == 0 {
+		t.Fatal("cursor did not advance")
+	}
+	if page.LayoutContext() != ctx {
+		t.Error("LayoutContext returned a different context")
+	}
+
+	// Draw continues below the content drawn with the context.
+	if err := page.Draw(NewParagraph("Next")); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	ops := page.TextOperations()
+	if ops[1].Y >= ops[0].Y {
+		t.Errorf("second paragraph at y=%v, want below first at y=%v", ops[1].Y, ops[0].Y)
+	}
+
+	// Margin changes apply to the persistent context.
+	if err := page.SetMargins(10, 20, 30, 40); err != nil {
+		t.Fatalf("SetMargins failed: %v", err)
+	}
+	if got := page.LayoutContext().Margins; got != page.Margins() {
+		t.Errorf("context margins = %+v, want %+v", got, page.Margins())
+	}
+
+	page.ResetCursor()
+	if got := page.LayoutContext().CursorY; got != 0 {
+		t.Errorf("CursorY after ResetCursor = %v, want 0", got)
+	}
+}
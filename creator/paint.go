@@ -16,6 +16,7 @@ import (
 //   - Color (RGB solid color)
 //   - ColorRGBA (RGB with alpha)
 //   - ColorCMYK (CMYK solid color)
+//   - IndexedColor (palette entry of an Indexed color space)
 //   - Gradient (linear/radial gradient)
 //
 // Example:
@@ -34,14 +35,16 @@ var (
 	_ Paint = Color{}
 	_ Paint = ColorRGBA{}
 	_ Paint = ColorCMYK{}
+	_ Paint = IndexedColor{}
 	_ Paint = (*Gradient)(nil)
 )
 
 // isPaint implementations for each paint type.
-func (Color) isPaint()     {}
-func (ColorRGBA) isPaint() {}
-func (ColorCMYK) isPaint() {}
-func (*Gradient) isPaint() {}
+func (Color) isPaint()        {}
+func (ColorRGBA) isPaint()    {}
+func (ColorCMYK) isPaint()    {}
+func (IndexedColor) isPaint() {}
+func (*Gradient) isPaint()    {}
 
 // RGB creates a Color from 8-bit RGB values (0-255).
 //
@@ -24,6 +24,14 @@ type Paragraph struct {
 	color       Color
 	alignment   Alignment
 	lineSpacing float64 // multiplier (1.0 = normal)
+
+	// Pagination control (used when the paragraph is split across pages).
+	keepTogether bool
+	orphans      int // minimum lines left at the bottom of a page
+	widows       int // minimum lines carried to the top of the next page
+
+	// Footnotes referenced at the end of the paragraph.
+	footnotes []*Footnote
 }
 
 // NewParagraph creates a new paragraph with the given text.
@@ -74,6 +82,75 @@ func (p *Paragraph) SetLineSpacing(spacing float64) *Paragraph {
 	return p
 }
 
+// SetKeepTogether prevents the paragraph from being split across pages.
+// When it does not fit in the remaining space, it moves to the next page.
+// A paragraph taller than a whole page is still split.
+// Returns the paragraph for method chaining.
+func (p *Paragraph) SetKeepTogether(keep bool) *Paragraph {
+	p.keepTogether = keep
+	return p
+}
+
+// SetOrphans sets the minimum number of lines that must remain at the
+// bottom of a page when the paragraph is split (orphan control).
+// If fewer lines would fit, the whole paragraph moves to the next page.
+// Values below 1 disable the control.
+// Returns the paragraph for method chaining.
+func (p *Paragraph) SetOrphans(lines int) *Paragraph {
+	p.orphans = lines
+	return p
+}
+
+// SetWidows sets the minimum number of lines that must be carried to the
+// top of the next page when the paragraph is split (widow control).
+// Lines are pulled from the current page as needed.
+// Values below 1 disable the control.
+// Returns the paragraph for method chaining.
+func (p *Paragraph) SetWidows(lines int) *Paragraph {
+	p.widows = lines
+	return p
+}
+
+// AddFootnote attaches a footnote referenced at the end of the paragraph.
+//
+// The marker is appended to the paragraph text and the note is rendered at
+// the bottom of the page where the paragraph ends. An empty marker numbers
+// the footnote automatically (1, 2, 3, ... across the document).
+//
+// Footnotes are laid out by Creator.Draw, which reserves space for them
+// at the page bottom. They are not rendered when drawing with Page.Draw.
+// Returns the paragraph for method chaining.
+//
+// Example:
+//
+//	p := NewParagraph("Revenue grew by 12% in 2025.")
+//	p.AddFootnote("", "Unaudited figures.")
+//	c.Draw(p)
+func (p *Paragraph) AddFootnote(marker, text string) *Paragraph {
+	p.footnotes = append(p.footnotes, &Footnote{Marker: marker, Text: text})
+	return p
+}
+
+// Footnotes returns the footnotes attached to the paragraph.
+func (p *Paragraph) Footnotes() []*Footnote {
+	return p.footnotes
+}
+
+// KeepTogether reports whether the paragraph must not be split across pages.
+func (p *Paragraph) KeepTogether() bool {
+	return p.keepTogether
+}
+
+// Orphans returns the minimum number of lines kept at the bottom of a page.
+func (p *Paragraph) Orphans() int {
+	return p.orphans
+}
+
+// Widows returns the minimum number of lines carried to the next page.
+func (p *Paragraph) Widows() int {
+	return p.widows
+}
+
 // Font returns the current font name.
 func (p *Paragraph) Font() FontName {
 	return p.font
@@ -123,29 +200,131 @@ func (p *Paragraph) Draw(ctx *LayoutContext, page *Page) error {
 	lines := p.wrapText(ctx.AvailableWidth())
 	lineHeight := p.calculateLineHeight()
 
-	for _, line := range lines {
-		x := p.calculateLineX(ctx, line)
+	markers := footnoteMarkers(p.footnotes)
+
+	for i, line := range lines {
+		// Footnote markers follow the last line.
+		last := i == len(lines)-1 && markers != ""
+		extra := 0.0
+		if last {
+			extra = p.markerWidth()
+		}
+
+		x := p.calculateLineX(ctx, line, extra)
 		y := ctx.CurrentPDFY() - p.fontSize // baseline position
 
-		err := page.AddTextColor(line, x, y, p.font, p.fontSize, p.color)
+		err := page.addTextColor(line, x, y, p.font, p.fontSize, p.color)
 		if err != nil {
 			return err
 		}
 
+		if last {
+			markerX := x + fonts.MeasureString(string(p.font), line, p.fontSize)
+			err := page.addTextColor(markers, markerX, y+p.fontSize*footnoteMarkerRise,
+				p.font, p.fontSize*footnoteMarkerScale, p.color)
+			if err != nil {
+				return err
+			}
+		}
+
 		ctx.CursorY += lineHeight
 	}
 
 	return nil
 }
 
+// Split divides the paragraph at a line boundary so that the head fits
+// within the given height. It implements SplittableDrawable.
+//
+// Keep-together, orphan and widow settings are honored: when they cannot
+// be satisfied, head is nil and the whole paragraph moves to the next page.
+func (p *Paragraph) Split(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	return p.split(ctx, height, false)
+}
+
+// splitForced splits the paragraph at the last line that fits, ignoring
+// the keep-together, orphan and widow settings.
+func (p *Paragraph) splitForced(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	return p.split(ctx, height, true)
+}
+
+// split divides the paragraph at a line boundary; force ignores the
+// keep-together, orphan and widow settings.
+func (p *Paragraph) split(ctx *LayoutContext, height float64, force bool) (head, tail Drawable) {
+	lines := p.wrapText(ctx.AvailableWidth())
+	lineHeight := p.calculateLineHeight()
+
+	fit := len(lines)
+	if lineHeight > 0 {
+		fit = int(height / lineHeight)
+	}
+	if fit >= len(lines) {
+		return p, nil
+	}
+
+	if !force {
+		fit = p.splitPoint(len(lines), fit)
+	}
+	if fit <= 0 {
+		return nil, p
+	}
+
+	return p.withLines(lines[:fit], false), p.withLines(lines[fit:], true)
+}
+
+// splitPoint adjusts the number of lines placed on the current page
+// according to the keep-together, orphan and widow settings.
+// Returns 0 if the paragraph should move to the next page as a whole.
+func (p *Paragraph) splitPoint(total, fit int) int {
+	if p.keepTogether {
+		return 0
+	}
+
+	// Widows: pull lines down so the next page gets enough of them.
+	if p.widows > 0 && total-fit < p.widows {
+		fit = total - p.widows
+	}
+
+	// Orphans: don't leave a dangling first line at the bottom.
+	if p.orphans > 0 && fit < p.orphans {
+		return 0
+	}
+
+	return fit
+}
+
+// withLines returns a copy of the paragraph holding only the given lines.
+//
+// Footnote markers are drawn after the last line, so only the part that
+// contains the last line keeps the footnotes.
+func (p *Paragraph) withLines(lines []string, last bool) *Paragraph {
+	part := *p
+	part.text = strings.Join(lines, " ")
+	if !last {
+		part.footnotes = nil
+	}
+	return &part
+}
+
+// markerWidth returns the width of the superscript footnote markers drawn
+// after the last line, or 0 if there are none.
+func (p *Paragraph) markerWidth() float64 {
+	markers := footnoteMarkers(p.footnotes)
+	if markers == "" {
+		return 0
+	}
+	return fonts.MeasureString(string(p.font), markers, p.fontSize*footnoteMarkerScale)
+}
+
 // calculateLineHeight returns the height of one line.
 func (p *Paragraph) calculateLineHeight() float64 {
 	return p.fontSize * p.lineSpacing
 }
 
 // calculateLineX calculates the X position for a line based on alignment.
-func (p *Paragraph) calculateLineX(ctx *LayoutContext, line string) float64 {
-	lineWidth := fonts.MeasureString(string(p.font), line, p.fontSize)
+// extra is the width of content drawn after the line (footnote markers).
+func (p *Paragraph) calculateLineX(ctx *LayoutContext, line string, extra float64) float64 {
+	lineWidth := fonts.MeasureString(string(p.font), line, p.fontSize) + extra
 	availableWidth := ctx.AvailableWidth()
 
 	switch p.alignment {
@@ -177,8 +356,12 @@ func (p *Paragraph) wrapText(availableWidth float64) []string {
 	var currentLine []string
 	var currentWidth float64
 
-	for _, word := range words {
+	for i, word := range words {
 		wordWidth := fonts.MeasureString(string(p.font), word, p.fontSize)
+		if i == len(words)-1 {
+			// Keep footnote markers on the line of the last word.
+			wordWidth += p.markerWidth()
+		}
 
 		// Check if adding this word exceeds available width.
 		newWidth := currentWidth + wordWidth
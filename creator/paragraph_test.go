@@ -1,6 +1,7 @@
 package creator
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -350,3 +351,65 @@ func TestParagraph_Draw_Alignment_Right(t *testing.T) {
 func TestParagraph_ImplementsDrawable(_ *testing.T) {
 	var _ Drawable = (*Paragraph)(nil)
 }
+
+func TestParagraph_ImplementsSplittableDrawable(_ *testing.T) {
+	var _ SplittableDrawable = (*Paragraph)(nil)
+}
+
+// tenLineParagraph returns a paragraph that wraps to ten lines of 10pt height.
+func tenLineParagraph() *Paragraph {
+	lines := make([]string, 10)
+	for i := range lines {
+		lines[i] = "Line"
+	}
+	// Narrow width forces one word per line.
+	return NewParagraph(strings.Join(lines, " ")).SetFont(Helvetica, 10).SetLineSpacing(1.0)
+}
+
+func narrowContext() *LayoutContext {
+	return &LayoutContext{PageWidth: 40, PageHeight: 200}
+}
+
+func TestParagraph_Split(t *testing.T) {
+	ctx := narrowContext()
+
+	tests := []struct {
+		name     string
+		setup    func(p *Paragraph)
+		height   float64
+		wantHead int // lines in head, 0 = nil
+		wantTail int // lines in tail, 0 = nil
+	}{
+		{"fits", func(*Paragraph) {}, 100, 10, 0},
+		{"plain split", func(*Paragraph) {}, 35, 3, 7},
+		{"single orphan", func(*Paragraph) {}, 15, 1, 9},
+		{"orphan control", func(p *Paragraph) { p.SetOrphans(2) }, 15, 0, 10},
+		{"orphan satisfied", func(p *Paragraph) { p.SetOrphans(2) }, 25, 2, 8},
+		{"widow control", func(p *Paragraph) { p.SetWidows(3) }, 90, 7, 3},
+		{"widow pushes below orphans", func(p *Paragraph) { p.SetWidows(9).SetOrphans(2) }, 50, 0, 10},
+		{"keep together", func(p *Paragraph) { p.SetKeepTogether(true) }, 90, 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tenLineParagraph()
+			tt.setup(p)
+
+			head, tail := p.Split(ctx, tt.height)
+
+			if got := countLines(ctx, head); got != tt.wantHead {
+				t.Errorf("head lines = %d, want %d", got, tt.wantHead)
+			}
+			if got := countLines(ctx, tail); got != tt.wantTail {
+				t.Errorf("tail lines = %d, want %d", got, tt.wantTail)
+			}
+		})
+	}
+}
+
+func countLines(ctx *LayoutContext, d Drawable) int {
+	if d == nil {
+		return 0
+	}
+	return len(d.(*Paragraph).WrapTextLines(ctx.AvailableWidth()))
+}
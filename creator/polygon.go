@@ -33,6 +33,11 @@ type PolygonOptions struct {
 	// Mutually exclusive with FillGradient.
 	FillColorCMYK *ColorCMYK
 
+	// FillColorIndexed is the fill color as a palette entry of an Indexed
+	// color space (nil = no fill).
+	// If set, this takes precedence over FillColorCMYK and FillColor.
+	FillColorIndexed *IndexedColor
+
 	// FillGradient is the gradient fill (nil = no gradient fill).
 	// Mutually exclusive with FillColor and FillColorCMYK.
 	FillGradient *Gradient
@@ -85,6 +90,7 @@ func (p *Page) DrawPolygon(vertices []Point, opts *PolygonOptions) error {
 	if opts == nil {
 		return errors.New("polygon options cannot be nil")
 	}
+	opts = p.defaults.polygon(opts)
 
 	// Validate vertices
 	if len(vertices) < 3 {
@@ -99,7 +105,7 @@ func (p *Page) DrawPolygon(vertices []Point, opts *PolygonOptions) error {
 	// Store graphics operation
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:        GraphicsOpPolygon,
-		Vertices:    vertices,
+		Vertices:    p.pdfPoints(vertices),
 		PolygonOpts: opts,
 	})
 
@@ -122,13 +128,20 @@ func validatePolygonOptions(opts *PolygonOptions) error {
 		}
 	}
 
+	// Validate indexed fill color if provided
+	if opts.FillColorIndexed != nil {
+		if err := validateIndexedColor(*opts.FillColorIndexed); err != nil {
+			return errors.New("fill " + err.Error())
+		}
+	}
+
 	// Validate stroke width
 	if opts.StrokeWidth < 0 {
 		return errors.New("stroke width must be non-negative")
 	}
 
 	// At least one of stroke or fill must be set
-	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillGradient == nil {
+	if opts.StrokeColor == nil && opts.FillColor == nil && opts.FillColorIndexed == nil && opts.FillGradient == nil {
 		return errors.New("polygon must have at least stroke, fill color, or gradient")
 	}
 
@@ -60,6 +60,7 @@ func (p *Page) DrawPolyline(vertices []Point, opts *PolylineOptions) error {
 	if opts == nil {
 		return errors.New("polyline options cannot be nil")
 	}
+	opts = p.defaults.polyline(opts)
 
 	// Validate vertices
 	if len(vertices) < 2 {
@@ -74,7 +75,7 @@ func (p *Page) DrawPolyline(vertices []Point, opts *PolylineOptions) error {
 	// Store graphics operation
 	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
 		Type:         GraphicsOpPolyline,
-		Vertices:     vertices,
+		Vertices:     p.pdfPoints(vertices),
 		PolylineOpts: opts,
 	})
 
@@ -0,0 +1,195 @@
+package creator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Reference is a drawable cross-reference to a figure, table or heading
+// marked with an ID, such as "see Table 3 on page 12".
+//
+// The text is resolved when the document is written, after all content
+// is laid out, so the number and page stay correct when content reflows
+// and the target may come before or after the reference. The reference
+// links to the target's page.
+//
+// The format may contain these placeholders:
+//   - {label}: the target's label ("Figure 3", "Table 1" or the heading text)
+//   - {page}: the target's page number (1-based)
+//
+// References and targets must be drawn with Creator.Draw or added to a
+// chapter. A reference to an unknown ID fails when the document is written.
+//
+// Example:
+//
+//	c.Draw(creator.NewTableFigure(results, "Measurements").SetID("results"))
+//	c.Draw(creator.Ref("results", "The values are listed in {label} on page {page}."))
+type Reference struct {
+	id     string
+	format string
+	style  TextStyle
+
+	// Set when the reference is drawn.
+	page *Page
+	x, y float64 // Baseline start (PDF coordinates)
+}
+
+// referenceTarget is a drawable that can be referenced by ID.
+type referenceTarget interface {
+	// targetID returns the ID of the target ("" = not referenceable).
+	targetID() string
+
+	// targetLabel returns the text for the {label} placeholder.
+	targetLabel() string
+
+	// targetPage returns the page the target was drawn on (nil = not drawn).
+	targetPage() *Page
+}
+
+// DefaultReferenceFormat is the format of references created with an
+// empty format.
+const DefaultReferenceFormat = "{label} on page {page}"
+
+// Ref creates a cross-reference to the target with the given ID.
+//
+// An empty format uses DefaultReferenceFormat. The reference is drawn
+// in Helvetica 12pt, blue; use SetStyle to change it.
+func Ref(id, format string) *Reference {
+	if format == "" {
+		format = DefaultReferenceFormat
+	}
+	return &Reference{
+		id:     id,
+		format: format,
+		style:  TextStyle{Font: Helvetica, Size: 12, Color: Blue},
+	}
+}
+
+// SetStyle sets the reference font, size and color.
+// Returns the reference for method chaining.
+func (r *Reference) SetStyle(style TextStyle) *Reference {
+	r.style = style
+	return r
+}
+
+// ID returns the ID of the referenced target.
+func (r *Reference) ID() string {
+	return r.id
+}
+
+// Height returns the height of one line of the reference.
+func (r *Reference) Height(_ *LayoutContext) float64 {
+	return r.style.Size * 1.2
+}
+
+// Draw reserves a line for the reference at the current cursor position.
+// The text is added when the document is written.
+func (r *Reference) Draw(ctx *LayoutContext, page *Page) error {
+	if r.id == "" {
+		return errors.New("reference ID cannot be empty")
+	}
+	r.page = page
+	r.x = ctx.ContentLeft()
+	r.y = ctx.CurrentPDFY() - r.style.Size
+	ctx.CursorY += r.Height(ctx)
+	return nil
+}
+
+// text returns the reference text for the target.
+func (r *Reference) text(label string, pageNum int) string {
+	return strings.NewReplacer(
+		"{label}", label,
+		"{page}", strconv.Itoa(pageNum),
+	).Replace(r.format)
+}
+
+// SetID sets the ID that references (see Ref) use to refer to the figure.
+// Returns the figure for method chaining.
+func (f *Figure) SetID(id string) *Figure {
+	f.id = id
+	return f
+}
+
+// targetID implements referenceTarget.
+func (f *Figure) targetID() string {
+	return f.id
+}
+
+// targetLabel implements referenceTarget.
+func (f *Figure) targetLabel() string {
+	return f.Label()
+}
+
+// targetPage implements referenceTarget.
+func (f *Figure) targetPage() *Page {
+	return f.page
+}
+
+// SetID sets the ID that references (see Ref) use to refer to the heading.
+// Returns the heading for method chaining.
+func (h *Heading) SetID(id string) *Heading {
+	h.id = id
+	return h
+}
+
+// targetID implements referenceTarget.
+func (h *Heading) targetID() string {
+	return h.id
+}
+
+// targetLabel implements referenceTarget.
+func (h *Heading) targetLabel() string {
+	return h.text
+}
+
+// targetPage implements referenceTarget.
+func (h *Heading) targetPage() *Page {
+	return h.page
+}
+
+// registerReferences records the reference targets and references among
+// drawables drawn in the document.
+func (c *Creator) registerReferences(d Drawable) {
+	switch v := d.(type) {
+	case *Reference:
+		c.references = append(c.references, v)
+	case referenceTarget:
+		if v.targetID() != "" {
+			c.referenceTargets = append(c.referenceTargets, v)
+		}
+	}
+}
+
+// resolveReferences adds the text of the drawn references, linked to their
+// targets' pages.
+func (c *Creator) resolveReferences() error {
+	targets := make(map[string]referenceTarget, len(c.referenceTargets))
+	for _, t := range c.referenceTargets {
+		targets[t.targetID()] = t
+	}
+
+	for _, r := range c.references {
+		if r.page == nil {
+			continue
+		}
+		target, ok := targets[r.id]
+		if !ok {
+			return fmt.Errorf("unresolved reference %q", r.id)
+		}
+		pageIndex := slices.Index(c.pages, target.targetPage())
+		if pageIndex < 0 {
+			return fmt.Errorf("reference %q: target was not drawn", r.id)
+		}
+
+		style := LinkStyle{Font: r.style.Font, Size: r.style.Size, Color: r.style.Color}
+		text := r.text(target.targetLabel(), pageIndex+1)
+		if err := r.page.addLinkWithStyle(text, "", pageIndex, true, r.x, r.y, style); err != nil {
+			return fmt.Errorf("reference %q: %w", r.id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package creator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRef_ForwardReference(t *testing.T) {
+	c := New()
+
+	ref := Ref("results", "see {label} on page {page}")
+	_ = c.Draw(ref)
+	_ = c.Draw(NewPageBreak())
+	_ = c.Draw(NewTableFigure(NewParagraph("Cells"), "Results").SetID("results"))
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+
+	ops := c.pages[0].TextOperations()
+	if len(ops) != 1 || ops[0].Text != "see Table 1 on page 2" {
+		t.Fatalf("reference text = %+v, want %q", ops, "see Table 1 on page 2")
+	}
+	if ops[0].Color != Blue {
+		t.Errorf("reference color = %v, want blue", ops[0].Color)
+	}
+
+	annots := c.pages[0].page.Annotations()
+	if len(annots) != 1 || !annots[0].IsInternal || annots[0].DestPage != 1 {
+		t.Errorf("annotations = %+v, want internal link to page index 1", annots)
+	}
+}
+
+func TestRef_Heading(t *testing.T) {
+	c := New()
+	c.EnableListOfFigures()
+
+	_ = c.Draw(NewHeading("Methods", 1).SetID("methods"))
+	_ = c.Draw(NewFigure(NewParagraph("Chart"), "Growth"))
+	_ = c.Draw(Ref("methods", ""))
+
+	if err := c.renderTOCAndChapters(); err != nil {
+		t.Fatalf("renderTOCAndChapters() error = %v", err)
+	}
+
+	// The list of figures moves the heading to page 2.
+	var found bool
+	for _, op := range c.pages[1].TextOperations() {
+		if op.Text == "Methods on page 2" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("reference text \"Methods on page 2\" not found")
+	}
+}
+
+func TestRef_Unresolved(t *testing.T) {
+	c := New()
+	_ = c.Draw(Ref("missing", ""))
+
+	err := c.renderTOCAndChapters()
+	if err == nil || !strings.Contains(err.Error(), `unresolved reference "missing"`) {
+		t.Errorf("error = %v, want unresolved reference", err)
+	}
+}
+
+func TestRef_EmptyID(t *testing.T) {
+	page := createTestPage(t)
+	if err := page.Draw(Ref("", "")); err == nil {
+		t.Error("expected error for empty reference ID")
+	}
+}
@@ -0,0 +1,70 @@
+package creator
+
+import (
+	"errors"
+
+	"github.com/coregx/gxpdf/internal/document"
+)
+
+// Annotation is implemented by all Creator API annotation types.
+//
+// It is used to refer to an annotation that was added to a page, e.g. as
+// the target of a reply (see Page.AddReply).
+type Annotation interface {
+	isAnnotation()
+}
+
+func (*TextAnnotation) isAnnotation()      {}
+func (*HighlightAnnotation) isAnnotation() {}
+func (*UnderlineAnnotation) isAnnotation() {}
+func (*StrikeOutAnnotation) isAnnotation() {}
+func (*StampAnnotation) isAnnotation()     {}
+func (*FreeTextAnnotation) isAnnotation()  {}
+func (*LineAnnotation) isAnnotation()      {}
+func (*SquareAnnotation) isAnnotation()    {}
+func (*CircleAnnotation) isAnnotation()    {}
+func (*InkAnnotation) isAnnotation()       {}
+
+// AddReply adds a reply to an annotation on this page.
+//
+// The reply is a text annotation linked to its target (/IRT), so viewers
+// show it in the target's comment thread. Replies can be replied to as
+// well, forming a thread.
+//
+// Returns ErrAnnotationNotOnPage if the target was not added to this page.
+//
+// Example:
+//
+//	highlight := creator.NewHighlightAnnotation(100, 650, 300, 670).SetNote("Is this correct?")
+//	page.AddHighlightAnnotation(highlight)
+//	reply, err := page.AddReply(highlight, "Yes, checked against the spec.", "Bob")
+func (p *Page) AddReply(to Annotation, text, author string) (*TextAnnotation, error) {
+	target, ok := p.annotations[to]
+	if !ok {
+		return nil, ErrAnnotationNotOnPage
+	}
+
+	rect := target.Bounds()
+	reply := NewTextAnnotation(rect[0], rect[1], text).SetAuthor(author)
+
+	domainAnnot := reply.toDomain()
+	domainAnnot.InReplyTo = target
+	if err := p.page.AddTextAnnotation(domainAnnot); err != nil {
+		return nil, err
+	}
+	p.recordAnnotation(reply, domainAnnot)
+
+	return reply, nil
+}
+
+// recordAnnotation remembers the domain annotation added for annotation.
+func (p *Page) recordAnnotation(annotation Annotation, domainAnnot document.Annotation) {
+	if p.annotations == nil {
+		p.annotations = make(map[Annotation]document.Annotation)
+	}
+	p.annotations[annotation] = domainAnnot
+}
+
+// ErrAnnotationNotOnPage is returned when replying to an annotation that
+// was not added to the page.
+var ErrAnnotationNotOnPage = errors.New("annotation was not added to this page")
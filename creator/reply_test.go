@@ -0,0 +1,85 @@
+package creator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPage_AddReply(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	highlight := NewHighlightAnnotation(100, 650, 300, 670).SetNote("Is this correct?")
+	require.NoError(t, page.AddHighlightAnnotation(highlight))
+
+	reply, err := page.AddReply(highlight, "Yes, checked.", "Bob")
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", reply.author)
+	assert.Equal(t, 100.0, reply.x)
+	assert.Equal(t, 650.0, reply.y)
+
+	// Replies can be replied to.
+	_, err = page.AddReply(reply, "Thanks!", "Alice")
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.page.AnnotationCount())
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	// Highlight is object 3; replies follow in order.
+	assert.Contains(t, out, "/Subtype /Highlight")
+	assert.Contains(t, out, "/Contents (Yes, checked.) /T (Bob) /C [1.00 1.00 0.00] /Open false /IRT 3 0 R /RT /R >>")
+	assert.Contains(t, out, "/Contents (Thanks!) /T (Alice) /C [1.00 1.00 0.00] /Open false /IRT 4 0 R /RT /R >>")
+	assert.Contains(t, out, "/Annots [3 0 R 4 0 R 5 0 R]")
+}
+
+func TestPage_AddReply_WrittenAfterTarget(t *testing.T) {
+	// Text annotations are normally written before markups; a reply to a
+	// markup must still reference it.
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	require.NoError(t, page.AddTextAnnotation(NewTextAnnotation(50, 700, "General note")))
+	stamp := NewStampAnnotation(300, 700, 100, 50, StampDraft)
+	require.NoError(t, page.AddStampAnnotation(stamp))
+	_, err = page.AddReply(stamp, "Still a draft?", "Carol")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	assert.Contains(t, out, "4 0 obj\n<< /Type /Annot /Subtype /Stamp")
+	assert.Contains(t, out, "/Contents (Still a draft?) /T (Carol) /C [1.00 1.00 0.00] /Open false /IRT 4 0 R /RT /R >>")
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("/IRT")))
+}
+
+func TestPage_AddReply_NotOnPage(t *testing.T) {
+	c := New()
+	page1, err := c.NewPage()
+	require.NoError(t, err)
+	page2, err := c.NewPage()
+	require.NoError(t, err)
+
+	note := NewTextAnnotation(100, 700, "Note")
+	_, err = page1.AddReply(note, "Reply", "Bob")
+	assert.ErrorIs(t, err, ErrAnnotationNotOnPage)
+
+	require.NoError(t, page1.AddTextAnnotation(note))
+	_, err = page2.AddReply(note, "Reply", "Bob")
+	assert.ErrorIs(t, err, ErrAnnotationNotOnPage)
+
+	// A failed add is not recorded.
+	bad := NewSquareAnnotation(Rect{X: 10, Y: 10})
+	require.Error(t, page1.AddSquareAnnotation(bad))
+	_, err = page1.AddReply(bad, "Reply", "Bob")
+	assert.ErrorIs(t, err, ErrAnnotationNotOnPage)
+}
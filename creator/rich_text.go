@@ -0,0 +1,303 @@
+package creator
+
+import "strings"
+
+// StyledRun is a run of text with its own font, size and color, or an
+// inline image. A run with a URL is a hyperlink.
+//
+// A zero Font, Size or Color falls back to the theme's body style when
+// the run is laid out, so Run("text", TextStyle{}) renders as body text.
+type StyledRun struct {
+	// Text is the text content. Newlines force a line break.
+	Text string
+
+	// Style is the styling to apply to this run.
+	Style TextStyle
+
+	// Image is drawn inline instead of text when set (see ImageRun).
+	Image *Image
+
+	// Width and Height are the display size of the image in points.
+	Width  float64
+	Height float64
+
+	// URL makes the run a clickable link (see LinkRun).
+	URL string
+}
+
+// Run creates a styled run.
+func Run(text string, style TextStyle) StyledRun {
+	return StyledRun{Text: text, Style: style}
+}
+
+// ImageRun creates a run that draws an image inline with the text.
+//
+// The image sits on the baseline and reserves its width in the line, so
+// it wraps together with the surrounding text. Like text, it is joined to
+// adjacent runs unless whitespace separates them.
+//
+// A zero height uses the run style size (the body font size by default);
+// a zero width keeps the image's aspect ratio.
+//
+// Example:
+//
+//	check, _ := LoadImage("check.png")
+//	rt := NewRichText(ImageRun(check, 0, 10), Run(" Approved", TextStyle{}))
+func ImageRun(img *Image, width, height float64) StyledRun {
+	return StyledRun{Image: img, Width: width, Height: height}
+}
+
+// LinkRun creates a run of text that links to url.
+//
+// The link rectangle is computed from the laid-out position of the run, so
+// a link can sit mid-paragraph and wrap across lines (one rectangle per
+// line). A zero Color uses the theme's accent color.
+//
+// Example:
+//
+//	rt := NewRichText(
+//	    Run("See the ", TextStyle{}),
+//	    LinkRun("pricing page", "https://example.com/pricing", TextStyle{}),
+//	    Run(" for details.", TextStyle{}),
+//	)
+func LinkRun(text, url string, style TextStyle) StyledRun {
+	return StyledRun{Text: text, Style: style, URL: url}
+}
+
+// imageSize returns the display size of an image run.
+func (r StyledRun) imageSize(style TextStyle) (width, height float64) {
+	width, height = r.Width, r.Height
+	if height <= 0 {
+		height = style.Size
+	}
+	if width <= 0 {
+		width = height
+		if r.Image.Height() > 0 {
+			width = height * float64(r.Image.Width()) / float64(r.Image.Height())
+		}
+	}
+	return width, height
+}
+
+// resolve returns the run style with zero fields taken from base.
+func (r StyledRun) resolve(base TextStyle) TextStyle {
+	style := r.Style
+	if style.Font == "" {
+		style.Font = base.Font
+	}
+	if style.Size <= 0 {
+		style.Size = base.Size
+	}
+	if style.Color == (Color{}) {
+		style.Color = base.Color
+	}
+	return style
+}
+
+// RichText is a paragraph made of styled runs.
+//
+// Each run is measured with its own font and size. Whitespace in the run
+// text decides where lines can break, so runs can join without a space
+// ("**bold**," stays "bold,") and a word may mix several styles. All runs
+// on a line share a common baseline, placed below the tallest run.
+//
+// RichText can be split across pages by Creator.Draw.
+//
+// Example:
+//
+//	rt := NewRichText(
+//	    Run("Sales are ", TextStyle{}),
+//	    Run("up 12%", TextStyle{Font: HelveticaBold, Color: Green}),
+//	    Run(" this quarter.", TextStyle{}),
+//	)
+//	rt.SetAlignment(AlignJustify)
+//	c.Draw(rt)
+type RichText struct {
+	para         StyledParagraph // alignment, line spacing and line layout
+	runs         []StyledRun
+	keepTogether bool
+
+	// continued marks a head part of a split paragraph: its last line is
+	// not the end of the paragraph and is justified like the others.
+	continued bool
+}
+
+// NewRichText creates a rich text paragraph from the given runs.
+//
+// Default settings:
+//   - Alignment: Left
+//   - Line spacing: 1.2 (120%)
+func NewRichText(runs ...StyledRun) *RichText {
+	return &RichText{
+		para: StyledParagraph{alignment: AlignLeft, lineSpacing: 1.2},
+		runs: runs,
+	}
+}
+
+// Add appends a run of text with the given style.
+// Returns the rich text for method chaining.
+func (rt *RichText) Add(text string, style TextStyle) *RichText {
+	return rt.AddRun(Run(text, style))
+}
+
+// AddRun appends a styled run.
+// Returns the rich text for method chaining.
+func (rt *RichText) AddRun(run StyledRun) *RichText {
+	rt.runs = append(rt.runs, run)
+	return rt
+}
+
+// SetAlignment sets the text alignment.
+// Returns the rich text for method chaining.
+func (rt *RichText) SetAlignment(a Alignment) *RichText {
+	rt.para.alignment = a
+	return rt
+}
+
+// SetLineSpacing sets the line spacing multiplier.
+// 1.0 = single spacing, 1.5 = 150% spacing, 2.0 = double spacing.
+// Returns the rich text for method chaining.
+func (rt *RichText) SetLineSpacing(spacing float64) *RichText {
+	rt.para.lineSpacing = spacing
+	return rt
+}
+
+// SetKeepTogether prevents the paragraph from being split across pages.
+// A paragraph taller than a whole page is still split.
+// Returns the rich text for method chaining.
+func (rt *RichText) SetKeepTogether(keep bool) *RichText {
+	rt.keepTogether = keep
+	return rt
+}
+
+// Runs returns the runs of the paragraph.
+func (rt *RichText) Runs() []StyledRun {
+	return rt.runs
+}
+
+// Alignment returns the current text alignment.
+func (rt *RichText) Alignment() Alignment {
+	return rt.para.alignment
+}
+
+// LineSpacing returns the current line spacing multiplier.
+func (rt *RichText) LineSpacing() float64 {
+	return rt.para.lineSpacing
+}
+
+// KeepTogether reports whether the paragraph must not be split across pages.
+func (rt *RichText) KeepTogether() bool {
+	return rt.keepTogether
+}
+
+// Text returns the plain text of all runs.
+func (rt *RichText) Text() string {
+	var sb strings.Builder
+	for _, run := range rt.runs {
+		sb.WriteString(run.Text)
+	}
+	return sb.String()
+}
+
+// Height calculates the total height of the paragraph when rendered.
+func (rt *RichText) Height(ctx *LayoutContext) float64 {
+	return rt.para.linesHeight(rt.layout(ctx))
+}
+
+// Draw renders the paragraph on the page at the current cursor position.
+func (rt *RichText) Draw(ctx *LayoutContext, page *Page) error {
+	return rt.para.drawLines(ctx, page, rt.layout(ctx))
+}
+
+// Split divides the paragraph at a line boundary so that the head fits
+// within the given height. It implements SplittableDrawable.
+func (rt *RichText) Split(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	return rt.split(ctx, height, false)
+}
+
+// splitForced splits the paragraph at the last line that fits, even if
+// it is set to keep together.
+func (rt *RichText) splitForced(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	return rt.split(ctx, height, true)
+}
+
+// split divides the paragraph at a line boundary; force ignores the
+// keep-together setting.
+func (rt *RichText) split(ctx *LayoutContext, height float64, force bool) (head, tail Drawable) {
+	lines := rt.layout(ctx)
+
+	fit := 0
+	var used float64
+	for fit < len(lines) {
+		h := rt.para.calculateLineHeight(lines[fit])
+		if used+h > height {
+			break
+		}
+		used += h
+		fit++
+	}
+
+	if fit >= len(lines) {
+		return rt, nil
+	}
+	if fit == 0 || (rt.keepTogether && !force) {
+		return nil, rt
+	}
+	return rt.withLines(lines[:fit], true), rt.withLines(lines[fit:], rt.continued)
+}
+
+// withLines returns a copy of the paragraph holding only the given lines.
+// Run styles are resolved, so the copy lays out the same on any page.
+func (rt *RichText) withLines(lines []styledLine, continued bool) *RichText {
+	part := *rt
+	part.runs = nil
+	part.continued = continued
+
+	for i, line := range lines {
+		for j, word := range line.words {
+			switch {
+			case j > 0 && word.gap > 0:
+				part.appendText(" ", word.spaceStyle, word.spaceURL)
+			case j == 0 && i > 0 && word.lineBreak:
+				part.appendText("\n", word.spaceStyle, word.spaceURL)
+			case j == 0 && i > 0:
+				part.appendText(" ", word.spaceStyle, word.spaceURL)
+			}
+			for _, piece := range word.pieces {
+				part.appendPiece(piece)
+			}
+		}
+	}
+	return &part
+}
+
+// appendPiece appends a laid-out piece as a run.
+func (rt *RichText) appendPiece(piece styledPiece) {
+	if piece.image != nil {
+		rt.runs = append(rt.runs, StyledRun{Style: piece.style, Image: piece.image, Width: piece.width, Height: piece.height, URL: piece.url})
+		return
+	}
+	rt.appendText(piece.text, piece.style, piece.url)
+}
+
+// appendText appends text, merging it into the last run if the style and
+// link match.
+func (rt *RichText) appendText(text string, style TextStyle, url string) {
+	if n := len(rt.runs); n > 0 {
+		last := &rt.runs[n-1]
+		if last.Image == nil && last.Style == style && last.URL == url {
+			last.Text += text
+			return
+		}
+	}
+	rt.runs = append(rt.runs, StyledRun{Text: text, Style: style, URL: url})
+}
+
+// layout breaks the runs into lines that fit the available width.
+func (rt *RichText) layout(ctx *LayoutContext) []styledLine {
+	lines := rt.para.buildLines(splitRunsIntoWords(rt.runs, ctx.Theme()), ctx.AvailableWidth())
+	if n := len(lines); n > 0 {
+		lines[n-1].last = !rt.continued
+	}
+	return lines
+}
@@ -0,0 +1,254 @@
+package creator
+
+import (
+	"bytes"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+)
+
+func wideContext() *LayoutContext {
+	return &LayoutContext{PageWidth: 612, PageHeight: 792}
+}
+
+// lineTexts returns the text of each laid-out line, with single spaces
+// between words.
+func lineTexts(rt *RichText, ctx *LayoutContext) []string {
+	var out []string
+	for _, line := range rt.layout(ctx) {
+		var sb strings.Builder
+		for i, word := range line.words {
+			if i > 0 && word.gap > 0 {
+				sb.WriteString(" ")
+			}
+			for _, piece := range word.pieces {
+				sb.WriteString(piece.text)
+			}
+		}
+		out = append(out, sb.String())
+	}
+	return out
+}
+
+func TestRichText_JoinsRunsWithoutSpace(t *testing.T) {
+	bold := TextStyle{Font: HelveticaBold}
+	rt := NewRichText(Run("Sales are ", TextStyle{}), Run("up", bold), Run(", again.", TextStyle{}))
+
+	got := lineTexts(rt, wideContext())
+	if len(got) != 1 || got[0] != "Sales are up, again." {
+		t.Errorf("lines = %q, want [\"Sales are up, again.\"]", got)
+	}
+
+	words := splitRunsIntoWords(rt.runs, DefaultTheme())
+	if len(words) != 4 {
+		t.Fatalf("words = %d, want 4", len(words))
+	}
+	if len(words[2].pieces) != 2 || words[2].pieces[0].style.Font != HelveticaBold {
+		t.Errorf("word 3 pieces = %+v, want bold \"up\" + regular \",\"", words[2].pieces)
+	}
+}
+
+func TestRichText_ResolvesStyleFromTheme(t *testing.T) {
+	rt := NewRichText(Run("text", TextStyle{Color: Red}))
+	words := splitRunsIntoWords(rt.runs, DefaultTheme())
+
+	style := words[0].pieces[0].style
+	if style.Font != Helvetica || style.Size != 11 || style.Color != Red {
+		t.Errorf("style = %+v, want Helvetica 11 red", style)
+	}
+}
+
+func TestRichText_WrapsWithPerRunWidths(t *testing.T) {
+	ctx := &LayoutContext{PageWidth: 200, PageHeight: 792}
+	rt := NewRichText(
+		Run("small words here and there ", TextStyle{Size: 8}),
+		Run("LARGE WORDS TAKE MORE ROOM", TextStyle{Font: HelveticaBold, Size: 20}),
+	)
+
+	lines := rt.layout(ctx)
+	if len(lines) < 2 {
+		t.Fatalf("lines = %d, want at least 2", len(lines))
+	}
+	for i, line := range lines {
+		if line.totalWidth > ctx.AvailableWidth() && len(line.words) > 1 {
+			t.Errorf("line %d width %.1f exceeds %.1f", i, line.totalWidth, ctx.AvailableWidth())
+		}
+	}
+}
+
+func TestRichText_LineHeightUsesTallestRun(t *testing.T) {
+	ctx := wideContext()
+	small := NewRichText(Run("a b", TextStyle{Size: 10})).SetLineSpacing(1)
+	mixed := NewRichText(Run("a ", TextStyle{Size: 10}), Run("b", TextStyle{Size: 20})).SetLineSpacing(1)
+
+	if got, want := mixed.Height(ctx), small.Height(ctx)*2; got < want-0.01 || got > want+0.01 {
+		t.Errorf("mixed height = %.2f, want %.2f", got, want)
+	}
+}
+
+func TestRichText_NewlineForcesBreak(t *testing.T) {
+	rt := NewRichText(Run("first\nsecond line", TextStyle{}))
+
+	got := lineTexts(rt, wideContext())
+	if len(got) != 2 || got[0] != "first" || got[1] != "second line" {
+		t.Errorf("lines = %q, want [first, second line]", got)
+	}
+}
+
+func TestRichText_Split(t *testing.T) {
+	ctx := narrowContext()
+	rt := NewRichText(
+		Run("alpha bravo ", TextStyle{Size: 10}),
+		Run("charlie delta", TextStyle{Font: HelveticaBold, Size: 10}),
+	).SetLineSpacing(1)
+
+	lineHeight := rt.Height(ctx) / 4
+	head, tail := rt.Split(ctx, lineHeight*2.5)
+	if head == nil || tail == nil {
+		t.Fatalf("Split() = %v, %v, want both parts", head, tail)
+	}
+
+	headLines := lineTexts(head.(*RichText), ctx)
+	tailLines := lineTexts(tail.(*RichText), ctx)
+	if strings.Join(headLines, "|") != "alpha|bravo" || strings.Join(tailLines, "|") != "charlie|delta" {
+		t.Errorf("head = %q, tail = %q", headLines, tailLines)
+	}
+	if got := tail.(*RichText).Runs()[0].Style.Font; got != HelveticaBold {
+		t.Errorf("tail font = %s, want %s", got, HelveticaBold)
+	}
+
+	if h, tl := rt.Split(ctx, lineHeight*10); h != rt || tl != nil {
+		t.Error("Split() with enough room should return the whole paragraph")
+	}
+
+	rt.SetKeepTogether(true)
+	if h, tl := rt.Split(ctx, lineHeight*2.5); h != nil || tl != rt {
+		t.Error("Split() with keep-together should move the whole paragraph")
+	}
+}
+
+func TestRichText_Draw(t *testing.T) {
+	c := New()
+	rt := NewRichText(Run("Hello ", TextStyle{}), Run("world", TextStyle{Font: HelveticaBold}))
+
+	if err := c.Draw(rt); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+	page := c.pages[0]
+	if len(page.textOps) != 2 {
+		t.Errorf("text ops = %d, want 2", len(page.textOps))
+	}
+	if page.textOps[0].Y != page.textOps[1].Y {
+		t.Errorf("runs not on a common baseline: %.2f vs %.2f", page.textOps[0].Y, page.textOps[1].Y)
+	}
+}
+
+func TestRichText_InlineImage(t *testing.T) {
+	img, err := LoadImageFromReader(bytes.NewReader(createJPEGData(t, 20, 10, color.RGBA{0, 128, 0, 255})))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	c := New()
+	rt := NewRichText(ImageRun(img, 0, 8), Run(" Approved", TextStyle{}))
+	if err := c.Draw(rt); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	page := c.pages[0]
+	if len(page.graphicsOps) != 1 || len(page.textOps) != 1 {
+		t.Fatalf("ops = %d graphics, %d text, want 1 and 1", len(page.graphicsOps), len(page.textOps))
+	}
+
+	op, text := page.graphicsOps[0], page.textOps[0]
+	if op.Type != GraphicsOpImage || op.Width != 16 || op.Height != 8 {
+		t.Errorf("image op = %+v, want 16x8 image", op)
+	}
+	if op.Y != text.Y {
+		t.Errorf("image bottom %.2f, want on baseline %.2f", op.Y, text.Y)
+	}
+	if text.X <= op.X+op.Width {
+		t.Errorf("text x %.2f overlaps image ending at %.2f", text.X, op.X+op.Width)
+	}
+}
+
+func TestRichText_InlineImageWraps(t *testing.T) {
+	img, err := LoadImageFromReader(bytes.NewReader(createJPEGData(t, 10, 10, color.RGBA{0, 0, 0, 255})))
+	if err != nil {
+		t.Fatalf("failed to load test image: %v", err)
+	}
+
+	ctx := narrowContext()
+	rt := NewRichText(Run("alpha ", TextStyle{Size: 10}), ImageRun(img, 30, 30))
+
+	lines := rt.layout(ctx)
+	if len(lines) != 2 {
+		t.Fatalf("lines = %d, want 2", len(lines))
+	}
+	if lines[1].maxAscender != 30 {
+		t.Errorf("image line ascent = %.2f, want 30", lines[1].maxAscender)
+	}
+
+	_, tail := rt.Split(ctx, rt.para.calculateLineHeight(lines[0]))
+	if runs := tail.(*RichText).Runs(); len(runs) != 1 || runs[0].Image != img {
+		t.Errorf("tail runs = %+v, want the image run", runs)
+	}
+}
+
+func TestRichText_LinkRun(t *testing.T) {
+	c := New()
+	rt := NewRichText(
+		Run("See the ", TextStyle{}),
+		LinkRun("pricing page", "https://example.com/pricing", TextStyle{}),
+		Run(" for details.", TextStyle{}),
+	)
+	if err := c.Draw(rt); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	page := c.pages[0]
+	annotations := page.page.Annotations()
+	if len(annotations) != 1 {
+		t.Fatalf("annotations = %d, want 1", len(annotations))
+	}
+	annot := annotations[0]
+	if annot.URI != "https://example.com/pricing" {
+		t.Errorf("URI = %q, want https://example.com/pricing", annot.URI)
+	}
+
+	// The rectangle spans "pricing page", including the space between words.
+	var pricing, page2 TextOperation
+	for _, op := range page.textOps {
+		switch op.Text {
+		case "pricing":
+			pricing = op
+		case "page":
+			page2 = op
+		}
+	}
+	if pricing.Color != DefaultTheme().Accent {
+		t.Errorf("link color = %+v, want theme accent", pricing.Color)
+	}
+	right := page2.X + measureTextWidth(string(Helvetica), "page", 11)
+	if math.Abs(annot.Rect[0]-pricing.X) > 0.01 || math.Abs(annot.Rect[2]-right) > 0.01 {
+		t.Errorf("rect x = [%.2f, %.2f], want [%.2f, %.2f]", annot.Rect[0], annot.Rect[2], pricing.X, right)
+	}
+}
+
+func TestRichText_LinkRunWrapsAcrossLines(t *testing.T) {
+	page, err := New().NewPage()
+	if err != nil {
+		t.Fatalf("failed to create page: %v", err)
+	}
+	ctx := page.GetLayoutContext()
+	ctx.Margins.Right = ctx.PageWidth - ctx.Margins.Left - 40
+
+	rt := NewRichText(LinkRun("alpha bravo", "https://example.com", TextStyle{Size: 10}))
+	if err := rt.Draw(ctx, page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+	if got := len(page.page.Annotations()); got != 2 {
+		t.Errorf("annotations = %d, want 2 (one per line)", got)
+	}
+}
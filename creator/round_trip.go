@@ -0,0 +1,100 @@
+package creator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// RoundTripMismatch is a page whose extracted text differs from the text
+// drawn on it.
+type RoundTripMismatch struct {
+	// Page is the 0-based page index.
+	Page int
+
+	// Want is the text drawn on the page, one text operation per line.
+	Want string
+
+	// Got is the text extracted from the written page, one text element
+	// per line.
+	Got string
+}
+
+// VerifyRoundTrip writes the document, reads it back with the PDF reader
+// and compares the text extracted from each page with the text drawn on it
+// (including headers, footers and DrawTextClipped text). It returns the
+// written PDF and the pages whose text does not match. The PDF is read
+// back from memory; no file is written.
+//
+// Text is compared with whitespace removed, since extraction may split or
+// join text runs differently. Use it in tests of generated documents to
+// catch text that viewers cannot extract, e.g. because of a missing
+// character mapping. The document is written as by WriteTo; do not write
+// it again, save the returned PDF instead.
+//
+// Example:
+//
+//	pdf, mismatches, err := creator.VerifyRoundTrip(c)
+//	if err != nil || len(mismatches) > 0 {
+//	    t.Fatalf("round trip: %v %+v", err, mismatches)
+//	}
+//	_ = os.WriteFile("report.pdf", pdf, 0o644)
+func VerifyRoundTrip(c *Creator) ([]byte, []RoundTripMismatch, error) {
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		return nil, nil, err
+	}
+	pdf := buf.Bytes()
+
+	reader, err := parser.OpenPDFFrom(bytes.NewReader(pdf), int64(len(pdf)))
+	if err != nil {
+		return pdf, nil, fmt.Errorf("failed to read written PDF: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	textContents, graphicsContents := c.collectAllPageContents()
+	var mismatches []RoundTripMismatch
+	for i := range c.pages {
+		// Text blocks (DrawTextClipped) are written with the graphics,
+		// before the text operations.
+		var want []string
+		for _, op := range graphicsContents[i] {
+			if op.Type == int(GraphicsOpTextBlock) {
+				want = append(want, op.Text)
+			}
+		}
+		for _, op := range textContents[i] {
+			want = append(want, op.Text)
+		}
+
+		elements, err := extractor.NewTextExtractor(reader).ExtractFromPage(i)
+		if err != nil {
+			return pdf, nil, fmt.Errorf("failed to extract text from page %d: %w", i+1, err)
+		}
+		got := make([]string, len(elements))
+		for j, elem := range elements {
+			got[j] = elem.Text
+		}
+
+		wantText, gotText := strings.Join(want, "\n"), strings.Join(got, "\n")
+		if stripSpace(wantText) != stripSpace(gotText) {
+			mismatches = append(mismatches, RoundTripMismatch{Page: i, Want: wantText, Got: gotText})
+		}
+	}
+
+	return pdf, mismatches, nil
+}
+
+// stripSpace removes all whitespace from s.
+func stripSpace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,87 @@
+package creator
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestVerifyRoundTrip(t *testing.T) {
+	c := New()
+	c.SetFooterText("Page {page} of {pages}", TextStyle{})
+	_ = c.Draw(NewParagraph("Quarterly report"))
+	table := NewTableLayout(2).AddHeaderRow("Item", "Amount").AddRow("Coffee", "3.50")
+	_ = c.Draw(table)
+	_ = c.Draw(NewPageBreak())
+	_ = c.Draw(NewParagraph("Second page"))
+
+	pdf, mismatches, err := VerifyRoundTrip(c)
+	if err != nil {
+		t.Fatalf("VerifyRoundTrip() error = %v", err)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Error("VerifyRoundTrip() should return the written PDF")
+	}
+	for _, m := range mismatches {
+		t.Errorf("page %d: want %q, got %q", m.Page+1, m.Want, m.Got)
+	}
+}
+
+func TestVerifyRoundTrip_CustomFont(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	if err != nil {
+		t.Fatalf("NewPage() error = %v", err)
+	}
+	if err := page.AddTextCustomFont("ABBA", 100, 700, newTestCustomFont(), 12); err != nil {
+		t.Fatalf("AddTextCustomFont() error = %v", err)
+	}
+
+	_, mismatches, err := VerifyRoundTrip(c)
+	if err != nil {
+		t.Fatalf("VerifyRoundTrip() error = %v", err)
+	}
+	for _, m := range mismatches {
+		t.Errorf("page %d: want %q, got %q", m.Page+1, m.Want, m.Got)
+	}
+}
+
+func TestVerifyRoundTrip_TextBlock(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	if err != nil {
+		t.Fatalf("NewPage() error = %v", err)
+	}
+	if err := page.DrawTextClipped("ABBA", 100, 700, 90, 690, 100, 20, newTestCustomFont(), 12, Black); err != nil {
+		t.Fatalf("DrawTextClipped() error = %v", err)
+	}
+	_ = page.AddText("Total", 100, 650, Helvetica, 12)
+
+	_, mismatches, err := VerifyRoundTrip(c)
+	if err != nil {
+		t.Fatalf("VerifyRoundTrip() error = %v", err)
+	}
+	for _, m := range mismatches {
+		t.Errorf("page %d: want %q, got %q", m.Page+1, m.Want, m.Got)
+	}
+}
+
+func TestVerifyRoundTrip_ReportsMismatch(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	if err != nil {
+		t.Fatalf("NewPage() error = %v", err)
+	}
+	// Standard fonts cannot encode CJK text; it is written as "??".
+	_ = page.AddText("漢字 total", 100, 700, Helvetica, 12)
+
+	_, mismatches, err := VerifyRoundTrip(c)
+	if err != nil {
+		t.Fatalf("VerifyRoundTrip() error = %v", err)
+	}
+	if len(mismatches) != 1 {
+		t.Fatalf("mismatches = %+v, want 1", mismatches)
+	}
+	if m := mismatches[0]; m.Page != 0 || m.Want != "漢字 total" || m.Got != "?? total" {
+		t.Errorf("mismatch = %+v", m)
+	}
+}
@@ -0,0 +1,44 @@
+package creator
+
+// RunningElementFunc draws content repeated on every page, such as a
+// sidebar or a watermark.
+//
+// It receives the page to draw on, the page number (1-based) and the
+// total number of pages. It should draw at fixed positions with the page's
+// drawing methods.
+type RunningElementFunc func(page *Page, pageNum, totalPages int)
+
+// AddRunningElement adds a function that draws content on every page,
+// besides the header and footer.
+//
+// Running elements are drawn when the document is written, over the page
+// content and in the order they were added, so pages added later also get
+// them.
+//
+// Example:
+//
+//	c.AddRunningElement(func(page *creator.Page, pageNum, total int) {
+//		_ = page.DrawRect(0, 0, 24, page.Height(), &creator.RectOptions{FillColor: &creator.LightGray})
+//	})
+func (c *Creator) AddRunningElement(fn RunningElementFunc) {
+	c.runningElements = append(c.runningElements, fn)
+}
+
+// renderRunningElements draws the running elements for a page on a
+// scratch page with the same box and margins, and returns its operations.
+// The page itself is left untouched, so writing the document again does
+// not repeat them.
+func (c *Creator) renderRunningElements(page *Page, pageNum, totalPages int) ([]TextOperation, []GraphicsOperation) {
+	if len(c.runningElements) == 0 {
+		return nil, nil
+	}
+
+	overlay := c.newCreatorPage(page.page)
+	overlay.margins = page.margins
+	overlay.originTopLeft = page.originTopLeft
+	for _, fn := range c.runningElements {
+		fn(overlay, pageNum, totalPages)
+	}
+
+	return overlay.textOps, overlay.graphicsOps
+}
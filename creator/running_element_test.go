@@ -0,0 +1,60 @@
+package creator
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCreator_AddRunningElement(t *testing.T) {
+	c := New()
+	for range 3 {
+		page, err := c.NewPage()
+		if err != nil {
+			t.Fatalf("NewPage() error = %v", err)
+		}
+		_ = page.AddText("Body", 100, 500, Helvetica, 12)
+	}
+
+	c.AddRunningElement(func(page *Page, pageNum, total int) {
+		_ = page.AddText(fmt.Sprintf("Draft %d/%d", pageNum, total), 20, 400, Helvetica, 8)
+	})
+	c.AddRunningElement(func(page *Page, _, _ int) {
+		_ = page.DrawRect(0, 0, 24, page.Height(), &RectOptions{FillColor: &LightGray})
+	})
+
+	for range 2 { // Writing again must not repeat the elements.
+		text, graphics := c.collectAllPageContents()
+		for i := range 3 {
+			ops := text[i]
+			want := fmt.Sprintf("Draft %d/3", i+1)
+			if len(ops) != 2 || ops[0].Text != "Body" || ops[1].Text != want {
+				t.Errorf("page %d text = %+v, want Body then %q", i+1, ops, want)
+			}
+			if len(graphics[i]) != 1 {
+				t.Errorf("page %d graphics ops = %d, want 1", i+1, len(graphics[i]))
+			}
+		}
+	}
+
+	if n := len(c.pages[0].TextOperations()); n != 1 {
+		t.Errorf("page text operations = %d, want 1 (running elements not added to the page)", n)
+	}
+}
+
+func TestCreator_AddRunningElement_OriginTopLeft(t *testing.T) {
+	c := New()
+	c.SetOriginTopLeft(true)
+	page, err := c.NewPage()
+	if err != nil {
+		t.Fatalf("NewPage() error = %v", err)
+	}
+
+	c.AddRunningElement(func(p *Page, _, _ int) {
+		_ = p.AddText("Top", 20, 30, Helvetica, 8)
+	})
+
+	text, _ := c.collectAllPageContents()
+	if y := text[0][0].Y; y != page.Height()-30 {
+		t.Errorf("Y = %v, want %v", y, page.Height()-30)
+	}
+}
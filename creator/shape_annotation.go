@@ -0,0 +1,590 @@
+package creator
+
+import (
+	"math"
+
+	"github.com/coregx/gxpdf/internal/document"
+)
+
+// shapeStyle holds the styling shared by shape annotations.
+type shapeStyle struct {
+	color       Color                    // Border color
+	fill        *Color                   // Fill color (nil = none)
+	borderWidth float64                  // Border width
+	author      string                   // Author name
+	note        string                   // Optional note text
+	flags       document.AnnotationFlags // Annotation flags (/F)
+	popup       *document.Popup          // Pop-up window (nil = none)
+}
+
+// defaultShapeStyle returns the default shape style: red 1pt border, no fill.
+func defaultShapeStyle() shapeStyle {
+	return shapeStyle{
+		color:       Red,
+		borderWidth: 1,
+	}
+}
+
+// toDomain creates a domain shape annotation with this style.
+func (s shapeStyle) toDomain(annotType document.AnnotationType, rect [4]float64) *document.ShapeAnnotation {
+	domainAnnot := document.NewShapeAnnotation(annotType, rect)
+	domainAnnot.SetColor([3]float64{s.color.R, s.color.G, s.color.B})
+	if s.fill != nil {
+		domainAnnot.SetInteriorColor([3]float64{s.fill.R, s.fill.G, s.fill.B})
+	}
+	domainAnnot.SetBorderWidth(s.borderWidth)
+	domainAnnot.SetAuthor(s.author)
+	domainAnnot.SetContents(s.note)
+	domainAnnot.Flags = s.flags
+	domainAnnot.Popup = s.popup
+	return domainAnnot
+}
+
+// boundsRect returns the bounding rectangle of points, padded so that a
+// border of the given width fits inside it.
+func boundsRect(points []Point, borderWidth float64) [4]float64 {
+	if len(points) == 0 {
+		return [4]float64{}
+	}
+
+	rect := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, p := range points {
+		rect[0] = math.Min(rect[0], p.X)
+		rect[1] = math.Min(rect[1], p.Y)
+		rect[2] = math.Max(rect[2], p.X)
+		rect[3] = math.Max(rect[3], p.Y)
+	}
+
+	pad := borderWidth/2 + 1
+	return [4]float64{rect[0] - pad, rect[1] - pad, rect[2] + pad, rect[3] + pad}
+}
+
+// LineAnnotation represents a straight line annotation.
+//
+// Example:
+//
+//	line := creator.NewLineAnnotation(100, 600, 300, 650)
+//	line.SetColor(creator.Red).SetBorderWidth(2)
+//	page.AddLineAnnotation(line)
+type LineAnnotation struct {
+	start Point      // Start point
+	end   Point      // End point
+	style shapeStyle // Border and note
+}
+
+// NewLineAnnotation creates a new line annotation from (x1, y1) to (x2, y2).
+//
+// Example:
+//
+//	line := creator.NewLineAnnotation(100, 600, 300, 650)
+func NewLineAnnotation(x1, y1, x2, y2 float64) *LineAnnotation {
+	return &LineAnnotation{
+		start: Point{X: x1, Y: y1},
+		end:   Point{X: x2, Y: y2},
+		style: defaultShapeStyle(),
+	}
+}
+
+// SetColor sets the border color.
+//
+// Example:
+//
+//	line.SetColor(creator.Blue)
+func (a *LineAnnotation) SetColor(color Color) *LineAnnotation {
+	a.style.color = color
+	return a
+}
+
+// SetBorderWidth sets the border width in points (default: 1).
+//
+// Example:
+//
+//	line.SetBorderWidth(2)
+func (a *LineAnnotation) SetBorderWidth(width float64) *LineAnnotation {
+	a.style.borderWidth = width
+	return a
+}
+
+// SetAuthor sets the author name.
+//
+// Example:
+//
+//	line.SetAuthor("John Doe")
+func (a *LineAnnotation) SetAuthor(author string) *LineAnnotation {
+	a.style.author = author
+	return a
+}
+
+// SetNote sets an optional note text.
+//
+// Example:
+//
+//	line.SetNote("Measure from here")
+func (a *LineAnnotation) SetNote(note string) *LineAnnotation {
+	a.style.note = note
+	return a
+}
+
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	line.SetNote("Measure from here").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *LineAnnotation) SetPopup(open bool, rect Rect) *LineAnnotation {
+	a.style.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	line.SetPrintable(true)
+func (a *LineAnnotation) SetPrintable(printable bool) *LineAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	line.SetHidden(true)
+func (a *LineAnnotation) SetHidden(hidden bool) *LineAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	line.SetReadOnly(true)
+func (a *LineAnnotation) SetReadOnly(readOnly bool) *LineAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	line.SetNoView(true).SetPrintable(true)
+func (a *LineAnnotation) SetNoView(noView bool) *LineAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
+// toDomain converts the Creator API annotation to a domain annotation.
+func (a *LineAnnotation) toDomain() *document.ShapeAnnotation {
+	rect := boundsRect([]Point{a.start, a.end}, a.style.borderWidth)
+	domainAnnot := a.style.toDomain(document.AnnotationTypeLine, rect)
+	domainAnnot.LinePoints = [4]float64{a.start.X, a.start.Y, a.end.X, a.end.Y}
+	return domainAnnot
+}
+
+// SquareAnnotation represents a rectangle annotation.
+//
+// Example:
+//
+//	square := creator.NewSquareAnnotation(creator.Rect{X: 100, Y: 600, Width: 200, Height: 50})
+//	square.SetColor(creator.Red).SetFillColor(creator.Yellow)
+//	page.AddSquareAnnotation(square)
+type SquareAnnotation struct {
+	rect  Rect       // Rectangle (from bottom-left)
+	style shapeStyle // Border, fill and note
+}
+
+// NewSquareAnnotation creates a new rectangle annotation.
+//
+// The border is drawn inside the rectangle.
+//
+// Example:
+//
+//	square := creator.NewSquareAnnotation(creator.Rect{X: 100, Y: 600, Width: 200, Height: 50})
+func NewSquareAnnotation(rect Rect) *SquareAnnotation {
+	return &SquareAnnotation{
+		rect:  rect,
+		style: defaultShapeStyle(),
+	}
+}
+
+// SetColor sets the border color.
+//
+// Example:
+//
+//	square.SetColor(creator.Blue)
+func (a *SquareAnnotation) SetColor(color Color) *SquareAnnotation {
+	a.style.color = color
+	return a
+}
+
+// SetBorderWidth sets the border width in points (default: 1).
+//
+// Example:
+//
+//	square.SetBorderWidth(2)
+func (a *SquareAnnotation) SetBorderWidth(width float64) *SquareAnnotation {
+	a.style.borderWidth = width
+	return a
+}
+
+// SetFillColor sets the interior fill color (default: none).
+//
+// Example:
+//
+//	square.SetFillColor(creator.Yellow)
+func (a *SquareAnnotation) SetFillColor(color Color) *SquareAnnotation {
+	a.style.fill = &color
+	return a
+}
+
+// SetAuthor sets the author name.
+//
+// Example:
+//
+//	square.SetAuthor("John Doe")
+func (a *SquareAnnotation) SetAuthor(author string) *SquareAnnotation {
+	a.style.author = author
+	return a
+}
+
+// SetNote sets an optional note text.
+//
+// Example:
+//
+//	square.SetNote("Wrong table")
+func (a *SquareAnnotation) SetNote(note string) *SquareAnnotation {
+	a.style.note = note
+	return a
+}
+
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	square.SetNote("Wrong table").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *SquareAnnotation) SetPopup(open bool, rect Rect) *SquareAnnotation {
+	a.style.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	square.SetPrintable(true)
+func (a *SquareAnnotation) SetPrintable(printable bool) *SquareAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	square.SetHidden(true)
+func (a *SquareAnnotation) SetHidden(hidden bool) *SquareAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	square.SetReadOnly(true)
+func (a *SquareAnnotation) SetReadOnly(readOnly bool) *SquareAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	square.SetNoView(true).SetPrintable(true)
+func (a *SquareAnnotation) SetNoView(noView bool) *SquareAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
+// toDomain converts the Creator API annotation to a domain annotation.
+func (a *SquareAnnotation) toDomain() *document.ShapeAnnotation {
+	return a.style.toDomain(document.AnnotationTypeSquare, rectToArray(a.rect))
+}
+
+// CircleAnnotation represents an ellipse annotation.
+//
+// Example:
+//
+//	circle := creator.NewCircleAnnotation(creator.Rect{X: 100, Y: 600, Width: 80, Height: 80})
+//	circle.SetColor(creator.Red)
+//	page.AddCircleAnnotation(circle)
+type CircleAnnotation struct {
+	rect  Rect       // Bounding rectangle (from bottom-left)
+	style shapeStyle // Border, fill and note
+}
+
+// NewCircleAnnotation creates a new ellipse annotation inscribed in rect.
+//
+// Example:
+//
+//	circle := creator.NewCircleAnnotation(creator.Rect{X: 100, Y: 600, Width: 80, Height: 80})
+func NewCircleAnnotation(rect Rect) *CircleAnnotation {
+	return &CircleAnnotation{
+		rect:  rect,
+		style: defaultShapeStyle(),
+	}
+}
+
+// SetColor sets the border color.
+//
+// Example:
+//
+//	circle.SetColor(creator.Blue)
+func (a *CircleAnnotation) SetColor(color Color) *CircleAnnotation {
+	a.style.color = color
+	return a
+}
+
+// SetBorderWidth sets the border width in points (default: 1).
+//
+// Example:
+//
+//	circle.SetBorderWidth(2)
+func (a *CircleAnnotation) SetBorderWidth(width float64) *CircleAnnotation {
+	a.style.borderWidth = width
+	return a
+}
+
+// SetFillColor sets the interior fill color (default: none).
+//
+// Example:
+//
+//	circle.SetFillColor(creator.Yellow)
+func (a *CircleAnnotation) SetFillColor(color Color) *CircleAnnotation {
+	a.style.fill = &color
+	return a
+}
+
+// SetAuthor sets the author name.
+//
+// Example:
+//
+//	circle.SetAuthor("John Doe")
+func (a *CircleAnnotation) SetAuthor(author string) *CircleAnnotation {
+	a.style.author = author
+	return a
+}
+
+// SetNote sets an optional note text.
+//
+// Example:
+//
+//	circle.SetNote("Check this area")
+func (a *CircleAnnotation) SetNote(note string) *CircleAnnotation {
+	a.style.note = note
+	return a
+}
+
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	circle.SetNote("Check this area").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *CircleAnnotation) SetPopup(open bool, rect Rect) *CircleAnnotation {
+	a.style.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	circle.SetPrintable(true)
+func (a *CircleAnnotation) SetPrintable(printable bool) *CircleAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	circle.SetHidden(true)
+func (a *CircleAnnotation) SetHidden(hidden bool) *CircleAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	circle.SetReadOnly(true)
+func (a *CircleAnnotation) SetReadOnly(readOnly bool) *CircleAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	circle.SetNoView(true).SetPrintable(true)
+func (a *CircleAnnotation) SetNoView(noView bool) *CircleAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
+// toDomain converts the Creator API annotation to a domain annotation.
+func (a *CircleAnnotation) toDomain() *document.ShapeAnnotation {
+	return a.style.toDomain(document.AnnotationTypeCircle, rectToArray(a.rect))
+}
+
+// InkAnnotation represents a freehand (ink) annotation.
+//
+// Each stroke is a polyline of at least two points.
+//
+// Example:
+//
+//	ink := creator.NewInkAnnotation([]creator.Point{{X: 100, Y: 600}, {X: 120, Y: 620}, {X: 140, Y: 600}})
+//	ink.SetColor(creator.Blue).SetBorderWidth(2)
+//	page.AddInkAnnotation(ink)
+type InkAnnotation struct {
+	strokes [][]Point  // Freehand strokes
+	style   shapeStyle // Border and note
+}
+
+// NewInkAnnotation creates a new ink annotation from one or more strokes.
+//
+// Example:
+//
+//	ink := creator.NewInkAnnotation(
+//	    []creator.Point{{X: 100, Y: 600}, {X: 150, Y: 620}},
+//	    []creator.Point{{X: 100, Y: 620}, {X: 150, Y: 600}},
+//	)
+func NewInkAnnotation(strokes ...[]Point) *InkAnnotation {
+	return &InkAnnotation{
+		strokes: strokes,
+		style:   defaultShapeStyle(),
+	}
+}
+
+// SetColor sets the border color.
+//
+// Example:
+//
+//	ink.SetColor(creator.Blue)
+func (a *InkAnnotation) SetColor(color Color) *InkAnnotation {
+	a.style.color = color
+	return a
+}
+
+// SetBorderWidth sets the border width in points (default: 1).
+//
+// Example:
+//
+//	ink.SetBorderWidth(2)
+func (a *InkAnnotation) SetBorderWidth(width float64) *InkAnnotation {
+	a.style.borderWidth = width
+	return a
+}
+
+// SetAuthor sets the author name.
+//
+// Example:
+//
+//	ink.SetAuthor("John Doe")
+func (a *InkAnnotation) SetAuthor(author string) *InkAnnotation {
+	a.style.author = author
+	return a
+}
+
+// SetNote sets an optional note text.
+//
+// Example:
+//
+//	ink.SetNote("Signed off")
+func (a *InkAnnotation) SetNote(note string) *InkAnnotation {
+	a.style.note = note
+	return a
+}
+
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	ink.SetNote("Signed off").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *InkAnnotation) SetPopup(open bool, rect Rect) *InkAnnotation {
+	a.style.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	ink.SetPrintable(true)
+func (a *InkAnnotation) SetPrintable(printable bool) *InkAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	ink.SetHidden(true)
+func (a *InkAnnotation) SetHidden(hidden bool) *InkAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	ink.SetReadOnly(true)
+func (a *InkAnnotation) SetReadOnly(readOnly bool) *InkAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	ink.SetNoView(true).SetPrintable(true)
+func (a *InkAnnotation) SetNoView(noView bool) *InkAnnotation {
+	a.style.flags = a.style.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
+// toDomain converts the Creator API annotation to a domain annotation.
+func (a *InkAnnotation) toDomain() *document.ShapeAnnotation {
+	var all []Point
+	inkList := make([][]float64, 0, len(a.strokes))
+	for _, stroke := range a.strokes {
+		coords := make([]float64, 0, 2*len(stroke))
+		for _, p := range stroke {
+			coords = append(coords, p.X, p.Y)
+		}
+		inkList = append(inkList, coords)
+		all = append(all, stroke...)
+	}
+
+	domainAnnot := a.style.toDomain(document.AnnotationTypeInk, boundsRect(all, a.style.borderWidth))
+	domainAnnot.InkList = inkList
+	return domainAnnot
+}
+
+// rectToArray converts a Rect to [x1, y1, x2, y2].
+func rectToArray(r Rect) [4]float64 {
+	return [4]float64{r.X, r.Y, r.X + r.Width, r.Y + r.Height}
+}
@@ -0,0 +1,101 @@
+package creator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/document"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineAnnotation(t *testing.T) {
+	line := NewLineAnnotation(100, 600, 300, 650).SetColor(Blue).SetBorderWidth(2).SetNote("Measure")
+	d := line.toDomain()
+
+	assert.Equal(t, document.AnnotationTypeLine, d.Type)
+	assert.Equal(t, [4]float64{100, 600, 300, 650}, d.LinePoints)
+	assert.Equal(t, [4]float64{98, 598, 302, 652}, d.Rect, "rect encloses the line and its width")
+	assert.Equal(t, [3]float64{Blue.R, Blue.G, Blue.B}, d.Color)
+	assert.Equal(t, 2.0, d.BorderWidth)
+	assert.Equal(t, "Measure", d.Contents)
+}
+
+func TestSquareAndCircleAnnotation(t *testing.T) {
+	rect := Rect{X: 100, Y: 600, Width: 200, Height: 50}
+
+	square := NewSquareAnnotation(rect).SetFillColor(Yellow).SetAuthor("Alice").SetPrintable(true).toDomain()
+	assert.Equal(t, document.AnnotationTypeSquare, square.Type)
+	assert.Equal(t, [4]float64{100, 600, 300, 650}, square.Rect)
+	assert.Equal(t, [3]float64{Red.R, Red.G, Red.B}, square.Color, "red border by default")
+	require.NotNil(t, square.InteriorColor)
+	assert.Equal(t, [3]float64{Yellow.R, Yellow.G, Yellow.B}, *square.InteriorColor)
+	assert.Equal(t, "Alice", square.Title)
+	assert.Equal(t, document.AnnotationFlagPrint, square.Flags)
+
+	circle := NewCircleAnnotation(rect).toDomain()
+	assert.Equal(t, document.AnnotationTypeCircle, circle.Type)
+	assert.Nil(t, circle.InteriorColor)
+	assert.Equal(t, 1.0, circle.BorderWidth)
+}
+
+func TestInkAnnotation(t *testing.T) {
+	ink := NewInkAnnotation(
+		[]Point{{X: 100, Y: 600}, {X: 120, Y: 640}, {X: 140, Y: 600}},
+		[]Point{{X: 90, Y: 620}, {X: 150, Y: 620}},
+	).toDomain()
+
+	assert.Equal(t, document.AnnotationTypeInk, ink.Type)
+	assert.Equal(t, [][]float64{{100, 600, 120, 640, 140, 600}, {90, 620, 150, 620}}, ink.InkList)
+	assert.Equal(t, [4]float64{88.5, 598.5, 151.5, 641.5}, ink.Rect)
+}
+
+func TestShapeAnnotation_Invalid(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	assert.Error(t, page.AddInkAnnotation(NewInkAnnotation()), "no strokes")
+	assert.Error(t, page.AddInkAnnotation(NewInkAnnotation([]Point{{X: 1, Y: 1}})), "single point")
+	assert.Error(t, page.AddSquareAnnotation(NewSquareAnnotation(Rect{X: 10, Y: 10})), "empty rect")
+	assert.Error(t, page.AddCircleAnnotation(NewCircleAnnotation(Rect{X: 10, Y: 10, Width: 5, Height: 5}).SetBorderWidth(-1)))
+	assert.Error(t, page.AddLineAnnotation(NewLineAnnotation(0, 0, 10, 10).SetColor(Color{R: 2})))
+	assert.Zero(t, page.page.AnnotationCount())
+}
+
+func TestShapeAnnotation_Output(t *testing.T) {
+	c := New()
+	page, err := c.NewPage()
+	require.NoError(t, err)
+
+	require.NoError(t, page.AddLineAnnotation(NewLineAnnotation(100, 600, 300, 650)))
+	require.NoError(t, page.AddSquareAnnotation(
+		NewSquareAnnotation(Rect{X: 100, Y: 500, Width: 200, Height: 50}).SetFillColor(Yellow).SetBorderWidth(2)))
+	require.NoError(t, page.AddCircleAnnotation(NewCircleAnnotation(Rect{X: 100, Y: 400, Width: 80, Height: 40})))
+	require.NoError(t, page.AddInkAnnotation(NewInkAnnotation([]Point{{X: 100, Y: 300}, {X: 120, Y: 320}, {X: 140, Y: 300}})))
+	assert.Equal(t, 4, page.page.AnnotationCount())
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	// Line.
+	assert.Contains(t, out, "/Subtype /Line /Rect [98.50 598.50 301.50 651.50] /L [100.00 600.00 300.00 650.00]")
+	assert.Contains(t, out, "100.00 600.00 m\n300.00 650.00 l\nS\n")
+
+	// Square: fill and stroke, inset by half the border width.
+	assert.Contains(t, out, "/Subtype /Square /Rect [100.00 500.00 300.00 550.00] /C [1.00 0.00 0.00] /IC [1.00 1.00 0.00] /BS << /W 2.00 /S /S >>")
+	assert.Contains(t, out, "101.00 501.00 198.00 48.00 re\nB\n")
+
+	// Circle: Bézier curves, stroke only.
+	assert.Contains(t, out, "/Subtype /Circle /Rect [100.00 400.00 180.00 440.00]")
+	assert.Contains(t, out, "179.50 420.00 m\n")
+
+	// Ink.
+	assert.Contains(t, out, "/Subtype /Ink /Rect [98.50 298.50 141.50 321.50] /InkList [[100.00 300.00 120.00 320.00 140.00 300.00]]")
+	assert.Contains(t, out, "100.00 300.00 m\n120.00 320.00 l\n140.00 300.00 l\nS\n")
+
+	// Each annotation has an appearance stream.
+	assert.Equal(t, 4, bytes.Count(buf.Bytes(), []byte("/Subtype /Form")))
+}
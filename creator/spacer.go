@@ -0,0 +1,44 @@
+package creator
+
+// Spacer is an empty drawable that adds vertical space to the layout.
+//
+// In the document flow (Creator.Draw) a spacer that does not fit ends at
+// the bottom of the page instead of pushing space onto the next page.
+//
+// Example:
+//
+//	c.Draw(creator.NewParagraph("Above"))
+//	c.Draw(creator.NewSpacer(24))
+//	c.Draw(creator.NewParagraph("24pt further down"))
+type Spacer struct {
+	height float64
+}
+
+// NewSpacer creates a spacer of the given height in points.
+// Negative heights are treated as 0.
+func NewSpacer(height float64) *Spacer {
+	if height < 0 {
+		height = 0
+	}
+	return &Spacer{height: height}
+}
+
+// Height returns the spacer height.
+func (s *Spacer) Height(_ *LayoutContext) float64 {
+	return s.height
+}
+
+// Draw advances the cursor by the spacer height.
+func (s *Spacer) Draw(ctx *LayoutContext, _ *Page) error {
+	ctx.CursorY += s.height
+	return nil
+}
+
+// Split shortens the spacer to the given height. It implements
+// SplittableDrawable; the remaining space is dropped.
+func (s *Spacer) Split(_ *LayoutContext, height float64) (head, tail Drawable) {
+	if height <= 0 {
+		return nil, nil
+	}
+	return NewSpacer(height), nil
+}
@@ -0,0 +1,46 @@
+package creator
+
+import "testing"
+
+func TestSpacer(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("Above"))
+	if err := c.Draw(NewSpacer(24)); err != nil {
+		t.Fatalf("Draw(spacer) error = %v", err)
+	}
+	_ = c.Draw(NewParagraph("Below"))
+
+	ops := c.pages[0].TextOperations()
+	// One 14.4pt line plus the spacer.
+	if got := ops[0].Y - ops[1].Y; got < 38.39 || got > 38.41 {
+		t.Errorf("paragraph distance = %v, want 38.4", got)
+	}
+}
+
+func TestSpacer_EndsAtPageBottom(t *testing.T) {
+	c := New()
+
+	_ = c.Draw(NewParagraph("Above"))
+	if err := c.Draw(NewSpacer(5000)); err != nil {
+		t.Fatalf("Draw(spacer) error = %v", err)
+	}
+	if c.PageCount() != 1 {
+		t.Errorf("PageCount() = %d, want 1", c.PageCount())
+	}
+
+	// The next drawable starts at the top of a new page.
+	_ = c.Draw(NewParagraph("Next"))
+	if c.PageCount() != 2 {
+		t.Fatalf("PageCount() = %d, want 2", c.PageCount())
+	}
+	if ctx := c.FlowContext(); ctx.CursorY > 15 {
+		t.Errorf("CursorY = %v, want one line from the top", ctx.CursorY)
+	}
+}
+
+func TestNewSpacer_Negative(t *testing.T) {
+	if h := NewSpacer(-5).Height(nil); h != 0 {
+		t.Errorf("Height() = %v, want 0", h)
+	}
+}
@@ -15,14 +15,16 @@ import (
 //	stamp.SetAuthor("John Doe")
 //	page.AddStampAnnotation(stamp)
 type StampAnnotation struct {
-	x      float64            // X coordinate (from left)
-	y      float64            // Y coordinate (from bottom)
-	width  float64            // Stamp width
-	height float64            // Stamp height
-	name   document.StampName // Stamp name (Approved, Draft, etc.)
-	color  Color              // Stamp color
-	author string             // Author name
-	note   string             // Optional note text
+	x      float64                  // X coordinate (from left)
+	y      float64                  // Y coordinate (from bottom)
+	width  float64                  // Stamp width
+	height float64                  // Stamp height
+	name   document.StampName       // Stamp name (Approved, Draft, etc.)
+	color  Color                    // Stamp color
+	author string                   // Author name
+	note   string                   // Optional note text
+	flags  document.AnnotationFlags // Annotation flags (/F)
+	popup  *document.Popup          // Pop-up window (nil = none)
 }
 
 // Predefined stamp names (exported for user convenience).
@@ -120,6 +122,57 @@ func (a *StampAnnotation) SetNote(note string) *StampAnnotation {
 	return a
 }
 
+// SetPopup adds a pop-up window that shows the note text, at rect.
+//
+// Example:
+//
+//	stamp.SetNote("Approved on 2025-01-06").SetPopup(true, creator.Rect{X: 320, Y: 600, Width: 180, Height: 80})
+func (a *StampAnnotation) SetPopup(open bool, rect Rect) *StampAnnotation {
+	a.popup = &document.Popup{Rect: rectToArray(rect), Open: open}
+	return a
+}
+
+// SetPrintable sets whether the annotation is printed with the page.
+//
+// Example:
+//
+//	stamp.SetPrintable(true)
+func (a *StampAnnotation) SetPrintable(printable bool) *StampAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagPrint, printable)
+	return a
+}
+
+// SetHidden sets whether the annotation is hidden, both on screen and in print.
+//
+// Example:
+//
+//	stamp.SetHidden(true)
+func (a *StampAnnotation) SetHidden(hidden bool) *StampAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagHidden, hidden)
+	return a
+}
+
+// SetReadOnly sets whether users can interact with the annotation.
+//
+// Example:
+//
+//	stamp.SetReadOnly(true)
+func (a *StampAnnotation) SetReadOnly(readOnly bool) *StampAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagReadOnly, readOnly)
+	return a
+}
+
+// SetNoView sets whether the annotation is hidden on screen. Combined with
+// SetPrintable(true), the annotation appears only in print.
+//
+// Example:
+//
+//	stamp.SetNoView(true).SetPrintable(true)
+func (a *StampAnnotation) SetNoView(noView bool) *StampAnnotation {
+	a.flags = a.flags.Set(document.AnnotationFlagNoView, noView)
+	return a
+}
+
 // toDomain converts the Creator API annotation to a domain annotation.
 func (a *StampAnnotation) toDomain() *document.StampAnnotation {
 	rect := [4]float64{
@@ -133,6 +186,8 @@ func (a *StampAnnotation) toDomain() *document.StampAnnotation {
 	domainAnnot.SetColor([3]float64{a.color.R, a.color.G, a.color.B})
 	domainAnnot.SetAuthor(a.author)
 	domainAnnot.SetContents(a.note)
+	domainAnnot.Flags = a.flags
+	domainAnnot.Popup = a.popup
 
 	return domainAnnot
 }
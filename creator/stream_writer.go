@@ -0,0 +1,191 @@
+package creator
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/coregx/gxpdf/internal/document"
+	"github.com/coregx/gxpdf/internal/writer"
+)
+
+// StreamWriter creates a PDF page by page, writing each page to the output
+// as soon as it is closed.
+//
+// A Creator keeps all pages in memory until the document is written. A
+// StreamWriter keeps only the current page, so memory stays flat for
+// documents with tens of thousands of pages, such as large reports.
+//
+// Pages are drawn on like Creator pages. Compared to a Creator:
+//   - Closed pages cannot be changed, and pages cannot be inserted.
+//   - Header and footer functions get a TotalPages of 0, since the page
+//     count is only known at the end.
+//   - Custom fonts must be loaded with LoadFontOptions.EmbedFull: a font is
+//     written with the first page using it, before the glyphs of later
+//     pages are known.
+//   - TOC, chapters and the document flow are not available.
+//
+// Example:
+//
+//	f, _ := os.Create("report.pdf")
+//	defer f.Close()
+//
+//	sw := creator.NewStreamWriter(f)
+//	sw.SetMetadata("Report", "Finance", "")
+//	for _, row := range rows {
+//	    page, err := sw.NewPage()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    page.AddText(row.Text, 72, 720, creator.Helvetica, 12)
+//	}
+//	err := sw.Close()
+type StreamWriter struct {
+	// Settings and document metadata; holds no pages.
+	c *Creator
+
+	w       *writer.StreamWriter
+	subsets fontSubsets
+	page    *Page // Open page, nil if none
+	closed  bool
+}
+
+// NewStreamWriter creates a stream writer writing a PDF to w.
+//
+// The writer has the defaults of New. Change settings before the first
+// page; Close must be called to complete the PDF.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	c := New()
+	return &StreamWriter{
+		c: c,
+		w: writer.NewStreamWriter(w, c.doc.Version().String()),
+	}
+}
+
+// SetPageSize sets the size of pages added with NewPage.
+func (sw *StreamWriter) SetPageSize(size PageSize) {
+	sw.c.SetPageSize(size)
+}
+
+// SetMargins sets the margins of pages added afterwards.
+func (sw *StreamWriter) SetMargins(top, right, bottom, left float64) error {
+	return sw.c.SetMargins(top, right, bottom, left)
+}
+
+// SetMetadata sets the document title, author and subject.
+func (sw *StreamWriter) SetMetadata(title, author, subject string) {
+	sw.c.SetMetadata(title, author, subject)
+}
+
+// SetKeywords sets the document keywords.
+func (sw *StreamWriter) SetKeywords(keywords ...string) {
+	sw.c.SetKeywords(keywords...)
+}
+
+// SetPrecision sets the number of decimal places written for numbers in
+// page content streams (see Creator.SetPrecision).
+func (sw *StreamWriter) SetPrecision(digits int) {
+	sw.c.SetPrecision(digits)
+}
+
+// SetHeaderFunc sets a function drawing the header of every page (see
+// Creator.SetHeaderFunc). TotalPages is always 0.
+func (sw *StreamWriter) SetHeaderFunc(f HeaderFunc) {
+	sw.c.SetHeaderFunc(f)
+}
+
+// SetFooterFunc sets a function drawing the footer of every page (see
+// Creator.SetFooterFunc). TotalPages is always 0.
+func (sw *StreamWriter) SetFooterFunc(f FooterFunc) {
+	sw.c.SetFooterFunc(f)
+}
+
+// NewPage closes the open page, if any, and adds a page with the default
+// size.
+func (sw *StreamWriter) NewPage() (*Page, error) {
+	return sw.newPage(sw.c.defaultPageSize)
+}
+
+// NewPageWithSize closes the open page, if any, and adds a page with a
+// specific size.
+func (sw *StreamWriter) NewPageWithSize(size PageSize) (*Page, error) {
+	return sw.newPage(size.toDomainSize())
+}
+
+// newPage closes the open page and opens a page of the given size.
+func (sw *StreamWriter) newPage(size document.PageSize) (*Page, error) {
+	if err := sw.ClosePage(); err != nil {
+		return nil, err
+	}
+
+	domainPage := document.NewPage(sw.w.PageCount(), size)
+	sw.page = sw.c.newCreatorPage(domainPage)
+	return sw.page, nil
+}
+
+// ClosePage writes the open page to the output. The page must not be used
+// afterwards. Closing without an open page does nothing.
+func (sw *StreamWriter) ClosePage() error {
+	if sw.closed {
+		return fmt.Errorf("stream writer is closed")
+	}
+	if sw.page == nil {
+		return nil
+	}
+	page := sw.page
+	sw.page = nil
+
+	if sw.subsets == nil {
+		sw.subsets = sw.c.newFontSubsets()
+	}
+	pageNum := sw.w.PageCount() + 1
+	textOps, graphicsOps := sw.c.pageContents(page, pageNum, 0, sw.subsets)
+	if err := checkStreamFonts(textOps, graphicsOps); err != nil {
+		return fmt.Errorf("page %d: %w", pageNum, err)
+	}
+
+	sw.w.SetPrecision(sw.c.precision)
+	if err := sw.w.WritePage(page.page, textOps, graphicsOps); err != nil {
+		return fmt.Errorf("failed to write page %d: %w", pageNum, err)
+	}
+	return nil
+}
+
+// PageCount returns the number of pages added, including the open page.
+func (sw *StreamWriter) PageCount() int {
+	if sw.page != nil {
+		return sw.w.PageCount() + 1
+	}
+	return sw.w.PageCount()
+}
+
+// Close closes the open page and completes the PDF. It does not close the
+// underlying io.Writer.
+//
+// Returns an error if no page was added.
+func (sw *StreamWriter) Close() error {
+	if err := sw.ClosePage(); err != nil {
+		return err
+	}
+	sw.closed = true
+
+	if err := sw.w.Close(sw.c.doc); err != nil {
+		return fmt.Errorf("failed to write PDF: %w", err)
+	}
+	return nil
+}
+
+// checkStreamFonts returns an error if a custom font is embedded as a
+// subset, which cannot be completed once written.
+func checkStreamFonts(textOps []writer.TextOp, graphicsOps []writer.GraphicsOp) error {
+	for _, op := range textOps {
+		if op.CustomFont != nil && !op.CustomFont.Full {
+			return fmt.Errorf("custom font %q must be loaded with EmbedFull for streaming", op.CustomFont.ID)
+		}
+	}
+	for _, op := range graphicsOps {
+		if op.TextFont != nil && !op.TextFont.Full {
+			return fmt.Errorf("custom font %q must be loaded with EmbedFull for streaming", op.TextFont.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package creator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWriter(t *testing.T) {
+	var buf bytes.Buffer
+	sw := NewStreamWriter(&buf)
+	sw.SetMetadata("Report", "Finance", "")
+
+	var footerTotals []int
+	sw.SetFooterFunc(func(args FooterFunctionArgs) {
+		footerTotals = append(footerTotals, args.TotalPages)
+		_ = args.Block.Draw(NewParagraph(fmt.Sprintf("Page %d", args.PageNum)))
+	})
+
+	for i := 1; i <= 3; i++ {
+		page, err := sw.NewPage()
+		require.NoError(t, err)
+		require.NoError(t, page.AddText(fmt.Sprintf("Row %d", i), 72, 720, Helvetica, 12))
+		require.NoError(t, page.DrawLine(72, 710, 500, 710, &LineOptions{Color: Black, Width: 1}))
+		assert.Equal(t, i, sw.PageCount())
+
+		// Each page is written when the next one is added.
+		if i > 1 {
+			assert.Equal(t, i-1, strings.Count(buf.String(), "/Type /Page "))
+		}
+	}
+	require.NoError(t, sw.Close())
+	assert.Equal(t, []int{0, 0, 0}, footerTotals)
+
+	path := filepath.Join(t.TempDir(), "stream.pdf")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	reader, err := parser.OpenPDF(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	count, err := reader.GetPageCount()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	for i := 0; i < 3; i++ {
+		text := pageText(t, reader, i)
+		assert.Contains(t, text, fmt.Sprintf("Row %d", i+1))
+		assert.Contains(t, text, fmt.Sprintf("Page %d", i+1))
+	}
+	assert.Equal(t, "Report", reader.GetDocumentInfo().Title)
+}
+
+func TestStreamWriter_NoPages(t *testing.T) {
+	var buf bytes.Buffer
+	sw := NewStreamWriter(&buf)
+
+	assert.Error(t, sw.Close())
+	assert.Zero(t, buf.Len())
+}
+
+func TestStreamWriter_Closed(t *testing.T) {
+	sw := NewStreamWriter(&bytes.Buffer{})
+	_, err := sw.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, sw.Close())
+
+	_, err = sw.NewPage()
+	assert.Error(t, err)
+}
+
+func TestStreamWriter_SharesStandardFonts(t *testing.T) {
+	var buf bytes.Buffer
+	sw := NewStreamWriter(&buf)
+
+	for i := 1; i <= 3; i++ {
+		page, err := sw.NewPage()
+		require.NoError(t, err)
+		require.NoError(t, page.AddText(fmt.Sprintf("Row %d", i), 72, 720, Helvetica, 12))
+		require.NoError(t, page.AddText("Total", 72, 700, HelveticaBold, 12))
+	}
+	require.NoError(t, sw.Close())
+
+	// One font object per font, referenced by all three pages.
+	out := buf.String()
+	assert.Equal(t, 2, strings.Count(out, "/Type /Font"))
+	assert.Equal(t, 1, strings.Count(out, "/BaseFont /Helvetica-Bold"))
+	assert.Equal(t, 3, strings.Count(out, "/Type /Page "))
+}
@@ -3,6 +3,7 @@ package creator
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/coregx/gxpdf/internal/fonts"
 )
@@ -31,7 +32,8 @@ type TextChunk struct {
 // Unlike a simple Paragraph that has one style for all text,
 // StyledParagraph allows mixing different fonts, sizes, and colors
 // within the same paragraph while maintaining proper text wrapping
-// and alignment.
+// and alignment. RichText builds on the same line layout to add inline
+// images, links and words that mix several styles.
 //
 // Example:
 //
@@ -49,21 +51,6 @@ type StyledParagraph struct {
 	lineSpacing float64
 }
 
-// styledWord represents a word with its style and measured width.
-type styledWord struct {
-	text  string
-	style TextStyle
-	width float64
-}
-
-// styledLine represents a line of styled words with their metrics.
-type styledLine struct {
-	words        []styledWord
-	totalWidth   float64
-	maxAscender  float64 // Maximum ascender in font units
-	maxDescender float64 // Maximum descender in font units (negative)
-}
-
 // NewStyledParagraph creates a new styled paragraph.
 //
 // Default settings:
@@ -111,71 +98,110 @@ func (sp *StyledParagraph) SetLineSpacing(spacing float64) *StyledParagraph {
 
 // Height calculates the total height of the styled paragraph when rendered.
 func (sp *StyledParagraph) Height(ctx *LayoutContext) float64 {
-	if len(sp.chunks) == 0 {
-		return 0
-	}
+	return sp.linesHeight(sp.wrapText(ctx))
+}
 
-	lines := sp.wrapText(ctx.AvailableWidth())
-	if len(lines) == 0 {
-		return 0
-	}
+// Draw renders the styled paragraph on the page at the current cursor position.
+func (sp *StyledParagraph) Draw(ctx *LayoutContext, page *Page) error {
+	return sp.drawLines(ctx, page, sp.wrapText(ctx))
+}
 
-	var totalHeight float64
-	for _, line := range lines {
-		lineHeight := sp.calculateLineHeight(line)
-		totalHeight += lineHeight
+// wrapText breaks the text into lines that fit the available width.
+func (sp *StyledParagraph) wrapText(ctx *LayoutContext) []styledLine {
+	return sp.buildLines(sp.splitChunksIntoWords(ctx.Theme()), ctx.AvailableWidth())
+}
+
+// splitChunksIntoWords splits all text chunks into styled words.
+// Chunks are always separated by a space.
+func (sp *StyledParagraph) splitChunksIntoWords(theme *Theme) []styledWord {
+	runs := make([]StyledRun, 0, len(sp.chunks))
+	for i, chunk := range sp.chunks {
+		text := chunk.Text
+		if i > 0 {
+			text = " " + text
+		}
+		runs = append(runs, Run(text, chunk.Style))
 	}
+	return splitRunsIntoWords(runs, theme)
+}
 
-	return totalHeight
+// styledPiece is a part of a word in a single style, or an inline image.
+type styledPiece struct {
+	text   string
+	style  TextStyle
+	width  float64
+	image  *Image
+	height float64 // image height
+	url    string  // link target
 }
 
-// Draw renders the styled paragraph on the page at the current cursor position.
-func (sp *StyledParagraph) Draw(ctx *LayoutContext, page *Page) error {
-	if len(sp.chunks) == 0 {
-		return nil
-	}
+// styledWord is an unbreakable sequence of pieces.
+type styledWord struct {
+	pieces     []styledPiece
+	width      float64
+	gap        float64   // width of the space before the word (0 if none)
+	spaceStyle TextStyle // style of the space before the word
+	spaceURL   string    // link target of the space before the word
+	lineBreak  bool      // a newline precedes the word
+}
 
-	lines := sp.wrapText(ctx.AvailableWidth())
+// styledLine is a laid-out line of words with its metrics.
+type styledLine struct {
+	words        []styledWord
+	totalWidth   float64 // natural width including spaces between words
+	maxAscender  float64 // maximum ascender in points
+	maxDescender float64 // minimum descender in points (negative)
+	spaces       int     // number of stretchable spaces
+	last         bool    // ends the paragraph or a forced break (not justified)
+}
 
+// linesHeight returns the total height of the given lines.
+func (sp *StyledParagraph) linesHeight(lines []styledLine) float64 {
+	var height float64
+	for _, line := range lines {
+		height += sp.calculateLineHeight(line)
+	}
+	return height
+}
+
+// drawLines renders the given lines, advancing the cursor past each one.
+func (sp *StyledParagraph) drawLines(ctx *LayoutContext, page *Page, lines []styledLine) error {
 	for _, line := range lines {
 		if err := sp.drawLine(ctx, page, line); err != nil {
 			return err
 		}
-
-		lineHeight := sp.calculateLineHeight(line)
-		ctx.CursorY += lineHeight
+		ctx.CursorY += sp.calculateLineHeight(line)
 	}
-
 	return nil
 }
 
-// drawLine renders a single line of styled words.
+// drawLine renders a single line on a common baseline placed below the
+// tallest word.
 func (sp *StyledParagraph) drawLine(ctx *LayoutContext, page *Page, line styledLine) error {
 	x := sp.calculateLineX(ctx, line)
-	y := ctx.CurrentPDFY()
-
-	for _, word := range line.words {
-		// Calculate baseline position for this word.
-		// Use the word's own ascender to position baseline correctly.
-		metrics := fonts.GetMetrics(string(word.style.Font))
-		if metrics == nil {
-			return fmt.Errorf("font metrics not found for font: %s", word.style.Font)
-		}
+	baseline := ctx.CurrentPDFY() - line.maxAscender
 
-		// Baseline Y = current Y - (ascender * size / 1000).
-		ascenderPoints := float64(metrics.GetAscender()) * word.style.Size / 1000.0
-		baselineY := y - ascenderPoints
+	var stretch float64
+	if sp.alignment == AlignJustify && !line.last && line.spaces > 0 {
+		stretch = (ctx.AvailableWidth() - line.totalWidth) / float64(line.spaces)
+	}
 
-		err := page.AddTextColor(word.text, x, baselineY, word.style.Font, word.style.Size, word.style.Color)
-		if err != nil {
-			return fmt.Errorf("failed to add text: %w", err)
+	links := &linkSpans{baseline: baseline}
+	for i, word := range line.words {
+		if i > 0 && word.gap > 0 {
+			x += word.gap + stretch
+		}
+		for _, piece := range word.pieces {
+			if err := piece.draw(page, x, baseline); err != nil {
+				return fmt.Errorf("failed to draw text: %w", err)
+			}
+			if err := links.add(page, piece, x); err != nil {
+				return err
+			}
+			x += piece.width
 		}
-
-		// Advance X by word width.
-		x += word.width
 	}
-
-	return nil
+	return links.flush(page)
 }
 
 // calculateLineHeight calculates the height of a line.
@@ -183,154 +209,205 @@ func (sp *StyledParagraph) drawLine(ctx *LayoutContext, page *Page, line styledL
 func (sp *StyledParagraph) calculateLineHeight(line styledLine) float64 {
 	// Line height = (maxAscender - maxDescender) * lineSpacing.
 	// maxDescender is negative, so this becomes maxAscender + abs(maxDescender).
-	lineHeightPoints := (line.maxAscender - line.maxDescender) * sp.lineSpacing
-	return lineHeightPoints
+	return (line.maxAscender - line.maxDescender) * sp.lineSpacing
 }
 
 // calculateLineX calculates the X position for a line based on alignment.
 func (sp *StyledParagraph) calculateLineX(ctx *LayoutContext, line styledLine) float64 {
-	availableWidth := ctx.AvailableWidth()
-
 	switch sp.alignment {
 	case AlignCenter:
-		return ctx.ContentLeft() + (availableWidth-line.totalWidth)/2
+		return ctx.ContentLeft() + (ctx.AvailableWidth()-line.totalWidth)/2
 	case AlignRight:
 		return ctx.ContentRight() - line.totalWidth
-	case AlignJustify, AlignLeft:
-		return ctx.ContentLeft()
 	default:
 		return ctx.ContentLeft()
 	}
 }
 
-// wrapText breaks the text into lines that fit within the given width.
-func (sp *StyledParagraph) wrapText(availableWidth float64) []styledLine {
-	if len(sp.chunks) == 0 {
-		return []styledLine{}
+// buildLines groups styled words into lines that fit the available width.
+// A newline before a word always starts a new line.
+func (sp *StyledParagraph) buildLines(words []styledWord, availableWidth float64) []styledLine {
+	var lines []styledLine
+	var line styledLine
+	for _, word := range words {
+		if len(line.words) > 0 && (word.lineBreak || line.totalWidth+word.gap+word.width > availableWidth) {
+			line.last = word.lineBreak
+			lines = append(lines, line)
+			line = styledLine{}
+		}
+		line.add(word)
+	}
+	if len(line.words) > 0 {
+		line.last = true
+		lines = append(lines, line)
 	}
+	return lines
+}
 
-	// Split all chunks into styled words.
-	words := sp.splitChunksIntoWords()
-	if len(words) == 0 {
-		return []styledLine{}
+// add appends a word to the line and updates its metrics.
+// The space before the first word of a line is dropped.
+func (l *styledLine) add(word styledWord) {
+	gap := word.gap
+	if len(l.words) == 0 {
+		gap = 0
+	} else if gap > 0 {
+		l.spaces++
 	}
+	l.words = append(l.words, word)
+	l.totalWidth += gap + word.width
 
-	// Build lines by accumulating words.
-	return sp.buildLines(words, availableWidth)
+	for _, piece := range word.pieces {
+		ascent, descent := piece.metrics()
+		l.maxAscender = max(l.maxAscender, ascent)
+		l.maxDescender = min(l.maxDescender, descent)
+	}
 }
 
-// splitChunksIntoWords splits all text chunks into individual styled words.
-func (sp *StyledParagraph) splitChunksIntoWords() []styledWord {
-	var words []styledWord
+// metrics returns the ascender and descender of a piece in points.
+// Images sit on the baseline.
+func (p styledPiece) metrics() (ascent, descent float64) {
+	if p.image != nil {
+		return p.height, 0
+	}
+	return styleMetrics(p.style)
+}
 
-	for _, chunk := range sp.chunks {
-		if chunk.Text == "" {
-			continue
+// draw renders a piece with its left edge at x on the given baseline.
+func (p styledPiece) draw(page *Page, x, baseline float64) error {
+	if p.image != nil {
+		return page.drawImage(p.image, x, baseline, p.width, p.height)
+	}
+	return page.addTextColor(p.text, x, baseline, p.style.Font, p.style.Size, p.style.Color)
+}
+
+// splitRunsIntoWords splits runs into words, breaking only at whitespace,
+// so adjacent runs join into one word unless whitespace separates them.
+// Zero style fields are taken from the theme's body style (accent color
+// for links).
+func splitRunsIntoWords(runs []StyledRun, theme *Theme) []styledWord {
+	var words []styledWord
+	var cur styledWord
+	var space TextStyle
+	var spaceURL string
+	hasSpace, lineBreak := false, false
+
+	flushWord := func() {
+		if len(cur.pieces) > 0 {
+			words = append(words, cur)
+			cur = styledWord{}
 		}
+	}
 
-		// Split chunk into words.
-		chunkWords := strings.Fields(chunk.Text)
+	for _, run := range runs {
+		base := theme.Body
+		if run.URL != "" {
+			base.Color = theme.Accent
+		}
+		style := run.resolve(base)
+		var buf strings.Builder
+
+		addPiece := func(piece styledPiece) {
+			if len(cur.pieces) == 0 {
+				if hasSpace && len(words) > 0 {
+					cur.gap = fonts.MeasureString(string(space.Font), " ", space.Size)
+				}
+				cur.spaceStyle, cur.spaceURL = space, spaceURL
+				cur.lineBreak = lineBreak && len(words) > 0
+				hasSpace, lineBreak = false, false
+			}
+			cur.pieces = append(cur.pieces, piece)
+			cur.width += piece.width
+		}
 
-		// Create styled words.
-		for _, wordText := range chunkWords {
-			width := fonts.MeasureString(string(chunk.Style.Font), wordText, chunk.Style.Size)
+		if run.Image != nil {
+			width, height := run.imageSize(style)
+			addPiece(styledPiece{style: style, width: width, image: run.Image, height: height, url: run.URL})
+			continue
+		}
 
-			// Add space width if not the first word.
-			if len(words) > 0 {
-				spaceWidth := fonts.MeasureString(string(chunk.Style.Font), " ", chunk.Style.Size)
-				width += spaceWidth
-				wordText = " " + wordText
+		flushPiece := func() {
+			if buf.Len() == 0 {
+				return
 			}
+			text := buf.String()
+			width := fonts.MeasureString(string(style.Font), text, style.Size)
+			addPiece(styledPiece{text: text, style: style, width: width, url: run.URL})
+			buf.Reset()
+		}
 
-			words = append(words, styledWord{
-				text:  wordText,
-				style: chunk.Style,
-				width: width,
-			})
+		for _, r := range run.Text {
+			switch {
+			case r == '\n':
+				flushPiece()
+				flushWord()
+				lineBreak, hasSpace, space, spaceURL = true, false, style, run.URL
+			case unicode.IsSpace(r):
+				flushPiece()
+				flushWord()
+				if !lineBreak {
+					hasSpace, space, spaceURL = true, style, run.URL
+				}
+			default:
+				buf.WriteRune(r)
+			}
 		}
+		flushPiece()
 	}
+	flushWord()
 
 	return words
 }
 
-// buildLines groups styled words into lines that fit the available width.
-func (sp *StyledParagraph) buildLines(words []styledWord, availableWidth float64) []styledLine {
-	var lines []styledLine
-	var currentLine styledLine
-
-	for i, word := range words {
-		// Check if adding this word exceeds available width.
-		newWidth := currentLine.totalWidth + word.width
-
-		if newWidth > availableWidth && len(currentLine.words) > 0 {
-			// Finalize current line and start a new one.
-			lines = append(lines, currentLine)
-
-			// Start new line with this word.
-			// If it's not the first word overall, remove leading space.
-			wordText := word.text
-			if i > 0 && strings.HasPrefix(wordText, " ") {
-				wordText = strings.TrimPrefix(wordText, " ")
-				// Recalculate width without leading space.
-				word.width = fonts.MeasureString(string(word.style.Font), wordText, word.style.Size)
-				word.text = wordText
-			}
-
-			currentLine = sp.createLine(word)
-		} else {
-			// Add to current line.
-			sp.addWordToLine(&currentLine, word)
-		}
-	}
-
-	// Add the last line.
-	if len(currentLine.words) > 0 {
-		lines = append(lines, currentLine)
+// styleMetrics returns the ascender and descender of a style in points.
+func styleMetrics(style TextStyle) (ascent, descent float64) {
+	metrics := fonts.GetMetrics(string(style.Font))
+	if metrics == nil {
+		return style.Size * 0.75, -style.Size * 0.25 // Approximate.
 	}
+	ascent = float64(metrics.GetAscender()) * style.Size / 1000.0
+	descent = float64(metrics.GetDescender()) * style.Size / 1000.0
+	return ascent, descent
+}
 
-	return lines
+// linkSpans merges adjacent pieces with the same link target on a line
+// into a single link annotation.
+type linkSpans struct {
+	baseline float64
+	url      string
+	left     float64
+	right    float64
+	size     float64
 }
 
-// createLine creates a new line with the given word.
-func (sp *StyledParagraph) createLine(word styledWord) styledLine {
-	metrics := fonts.GetMetrics(string(word.style.Font))
-	if metrics == nil {
-		// Fallback to default metrics if not found.
-		return styledLine{
-			words:        []styledWord{word},
-			totalWidth:   word.width,
-			maxAscender:  word.style.Size * 0.75,  // Approximate.
-			maxDescender: -word.style.Size * 0.25, // Approximate.
+// add extends the current span with a piece drawn at x, or starts a new
+// one when the link target changes.
+func (s *linkSpans) add(page *Page, piece styledPiece, x float64) error {
+	if piece.url != s.url {
+		if err := s.flush(page); err != nil {
+			return err
 		}
+		s.url, s.left, s.size = piece.url, x, 0
+	}
+	if s.url == "" {
+		return nil
 	}
 
-	ascender := float64(metrics.GetAscender()) * word.style.Size / 1000.0
-	descender := float64(metrics.GetDescender()) * word.style.Size / 1000.0
-
-	return styledLine{
-		words:        []styledWord{word},
-		totalWidth:   word.width,
-		maxAscender:  ascender,
-		maxDescender: descender,
+	s.right = x + piece.width
+	size := piece.style.Size
+	if piece.image != nil {
+		size = piece.height
 	}
+	s.size = max(s.size, size)
+	return nil
 }
 
-// addWordToLine adds a word to an existing line.
-func (sp *StyledParagraph) addWordToLine(line *styledLine, word styledWord) {
-	line.words = append(line.words, word)
-	line.totalWidth += word.width
-
-	// Update line metrics.
-	metrics := fonts.GetMetrics(string(word.style.Font))
-	if metrics != nil {
-		ascender := float64(metrics.GetAscender()) * word.style.Size / 1000.0
-		descender := float64(metrics.GetDescender()) * word.style.Size / 1000.0
-
-		if ascender > line.maxAscender {
-			line.maxAscender = ascender
-		}
-		if descender < line.maxDescender {
-			line.maxDescender = descender
-		}
+// flush adds the annotation for the current span, if any.
+func (s *linkSpans) flush(page *Page) error {
+	if s.url == "" {
+		return nil
 	}
+	rect := calculateLinkRect(s.left, s.baseline, s.right-s.left, s.size)
+	annot := createLinkAnnotation(rect, s.url, -1, false)
+	s.url = ""
+	return page.page.AddAnnotation(annot)
 }
@@ -154,7 +154,7 @@ func TestStyledParagraph_TextWrapping_SingleChunk(t *testing.T) {
 
 	// Available width = 612 - 72 - 72 = 468 points.
 	// With 12pt Helvetica, this should wrap into multiple lines.
-	lines := sp.wrapText(ctx.AvailableWidth())
+	lines := sp.wrapText(ctx)
 
 	if len(lines) == 0 {
 		t.Fatal("Expected at least 1 line, got 0")
@@ -194,7 +194,7 @@ func TestStyledParagraph_TextWrapping_AcrossChunks(t *testing.T) {
 		CursorY: 0,
 	}
 
-	lines := sp.wrapText(ctx.AvailableWidth())
+	lines := sp.wrapText(ctx)
 
 	if len(lines) == 0 {
 		t.Fatal("Expected at least 1 line, got 0")
@@ -338,34 +338,32 @@ func TestStyledParagraph_SplitChunksIntoWords(t *testing.T) {
 	sp.Append("Hello World")
 	sp.AppendStyled("Bold Text", TextStyle{Font: HelveticaBold, Size: 12, Color: Black})
 
-	words := sp.splitChunksIntoWords()
+	words := sp.splitChunksIntoWords(DefaultTheme())
 
-	// Should have 4 words: "Hello", " World", " Bold", " Text".
-	// First word has no leading space, others do.
+	// Should have 4 words: "Hello", "World", "Bold", "Text".
 	expectedCount := 4
 	if len(words) != expectedCount {
 		t.Fatalf("Expected %d words, got %d", expectedCount, len(words))
 	}
 
-	// Verify first word has no leading space.
-	if words[0].text != "Hello" {
-		t.Errorf("Expected 'Hello', got '%s'", words[0].text)
-	}
-
-	// Verify subsequent words have leading space.
-	expectedWords := []string{"Hello", " World", " Bold", " Text"}
+	// Verify the first word has no space before it and the others do,
+	// including across the chunk boundary.
+	expectedWords := []string{"Hello", "World", "Bold", "Text"}
 	for i, expected := range expectedWords {
-		if words[i].text != expected {
-			t.Errorf("Word %d: expected '%s', got '%s'", i, expected, words[i].text)
+		if got := words[i].pieces[0].text; got != expected {
+			t.Errorf("Word %d: expected '%s', got '%s'", i, expected, got)
+		}
+		if hasGap := words[i].gap > 0; hasGap != (i > 0) {
+			t.Errorf("Word %d: gap = %f", i, words[i].gap)
 		}
 	}
 
 	// Verify styles.
-	if words[0].style.Font != Helvetica {
-		t.Errorf("Word 0: expected Helvetica, got %s", words[0].style.Font)
+	if words[0].pieces[0].style.Font != Helvetica {
+		t.Errorf("Word 0: expected Helvetica, got %s", words[0].pieces[0].style.Font)
 	}
-	if words[2].style.Font != HelveticaBold {
-		t.Errorf("Word 2: expected HelveticaBold, got %s", words[2].style.Font)
+	if words[2].pieces[0].style.Font != HelveticaBold {
+		t.Errorf("Word 2: expected HelveticaBold, got %s", words[2].pieces[0].style.Font)
 	}
 }
 
@@ -373,7 +371,7 @@ func TestStyledParagraph_BuildLines(t *testing.T) {
 	sp := NewStyledParagraph()
 	sp.Append("Word1 Word2 Word3 Word4")
 
-	words := sp.splitChunksIntoWords()
+	words := sp.splitChunksIntoWords(DefaultTheme())
 
 	// Test with very limited width (forces one word per line).
 	lines := sp.buildLines(words, 50.0)
@@ -423,7 +421,7 @@ func TestStyledParagraph_EmptyText(t *testing.T) {
 		CursorY: 0,
 	}
 
-	lines := sp.wrapText(ctx.AvailableWidth())
+	lines := sp.wrapText(ctx)
 
 	if len(lines) != 0 {
 		t.Errorf("Expected 0 lines for empty text, got %d", len(lines))
@@ -452,16 +450,34 @@ func TestStyledParagraph_WhitespaceOnly(t *testing.T) {
 		CursorY: 0,
 	}
 
-	// strings.Fields() removes whitespace, so should have no words.
-	words := sp.splitChunksIntoWords()
+	// Whitespace only separates words, so there should be no words.
+	words := sp.splitChunksIntoWords(DefaultTheme())
 
 	if len(words) != 0 {
 		t.Errorf("Expected 0 words for whitespace-only text, got %d", len(words))
 	}
 
-	lines := sp.wrapText(ctx.AvailableWidth())
+	lines := sp.wrapText(ctx)
 
 	if len(lines) != 0 {
 		t.Errorf("Expected 0 lines for whitespace-only text, got %d", len(lines))
 	}
 }
+
+func TestStyledParagraph_Draw_CommonBaseline(t *testing.T) {
+	c := New()
+	sp := NewStyledParagraph().
+		Append("small").
+		AppendStyled("large", TextStyle{Font: HelveticaBold, Size: 24, Color: Black})
+
+	if err := c.Draw(sp); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+	ops := c.pages[0].textOps
+	if len(ops) != 2 {
+		t.Fatalf("text ops = %d, want 2", len(ops))
+	}
+	if ops[0].Y != ops[1].Y {
+		t.Errorf("words not on a common baseline: %.2f vs %.2f", ops[0].Y, ops[1].Y)
+	}
+}
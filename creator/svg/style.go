@@ -0,0 +1,258 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+// style is the inheritable paint state of an element.
+type style struct {
+	fill        *creator.Color // nil = none
+	stroke      *creator.Color // nil = none
+	strokeWidth float64
+	evenOdd     bool
+	invisible   bool // visibility: hidden
+}
+
+// defaultStyle returns the SVG initial values: black fill, no stroke.
+func defaultStyle() style {
+	black := creator.Black
+	return style{fill: &black, strokeWidth: 1}
+}
+
+// apply returns the style updated with an element's presentation
+// attributes and style declarations (declarations take precedence).
+func (s style) apply(attrs map[string]string) style {
+	props := make(map[string]string)
+	for _, key := range []string{"fill", "stroke", "stroke-width", "fill-rule", "visibility"} {
+		if v, ok := attrs[key]; ok {
+			props[key] = v
+		}
+	}
+	for _, decl := range strings.Split(attrs["style"], ";") {
+		if key, value, ok := strings.Cut(decl, ":"); ok {
+			props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	for key, value := range props {
+		switch key {
+		case "fill":
+			s.fill = s.paint(value, s.fill)
+		case "stroke":
+			s.stroke = s.paint(value, s.stroke)
+		case "stroke-width":
+			if w, ok := parseLength(value); ok {
+				s.strokeWidth = w
+			}
+		case "fill-rule":
+			s.evenOdd = value == "evenodd"
+		case "visibility":
+			s.invisible = value == "hidden" || value == "collapse"
+		}
+	}
+	return s
+}
+
+// paint parses a fill or stroke value. Unsupported paint servers
+// (url(#gradient)) fall back to their fallback color or none.
+func (s style) paint(value string, inherited *creator.Color) *creator.Color {
+	switch value {
+	case "inherit":
+		return inherited
+	case "none", "transparent":
+		return nil
+	case "currentColor":
+		black := creator.Black
+		return &black
+	}
+	if strings.HasPrefix(value, "url(") {
+		if _, fallback, ok := strings.Cut(value, ")"); ok && strings.TrimSpace(fallback) != "" {
+			return s.paint(strings.TrimSpace(fallback), inherited)
+		}
+		return nil
+	}
+	if c, ok := parseColor(value); ok {
+		return &c
+	}
+	return inherited
+}
+
+// styleDecl returns the value of a property in the style attribute.
+func styleDecl(attrs map[string]string, name string) string {
+	for _, decl := range strings.Split(attrs["style"], ";") {
+		if key, value, ok := strings.Cut(decl, ":"); ok && strings.TrimSpace(key) == name {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// namedColors are the CSS color keywords most common in icons.
+var namedColors = map[string]creator.Color{
+	"black":   creator.Black,
+	"white":   creator.White,
+	"red":     creator.Red,
+	"green":   {R: 0, G: 128.0 / 255, B: 0},
+	"lime":    {R: 0, G: 1, B: 0},
+	"blue":    creator.Blue,
+	"yellow":  {R: 1, G: 1, B: 0},
+	"orange":  {R: 1, G: 165.0 / 255, B: 0},
+	"purple":  {R: 128.0 / 255, G: 0, B: 128.0 / 255},
+	"gray":    {R: 128.0 / 255, G: 128.0 / 255, B: 128.0 / 255},
+	"grey":    {R: 128.0 / 255, G: 128.0 / 255, B: 128.0 / 255},
+	"silver":  {R: 192.0 / 255, G: 192.0 / 255, B: 192.0 / 255},
+	"navy":    {R: 0, G: 0, B: 128.0 / 255},
+	"teal":    {R: 0, G: 128.0 / 255, B: 128.0 / 255},
+	"maroon":  {R: 128.0 / 255, G: 0, B: 0},
+	"olive":   {R: 128.0 / 255, G: 128.0 / 255, B: 0},
+	"aqua":    {R: 0, G: 1, B: 1},
+	"cyan":    {R: 0, G: 1, B: 1},
+	"fuchsia": {R: 1, G: 0, B: 1},
+	"magenta": {R: 1, G: 0, B: 1},
+}
+
+// parseColor parses #rgb, #rrggbb, rgb(r, g, b) and named colors.
+func parseColor(value string) (creator.Color, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if c, ok := namedColors[value]; ok {
+		return c, true
+	}
+
+	if hex, ok := strings.CutPrefix(value, "#"); ok {
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if len(hex) != 6 {
+			return creator.Color{}, false
+		}
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return creator.Color{}, false
+		}
+		return creator.Color{
+			R: float64(v>>16&0xff) / 255,
+			G: float64(v>>8&0xff) / 255,
+			B: float64(v&0xff) / 255,
+		}, true
+	}
+
+	if args, ok := strings.CutPrefix(value, "rgb("); ok {
+		parts := strings.Split(strings.TrimSuffix(args, ")"), ",")
+		if len(parts) != 3 {
+			return creator.Color{}, false
+		}
+		var rgb [3]float64
+		for i, p := range parts {
+			p = strings.TrimSpace(p)
+			scale := 255.0
+			if pct, ok := strings.CutSuffix(p, "%"); ok {
+				p, scale = pct, 100
+			}
+			v, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return creator.Color{}, false
+			}
+			rgb[i] = math.Max(0, math.Min(1, v/scale))
+		}
+		return creator.Color{R: rgb[0], G: rgb[1], B: rgb[2]}, true
+	}
+
+	return creator.Color{}, false
+}
+
+// parseLength parses a length in user units. Unit suffixes px and pt are
+// accepted (pt is converted at 96 dpi); percentages are not supported.
+func parseLength(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	scale := 1.0
+	switch {
+	case strings.HasSuffix(value, "px"):
+		value = strings.TrimSuffix(value, "px")
+	case strings.HasSuffix(value, "pt"):
+		value, scale = strings.TrimSuffix(value, "pt"), 96.0/72.0
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v * scale, true
+}
+
+// parseNumbers parses a whitespace- or comma-separated list of numbers.
+func parseNumbers(value string) ([]float64, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	nums := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", f)
+		}
+		nums[i] = v
+	}
+	return nums, nil
+}
+
+// parseTransform parses an SVG transform list such as
+// "translate(10 20) rotate(45)". Transforms apply right to left, as in SVG.
+func parseTransform(value string) (creator.Transform, error) {
+	result := creator.Identity()
+
+	rest := strings.TrimSpace(value)
+	for rest != "" {
+		open := strings.IndexByte(rest, '(')
+		closeIdx := strings.IndexByte(rest, ')')
+		if open < 0 || closeIdx < open {
+			return creator.Transform{}, fmt.Errorf("invalid transform %q", value)
+		}
+		name := strings.TrimSpace(rest[:open])
+		args, err := parseNumbers(rest[open+1 : closeIdx])
+		if err != nil {
+			return creator.Transform{}, fmt.Errorf("invalid transform %q: %w", value, err)
+		}
+		rest = strings.TrimLeft(rest[closeIdx+1:], " ,\t\n\r")
+
+		t, err := transformFunc(name, args)
+		if err != nil {
+			return creator.Transform{}, err
+		}
+		// In "A B", B applies first, then A.
+		result = t.Then(result)
+	}
+	return result, nil
+}
+
+// transformFunc builds a single transform function.
+func transformFunc(name string, args []float64) (creator.Transform, error) {
+	arg := func(i int, def float64) float64 {
+		if i < len(args) {
+			return args[i]
+		}
+		return def
+	}
+
+	switch {
+	case name == "matrix" && len(args) == 6:
+		return creator.Transform{A: args[0], B: args[1], C: args[2], D: args[3], E: args[4], F: args[5]}, nil
+	case name == "translate" && len(args) >= 1:
+		return creator.Translate(args[0], arg(1, 0)), nil
+	case name == "scale" && len(args) >= 1:
+		return creator.Scale(args[0], arg(1, args[0])), nil
+	case name == "rotate" && len(args) == 1:
+		return creator.Rotate(args[0]), nil
+	case name == "rotate" && len(args) == 3:
+		return creator.RotateAround(args[0], args[1], args[2]), nil
+	case name == "skewX" && len(args) == 1:
+		return creator.Skew(args[0], 0), nil
+	case name == "skewY" && len(args) == 1:
+		return creator.Skew(0, args[0]), nil
+	}
+	return creator.Transform{}, fmt.Errorf("unsupported transform %s(%v)", name, args)
+}
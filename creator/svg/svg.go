@@ -0,0 +1,355 @@
+// Package svg draws simple SVG images onto creator pages.
+//
+// The importer covers the flat vector subset used by most logos and
+// icons:
+//   - Elements: path, rect (with rx/ry), circle, ellipse, line, polyline,
+//     polygon and nested g groups
+//   - Presentation attributes and style declarations: fill, stroke,
+//     stroke-width, fill-rule, display and visibility
+//   - Transforms: matrix, translate, scale, rotate, skewX and skewY
+//
+// Text, images, gradients, patterns, clipping, masks and CSS stylesheets
+// are not supported; unsupported elements are skipped.
+//
+// Example:
+//
+//	logo, _ := os.ReadFile("logo.svg")
+//	c := creator.New()
+//	if err := svg.Import(c, logo, 72, 700, 120, 40); err != nil {
+//	    log.Fatal(err)
+//	}
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+// Import draws an SVG image on the current page of the document.
+//
+// The image is scaled uniformly to fit the box with lower-left corner
+// (x, y) and size w x h in PDF coordinates, and centered in it (like
+// SVG's default preserveAspectRatio "xMidYMid meet"). With the top-left
+// origin (see creator.Creator.SetOriginTopLeft), (x, y) is the top-left
+// corner of the box, like for DrawRect and DrawImage.
+// If the document has no pages, a page is created.
+func Import(c *creator.Creator, svgBytes []byte, x, y, w, h float64) error {
+	page, err := c.CurrentPage()
+	if err != nil {
+		return fmt.Errorf("svg: %w", err)
+	}
+	return ImportPage(page, svgBytes, x, y, w, h)
+}
+
+// ImportPage draws an SVG image on the given page. See Import.
+func ImportPage(page *creator.Page, svgBytes []byte, x, y, w, h float64) error {
+	if w <= 0 || h <= 0 {
+		return errors.New("svg: box width and height must be positive")
+	}
+
+	im := &importer{page: page, box: creator.Rect{X: x, Y: y, Width: w, Height: h}}
+	if err := im.run(svgBytes); err != nil {
+		return fmt.Errorf("svg: %w", err)
+	}
+	return nil
+}
+
+// state is the inherited drawing state of an element.
+type state struct {
+	style     style
+	transform creator.Transform
+	hidden    bool
+}
+
+// importer walks the SVG document and draws its shapes.
+type importer struct {
+	page  *creator.Page
+	box   creator.Rect
+	stack []state
+	skip  int // depth inside an unsupported container
+	root  bool
+}
+
+// containers whose content is not rendered directly.
+var skippedElements = map[string]bool{
+	"defs": true, "clipPath": true, "mask": true, "symbol": true, "pattern": true,
+	"marker": true, "linearGradient": true, "radialGradient": true, "style": true,
+	"title": true, "desc": true, "metadata": true, "text": true, "image": true,
+	"foreignObject": true,
+}
+
+// run parses and draws the document.
+func (im *importer) run(data []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = false
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if err := im.start(t); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			im.end()
+		}
+	}
+
+	if !im.root {
+		return errors.New("no <svg> root element")
+	}
+	return nil
+}
+
+// start handles an opening tag.
+func (im *importer) start(el xml.StartElement) error {
+	name := el.Name.Local
+	attrs := attrMap(el.Attr)
+
+	if im.skip > 0 || skippedElements[name] {
+		im.skip++
+		return nil
+	}
+
+	if !im.root {
+		if name != "svg" {
+			return fmt.Errorf("root element is <%s>, want <svg>", name)
+		}
+		viewport, err := im.viewport(attrs)
+		if err != nil {
+			return err
+		}
+		im.root = true
+		im.stack = append(im.stack, state{style: defaultStyle(), transform: viewport})
+	}
+
+	parent := im.stack[len(im.stack)-1]
+	cur := state{
+		style:     parent.style.apply(attrs),
+		transform: parent.transform,
+		hidden:    parent.hidden || attrs["display"] == "none" || styleDecl(attrs, "display") == "none",
+	}
+	if t, ok := attrs["transform"]; ok {
+		local, err := parseTransform(t)
+		if err != nil {
+			return err
+		}
+		cur.transform = local.Then(parent.transform)
+	}
+	im.stack = append(im.stack, cur)
+
+	if cur.hidden || cur.style.invisible {
+		return nil
+	}
+	d, err := shapePath(name, attrs)
+	if err != nil {
+		return fmt.Errorf("<%s>: %w", name, err)
+	}
+	if d == "" {
+		return nil
+	}
+	return im.draw(name, d, cur)
+}
+
+// end handles a closing tag.
+func (im *importer) end() {
+	if im.skip > 0 {
+		im.skip--
+		return
+	}
+	if len(im.stack) > 0 {
+		im.stack = im.stack[:len(im.stack)-1]
+	}
+}
+
+// draw renders a shape's path with the element state.
+func (im *importer) draw(name, d string, st state) error {
+	opts := &creator.PathOptions{Transform: &st.transform}
+
+	s := st.style
+	if s.fill != nil && name != "line" {
+		opts.FillColor = s.fill
+		if s.evenOdd {
+			opts.FillRule = creator.FillRuleEvenOdd
+		}
+	}
+	if s.stroke != nil && s.strokeWidth > 0 {
+		opts.StrokeColor = s.stroke
+		opts.StrokeWidth = s.strokeWidth * scaleOf(st.transform)
+	}
+	if opts.FillColor == nil && opts.StrokeColor == nil {
+		return nil
+	}
+
+	if err := im.page.DrawPath(d, opts); err != nil {
+		return fmt.Errorf("<%s>: %w", name, err)
+	}
+	return nil
+}
+
+// viewport returns the transform from SVG user space to the target box.
+func (im *importer) viewport(attrs map[string]string) (creator.Transform, error) {
+	var vx, vy, vw, vh float64
+	if vb, ok := attrs["viewBox"]; ok {
+		nums, err := parseNumbers(vb)
+		if err != nil || len(nums) != 4 {
+			return creator.Transform{}, fmt.Errorf("invalid viewBox %q", vb)
+		}
+		vx, vy, vw, vh = nums[0], nums[1], nums[2], nums[3]
+	} else {
+		vw, _ = parseLength(attrs["width"])
+		vh, _ = parseLength(attrs["height"])
+	}
+	if vw <= 0 || vh <= 0 {
+		return creator.Transform{}, errors.New("svg has no size (set viewBox or width and height)")
+	}
+
+	// Uniform scale, centered in the box. SVG's Y axis points down, like
+	// the page's with the top-left origin, so it is only flipped for PDF
+	// coordinates; DrawPath converts the top-left origin itself.
+	s := math.Min(im.box.Width/vw, im.box.Height/vh)
+	offX := im.box.X + (im.box.Width-vw*s)/2
+	if im.page.OriginTopLeft() {
+		offY := im.box.Y + (im.box.Height-vh*s)/2
+		return creator.Translate(-vx, -vy).
+			Then(creator.Scale(s, s)).
+			Then(creator.Translate(offX, offY)), nil
+	}
+	offY := im.box.Y + (im.box.Height+vh*s)/2
+
+	return creator.Translate(-vx, -vy).
+		Then(creator.Scale(s, -s)).
+		Then(creator.Translate(offX, offY)), nil
+}
+
+// shapePath returns SVG path data for a shape element, or "" for
+// elements that draw nothing.
+func shapePath(name string, attrs map[string]string) (string, error) {
+	num := func(key string) float64 {
+		v, _ := parseLength(attrs[key])
+		return v
+	}
+
+	switch name {
+	case "path":
+		return attrs["d"], nil
+
+	case "rect":
+		x, y, w, h := num("x"), num("y"), num("width"), num("height")
+		if w <= 0 || h <= 0 {
+			return "", nil
+		}
+		rx, rxOK := parseLength(attrs["rx"])
+		ry, ryOK := parseLength(attrs["ry"])
+		if !rxOK {
+			rx = ry
+		}
+		if !ryOK {
+			ry = rx
+		}
+		rx, ry = math.Min(rx, w/2), math.Min(ry, h/2)
+		if rx <= 0 || ry <= 0 {
+			return pathData("M", x, y, "H", x+w, "V", y+h, "H", x, "Z"), nil
+		}
+		return pathData(
+			"M", x+rx, y, "H", x+w-rx, "A", rx, ry, 0, 0, 1, x+w, y+ry,
+			"V", y+h-ry, "A", rx, ry, 0, 0, 1, x+w-rx, y+h,
+			"H", x+rx, "A", rx, ry, 0, 0, 1, x, y+h-ry,
+			"V", y+ry, "A", rx, ry, 0, 0, 1, x+rx, y, "Z"), nil
+
+	case "circle":
+		r := num("r")
+		return ellipsePath(num("cx"), num("cy"), r, r), nil
+
+	case "ellipse":
+		return ellipsePath(num("cx"), num("cy"), num("rx"), num("ry")), nil
+
+	case "line":
+		return pathData("M", num("x1"), num("y1"), "L", num("x2"), num("y2")), nil
+
+	case "polyline", "polygon":
+		pts, err := parseNumbers(attrs["points"])
+		if err != nil {
+			return "", err
+		}
+		if len(pts) < 4 {
+			return "", nil
+		}
+		var sb strings.Builder
+		sb.WriteString("M")
+		for i := 0; i+1 < len(pts); i += 2 {
+			if i > 0 {
+				sb.WriteString(" L")
+			}
+			sb.WriteString(formatNum(pts[i]) + " " + formatNum(pts[i+1]))
+		}
+		if name == "polygon" {
+			sb.WriteString(" Z")
+		}
+		return sb.String(), nil
+	}
+
+	return "", nil
+}
+
+// ellipsePath returns path data for an ellipse as two arcs.
+func ellipsePath(cx, cy, rx, ry float64) string {
+	if rx <= 0 || ry <= 0 {
+		return ""
+	}
+	return pathData(
+		"M", cx-rx, cy,
+		"A", rx, ry, 0, 1, 0, cx+rx, cy,
+		"A", rx, ry, 0, 1, 0, cx-rx, cy, "Z")
+}
+
+// pathData joins commands and numbers into SVG path data.
+func pathData(parts ...any) string {
+	out := make([]string, len(parts))
+	for i, p := range parts {
+		switch v := p.(type) {
+		case string:
+			out[i] = v
+		case float64:
+			out[i] = formatNum(v)
+		case int:
+			out[i] = strconv.Itoa(v)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// formatNum formats a number for path data.
+func formatNum(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// scaleOf returns the average scale factor of a transform, used to scale
+// stroke widths.
+func scaleOf(t creator.Transform) float64 {
+	return math.Sqrt(math.Abs(t.A*t.D - t.B*t.C))
+}
+
+// attrMap converts XML attributes to a map keyed by local name.
+func attrMap(attrs []xml.Attr) map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		m[a.Name.Local] = strings.TrimSpace(a.Value)
+	}
+	return m
+}
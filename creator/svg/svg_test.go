@@ -0,0 +1,228 @@
+package svg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+const sample = `<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 50">
+  <title>Sample</title>
+  <defs><linearGradient id="g"/></defs>
+  <rect x="0" y="0" width="100" height="50" fill="#ff0000"/>
+  <circle cx="25" cy="25" r="10" style="fill: blue; stroke: black; stroke-width: 2"/>
+  <g transform="translate(50 0)" fill="none" stroke="rgb(0, 128, 0)">
+    <path d="M0 0 L10 10"/>
+    <line x1="0" y1="0" x2="10" y2="0"/>
+  </g>
+  <rect width="10" height="10" display="none"/>
+</svg>`
+
+func newPage(t *testing.T) *creator.Page {
+	t.Helper()
+	page, err := creator.New().NewPage()
+	if err != nil {
+		t.Fatalf("NewPage() error = %v", err)
+	}
+	return page
+}
+
+func TestImport(t *testing.T) {
+	c := creator.New()
+	if err := Import(c, []byte(sample), 100, 500, 200, 100); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if c.PageCount() != 1 {
+		t.Fatalf("PageCount() = %d, want 1", c.PageCount())
+	}
+
+	page, _ := c.CurrentPage()
+	ops := page.GraphicsOperations()
+	if len(ops) != 4 {
+		t.Fatalf("graphics ops = %d, want 4", len(ops))
+	}
+
+	bg := ops[0].PathOpts
+	if bg.FillColor == nil || *bg.FillColor != creator.Red || bg.StrokeColor != nil {
+		t.Errorf("rect options = %+v, want red fill without stroke", bg)
+	}
+
+	circle := ops[1].PathOpts
+	if circle.FillColor == nil || *circle.FillColor != creator.Blue {
+		t.Errorf("circle fill = %v, want blue", circle.FillColor)
+	}
+	// Stroke width scales with the viewport (2x).
+	if circle.StrokeColor == nil || math.Abs(circle.StrokeWidth-4) > 1e-9 {
+		t.Errorf("circle stroke = %v width %.2f, want black width 4", circle.StrokeColor, circle.StrokeWidth)
+	}
+
+	for i, op := range ops[2:] {
+		opts := op.PathOpts
+		if opts.FillColor != nil || opts.StrokeColor == nil {
+			t.Errorf("group child %d = %+v, want stroke only", i, opts)
+		}
+	}
+}
+
+func TestImport_Viewport(t *testing.T) {
+	page := newPage(t)
+	// 100x50 viewBox in a 200x200 box: scale 2, centered vertically.
+	if err := ImportPage(page, []byte(sample), 100, 500, 200, 200); err != nil {
+		t.Fatalf("ImportPage() error = %v", err)
+	}
+	tr := page.GraphicsOperations()[0].PathOpts.Transform
+
+	tests := []struct {
+		x, y, wantX, wantY float64
+	}{
+		{0, 0, 100, 650},    // SVG top-left
+		{100, 50, 300, 550}, // SVG bottom-right
+	}
+	for _, tt := range tests {
+		gx, gy := tr.TransformPoint(tt.x, tt.y)
+		if math.Abs(gx-tt.wantX) > 1e-9 || math.Abs(gy-tt.wantY) > 1e-9 {
+			t.Errorf("(%g, %g) -> (%g, %g), want (%g, %g)", tt.x, tt.y, gx, gy, tt.wantX, tt.wantY)
+		}
+	}
+
+	// The group's translate(50 0) is applied before the viewport.
+	gtr := page.GraphicsOperations()[2].PathOpts.Transform
+	if gx, gy := gtr.TransformPoint(0, 0); math.Abs(gx-200) > 1e-9 || math.Abs(gy-650) > 1e-9 {
+		t.Errorf("group origin -> (%g, %g), want (200, 650)", gx, gy)
+	}
+}
+
+func TestImport_OriginTopLeft(t *testing.T) {
+	c := creator.New()
+	c.SetOriginTopLeft(true)
+	page, err := c.NewPageWithSize(creator.Letter)
+	if err != nil {
+		t.Fatalf("NewPageWithSize() error = %v", err)
+	}
+
+	// The same box as TestImport_Viewport, by its top-left corner on the
+	// 792pt high page.
+	if err := ImportPage(page, []byte(sample), 100, 92, 200, 200); err != nil {
+		t.Fatalf("ImportPage() error = %v", err)
+	}
+	tr := page.GraphicsOperations()[0].PathOpts.Transform
+
+	tests := []struct {
+		x, y, wantX, wantY float64
+	}{
+		{0, 0, 100, 650},    // SVG top-left
+		{100, 50, 300, 550}, // SVG bottom-right
+	}
+	for _, tt := range tests {
+		gx, gy := tr.TransformPoint(tt.x, tt.y)
+		if math.Abs(gx-tt.wantX) > 1e-9 || math.Abs(gy-tt.wantY) > 1e-9 {
+			t.Errorf("(%g, %g) -> (%g, %g), want (%g, %g)", tt.x, tt.y, gx, gy, tt.wantX, tt.wantY)
+		}
+	}
+}
+
+func TestImport_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		svg  string
+	}{
+		{"not svg", `<html/>`},
+		{"no size", `<svg/>`},
+		{"bad viewBox", `<svg viewBox="0 0 10"/>`},
+		{"bad transform", `<svg viewBox="0 0 10 10"><g transform="spin(3)"/></svg>`},
+		{"bad path", `<svg viewBox="0 0 10 10"><path d="L1 1"/></svg>`},
+		{"empty", ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ImportPage(newPage(t), []byte(tt.svg), 0, 0, 10, 10); err == nil {
+				t.Errorf("ImportPage(%q) expected error", tt.svg)
+			}
+		})
+	}
+
+	if err := ImportPage(newPage(t), []byte(sample), 0, 0, 0, 10); err == nil {
+		t.Error("expected error for zero-width box")
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		in   string
+		want creator.Color
+		ok   bool
+	}{
+		{"#fff", creator.White, true},
+		{"#0000FF", creator.Blue, true},
+		{"rgb(255, 0, 0)", creator.Red, true},
+		{"rgb(0%, 0%, 100%)", creator.Blue, true},
+		{"black", creator.Black, true},
+		{"#12", creator.Color{}, false},
+		{"hsl(0, 0%, 0%)", creator.Color{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseColor(tt.in)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseColor(%q) = %v, %v, want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestStyleApply(t *testing.T) {
+	s := defaultStyle().apply(map[string]string{
+		"fill":      "red",
+		"style":     "fill: url(#grad); stroke: #000; stroke-width: 3px",
+		"fill-rule": "evenodd",
+	})
+	if s.fill != nil {
+		t.Errorf("fill = %v, want none for unsupported paint server", s.fill)
+	}
+	if s.stroke == nil || *s.stroke != creator.Black || s.strokeWidth != 3 {
+		t.Errorf("stroke = %v width %g, want black width 3", s.stroke, s.strokeWidth)
+	}
+	if !s.evenOdd {
+		t.Error("fill-rule evenodd not applied")
+	}
+
+	child := s.apply(map[string]string{"stroke": "inherit", "visibility": "hidden"})
+	if child.stroke != s.stroke || !child.invisible {
+		t.Errorf("child style = %+v, want inherited stroke and invisible", child)
+	}
+}
+
+func TestParseTransform(t *testing.T) {
+	tests := []struct {
+		in           string
+		x, y         float64
+		wantX, wantY float64
+	}{
+		{"translate(10)", 1, 1, 11, 1},
+		{"scale(2, 3)", 1, 1, 2, 3},
+		{"matrix(1 0 0 1 5 6)", 0, 0, 5, 6},
+		{"translate(10 0) scale(2)", 1, 0, 12, 0}, // scale first, then translate
+		{"rotate(90 5 5)", 5, 5, 5, 5},
+	}
+
+	for _, tt := range tests {
+		tr, err := parseTransform(tt.in)
+		if err != nil {
+			t.Errorf("parseTransform(%q) error = %v", tt.in, err)
+			continue
+		}
+		gx, gy := tr.TransformPoint(tt.x, tt.y)
+		if math.Abs(gx-tt.wantX) > 1e-9 || math.Abs(gy-tt.wantY) > 1e-9 {
+			t.Errorf("parseTransform(%q) maps (%g, %g) to (%g, %g), want (%g, %g)",
+				tt.in, tt.x, tt.y, gx, gy, tt.wantX, tt.wantY)
+		}
+	}
+
+	for _, bad := range []string{"rotate(1 2)", "translate(", "scale(a)"} {
+		if _, err := parseTransform(bad); err == nil {
+			t.Errorf("parseTransform(%q) expected error", bad)
+		}
+	}
+}
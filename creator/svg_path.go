@@ -0,0 +1,544 @@
+package creator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// PathOptions configures drawing of an SVG-style path.
+type PathOptions struct {
+	// StrokeColor is the outline color (nil = no stroke).
+	// If StrokeColorCMYK is set, this field is ignored.
+	StrokeColor *Color
+
+	// StrokeColorCMYK is the outline color in CMYK (nil = no stroke).
+	// If set, this takes precedence over StrokeColor (RGB).
+	StrokeColorCMYK *ColorCMYK
+
+	// StrokeWidth is the outline width in points (default: 1.0).
+	StrokeWidth float64
+
+	// FillColor is the fill color (nil = no fill).
+	// If FillColorCMYK is set, this field is ignored.
+	FillColor *Color
+
+	// FillColorCMYK is the fill color in CMYK (nil = no fill).
+	// If set, this takes precedence over FillColor (RGB).
+	FillColorCMYK *ColorCMYK
+
+	// FillColorIndexed is the fill color as a palette entry of an Indexed
+	// color space (nil = no fill).
+	// If set, this takes precedence over FillColorCMYK and FillColor.
+	FillColorIndexed *IndexedColor
+
+	// FillRule selects the non-zero (default) or even-odd fill rule.
+	FillRule FillRule
+
+	// Dashed enables dashed outline rendering.
+	Dashed bool
+
+	// DashArray defines the dash pattern for the outline.
+	// Only used when Dashed is true.
+	DashArray []float64
+
+	// DashPhase is the starting offset into the dash pattern.
+	// Only used when Dashed is true.
+	DashPhase float64
+
+	// Transform maps path coordinates to page coordinates (nil = identity).
+	// Use it to position, scale or flip paths authored in another
+	// coordinate system, e.g. SVG's top-down Y axis.
+	Transform *Transform
+}
+
+// DrawPath draws a path given in SVG path data syntax.
+//
+// Supported commands (absolute uppercase, relative lowercase):
+//   - M/m x y: move to (extra coordinate pairs are line segments)
+//   - L/l x y: line to
+//   - H/h x, V/v y: horizontal and vertical line to
+//   - C/c x1 y1 x2 y2 x y: cubic Bézier curve
+//   - S/s x2 y2 x y: smooth cubic Bézier curve
+//   - Q/q x1 y1 x y: quadratic Bézier curve (converted to cubic)
+//   - T/t x y: smooth quadratic Bézier curve
+//   - A/a rx ry rotation large-arc sweep x y: elliptical arc (converted to cubics)
+//   - Z/z: close subpath
+//
+// Coordinates are in PDF user space (origin at bottom-left, Y up, or
+// top-left, Y down, with Creator.SetOriginTopLeft) unless opts.Transform
+// maps them. At least a stroke or a fill color is required.
+//
+// Example:
+//
+//	// A filled triangle
+//	err := page.DrawPath("M100 100 L200 100 L150 180 Z", &creator.PathOptions{
+//	    FillColor: &creator.Blue,
+//	})
+//
+//	// An SVG icon (Y down) scaled into a 24x24 box at (72, 700)
+//	t := creator.Scale(1, -1).Then(creator.Translate(72, 724))
+//	err = page.DrawPath(iconPath, &creator.PathOptions{FillColor: &creator.Black, Transform: &t})
+func (p *Page) DrawPath(d string, opts *PathOptions) error {
+	if opts == nil {
+		return errors.New("path options cannot be nil")
+	}
+	opts = p.defaults.path(opts)
+
+	path, err := ParseSVGPath(d)
+	if err != nil {
+		return err
+	}
+	if path.IsEmpty() {
+		return errors.New("path data is empty")
+	}
+
+	return p.drawPath(path, p.pdfPathOptions(opts))
+}
+
+// drawPath validates the options and adds a path painting operation.
+func (p *Page) drawPath(path *Path, opts *PathOptions) error {
+	if err := validatePathOptions(opts); err != nil {
+		return err
+	}
+
+	p.graphicsOps = append(p.graphicsOps, GraphicsOperation{
+		Type:     GraphicsOpPath,
+		Path:     path,
+		PathOpts: opts,
+	})
+
+	return nil
+}
+
+// validatePathOptions validates path drawing options.
+func validatePathOptions(opts *PathOptions) error {
+	if opts.StrokeColor != nil {
+		if err := validateColor(*opts.StrokeColor); err != nil {
+			return errors.New("stroke " + err.Error())
+		}
+	}
+
+	if opts.FillColor != nil {
+		if err := validateColor(*opts.FillColor); err != nil {
+			return errors.New("fill " + err.Error())
+		}
+	}
+
+	if opts.FillColorIndexed != nil {
+		if err := validateIndexedColor(*opts.FillColorIndexed); err != nil {
+			return errors.New("fill " + err.Error())
+		}
+	}
+
+	if opts.StrokeWidth < 0 {
+		return errors.New("stroke width must be non-negative")
+	}
+
+	if opts.StrokeColor == nil && opts.StrokeColorCMYK == nil &&
+		opts.FillColor == nil && opts.FillColorCMYK == nil && opts.FillColorIndexed == nil {
+		return errors.New("path must have at least stroke or fill color")
+	}
+
+	return nil
+}
+
+// ParseSVGPath parses SVG path data into a Path.
+//
+// See Page.DrawPath for the supported commands.
+//
+// Example:
+//
+//	path, err := creator.ParseSVGPath("M10 10 L50 50 Q80 10 100 50 Z")
+func ParseSVGPath(d string) (*Path, error) {
+	sp := &svgPathParser{data: d, path: NewPath()}
+	if err := sp.parse(); err != nil {
+		return nil, fmt.Errorf("invalid path data: %w", err)
+	}
+	return sp.path, nil
+}
+
+// svgPathParser converts SVG path data into Path commands.
+type svgPathParser struct {
+	data string
+	pos  int
+	path *Path
+
+	current Point // current point
+	start   Point // start of the current subpath
+	open    bool  // a subpath is in progress (a current point exists)
+
+	// Control point of the previous curve, reflected by S and T.
+	lastCubic Point
+	lastQuad  Point
+	prev      byte // previous command (uppercase)
+}
+
+// parse processes all commands in the path data.
+func (sp *svgPathParser) parse() error {
+	var cmd byte
+	for {
+		sp.skipSeparators()
+		if sp.pos >= len(sp.data) {
+			return nil
+		}
+
+		ch := sp.data[sp.pos]
+		switch {
+		case isPathCommand(ch):
+			cmd = ch
+			sp.pos++
+		case cmd == 0:
+			return fmt.Errorf("expected command at offset %d", sp.pos)
+		case cmd == 'Z' || cmd == 'z':
+			return fmt.Errorf("unexpected number after close at offset %d", sp.pos)
+		}
+
+		if err := sp.command(cmd); err != nil {
+			return err
+		}
+
+		// Extra coordinate pairs after a move are implicit line segments.
+		switch cmd {
+		case 'M':
+			cmd = 'L'
+		case 'm':
+			cmd = 'l'
+		}
+	}
+}
+
+// command executes one command with its arguments.
+func (sp *svgPathParser) command(cmd byte) error {
+	relative := cmd >= 'a' && cmd <= 'z'
+	var origin Point
+	if relative {
+		origin = sp.current
+	}
+
+	if cmd != 'M' && cmd != 'm' && cmd != 'Z' && cmd != 'z' && !sp.open {
+		if sp.path.IsEmpty() {
+			return fmt.Errorf("path must start with a move command, got %q", cmd)
+		}
+		// Drawing after a close continues from the subpath start.
+		sp.path.MoveTo(sp.start.X, sp.start.Y)
+		sp.open = true
+	}
+
+	switch cmd {
+	case 'M', 'm':
+		args, err := sp.numbers(2)
+		if err != nil {
+			return err
+		}
+		sp.current = Point{X: origin.X + args[0], Y: origin.Y + args[1]}
+		sp.start = sp.current
+		sp.path.MoveTo(sp.current.X, sp.current.Y)
+		sp.open = true
+
+	case 'L', 'l':
+		args, err := sp.numbers(2)
+		if err != nil {
+			return err
+		}
+		sp.lineTo(origin.X+args[0], origin.Y+args[1])
+
+	case 'H', 'h':
+		args, err := sp.numbers(1)
+		if err != nil {
+			return err
+		}
+		sp.lineTo(origin.X+args[0], sp.current.Y)
+
+	case 'V', 'v':
+		args, err := sp.numbers(1)
+		if err != nil {
+			return err
+		}
+		sp.lineTo(sp.current.X, origin.Y+args[0])
+
+	case 'C', 'c':
+		args, err := sp.numbers(6)
+		if err != nil {
+			return err
+		}
+		sp.path.CubicTo(
+			origin.X+args[0], origin.Y+args[1],
+			origin.X+args[2], origin.Y+args[3],
+			origin.X+args[4], origin.Y+args[5])
+		sp.lastCubic = Point{X: origin.X + args[2], Y: origin.Y + args[3]}
+		sp.current = Point{X: origin.X + args[4], Y: origin.Y + args[5]}
+
+	case 'S', 's':
+		args, err := sp.numbers(4)
+		if err != nil {
+			return err
+		}
+		c1 := sp.reflect(sp.lastCubic, sp.prev == 'C' || sp.prev == 'S')
+		sp.path.CubicTo(c1.X, c1.Y, origin.X+args[0], origin.Y+args[1], origin.X+args[2], origin.Y+args[3])
+		sp.lastCubic = Point{X: origin.X + args[0], Y: origin.Y + args[1]}
+		sp.current = Point{X: origin.X + args[2], Y: origin.Y + args[3]}
+
+	case 'Q', 'q':
+		args, err := sp.numbers(4)
+		if err != nil {
+			return err
+		}
+		sp.path.QuadraticTo(origin.X+args[0], origin.Y+args[1], origin.X+args[2], origin.Y+args[3])
+		sp.lastQuad = Point{X: origin.X + args[0], Y: origin.Y + args[1]}
+		sp.current = Point{X: origin.X + args[2], Y: origin.Y + args[3]}
+
+	case 'T', 't':
+		args, err := sp.numbers(2)
+		if err != nil {
+			return err
+		}
+		c := sp.reflect(sp.lastQuad, sp.prev == 'Q' || sp.prev == 'T')
+		sp.path.QuadraticTo(c.X, c.Y, origin.X+args[0], origin.Y+args[1])
+		sp.lastQuad = c
+		sp.current = Point{X: origin.X + args[0], Y: origin.Y + args[1]}
+
+	case 'A', 'a':
+		if err := sp.arc(origin); err != nil {
+			return err
+		}
+
+	case 'Z', 'z':
+		if sp.open {
+			sp.path.Close()
+			sp.open = false
+		}
+		sp.current = sp.start
+	}
+
+	sp.prev = cmd &^ 0x20 // uppercase
+	return nil
+}
+
+// reflect returns the reflection of ctrl about the current point, or the
+// current point itself when the previous command was not a matching curve.
+func (sp *svgPathParser) reflect(ctrl Point, smooth bool) Point {
+	if !smooth {
+		return sp.current
+	}
+	return Point{X: 2*sp.current.X - ctrl.X, Y: 2*sp.current.Y - ctrl.Y}
+}
+
+// arc reads elliptical arc arguments and appends the arc as cubic curves.
+func (sp *svgPathParser) arc(origin Point) error {
+	radii, err := sp.numbers(3)
+	if err != nil {
+		return err
+	}
+	largeArc, err := sp.flag()
+	if err != nil {
+		return err
+	}
+	sweep, err := sp.flag()
+	if err != nil {
+		return err
+	}
+	end, err := sp.numbers(2)
+	if err != nil {
+		return err
+	}
+
+	to := Point{X: origin.X + end[0], Y: origin.Y + end[1]}
+	for _, c := range arcToCubics(sp.current, to, radii[0], radii[1], radii[2], largeArc, sweep) {
+		sp.path.CubicTo(c[0], c[1], c[2], c[3], c[4], c[5])
+	}
+	sp.current = to
+	return nil
+}
+
+// flag reads an arc flag ('0' or '1'), which may be written without
+// separators ("a5 5 0 11 10 10").
+func (sp *svgPathParser) flag() (bool, error) {
+	sp.skipSeparators()
+	if sp.pos >= len(sp.data) {
+		return false, errors.New("unexpected end of path data")
+	}
+	switch sp.data[sp.pos] {
+	case '0':
+		sp.pos++
+		return false, nil
+	case '1':
+		sp.pos++
+		return true, nil
+	}
+	return false, fmt.Errorf("expected arc flag at offset %d", sp.pos)
+}
+
+// arcToCubics converts an SVG elliptical arc from p1 to p2 into cubic
+// Bézier segments [c1x c1y c2x c2y x y], one per quarter turn or less.
+//
+// Reference: SVG 1.1 Specification, Appendix F.6 (Elliptical arc
+// implementation notes).
+func arcToCubics(p1, p2 Point, rx, ry, rotation float64, largeArc, sweep bool) [][6]float64 {
+	if p1 == p2 {
+		return nil
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		// Degenerate ellipse: a straight line.
+		return [][6]float64{{p1.X, p1.Y, p2.X, p2.Y, p2.X, p2.Y}}
+	}
+
+	phi := rotation * math.Pi / 180
+	cos, sin := math.Cos(phi), math.Sin(phi)
+
+	// Step 1: compute (x1', y1').
+	dx, dy := (p1.X-p2.X)/2, (p1.Y-p2.Y)/2
+	x1p := cos*dx + sin*dy
+	y1p := -sin*dx + cos*dy
+
+	// Scale up radii that are too small to span the endpoints.
+	if lambda := x1p*x1p/(rx*rx) + y1p*y1p/(ry*ry); lambda > 1 {
+		rx *= math.Sqrt(lambda)
+		ry *= math.Sqrt(lambda)
+	}
+
+	// Step 2: compute the center (cx', cy').
+	num := rx*rx*ry*ry - rx*rx*y1p*y1p - ry*ry*x1p*x1p
+	den := rx*rx*y1p*y1p + ry*ry*x1p*x1p
+	coef := math.Sqrt(math.Max(0, num/den))
+	if largeArc == sweep {
+		coef = -coef
+	}
+	cxp := coef * rx * y1p / ry
+	cyp := -coef * ry * x1p / rx
+
+	// Step 3: compute the center (cx, cy).
+	cx := cos*cxp - sin*cyp + (p1.X+p2.X)/2
+	cy := sin*cxp + cos*cyp + (p1.Y+p2.Y)/2
+
+	// Step 4: compute the start angle and sweep.
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+	theta := angle(1, 0, (x1p-cxp)/rx, (y1p-cyp)/ry)
+	delta := angle((x1p-cxp)/rx, (y1p-cyp)/ry, (-x1p-cxp)/rx, (-y1p-cyp)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	// Approximate each segment of at most 90 degrees with a cubic.
+	n := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	step := delta / float64(n)
+	k := 4.0 / 3.0 * math.Tan(step/4)
+
+	point := func(x, y float64) (float64, float64) {
+		return cx + rx*cos*x - ry*sin*y, cy + rx*sin*x + ry*cos*y
+	}
+
+	curves := make([][6]float64, 0, n)
+	for i := 0; i < n; i++ {
+		t1 := theta + float64(i)*step
+		t2 := t1 + step
+		c1x, c1y := point(math.Cos(t1)-k*math.Sin(t1), math.Sin(t1)+k*math.Cos(t1))
+		c2x, c2y := point(math.Cos(t2)+k*math.Sin(t2), math.Sin(t2)-k*math.Cos(t2))
+		x, y := point(math.Cos(t2), math.Sin(t2))
+		curves = append(curves, [6]float64{c1x, c1y, c2x, c2y, x, y})
+	}
+	// Land exactly on the endpoint.
+	curves[n-1][4], curves[n-1][5] = p2.X, p2.Y
+	return curves
+}
+
+// lineTo appends a line segment and updates the current point.
+func (sp *svgPathParser) lineTo(x, y float64) {
+	sp.path.LineTo(x, y)
+	sp.current = Point{X: x, Y: y}
+}
+
+// numbers reads n numeric arguments.
+func (sp *svgPathParser) numbers(n int) ([]float64, error) {
+	args := make([]float64, n)
+	for i := range args {
+		sp.skipSeparators()
+		v, err := sp.number()
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return args, nil
+}
+
+// number reads a single number such as "-1.5", ".5" or "1e-3".
+//
+// Numbers may follow each other without separators where unambiguous,
+// e.g. "10-5" (10, -5) and "0.5.5" (0.5, 0.5).
+func (sp *svgPathParser) number() (float64, error) {
+	start := sp.pos
+	i := sp.pos
+
+	if i < len(sp.data) && (sp.data[i] == '+' || sp.data[i] == '-') {
+		i++
+	}
+	digits, dot := false, false
+scan:
+	for ; i < len(sp.data); i++ {
+		ch := sp.data[i]
+		switch {
+		case ch >= '0' && ch <= '9':
+			digits = true
+		case ch == '.' && !dot:
+			dot = true
+		default:
+			break scan
+		}
+	}
+
+	if digits && i < len(sp.data) && (sp.data[i] == 'e' || sp.data[i] == 'E') {
+		j := i + 1
+		if j < len(sp.data) && (sp.data[j] == '+' || sp.data[j] == '-') {
+			j++
+		}
+		if j < len(sp.data) && sp.data[j] >= '0' && sp.data[j] <= '9' {
+			for j < len(sp.data) && sp.data[j] >= '0' && sp.data[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+
+	if !digits {
+		if start >= len(sp.data) {
+			return 0, errors.New("unexpected end of path data")
+		}
+		return 0, fmt.Errorf("expected number at offset %d", start)
+	}
+
+	v, err := strconv.ParseFloat(sp.data[start:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q: %w", sp.data[start:i], err)
+	}
+	sp.pos = i
+	return v, nil
+}
+
+// skipSeparators skips whitespace and commas.
+func (sp *svgPathParser) skipSeparators() {
+	for sp.pos < len(sp.data) {
+		switch sp.data[sp.pos] {
+		case ' ', '\t', '\n', '\r', '\f', ',':
+			sp.pos++
+		default:
+			return
+		}
+	}
+}
+
+// isPathCommand reports whether ch is an SVG path command letter.
+func isPathCommand(ch byte) bool {
+	switch ch {
+	case 'M', 'm', 'L', 'l', 'H', 'h', 'V', 'v', 'C', 'c', 'Q', 'q', 'Z', 'z',
+		'A', 'a', 'S', 's', 'T', 't':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package creator
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/writer"
+)
+
+func TestParseSVGPath(t *testing.T) {
+	tests := []struct {
+		name string
+		d    string
+		want string // PDF operators produced by the path
+	}{
+		{
+			name: "absolute lines",
+			d:    "M10 10 L50 50 Z",
+			want: "10.00 10.00 m\n50.00 50.00 l\nh\n",
+		},
+		{
+			name: "relative and implicit lines",
+			d:    "m10,10 20,0 0,20 z",
+			want: "10.00 10.00 m\n30.00 10.00 l\n30.00 30.00 l\nh\n",
+		},
+		{
+			name: "horizontal and vertical",
+			d:    "M0 0 H10 v5 h-10 V0",
+			want: "0.00 0.00 m\n10.00 0.00 l\n10.00 5.00 l\n0.00 5.00 l\n0.00 0.00 l\n",
+		},
+		{
+			name: "cubic",
+			d:    "M0 0 C10 20 30 20 40 0 c0 -10 -10 -10 -20 0",
+			want: "0.00 0.00 m\n10.00 20.00 30.00 20.00 40.00 0.00 c\n40.00 -10.00 30.00 -10.00 20.00 0.00 c\n",
+		},
+		{
+			name: "quadratic converted to cubic",
+			d:    "M0 0 Q30 30 60 0",
+			want: "0.00 0.00 m\n20.00 20.00 40.00 20.00 60.00 0.00 c\n",
+		},
+		{
+			name: "compact numbers",
+			d:    "M1.5.5L-2-3e1",
+			want: "1.50 0.50 m\n-2.00 -30.00 l\n",
+		},
+		{
+			name: "smooth cubic reflects previous control point",
+			d:    "M0 0 C0 10 10 10 10 0 S20 -10 20 0",
+			want: "0.00 0.00 m\n0.00 10.00 10.00 10.00 10.00 0.00 c\n10.00 -10.00 20.00 -10.00 20.00 0.00 c\n",
+		},
+		{
+			name: "smooth quadratic reflects previous control point",
+			d:    "M0 0 Q15 15 30 0 T60 0",
+			want: "0.00 0.00 m\n10.00 10.00 20.00 10.00 30.00 0.00 c\n40.00 -10.00 50.00 -10.00 60.00 0.00 c\n",
+		},
+		{
+			name: "drawing after close starts at subpath start",
+			d:    "M5 5 L10 5 Z l0 5",
+			want: "5.00 5.00 m\n10.00 5.00 l\nh\n5.00 5.00 m\n5.00 10.00 l\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := ParseSVGPath(tt.d)
+			if err != nil {
+				t.Fatalf("ParseSVGPath(%q) error = %v", tt.d, err)
+			}
+			if got := path.toPDFOperators(); got != tt.want {
+				t.Errorf("ParseSVGPath(%q) =\n%s\nwant:\n%s", tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSVGPath_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		d    string
+	}{
+		{"no initial move", "L10 10"},
+		{"missing argument", "M10"},
+		{"bad number", "M10 x"},
+		{"number without command", "10 10"},
+		{"bad arc flag", "M0 0 A5 5 0 2 1 10 10"},
+		{"number after close", "M0 0 L5 5 Z 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseSVGPath(tt.d); err == nil {
+				t.Errorf("ParseSVGPath(%q) expected error", tt.d)
+			}
+		})
+	}
+}
+
+func TestParseSVGPath_Arc(t *testing.T) {
+	// Half circle of radius 10 from (0,0) to (20,0), flags packed together.
+	path, err := ParseSVGPath("M0 0 A10 10 0 01 20 0")
+	if err != nil {
+		t.Fatalf("ParseSVGPath() error = %v", err)
+	}
+
+	// Two quarter-circle cubics.
+	if len(path.commands) != 3 {
+		t.Fatalf("commands = %d, want 3", len(path.commands))
+	}
+	mid := path.commands[1].args
+	end := path.commands[2].args
+	if math.Abs(mid[4]-10) > 1e-9 || math.Abs(math.Abs(mid[5])-10) > 1e-9 {
+		t.Errorf("arc midpoint = (%.3f, %.3f), want (10, ±10)", mid[4], mid[5])
+	}
+	if end[4] != 20 || end[5] != 0 {
+		t.Errorf("arc end = (%.3f, %.3f), want (20, 0)", end[4], end[5])
+	}
+
+	// Radii too small are scaled up to span the endpoints.
+	path, err = ParseSVGPath("M0 0 A1 1 0 0 1 20 0")
+	if err != nil {
+		t.Fatalf("ParseSVGPath() error = %v", err)
+	}
+	if got := path.Bounds(); math.Abs(got.Height-10) > 0.5 {
+		t.Errorf("scaled arc height = %.2f, want about 10", got.Height)
+	}
+}
+
+func TestDrawPath(t *testing.T) {
+	page := createTestPage(t)
+
+	if err := page.DrawPath("M0 0 L10 0", nil); err == nil {
+		t.Error("expected error for nil options")
+	}
+	if err := page.DrawPath("M0 0 L10 0", &PathOptions{}); err == nil {
+		t.Error("expected error without stroke or fill")
+	}
+	if err := page.DrawPath("", &PathOptions{StrokeColor: &Black}); err == nil {
+		t.Error("expected error for empty path")
+	}
+
+	opts := &PathOptions{FillColor: &Red, StrokeColor: &Black, FillRule: FillRuleEvenOdd}
+	if err := page.DrawPath("M0 0 L10 0 L5 10 Z", opts); err != nil {
+		t.Fatalf("DrawPath() error = %v", err)
+	}
+
+	ops := page.GraphicsOperations()
+	if len(ops) != 1 || ops[0].Type != GraphicsOpPath || ops[0].PathOpts != opts {
+		t.Fatalf("graphics ops = %+v, want one path op", ops)
+	}
+
+	content, _, err := writer.GenerateContentStreamWithGraphics(nil, convertGraphicsOps(ops, make(fontSubsets)))
+	if err != nil {
+		t.Fatalf("GenerateContentStreamWithGraphics() error = %v", err)
+	}
+	for _, want := range []string{" m\n", " l\n", "h\n", "B*\n"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("content stream missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestConvertPath_Transform(t *testing.T) {
+	path, err := ParseSVGPath("M0 0 L10 20")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flip := Scale(1, -1).Then(Translate(100, 200))
+	segs := convertPath(path, &flip)
+	if len(segs) != 2 {
+		t.Fatalf("segments = %d, want 2", len(segs))
+	}
+	if got := segs[1].Points[0]; got.X != 110 || got.Y != 180 {
+		t.Errorf("transformed point = %+v, want {110 180}", got)
+	}
+}
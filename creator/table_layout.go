@@ -1,6 +1,8 @@
 package creator
 
 import (
+	"sort"
+
 	"github.com/coregx/gxpdf/internal/fonts"
 )
 
@@ -21,8 +23,28 @@ type TableCell struct {
 	// Align is the horizontal alignment within the cell.
 	Align Alignment
 
-	// ColSpan is the number of columns this cell spans (future use).
+	// ColSpan is the number of columns this cell spans (0 or 1 = single column).
 	ColSpan int
+
+	// RowSpan is the number of rows this cell spans (0 or 1 = single row).
+	RowSpan int
+
+	// Background is the cell fill color (nil = transparent).
+	Background *Color
+
+	// BorderTop, BorderRight, BorderBottom and BorderLeft override the
+	// table border for individual sides (nil = table default).
+	// A Border with zero width hides that side; with BorderCollapse it
+	// also hides the neighbor's side of the shared edge.
+	BorderTop    *Border
+	BorderRight  *Border
+	BorderBottom *Border
+	BorderLeft   *Border
+
+	// CornerRadius rounds the corners of the cell box (0 = square).
+	// A rounded cell draws its own outline, using the first border set
+	// among top, right, bottom and left.
+	CornerRadius float64
 }
 
 // NewTableCell creates a new table cell with text content and default styling.
@@ -34,14 +56,155 @@ func NewTableCell(content string) TableCell {
 		Color:    Black,
 		Align:    AlignLeft,
 		ColSpan:  1,
+		RowSpan:  1,
 	}
 }
 
+// SetBackground sets the cell fill color.
+// Returns the cell for method chaining.
+func (c *TableCell) SetBackground(color Color) *TableCell {
+	c.Background = &color
+	return c
+}
+
+// SetTextColor sets the cell text color.
+// Returns the cell for method chaining.
+func (c *TableCell) SetTextColor(color Color) *TableCell {
+	c.Color = color
+	return c
+}
+
+// SetFont sets the cell font and size.
+// Returns the cell for method chaining.
+func (c *TableCell) SetFont(font FontName, size float64) *TableCell {
+	c.Font = font
+	c.FontSize = size
+	return c
+}
+
+// SetBorder overrides the table border on all four sides of the cell.
+// Returns the cell for method chaining.
+func (c *TableCell) SetBorder(b Border) *TableCell {
+	c.BorderTop, c.BorderRight, c.BorderBottom, c.BorderLeft = &b, &b, &b, &b
+	return c
+}
+
+// SetBorderTop overrides the table border on the top side of the cell.
+// Returns the cell for method chaining.
+func (c *TableCell) SetBorderTop(b Border) *TableCell {
+	c.BorderTop = &b
+	return c
+}
+
+// SetBorderRight overrides the table border on the right side of the cell.
+// Returns the cell for method chaining.
+func (c *TableCell) SetBorderRight(b Border) *TableCell {
+	c.BorderRight = &b
+	return c
+}
+
+// SetBorderBottom overrides the table border on the bottom side of the cell.
+// Returns the cell for method chaining.
+func (c *TableCell) SetBorderBottom(b Border) *TableCell {
+	c.BorderBottom = &b
+	return c
+}
+
+// SetBorderLeft overrides the table border on the left side of the cell.
+// Returns the cell for method chaining.
+func (c *TableCell) SetBorderLeft(b Border) *TableCell {
+	c.BorderLeft = &b
+	return c
+}
+
+// SetCornerRadius rounds the corners of the cell background and border.
+// Returns the cell for method chaining.
+//
+// Example:
+//
+//	row.Cell(0).SetBackground(LightGray).SetCornerRadius(4)
+func (c *TableCell) SetCornerRadius(radius float64) *TableCell {
+	c.CornerRadius = max(radius, 0)
+	return c
+}
+
+// newHeaderCell creates a table cell with default header styling.
+func newHeaderCell(content string) TableCell {
+	cell := NewTableCell(content)
+	cell.Font = HelveticaBold
+	return cell
+}
+
 // TableRow represents a row in a table.
+//
+// Columns covered by a cell spanning down from a previous row are skipped
+// when the row's cells are placed.
 type TableRow struct {
 	Cells []TableCell
+
+	header bool // cells added via AddCell use header styling
 }
 
+// Cell returns the cell at index i for styling, or nil if out of range.
+//
+// Example:
+//
+//	row := table.NewRow().AddCell("Build").AddCell("FAILED")
+//	row.Cell(1).SetBackground(Red).SetTextColor(White)
+func (r *TableRow) Cell(i int) *TableCell {
+	if i < 0 || i >= len(r.Cells) {
+		return nil
+	}
+	return &r.Cells[i]
+}
+
+// LastCell returns the most recently added cell, or nil if the row is empty.
+func (r *TableRow) LastCell() *TableCell {
+	return r.Cell(len(r.Cells) - 1)
+}
+
+// AddCell adds a single-column cell with default styling.
+// Returns the row for method chaining.
+func (r *TableRow) AddCell(text string) *TableRow {
+	return r.AddCellSpan(text, 1, 1)
+}
+
+// AddCellSpan adds a cell that spans colspan columns and rowspan rows.
+//
+// Values below 1 are treated as 1. Spans are clipped to the table bounds.
+// Returns the row for method chaining.
+//
+// Example:
+//
+//	table := NewTableLayout(3)
+//	table.NewHeaderRow().AddCellSpan("Name", 1, 2).AddCellSpan("Contact", 2, 1)
+//	table.NewHeaderRow().AddCell("Email").AddCell("Phone")
+//	table.NewRow().AddCell("Alice").AddCell("alice@example.com").AddCell("555-0100")
+func (r *TableRow) AddCellSpan(text string, colspan, rowspan int) *TableRow {
+	cell := NewTableCell(text)
+	if r.header {
+		cell = newHeaderCell(text)
+	}
+	cell.ColSpan = max(colspan, 1)
+	cell.RowSpan = max(rowspan, 1)
+	r.Cells = append(r.Cells, cell)
+	return r
+}
+
+// BorderModel selects how borders of adjacent table cells are drawn.
+type BorderModel int
+
+const (
+	// BorderCollapse merges the borders of adjacent cells: each shared
+	// edge is drawn once. When neighbors disagree, a hidden (zero-width)
+	// side wins, then the wider border.
+	BorderCollapse BorderModel = iota
+
+	// BorderSeparate draws a complete box around every cell. Use
+	// SetBorderSpacing to leave a gap between neighboring boxes.
+	BorderSeparate
+)
+
 // TableLayout represents a table that can be drawn on a page.
 //
 // Tables support automatic column width calculation, borders,
@@ -58,13 +221,28 @@ type TableRow struct {
 type TableLayout struct {
 	columns      int
 	columnWidths []float64 // nil = auto
-	rows         []TableRow
+	rows         []*TableRow
 	borderWidth  float64
 	borderColor  *Color
 	headerRows   int
 	cellPadding  float64 // padding inside cells
+	repeatHeader bool    // repeat header rows on continuation pages
+	borderModel  BorderModel
+	spacing      float64 // gap between cells (BorderSeparate only)
+
+	// Continuation labels of a table split across pages ("" = none).
+	continueNext string // below each part followed by another
+	continuePrev string // above each part after the first
+	splitNext    bool   // this part is followed by another
+	splitPrev    bool   // this part follows another
 }
 
+// Continuation label appearance.
+const (
+	continuationFont = HelveticaOblique
+	continuationSize = 8.0
+)
+
 // NewTableLayout creates a new table with the specified number of columns.
 func NewTableLayout(columns int) *TableLayout {
 	if columns < 1 {
@@ -72,7 +250,7 @@ func NewTableLayout(columns int) *TableLayout {
 	}
 	return &TableLayout{
 		columns:     columns,
-		rows:        make([]TableRow, 0),
+		rows:        make([]*TableRow, 0),
 		borderWidth: 0,
 		borderColor: nil,
 		headerRows:  0,
@@ -96,6 +274,27 @@ func (t *TableLayout) SetBorder(width float64, color Color) *TableLayout {
 	return t
 }
 
+// SetBorderModel sets how borders of adjacent cells are drawn.
+// The default is BorderCollapse.
+// Returns the table for method chaining.
+func (t *TableLayout) SetBorderModel(model BorderModel) *TableLayout {
+	t.borderModel = model
+	return t
+}
+
+// SetBorderSpacing sets the gap between cell boxes in points.
+// It only applies to the BorderSeparate model.
+// Returns the table for method chaining.
+func (t *TableLayout) SetBorderSpacing(spacing float64) *TableLayout {
+	t.spacing = spacing
+	return t
+}
+
+// BorderModel returns the border model.
+func (t *TableLayout) BorderModel() BorderModel {
+	return t.borderModel
+}
+
 // SetCellPadding sets the padding inside cells.
 // Returns the table for method chaining.
 func (t *TableLayout) SetCellPadding(padding float64) *TableLayout {
@@ -103,48 +302,86 @@ func (t *TableLayout) SetCellPadding(padding float64) *TableLayout {
 	return t
 }
 
+// SetRepeatHeader controls whether header rows are repeated at the top of
+// each continuation page when the table is split across pages by
+// Creator.Draw.
+// Returns the table for method chaining.
+func (t *TableLayout) SetRepeatHeader(repeat bool) *TableLayout {
+	t.repeatHeader = repeat
+	return t
+}
+
+// RepeatHeader reports whether header rows are repeated on continuation pages.
+func (t *TableLayout) RepeatHeader() bool {
+	return t.repeatHeader
+}
+
+// SetContinuationLabels sets the labels drawn when the table is split
+// across pages by Creator.Draw: next below each part that continues on the
+// next page, prev above each part continued from the previous page.
+// An empty label is not drawn.
+// Returns the table for method chaining.
+//
+// Example:
+//
+//	table.SetContinuationLabels("Continued on next page", "Continued from previous page")
+func (t *TableLayout) SetContinuationLabels(next, prev string) *TableLayout {
+	t.continueNext = next
+	t.continuePrev = prev
+	return t
+}
+
+// ContinuationLabels returns the labels set by SetContinuationLabels.
+func (t *TableLayout) ContinuationLabels() (next, prev string) {
+	return t.continueNext, t.continuePrev
+}
+
 // AddHeaderRow adds a header row with the given cell texts.
 // Header rows use bold font by default.
 // Returns the table for method chaining.
 func (t *TableLayout) AddHeaderRow(cells ...string) *TableLayout {
-	row := TableRow{
-		Cells: make([]TableCell, len(cells)),
-	}
-	for i, content := range cells {
-		row.Cells[i] = TableCell{
-			Content:  content,
-			Font:     HelveticaBold,
-			FontSize: 10,
-			Color:    Black,
-			Align:    AlignLeft,
-			ColSpan:  1,
-		}
+	row := t.NewHeaderRow()
+	for _, content := range cells {
+		row.AddCell(content)
 	}
+	return t
+}
+
+// NewHeaderRow adds an empty header row and returns it for adding cells.
+// Cells added to the row use bold font by default.
+// Header rows must be added before body rows.
+func (t *TableLayout) NewHeaderRow() *TableRow {
+	row := &TableRow{header: true}
 	t.rows = append(t.rows, row)
 	t.headerRows++
-	return t
+	return row
+}
+
+// NewRow adds an empty row and returns it for adding cells.
+//
+// Example:
+//
+//	table.NewRow().AddCellSpan("Total", 2, 1).AddCell("42")
+func (t *TableLayout) NewRow() *TableRow {
+	row := &TableRow{}
+	t.rows = append(t.rows, row)
+	return row
 }
 
 // AddRow adds a row with the given cell texts using default styling.
 // Returns the table for method chaining.
 func (t *TableLayout) AddRow(cells ...string) *TableLayout {
-	row := TableRow{
-		Cells: make([]TableCell, len(cells)),
-	}
-	for i, content := range cells {
-		row.Cells[i] = NewTableCell(content)
+	row := t.NewRow()
+	for _, content := range cells {
+		row.Cells = append(row.Cells, NewTableCell(content))
 	}
-	t.rows = append(t.rows, row)
 	return t
 }
 
 // AddRowCells adds a row with fully-configured cells.
 // Returns the table for method chaining.
 func (t *TableLayout) AddRowCells(cells ...TableCell) *TableLayout {
-	row := TableRow{
-		Cells: cells,
-	}
-	t.rows = append(t.rows, row)
+	t.rows = append(t.rows, &TableRow{Cells: cells})
 	return t
 }
 
@@ -158,6 +395,11 @@ func (t *TableLayout) RowCount() int {
 	return len(t.rows)
 }
 
+// Rows returns the table rows (including header rows).
+func (t *TableLayout) Rows() []*TableRow {
+	return t.rows
+}
+
 // HeaderRowCount returns the number of header rows.
 func (t *TableLayout) HeaderRowCount() int {
 	return t.headerRows
@@ -169,8 +411,12 @@ func (t *TableLayout) Height(_ *LayoutContext) float64 {
 		return 0
 	}
 
-	rowHeight := t.calculateRowHeight()
-	totalHeight := float64(len(t.rows)) * rowHeight
+	return t.rowsHeight() + t.labelHeights()
+}
+
+// rowsHeight returns the height of the rows and the bottom border.
+func (t *TableLayout) rowsHeight() float64 {
+	totalHeight := float64(len(t.rows)) * t.calculateRowHeight()
 
 	// Add border widths if borders are enabled.
 	if t.borderWidth > 0 {
@@ -180,39 +426,165 @@ func (t *TableLayout) Height(_ *LayoutContext) float64 {
 	return totalHeight
 }
 
+// labelHeights returns the height of the continuation labels drawn with
+// this part of the table.
+func (t *TableLayout) labelHeights() float64 {
+	var height float64
+	if t.splitPrev {
+		height += continuationLabelHeight(t.continuePrev)
+	}
+	if t.splitNext {
+		height += continuationLabelHeight(t.continueNext)
+	}
+	return height
+}
+
+// continuationLabelHeight returns the height of a continuation label line.
+func continuationLabelHeight(label string) float64 {
+	if label == "" {
+		return 0
+	}
+	return continuationSize * 1.2
+}
+
 // Draw renders the table on the page at the current cursor position.
 func (t *TableLayout) Draw(ctx *LayoutContext, page *Page) error {
 	if len(t.rows) == 0 {
 		return nil
 	}
 
+	if t.splitPrev {
+		if err := t.drawContinuationLabel(ctx, page, t.continuePrev); err != nil {
+			return err
+		}
+	}
+
 	colWidths := t.calculateColumnWidths(ctx.AvailableWidth())
 	rowHeight := t.calculateRowHeight()
 	startX := ctx.ContentLeft()
 	startY := ctx.CurrentPDFY()
 
-	// Draw rows.
-	for rowIdx, row := range t.rows {
-		y := startY - float64(rowIdx)*rowHeight
+	placements := t.placeCells()
 
-		if err := t.drawRow(page, row, startX, y, colWidths, rowHeight); err != nil {
-			return err
-		}
+	// Draw cell backgrounds.
+	if err := t.drawBackgrounds(page, placements, startX, startY, colWidths, rowHeight); err != nil {
+		return err
 	}
 
-	// Draw borders if enabled.
-	if t.borderWidth > 0 && t.borderColor != nil {
-		if err := t.drawBorders(page, startX, startY, colWidths, rowHeight); err != nil {
+	// Draw cell contents.
+	for _, pl := range placements {
+		if pl.filler {
+			continue
+		}
+		x, y, width, _ := pl.bounds(startX, startY, colWidths, rowHeight)
+		if err := t.drawCell(page, pl.cell, x, y, width); err != nil {
 			return err
 		}
 	}
 
+	// Draw borders (table default and per-cell overrides).
+	if err := t.drawBorders(page, placements, startX, startY, colWidths, rowHeight); err != nil {
+		return err
+	}
+
 	// Update cursor position.
-	ctx.CursorY += t.Height(ctx)
+	ctx.CursorY += t.rowsHeight()
 
+	if t.splitNext {
+		return t.drawContinuationLabel(ctx, page, t.continueNext)
+	}
 	return nil
 }
 
+// drawContinuationLabel draws a continuation label right-aligned at the
+// cursor and advances the cursor past it.
+func (t *TableLayout) drawContinuationLabel(ctx *LayoutContext, page *Page, label string) error {
+	if label == "" {
+		return nil
+	}
+	width := fonts.MeasureString(string(continuationFont), label, continuationSize)
+	x := ctx.ContentRight() - width
+	y := ctx.CurrentPDFY() - continuationSize
+	if err := page.addTextColor(label, x, y, continuationFont, continuationSize, Black); err != nil {
+		return err
+	}
+	ctx.CursorY += continuationLabelHeight(label)
+	return nil
+}
+
+// Split divides the table at a row boundary so that the head fits within
+// the given height. It implements SplittableDrawable.
+//
+// Header rows are never left alone at the bottom of a page. When
+// SetRepeatHeader is enabled, the tail starts with a copy of the header rows.
+// The head and tail make room for the labels set by SetContinuationLabels.
+//
+// Cells spanning several rows are kept whole. Only a span taller than a
+// whole page is broken by Creator.Draw; its cell continues, empty, at the
+// top of the next page.
+func (t *TableLayout) Split(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	return t.split(ctx, height, false)
+}
+
+// splitForced splits the table at the last row that fits, even through
+// cells spanning several rows. The tail continues the spanned cells.
+func (t *TableLayout) splitForced(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	return t.split(ctx, height, true)
+}
+
+// split divides the table at a row boundary; force allows cutting through
+// cells spanning several rows.
+func (t *TableLayout) split(ctx *LayoutContext, height float64, force bool) (head, tail Drawable) {
+	if t.Height(ctx) <= height {
+		return t, nil
+	}
+
+	rowHeight := t.calculateRowHeight()
+	available := height - t.borderWidth - continuationLabelHeight(t.continueNext)
+	if t.splitPrev {
+		available -= continuationLabelHeight(t.continuePrev)
+	}
+	fit := min(int(available/rowHeight), len(t.rows)-1)
+
+	// Don't break through cells spanning several rows.
+	if !force {
+		fit = t.spanSafeRow(fit)
+	}
+
+	// Keep at least one body row with the header.
+	if fit <= t.headerRows {
+		return nil, t
+	}
+
+	headPart := t.withRows(t.rows[:fit], t.headerRows)
+	headPart.splitNext = true
+
+	rest := make([]*TableRow, 0, len(t.rows)-fit)
+	rest = append(rest, t.continueSpans(fit))
+	rest = append(rest, t.rows[fit+1:]...)
+
+	var tailPart *TableLayout
+	if t.repeatHeader {
+		rows := make([]*TableRow, 0, t.headerRows+len(rest))
+		rows = append(rows, t.rows[:t.headerRows]...)
+		rows = append(rows, rest...)
+		tailPart = t.withRows(rows, t.headerRows)
+	} else {
+		tailPart = t.withRows(rest, 0)
+	}
+	tailPart.splitPrev = true
+
+	return headPart, tailPart
+}
+
+// withRows returns a copy of the table holding the given rows.
+func (t *TableLayout) withRows(rows []*TableRow, headerRows int) *TableLayout {
+	part := *t
+	part.rows = append([]*TableRow(nil), rows...)
+	part.headerRows = headerRows
+	return &part
+}
+
 // calculateRowHeight returns the height of one row.
 func (t *TableLayout) calculateRowHeight() float64 {
 	// Find the maximum font size across all cells.
@@ -262,32 +634,131 @@ func (t *TableLayout) calculateColumnWidths(availableWidth float64) []float64 {
 	return widths
 }
 
-// drawRow draws a single row at the specified position.
-func (t *TableLayout) drawRow(
-	page *Page,
-	row TableRow,
-	startX, y float64,
-	colWidths []float64,
-	_ float64, // rowHeight reserved for future multi-line cell support
-) error {
-	x := startX
+// cellPlacement is a cell positioned in the table grid.
+type cellPlacement struct {
+	cell    TableCell
+	row     int
+	col     int
+	colSpan int
+	rowSpan int
+	filler  bool // grid position without a cell (border only)
+}
 
-	for colIdx := 0; colIdx < t.columns && colIdx < len(row.Cells); colIdx++ {
-		cell := row.Cells[colIdx]
-		colWidth := colWidths[colIdx]
+// bounds returns the cell rectangle in PDF coordinates: left X, top Y,
+// width and height.
+func (pl cellPlacement) bounds(startX, startY float64, colWidths []float64, rowHeight float64) (x, y, width, height float64) {
+	x = startX
+	for i := 0; i < pl.col; i++ {
+		x += colWidths[i]
+	}
+	for i := pl.col; i < pl.col+pl.colSpan; i++ {
+		width += colWidths[i]
+	}
+	y = startY - float64(pl.row)*rowHeight
+	height = float64(pl.rowSpan) * rowHeight
+	return x, y, width, height
+}
 
-		// Calculate text position within cell.
-		textX := t.calculateCellTextX(x, colWidth, cell)
-		textY := y - t.cellPadding - cell.FontSize // baseline
+// placeCells assigns each cell to its grid position, honoring column
+// and row spans. Columns covered by a span from an earlier row are skipped.
+func (t *TableLayout) placeCells() []cellPlacement {
+	occupied := make([][]bool, len(t.rows))
+	for i := range occupied {
+		occupied[i] = make([]bool, t.columns)
+	}
 
-		if err := page.AddTextColor(cell.Content, textX, textY, cell.Font, cell.FontSize, cell.Color); err != nil {
-			return err
+	var placements []cellPlacement
+	for rowIdx, row := range t.rows {
+		col := 0
+		for _, cell := range row.Cells {
+			for col < t.columns && occupied[rowIdx][col] {
+				col++
+			}
+			if col >= t.columns {
+				break
+			}
+
+			colSpan := min(max(cell.ColSpan, 1), t.columns-col)
+			rowSpan := min(max(cell.RowSpan, 1), len(t.rows)-rowIdx)
+			for r := rowIdx; r < rowIdx+rowSpan; r++ {
+				for c := col; c < col+colSpan; c++ {
+					occupied[r][c] = true
+				}
+			}
+
+			placements = append(placements, cellPlacement{
+				cell:    cell,
+				row:     rowIdx,
+				col:     col,
+				colSpan: colSpan,
+				rowSpan: rowSpan,
+			})
+			col += colSpan
+		}
+	}
+
+	// Empty grid positions still get their borders.
+	for rowIdx := range occupied {
+		for col, used := range occupied[rowIdx] {
+			if !used {
+				placements = append(placements, cellPlacement{
+					row: rowIdx, col: col, colSpan: 1, rowSpan: 1, filler: true,
+				})
+			}
 		}
+	}
 
-		x += colWidth
+	return placements
+}
+
+// spanSafeRow returns the largest row index <= fit at which the table can
+// be split without cutting through a cell that spans several rows.
+func (t *TableLayout) spanSafeRow(fit int) int {
+	for _, pl := range t.placeCells() {
+		if !pl.filler && pl.row < fit && pl.row+pl.rowSpan > fit {
+			return t.spanSafeRow(pl.row)
+		}
 	}
+	return fit
+}
 
-	return nil
+// continueSpans returns a copy of row fit, the first row of the tail of a
+// table split there, with empty continuations of the cells that span
+// across the split. The rest of a span keeps its columns and styling.
+func (t *TableLayout) continueSpans(fit int) *TableRow {
+	type placedCell struct {
+		col  int
+		cell TableCell
+	}
+	var cells []placedCell
+	for _, pl := range t.placeCells() {
+		switch {
+		case pl.filler:
+		case pl.row == fit:
+			cells = append(cells, placedCell{pl.col, pl.cell})
+		case pl.row < fit && pl.row+pl.rowSpan > fit:
+			cell := pl.cell
+			cell.Content = ""
+			cell.RowSpan = pl.row + pl.rowSpan - fit
+			cells = append(cells, placedCell{pl.col, cell})
+		}
+	}
+	sort.Slice(cells, func(i, j int) bool { return cells[i].col < cells[j].col })
+
+	row := *t.rows[fit]
+	row.Cells = make([]TableCell, len(cells))
+	for i, c := range cells {
+		row.Cells[i] = c.cell
+	}
+	return &row
+}
+
+// drawCell draws the content of a single cell whose top-left corner is (x, y).
+func (t *TableLayout) drawCell(page *Page, cell TableCell, x, y, width float64) error {
+	textX := t.calculateCellTextX(x, width, cell)
+	textY := y - t.cellPadding - cell.FontSize // baseline
+
+	return page.addTextColor(cell.Content, textX, textY, cell.Font, cell.FontSize, cell.Color)
 }
 
 // calculateCellTextX calculates the X position for text within a cell.
@@ -305,42 +776,330 @@ func (t *TableLayout) calculateCellTextX(cellX, cellWidth float64, cell TableCel
 	}
 }
 
-// drawBorders draws the table borders.
+// drawBackgrounds fills the cells that have a background color.
+func (t *TableLayout) drawBackgrounds(
+	page *Page,
+	placements []cellPlacement,
+	startX, startY float64,
+	colWidths []float64,
+	rowHeight float64,
+) error {
+	for _, pl := range placements {
+		if pl.cell.Background == nil {
+			continue
+		}
+		x, y, width, height := t.boxBounds(pl, startX, startY, colWidths, rowHeight)
+		if pl.cell.CornerRadius > 0 {
+			path := NewPath().AddRoundedRect(Rect{X: x, Y: y - height, Width: width, Height: height}, pl.cell.CornerRadius)
+			if err := page.drawPath(path, &PathOptions{FillColor: pl.cell.Background}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := page.drawRect(x, y-height, width, height, &RectOptions{FillColor: pl.cell.Background}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultBorder returns the table-wide border, or nil if borders are disabled.
+func (t *TableLayout) defaultBorder() *Border {
+	if t.borderWidth <= 0 || t.borderColor == nil {
+		return nil
+	}
+	return &Border{Width: t.borderWidth, Color: *t.borderColor}
+}
+
+// cellBorder returns the border to draw for one side of a cell, or nil.
+func cellBorder(override, fallback *Border) *Border {
+	b := fallback
+	if override != nil {
+		b = override
+	}
+	if b == nil || b.Width <= 0 {
+		return nil
+	}
+	return b
+}
+
+// hidesSide reports whether a border override hides its side (zero width).
+func hidesSide(override *Border) bool {
+	return override != nil && override.Width <= 0
+}
+
+// outlineBorder returns the border used for a rounded cell's outline:
+// the first visible side among top, right, bottom and left.
+func outlineBorder(cell TableCell, fallback *Border) *Border {
+	for _, side := range []*Border{cell.BorderTop, cell.BorderRight, cell.BorderBottom, cell.BorderLeft} {
+		if b := cellBorder(side, fallback); b != nil {
+			return b
+		}
+	}
+	return nil
+}
+
+// drawRoundedOutline strokes the rounded box of a cell.
+func (t *TableLayout) drawRoundedOutline(
+	page *Page,
+	pl cellPlacement,
+	startX, startY float64,
+	colWidths []float64,
+	rowHeight float64,
+) error {
+	b := outlineBorder(pl.cell, t.defaultBorder())
+	if b == nil {
+		return nil
+	}
+	x, y, width, height := t.boxBounds(pl, startX, startY, colWidths, rowHeight)
+	path := NewPath().AddRoundedRect(Rect{X: x, Y: y - height, Width: width, Height: height}, pl.cell.CornerRadius)
+	opts := &PathOptions{StrokeColor: &b.Color, StrokeWidth: b.Width}
+	if b.Dashed {
+		opts.Dashed = true
+		opts.DashArray = b.dashArray()
+	}
+	return page.drawPath(path, opts)
+}
+
+// boxBounds returns the rectangle of a cell's box (background and borders).
+//
+// With BorderSeparate, the box is inset by half the border spacing on each
+// side so that neighboring boxes are spacing points apart.
+func (t *TableLayout) boxBounds(
+	pl cellPlacement,
+	startX, startY float64,
+	colWidths []float64,
+	rowHeight float64,
+) (x, y, width, height float64) {
+	x, y, width, height = pl.bounds(startX, startY, colWidths, rowHeight)
+	if t.borderModel == BorderSeparate && t.spacing > 0 {
+		inset := t.spacing / 2
+		x += inset
+		y -= inset
+		width = max(width-t.spacing, 0)
+		height = max(height-t.spacing, 0)
+	}
+	return x, y, width, height
+}
+
+// drawBorders draws the cell borders according to the border model.
+//
+// Spanned cells get a single box, so no lines cross merged areas.
+// Per-cell border overrides take precedence over the table border.
 func (t *TableLayout) drawBorders(
 	page *Page,
+	placements []cellPlacement,
 	startX, startY float64,
 	colWidths []float64,
 	rowHeight float64,
 ) error {
-	totalWidth := 0.0
-	for _, w := range colWidths {
-		totalWidth += w
+	if t.borderModel == BorderSeparate {
+		return t.drawSeparateBorders(page, placements, startX, startY, colWidths, rowHeight)
 	}
-	totalHeight := float64(len(t.rows)) * rowHeight
+	return t.drawCollapsedBorders(page, placements, startX, startY, colWidths, rowHeight)
+}
 
-	opts := &LineOptions{
-		Color: *t.borderColor,
-		Width: t.borderWidth,
+// drawSeparateBorders draws a complete box around every cell.
+func (t *TableLayout) drawSeparateBorders(
+	page *Page,
+	placements []cellPlacement,
+	startX, startY float64,
+	colWidths []float64,
+	rowHeight float64,
+) error {
+	def := t.defaultBorder()
+
+	for _, pl := range placements {
+		if pl.cell.CornerRadius > 0 {
+			if err := t.drawRoundedOutline(page, pl, startX, startY, colWidths, rowHeight); err != nil {
+				return err
+			}
+			continue
+		}
+
+		x, y, width, height := t.boxBounds(pl, startX, startY, colWidths, rowHeight)
+		bottom := y - height
+		right := x + width
+
+		edges := []struct {
+			border         *Border
+			x1, y1, x2, y2 float64
+		}{
+			{cellBorder(pl.cell.BorderTop, def), x, y, right, y},
+			{cellBorder(pl.cell.BorderRight, def), right, y, right, bottom},
+			{cellBorder(pl.cell.BorderBottom, def), x, bottom, right, bottom},
+			{cellBorder(pl.cell.BorderLeft, def), x, y, x, bottom},
+		}
+		for _, e := range edges {
+			if e.border == nil {
+				continue
+			}
+			if err := page.drawLine(e.x1, e.y1, e.x2, e.y2, e.border.lineOptions()); err != nil {
+				return err
+			}
+		}
 	}
 
-	// Draw horizontal lines.
-	for i := 0; i <= len(t.rows); i++ {
-		y := startY - float64(i)*rowHeight
-		if err := page.DrawLine(startX, y, startX+totalWidth, y, opts); err != nil {
+	return nil
+}
+
+// gridEdge identifies one unit segment of the table grid.
+//
+// Horizontal segments lie on grid line `line` (0 = top) and cover column
+// `unit`. Vertical segments lie on grid line `line` (0 = left) and cover
+// row `unit`.
+type gridEdge struct {
+	vertical bool
+	line     int
+	unit     int
+}
+
+// drawCollapsedBorders draws every grid edge once.
+//
+// Each cell side claims the unit segments it covers; when two cells claim
+// the same segment, the wider border wins. A side hidden with a zero-width
+// border hides the segment for both cells sharing it. Consecutive segments
+// with the same border are then joined into a single line. Rounded cells
+// draw their own outline; the grid segments around them are left empty so
+// no straight line crosses their corners.
+func (t *TableLayout) drawCollapsedBorders(
+	page *Page,
+	placements []cellPlacement,
+	startX, startY float64,
+	colWidths []float64,
+	rowHeight float64,
+) error {
+	def := t.defaultBorder()
+	edges := make(map[gridEdge]*Border)
+
+	reserved := make(map[gridEdge]bool)
+
+	claim := func(e gridEdge, b *Border) {
+		if b == nil || reserved[e] {
+			return
+		}
+		if cur, ok := edges[e]; !ok || b.Width > cur.Width {
+			edges[e] = b
+		}
+	}
+
+	for _, pl := range placements {
+		if pl.cell.CornerRadius <= 0 {
+			continue
+		}
+		for c := pl.col; c < pl.col+pl.colSpan; c++ {
+			reserved[gridEdge{line: pl.row, unit: c}] = true
+			reserved[gridEdge{line: pl.row + pl.rowSpan, unit: c}] = true
+		}
+		for r := pl.row; r < pl.row+pl.rowSpan; r++ {
+			reserved[gridEdge{vertical: true, line: pl.col, unit: r}] = true
+			reserved[gridEdge{vertical: true, line: pl.col + pl.colSpan, unit: r}] = true
+		}
+	}
+
+	// Hidden sides win over the neighbor's border.
+	hide := func(e gridEdge, override *Border) {
+		if hidesSide(override) {
+			reserved[e] = true
+		}
+	}
+	for _, pl := range placements {
+		top, bottom := pl.row, pl.row+pl.rowSpan
+		left, right := pl.col, pl.col+pl.colSpan
+		for c := left; c < right; c++ {
+			hide(gridEdge{line: top, unit: c}, pl.cell.BorderTop)
+			hide(gridEdge{line: bottom, unit: c}, pl.cell.BorderBottom)
+		}
+		for r := top; r < bottom; r++ {
+			hide(gridEdge{vertical: true, line: left, unit: r}, pl.cell.BorderLeft)
+			hide(gridEdge{vertical: true, line: right, unit: r}, pl.cell.BorderRight)
+		}
+	}
+
+	for _, pl := range placements {
+		top, bottom := pl.row, pl.row+pl.rowSpan
+		left, right := pl.col, pl.col+pl.colSpan
+		for c := left; c < right; c++ {
+			claim(gridEdge{line: top, unit: c}, cellBorder(pl.cell.BorderTop, def))
+			claim(gridEdge{line: bottom, unit: c}, cellBorder(pl.cell.BorderBottom, def))
+		}
+		for r := top; r < bottom; r++ {
+			claim(gridEdge{vertical: true, line: left, unit: r}, cellBorder(pl.cell.BorderLeft, def))
+			claim(gridEdge{vertical: true, line: right, unit: r}, cellBorder(pl.cell.BorderRight, def))
+		}
+	}
+
+	// Grid line positions.
+	xs := make([]float64, t.columns+1)
+	xs[0] = startX
+	for i, w := range colWidths {
+		xs[i+1] = xs[i] + w
+	}
+	ys := make([]float64, len(t.rows)+1)
+	for i := range ys {
+		ys[i] = startY - float64(i)*rowHeight
+	}
+
+	// Horizontal lines, joined along each grid line.
+	for line := range ys {
+		if err := drawGridLine(page, edges, false, line, t.columns, func(from, to int) (x1, y1, x2, y2 float64) {
+			return xs[from], ys[line], xs[to], ys[line]
+		}); err != nil {
 			return err
 		}
 	}
 
-	// Draw vertical lines.
-	x := startX
-	for i := 0; i <= t.columns; i++ {
-		if err := page.DrawLine(x, startY, x, startY-totalHeight, opts); err != nil {
+	// Vertical lines, joined along each grid line.
+	for line := range xs {
+		if err := drawGridLine(page, edges, true, line, len(t.rows), func(from, to int) (x1, y1, x2, y2 float64) {
+			return xs[line], ys[from], xs[line], ys[to]
+		}); err != nil {
 			return err
 		}
-		if i < t.columns {
-			x += colWidths[i]
+	}
+
+	for _, pl := range placements {
+		if pl.cell.CornerRadius <= 0 {
+			continue
+		}
+		if err := t.drawRoundedOutline(page, pl, startX, startY, colWidths, rowHeight); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
+
+// drawGridLine draws the claimed segments of one grid line, joining runs
+// of consecutive segments that share the same border style.
+func drawGridLine(
+	page *Page,
+	edges map[gridEdge]*Border,
+	vertical bool,
+	line, units int,
+	coords func(from, to int) (x1, y1, x2, y2 float64),
+) error {
+	for unit := 0; unit < units; {
+		b := edges[gridEdge{vertical: vertical, line: line, unit: unit}]
+		if b == nil {
+			unit++
+			continue
+		}
+
+		end := unit + 1
+		for end < units {
+			next := edges[gridEdge{vertical: vertical, line: line, unit: end}]
+			if next == nil || !next.sameStyle(b) {
+				break
+			}
+			end++
+		}
+
+		x1, y1, x2, y2 := coords(unit, end)
+		if err := page.drawLine(x1, y1, x2, y2, b.lineOptions()); err != nil {
+			return err
+		}
+		unit = end
+	}
+	return nil
+}
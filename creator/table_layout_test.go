@@ -1,6 +1,7 @@
 package creator
 
 import (
+	"strconv"
 	"testing"
 )
 
@@ -460,3 +461,519 @@ func TestTableLayout_CellAlignment(t *testing.T) {
 		t.Error("Text X positions should increase for different columns")
 	}
 }
+
+func TestTableLayout_ImplementsSplittableDrawable(_ *testing.T) {
+	var _ SplittableDrawable = (*TableLayout)(nil)
+}
+
+func TestTableLayout_Split(t *testing.T) {
+	newTable := func() *TableLayout {
+		table := NewTableLayout(2).AddHeaderRow("Name", "Value")
+		for i := 0; i < 9; i++ {
+			table.AddRow("Item", "1")
+		}
+		return table // 10 rows of 18pt
+	}
+
+	tests := []struct {
+		name         string
+		repeat       bool
+		height       float64
+		wantHead     int // rows in head, 0 = nil
+		wantTail     int // rows in tail, 0 = nil
+		wantTailHead int // header rows in tail
+	}{
+		{"fits", false, 200, 10, 0, 0},
+		{"split", false, 80, 4, 6, 0},
+		{"header only fits", false, 20, 0, 10, 1},
+		{"repeat header", true, 80, 4, 7, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := newTable().SetRepeatHeader(tt.repeat)
+
+			head, tail := table.Split(nil, tt.height)
+
+			if got := tableRows(head); got != tt.wantHead {
+				t.Errorf("head rows = %d, want %d", got, tt.wantHead)
+			}
+			if got := tableRows(tail); got != tt.wantTail {
+				t.Errorf("tail rows = %d, want %d", got, tt.wantTail)
+			}
+			if tail != nil && tail.(*TableLayout).HeaderRowCount() != tt.wantTailHead {
+				t.Errorf("tail header rows = %d, want %d", tail.(*TableLayout).HeaderRowCount(), tt.wantTailHead)
+			}
+		})
+	}
+}
+
+func tableRows(d Drawable) int {
+	if d == nil {
+		return 0
+	}
+	return d.(*TableLayout).RowCount()
+}
+
+func TestCreator_Draw_TableRepeatsHeader(t *testing.T) {
+	c := New()
+
+	table := NewTableLayout(2).SetRepeatHeader(true).AddHeaderRow("Name", "Value")
+	for i := 0; i < 100; i++ {
+		table.AddRow("Item", "1")
+	}
+	if err := c.Draw(table); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() < 2 {
+		t.Fatalf("PageCount() = %d, want at least 2", c.PageCount())
+	}
+	for i, page := range c.pages {
+		ops := page.TextOperations()
+		if len(ops) == 0 || ops[0].Text != "Name" {
+			t.Errorf("page %d should start with the header row", i+1)
+		}
+	}
+}
+
+func TestTableLayout_Split_ContinuationLabels(t *testing.T) {
+	table := NewTableLayout(1).SetContinuationLabels("Continued on next page", "Continued from previous page")
+	for i := 0; i < 6; i++ {
+		table.AddRow("Row")
+	}
+	if next, prev := table.ContinuationLabels(); next != "Continued on next page" || prev != "Continued from previous page" {
+		t.Errorf("ContinuationLabels() = %q, %q", next, prev)
+	}
+
+	// Room for 4 rows, minus the label line below the head.
+	head, tail := table.Split(nil, 18*4)
+	if tableRows(head) != 3 || tableRows(tail) != 3 {
+		t.Fatalf("split = %d/%d rows, want 3/3", tableRows(head), tableRows(tail))
+	}
+	label := continuationLabelHeight("x")
+	if h := head.Height(nil); h != 18*3+label {
+		t.Errorf("head Height() = %v, want %v", h, 18*3+label)
+	}
+	if h := tail.Height(nil); h != 18*3+label {
+		t.Errorf("tail Height() = %v, want %v", h, 18*3+label)
+	}
+}
+
+func TestCreator_Draw_TableContinuationLabels(t *testing.T) {
+	c := New()
+
+	table := NewTableLayout(2).SetContinuationLabels("Continued on next page", "Continued from previous page")
+	for i := 0; i < 100; i++ {
+		table.AddRow("Item", "1")
+	}
+	if err := c.Draw(table); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() < 2 {
+		t.Fatalf("PageCount() = %d, want at least 2", c.PageCount())
+	}
+	for i, page := range c.pages {
+		ops := page.TextOperations()
+		first, last := ops[0].Text, ops[len(ops)-1].Text
+		if i > 0 && first != "Continued from previous page" {
+			t.Errorf("page %d starts with %q, want the previous-page label", i+1, first)
+		}
+		if i < len(c.pages)-1 && last != "Continued on next page" {
+			t.Errorf("page %d ends with %q, want the next-page label", i+1, last)
+		}
+		if i == len(c.pages)-1 && last == "Continued on next page" {
+			t.Errorf("last page should not have the next-page label")
+		}
+		if last := ops[len(ops)-1]; last.Y < page.margins.Bottom {
+			t.Errorf("page %d: text at y=%v below the bottom margin", i+1, last.Y)
+		}
+	}
+}
+
+func TestTableRow_AddCellSpan(t *testing.T) {
+	table := NewTableLayout(3)
+	row := table.NewHeaderRow().AddCellSpan("Name", 1, 2).AddCellSpan("Contact", 2, 1)
+
+	if len(row.Cells) != 2 {
+		t.Fatalf("Cells count = %d, want 2", len(row.Cells))
+	}
+	if row.Cells[1].ColSpan != 2 || row.Cells[1].RowSpan != 1 {
+		t.Errorf("span = %dx%d, want 2x1", row.Cells[1].ColSpan, row.Cells[1].RowSpan)
+	}
+	if row.Cells[0].Font != HelveticaBold {
+		t.Errorf("header cell font = %v, want HelveticaBold", row.Cells[0].Font)
+	}
+	if table.HeaderRowCount() != 1 {
+		t.Errorf("HeaderRowCount() = %d, want 1", table.HeaderRowCount())
+	}
+
+	cell := table.NewRow().AddCellSpan("X", 0, -1).Cells[0]
+	if cell.ColSpan != 1 || cell.RowSpan != 1 {
+		t.Errorf("invalid spans should be clamped to 1, got %dx%d", cell.ColSpan, cell.RowSpan)
+	}
+}
+
+func TestTableLayout_PlaceCells_Spans(t *testing.T) {
+	table := NewTableLayout(3)
+	table.NewHeaderRow().AddCellSpan("Name", 1, 2).AddCellSpan("Contact", 2, 1)
+	table.NewHeaderRow().AddCell("Email").AddCell("Phone")
+	table.NewRow().AddCell("Alice").AddCell("a@example.com").AddCell("555")
+
+	want := map[string][2]int{
+		"Name":    {0, 0},
+		"Contact": {0, 1},
+		"Email":   {1, 1}, // column 0 is covered by "Name"
+		"Phone":   {1, 2},
+		"Alice":   {2, 0},
+	}
+
+	for _, pl := range table.placeCells() {
+		if pos, ok := want[pl.cell.Content]; ok && (pl.row != pos[0] || pl.col != pos[1]) {
+			t.Errorf("%s placed at (%d,%d), want (%d,%d)", pl.cell.Content, pl.row, pl.col, pos[0], pos[1])
+		}
+	}
+}
+
+func TestTableLayout_Draw_SpannedCell(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(2).SetColumnWidths(100, 100)
+	table.NewRow().AddCellSpan("Centered", 2, 1)
+	table.Rows()[0].Cells[0].Align = AlignCenter
+
+	if err := table.Draw(page.GetLayoutContext(), page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	ops := page.TextOperations()
+	if len(ops) != 1 {
+		t.Fatalf("text ops = %d, want 1", len(ops))
+	}
+	// Centered across both columns: past the first column boundary's midpoint.
+	if ops[0].X < page.margins.Left+50 {
+		t.Errorf("X = %v, want text centered across both columns", ops[0].X)
+	}
+}
+
+func TestTableLayout_Draw_SpannedBorders(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(2).SetBorder(0.5, Black)
+	table.NewRow().AddCellSpan("Title", 2, 1)
+	table.NewRow().AddCell("A").AddCell("B")
+	_ = table.Draw(page.GetLayoutContext(), page)
+
+	// Three horizontal lines, the outer verticals and the column line
+	// below the spanned cell; shared edges are drawn once.
+	if got := len(page.GraphicsOperations()); got != 6 {
+		t.Errorf("graphics ops = %d, want 6", got)
+	}
+}
+
+func TestTableLayout_Split_KeepsRowSpans(t *testing.T) {
+	table := NewTableLayout(2)
+	table.NewRow().AddCell("A").AddCell("B")
+	table.NewRow().AddCellSpan("Tall", 1, 3).AddCell("1")
+	table.NewRow().AddCell("2")
+	table.NewRow().AddCell("3")
+
+	// Room for 3 rows would cut through the 3-row span starting at row 1.
+	head, tail := table.Split(nil, 18*3)
+
+	if tableRows(head) != 1 || tableRows(tail) != 3 {
+		t.Errorf("split = %d/%d rows, want 1/3", tableRows(head), tableRows(tail))
+	}
+}
+
+func TestCreator_Draw_TableBreaksTallRowSpan(t *testing.T) {
+	c := New()
+
+	table := NewTableLayout(2).SetColumnWidths(100, 100)
+	first := table.NewRow().AddCellSpan("Tall", 1, 200).AddCell("0")
+	first.Cell(0).SetBackground(Gray)
+	for i := 1; i < 200; i++ {
+		table.NewRow().AddCell(strconv.Itoa(i))
+	}
+	if err := c.Draw(table); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	if c.PageCount() < 2 {
+		t.Fatalf("PageCount() = %d, want at least 2", c.PageCount())
+	}
+	column := c.pages[0].Margins().Left + 100
+	numbers := 0
+	for i, page := range c.pages {
+		bottom := page.Margins().Bottom
+		for _, op := range page.TextOperations() {
+			if op.Y < bottom {
+				t.Errorf("page %d: %q at y = %.2f below the bottom margin %.2f", i+1, op.Text, op.Y, bottom)
+			}
+			if op.Text != "Tall" && op.Text != "" {
+				numbers++
+				if op.X < column {
+					t.Errorf("page %d: %q at x = %.2f, want in the second column", i+1, op.Text, op.X)
+				}
+			}
+		}
+
+		// The spanned cell continues on every page.
+		filled := false
+		for _, op := range page.GraphicsOperations() {
+			if op.RectOpts != nil && op.RectOpts.FillColor != nil && *op.RectOpts.FillColor == Gray {
+				filled = true
+			}
+		}
+		if !filled {
+			t.Errorf("page %d: spanned cell background missing", i+1)
+		}
+	}
+	if numbers != 200 {
+		t.Errorf("rows drawn = %d, want 200", numbers)
+	}
+}
+
+func TestTableCell_StyleSetters(t *testing.T) {
+	cell := NewTableCell("OK")
+
+	result := cell.SetBackground(Green).SetTextColor(White).SetFont(HelveticaBold, 12)
+	if result != &cell {
+		t.Error("setters should return the cell for chaining")
+	}
+
+	if cell.Background == nil || *cell.Background != Green {
+		t.Errorf("Background = %v, want Green", cell.Background)
+	}
+	if cell.Color != White || cell.Font != HelveticaBold || cell.FontSize != 12 {
+		t.Errorf("text style = %v %v %v, want White HelveticaBold 12", cell.Color, cell.Font, cell.FontSize)
+	}
+
+	cell.SetBorder(Border{Width: 1, Color: Red}).SetBorderLeft(Border{})
+	if cell.BorderTop == nil || cell.BorderTop.Width != 1 {
+		t.Error("SetBorder should set the top border")
+	}
+	if cell.BorderLeft == nil || cell.BorderLeft.Width != 0 {
+		t.Error("SetBorderLeft should override the left border")
+	}
+}
+
+func TestTableRow_Cell(t *testing.T) {
+	row := NewTableLayout(2).NewRow().AddCell("A").AddCell("B")
+
+	if row.Cell(1).Content != "B" {
+		t.Errorf("Cell(1) = %q, want B", row.Cell(1).Content)
+	}
+	if row.LastCell().Content != "B" {
+		t.Errorf("LastCell() = %q, want B", row.LastCell().Content)
+	}
+	if row.Cell(5) != nil {
+		t.Error("Cell(5) should be nil")
+	}
+}
+
+func TestTableLayout_Draw_CellBackground(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(2)
+	row := table.NewRow().AddCell("Build").AddCell("FAILED")
+	row.Cell(1).SetBackground(Red)
+
+	if err := table.Draw(page.GetLayoutContext(), page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	gops := page.GraphicsOperations()
+	if len(gops) != 1 || gops[0].Type != GraphicsOpRect {
+		t.Fatalf("expected one background rect, got %d ops", len(gops))
+	}
+	if *gops[0].RectOpts.FillColor != Red {
+		t.Errorf("background = %v, want Red", *gops[0].RectOpts.FillColor)
+	}
+}
+
+func TestTableLayout_Draw_CellBorderOverrides(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	// No table border: only the overridden side is drawn.
+	table := NewTableLayout(1)
+	table.NewRow().AddCell("Total").LastCell().SetBorderTop(Border{Width: 2, Color: Black})
+
+	if err := table.Draw(page.GetLayoutContext(), page); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+
+	gops := page.GraphicsOperations()
+	if len(gops) != 1 {
+		t.Fatalf("graphics ops = %d, want 1", len(gops))
+	}
+	if gops[0].LineOpts.Width != 2 || gops[0].Y != gops[0].Y2 {
+		t.Errorf("expected a horizontal 2pt line, got width %v", gops[0].LineOpts.Width)
+	}
+}
+
+func TestTableLayout_Draw_HiddenCellBorder(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(1).SetBorder(0.5, Black)
+	table.NewRow().AddCell("A").LastCell().SetBorderBottom(Border{})
+
+	_ = table.Draw(page.GetLayoutContext(), page)
+
+	if got := len(page.GraphicsOperations()); got != 3 {
+		t.Errorf("graphics ops = %d, want 3 (bottom border hidden)", got)
+	}
+}
+
+func TestTableLayout_CollapsedBorders_HiddenInteriorEdge(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(2).SetBorder(0.5, Black)
+	table.NewRow().AddCell("A").AddCell("B").Cell(0).SetBorderRight(Border{})
+	_ = table.Draw(page.GetLayoutContext(), page)
+
+	for _, op := range page.GraphicsOperations() {
+		if op.X == op.X2 && op.X > page.margins.Left+1 && op.X < page.Width()-page.margins.Right-1 {
+			t.Errorf("hidden shared edge drawn at X = %v", op.X)
+		}
+	}
+	// Top, bottom, left and right outer edges remain.
+	if got := len(page.GraphicsOperations()); got != 4 {
+		t.Errorf("graphics ops = %d, want 4", got)
+	}
+}
+
+func TestTableLayout_BorderModel(t *testing.T) {
+	tests := []struct {
+		name  string
+		model BorderModel
+		want  int
+	}{
+		{"collapse draws each grid line once", BorderCollapse, 6},
+		{"separate draws a box per cell", BorderSeparate, 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			page, _ := c.NewPage()
+
+			table := NewTableLayout(2).SetBorder(0.5, Black).SetBorderModel(tt.model).
+				AddRow("A", "B").
+				AddRow("C", "D")
+			_ = table.Draw(page.GetLayoutContext(), page)
+
+			if got := len(page.GraphicsOperations()); got != tt.want {
+				t.Errorf("graphics ops = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableLayout_CollapsedBorders_WiderWins(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(2).SetBorder(0.5, Black)
+	row := table.NewRow().AddCell("A").AddCell("B")
+	row.Cell(0).SetBorderRight(Border{Width: 2, Color: Red})
+	_ = table.Draw(page.GetLayoutContext(), page)
+
+	var shared []GraphicsOperation
+	for _, op := range page.GraphicsOperations() {
+		if op.X == op.X2 && op.X > page.margins.Left+1 && op.X < page.Width()-page.margins.Right-1 {
+			shared = append(shared, op)
+		}
+	}
+
+	if len(shared) != 1 {
+		t.Fatalf("shared edge drawn %d times, want 1", len(shared))
+	}
+	if shared[0].LineOpts.Width != 2 || shared[0].LineOpts.Color != Red {
+		t.Errorf("shared edge = %vpt %v, want 2pt Red", shared[0].LineOpts.Width, shared[0].LineOpts.Color)
+	}
+}
+
+func TestTableLayout_BorderSpacing(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	table := NewTableLayout(1).SetColumnWidths(100).SetBorderModel(BorderSeparate).SetBorderSpacing(4)
+	table.NewRow().AddCell("A").LastCell().SetBackground(LightGray)
+	_ = table.Draw(page.GetLayoutContext(), page)
+
+	rect := page.GraphicsOperations()[0]
+	if rect.X != page.margins.Left+2 || rect.Width != 96 {
+		t.Errorf("box = x %v width %v, want inset by 2 and width 96", rect.X, rect.Width)
+	}
+}
+
+func TestTableLayout_DashedBorders(t *testing.T) {
+	c := New()
+	page, _ := c.NewPage()
+
+	dashed := Border{Width: 0.5, Color: Gray, Dashed: true}
+	table := NewTableLayout(2).AddRow("A", "B")
+	table.rows[0].Cell(0).SetBorder(dashed)
+	table.rows[0].Cell(1).SetBorder(dashed)
+	_ = table.Draw(page.GetLayoutContext(), page)
+
+	// Collapsed grid: 2 joined horizontal lines + 3 vertical lines.
+	gops := page.GraphicsOperations()
+	if len(gops) != 5 {
+		t.Fatalf("graphics ops = %d, want 5", len(gops))
+	}
+	for _, op := range gops {
+		if !op.LineOpts.Dashed || len(op.LineOpts.DashArray) != 2 {
+			t.Errorf("line opts = %+v, want dashed with default pattern", op.LineOpts)
+		}
+	}
+}
+
+func TestTableLayout_RoundedCells(t *testing.T) {
+	tests := []struct {
+		name  string
+		model BorderModel
+		lines int
+	}{
+		// Outer edges of B only: top, bottom and right.
+		{"collapse leaves the rounded cell's grid edges empty", BorderCollapse, 3},
+		{"separate boxes the square cell", BorderSeparate, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			page, _ := c.NewPage()
+
+			table := NewTableLayout(2).SetBorder(1, Black).SetBorderModel(tt.model)
+			row := table.NewRow().AddCell("A").AddCell("B")
+			row.Cell(0).SetBackground(LightGray).SetCornerRadius(4)
+			_ = table.Draw(page.GetLayoutContext(), page)
+
+			var paths, lines int
+			for _, op := range page.GraphicsOperations() {
+				switch op.Type {
+				case GraphicsOpPath:
+					paths++
+				case GraphicsOpLine:
+					lines++
+				}
+			}
+			// Rounded background fill and rounded outline.
+			if paths != 2 {
+				t.Errorf("paths = %d, want 2", paths)
+			}
+			if lines != tt.lines {
+				t.Errorf("lines = %d, want %d", lines, tt.lines)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+// Package templates lays out common business documents with the creator
+// flow layout.
+//
+// Example:
+//
+//	inv := &templates.Invoice{
+//	    Number: "2025-0042",
+//	    Date:   time.Now(),
+//	    Seller: templates.Party{Name: "Acme GmbH", Address: []string{"Hauptstr. 1", "10115 Berlin"}},
+//	    Buyer:  templates.Party{Name: "Globex Corp.", Address: []string{"1 Main St", "Springfield"}},
+//	    Items: []templates.LineItem{
+//	        {Description: "Consulting", Quantity: 12, UnitPrice: 150},
+//	        {Description: "Travel expenses", Quantity: 1, UnitPrice: 320.5},
+//	    },
+//	    Currency: "€",
+//	    TaxRate:  19,
+//	}
+//	c := creator.New()
+//	if err := inv.Render(c); err != nil {
+//	    log.Fatal(err)
+//	}
+//	c.WriteToFile("invoice.pdf")
+package templates
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coregx/gxpdf/creator"
+)
+
+// Layout of the invoice (in points).
+const (
+	sectionSpacing  = 14.0
+	quantityWidth   = 60.0
+	unitPriceWidth  = 90.0
+	amountWidth     = 90.0
+	metaLabelWidth  = 90.0
+	totalsRuleWidth = 1.0
+)
+
+// Party is the seller or buyer of an invoice.
+type Party struct {
+	// Name is the person or company name.
+	Name string
+
+	// Address holds the address lines.
+	Address []string
+
+	// TaxID is the VAT or tax identification number, if any.
+	TaxID string
+
+	// Email is the contact email address, if any.
+	Email string
+}
+
+// lines returns the lines describing the party, starting with the name.
+func (p Party) lines() []string {
+	var lines []string
+	if p.Name != "" {
+		lines = append(lines, p.Name)
+	}
+	lines = append(lines, p.Address...)
+	if p.TaxID != "" {
+		lines = append(lines, "Tax ID: "+p.TaxID)
+	}
+	if p.Email != "" {
+		lines = append(lines, p.Email)
+	}
+	return lines
+}
+
+// LineItem is a billed product or service.
+type LineItem struct {
+	// Description is a single line describing the item.
+	Description string
+
+	// Quantity is the number of units.
+	Quantity float64
+
+	// UnitPrice is the price of one unit, before tax.
+	UnitPrice float64
+}
+
+// Amount returns the line total, rounded to cents.
+func (li LineItem) Amount() float64 {
+	return roundCents(li.Quantity * li.UnitPrice)
+}
+
+// Invoice is an invoice or receipt laid out with Render.
+//
+// Line items are listed in a table that continues on following pages,
+// repeating its header, followed by the subtotal, tax and total.
+type Invoice struct {
+	// Title is the document title. Default: "Invoice".
+	Title string
+
+	// Number is the invoice number.
+	Number string
+
+	// Date is the invoice date; DueDate is the payment due date. Zero
+	// dates are not shown.
+	Date    time.Time
+	DueDate time.Time
+
+	// Seller issues the invoice; Buyer receives it.
+	Seller Party
+	Buyer  Party
+
+	// Items are the billed line items.
+	Items []LineItem
+
+	// Currency is written before amounts, e.g. "$" or "EUR ".
+	Currency string
+
+	// TaxRate is the tax rate in percent applied to the subtotal, e.g. 19
+	// for 19%. A zero rate omits the tax line.
+	TaxRate float64
+
+	// TaxLabel names the tax. Default: "Tax".
+	TaxLabel string
+
+	// Notes are paragraphs shown below the totals, such as payment terms
+	// and bank details.
+	Notes []string
+
+	// DateLayout is the time layout of dates. Default: "2006-01-02".
+	DateLayout string
+
+	// FormatAmount formats amounts, e.g. for a locale's decimal separator.
+	// Default: Currency followed by the amount with two decimals and
+	// thousands separators, such as "$1,234.50".
+	FormatAmount func(amount float64) string
+}
+
+// Subtotal returns the sum of the line item amounts.
+func (inv *Invoice) Subtotal() float64 {
+	var sum float64
+	for _, item := range inv.Items {
+		sum += item.Amount()
+	}
+	return roundCents(sum)
+}
+
+// Tax returns the tax on the subtotal, rounded to cents.
+func (inv *Invoice) Tax() float64 {
+	return roundCents(inv.Subtotal() * inv.TaxRate / 100)
+}
+
+// Total returns the subtotal plus tax.
+func (inv *Invoice) Total() float64 {
+	return roundCents(inv.Subtotal() + inv.Tax())
+}
+
+// Render flows the invoice into the document using Creator.Draw, adding
+// pages as needed. Styling comes from the Creator's active theme.
+func (inv *Invoice) Render(c *creator.Creator) error {
+	for _, d := range inv.Drawables(c.Theme()) {
+		if err := c.Draw(d); err != nil {
+			return fmt.Errorf("invoice: %w", err)
+		}
+	}
+	return nil
+}
+
+// Drawables returns the invoice as creator drawables styled with theme.
+//
+// A nil theme uses creator.DefaultTheme(). The drawables can be drawn
+// with Creator.Draw or placed individually.
+func (inv *Invoice) Drawables(theme *creator.Theme) []creator.Drawable {
+	if theme == nil {
+		theme = creator.DefaultTheme()
+	}
+
+	title := inv.Title
+	if title == "" {
+		title = "Invoice"
+	}
+	out := []creator.Drawable{creator.NewHeading(title, 1)}
+	if meta := inv.metaTable(); meta != nil {
+		out = append(out, meta)
+	}
+	out = append(out,
+		creator.NewSpacer(sectionSpacing),
+		inv.partiesTable(),
+		creator.NewSpacer(sectionSpacing),
+		inv.itemsTable(theme),
+		keepTogether{inv.totalsTable(theme)},
+	)
+	for _, note := range inv.Notes {
+		p := creator.NewParagraph(note).
+			SetFont(theme.Body.Font, theme.Body.Size).
+			SetColor(theme.Secondary)
+		out = append(out, p)
+	}
+	return out
+}
+
+// metaTable lists the invoice number and dates, or returns nil if none is
+// set.
+func (inv *Invoice) metaTable() *creator.TableLayout {
+	layout := inv.DateLayout
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+
+	table := creator.NewTableLayout(2).SetColumnWidths(metaLabelWidth).SetCellPadding(2)
+	add := func(label, value string) {
+		if value == "" {
+			return
+		}
+		row := table.NewRow().AddCell(label).AddCell(value)
+		row.Cell(0).Font = creator.HelveticaBold
+	}
+	add("Number", inv.Number)
+	if !inv.Date.IsZero() {
+		add("Date", inv.Date.Format(layout))
+	}
+	if !inv.DueDate.IsZero() {
+		add("Due Date", inv.DueDate.Format(layout))
+	}
+
+	if table.RowCount() == 0 {
+		return nil
+	}
+	return table
+}
+
+// partiesTable lists the seller and buyer side by side.
+func (inv *Invoice) partiesTable() *creator.TableLayout {
+	table := creator.NewTableLayout(2).SetCellPadding(2)
+	table.NewHeaderRow().AddCell("From").AddCell("Bill To")
+
+	seller, buyer := inv.Seller.lines(), inv.Buyer.lines()
+	for i := 0; i < max(len(seller), len(buyer)); i++ {
+		table.NewRow().AddCell(lineAt(seller, i)).AddCell(lineAt(buyer, i))
+	}
+	return table
+}
+
+// itemsTable lists the line items with right-aligned numbers.
+func (inv *Invoice) itemsTable(theme *creator.Theme) *creator.TableLayout {
+	table := creator.NewTableLayout(4).
+		SetColumnWidths(0, quantityWidth, unitPriceWidth, amountWidth).
+		SetBorder(0.5, theme.Muted).
+		SetRepeatHeader(true).
+		SetContinuationLabels("Continued on next page", "Continued")
+
+	header := table.NewHeaderRow().AddCell("Description").AddCell("Quantity").AddCell("Unit Price").AddCell("Amount")
+	alignNumbers(header)
+
+	for _, item := range inv.Items {
+		row := table.NewRow().
+			AddCell(item.Description).
+			AddCell(formatQuantity(item.Quantity)).
+			AddCell(inv.formatAmount(item.UnitPrice)).
+			AddCell(inv.formatAmount(item.Amount()))
+		alignNumbers(row)
+	}
+	return table
+}
+
+// totalsTable lists the subtotal, tax and total below the amount column.
+func (inv *Invoice) totalsTable(theme *creator.Theme) *creator.TableLayout {
+	table := creator.NewTableLayout(4).SetColumnWidths(0, quantityWidth, unitPriceWidth, amountWidth)
+
+	add := func(label string, amount float64) *creator.TableRow {
+		row := table.NewRow().AddCellSpan("", 2, 1).AddCell(label).AddCell(inv.formatAmount(amount))
+		row.Cell(1).Align = creator.AlignRight
+		row.Cell(2).Align = creator.AlignRight
+		return row
+	}
+
+	add("Subtotal", inv.Subtotal())
+	if inv.TaxRate != 0 {
+		label := inv.TaxLabel
+		if label == "" {
+			label = "Tax"
+		}
+		add(fmt.Sprintf("%s (%s%%)", label, formatQuantity(inv.TaxRate)), inv.Tax())
+	}
+	total := add("Total", inv.Total())
+	rule := creator.Border{Width: totalsRuleWidth, Color: theme.Primary}
+	for i := 1; i <= 2; i++ {
+		total.Cell(i).Font = creator.HelveticaBold
+		total.Cell(i).SetBorderTop(rule)
+	}
+	return table
+}
+
+// formatAmount formats an amount with FormatAmount or the default format.
+func (inv *Invoice) formatAmount(amount float64) string {
+	if inv.FormatAmount != nil {
+		return inv.FormatAmount(amount)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	s := strconv.FormatFloat(amount, 'f', 2, 64)
+	whole, cents := s[:len(s)-3], s[len(s)-3:]
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	return sign + inv.Currency + b.String() + cents
+}
+
+// keepTogether wraps a drawable so that the flow layout moves it to the
+// next page as a whole instead of splitting it.
+type keepTogether struct {
+	creator.Drawable
+}
+
+// alignNumbers right-aligns the numeric columns of an items table row.
+func alignNumbers(row *creator.TableRow) {
+	for i := 1; i < len(row.Cells); i++ {
+		row.Cell(i).Align = creator.AlignRight
+	}
+}
+
+// formatQuantity formats a quantity without trailing zeros.
+func formatQuantity(q float64) string {
+	return strconv.FormatFloat(q, 'f', -1, 64)
+}
+
+// lineAt returns lines[i], or "" past the end.
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
+
+// roundCents rounds an amount to two decimals.
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,121 @@
+package templates
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coregx/gxpdf/creator"
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+func TestInvoice_Totals(t *testing.T) {
+	inv := &Invoice{
+		Items: []LineItem{
+			{Description: "Consulting", Quantity: 12, UnitPrice: 150},
+			{Description: "Cables", Quantity: 3, UnitPrice: 9.99},
+			{Description: "Discount", Quantity: 1, UnitPrice: -50},
+		},
+		TaxRate: 19,
+	}
+
+	if got := inv.Subtotal(); got != 1779.97 {
+		t.Errorf("Subtotal() = %v, want 1779.97", got)
+	}
+	if got := inv.Tax(); got != 338.19 {
+		t.Errorf("Tax() = %v, want 338.19", got)
+	}
+	if got := inv.Total(); got != 2118.16 {
+		t.Errorf("Total() = %v, want 2118.16", got)
+	}
+}
+
+func TestInvoice_FormatAmount(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   string
+	}{
+		{0, "$0.00"},
+		{9.5, "$9.50"},
+		{999.999, "$1,000.00"},
+		{1234567.8, "$1,234,567.80"},
+		{-42, "-$42.00"},
+	}
+	inv := &Invoice{Currency: "$"}
+	for _, tt := range tests {
+		if got := inv.formatAmount(tt.amount); got != tt.want {
+			t.Errorf("formatAmount(%v) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+
+	inv.FormatAmount = func(amount float64) string { return fmt.Sprintf("%.2f EUR", amount) }
+	if got := inv.formatAmount(3); got != "3.00 EUR" {
+		t.Errorf("formatAmount() with FormatAmount = %q, want %q", got, "3.00 EUR")
+	}
+}
+
+func TestInvoice_Render(t *testing.T) {
+	inv := &Invoice{
+		Number:   "2025-0042",
+		Date:     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:  time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		Seller:   Party{Name: "Acme GmbH", Address: []string{"Hauptstr. 1", "10115 Berlin"}, TaxID: "DE123456789"},
+		Buyer:    Party{Name: "Globex Corp.", Address: []string{"1 Main St"}},
+		Currency: "$",
+		TaxRate:  20,
+		Notes:    []string{"Payable within 30 days."},
+	}
+	for i := 1; i <= 80; i++ {
+		inv.Items = append(inv.Items, LineItem{Description: fmt.Sprintf("Item %d", i), Quantity: 1, UnitPrice: 10})
+	}
+
+	c := creator.New()
+	if err := inv.Render(c); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if c.PageCount() < 2 {
+		t.Fatalf("PageCount() = %d, want line items paginated", c.PageCount())
+	}
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	if err := c.WriteToFile(path); err != nil {
+		t.Fatalf("WriteToFile() error = %v", err)
+	}
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	first := pageText(t, reader, 0)
+	for _, want := range []string{"Invoice", "2025-0042", "2025-03-31", "Acme GmbH", "Tax ID: DE123456789", "Globex Corp.", "Item 1"} {
+		if !strings.Contains(first, want) {
+			t.Errorf("first page lacks %q", want)
+		}
+	}
+
+	last := pageText(t, reader, c.PageCount()-1)
+	for _, want := range []string{"Description", "Item 80", "Subtotal", "$800.00", "Tax (20%)", "$160.00", "Total", "$960.00", "Payable within 30 days."} {
+		if !strings.Contains(last, want) {
+			t.Errorf("last page lacks %q", want)
+		}
+	}
+}
+
+// pageText returns the text of a page, one element per line.
+func pageText(t *testing.T, reader *parser.Reader, page int) string {
+	t.Helper()
+
+	elements, err := extractor.NewTextExtractor(reader).ExtractFromPage(page)
+	if err != nil {
+		t.Fatalf("ExtractFromPage(%d) error = %v", page, err)
+	}
+	texts := make([]string, 0, len(elements))
+	for _, e := range elements {
+		texts = append(texts, e.Text)
+	}
+	return strings.Join(texts, "\n")
+}
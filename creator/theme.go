@@ -0,0 +1,276 @@
+package creator
+
+// Theme defines the typography, colors and spacing of a document.
+//
+// A theme is set on the Creator with SetTheme and applies to all pages.
+// Style-aware drawables such as Heading and Body read the active theme at
+// layout time, so a document can be rebranded by swapping the theme.
+//
+// Example:
+//
+//	theme := creator.DefaultTheme()
+//	theme.Primary = creator.Color{R: 0.1, G: 0.14, B: 0.49}
+//	theme.H1.Color = theme.Primary
+//	c.SetTheme(theme)
+//
+//	c.Draw(creator.NewHeading("Quarterly Report", 1))
+//	c.Draw(creator.NewBody("Revenue grew in every region."))
+type Theme struct {
+	// Heading styles by level (H1 is the largest).
+	H1 TextStyle
+	H2 TextStyle
+	H3 TextStyle
+
+	// Body is the style for body text.
+	Body TextStyle
+
+	// Palette colors for use by the document and its drawables.
+	Primary   Color
+	Secondary Color
+	Accent    Color
+	Muted     Color
+
+	// LineSpacing is the line spacing multiplier for body text and headings.
+	LineSpacing float64
+
+	// HeadingSpaceBefore is the space above a heading (skipped at the top of a page).
+	HeadingSpaceBefore float64
+
+	// HeadingSpaceAfter is the space below a heading.
+	HeadingSpaceAfter float64
+
+	// ParagraphSpacing is the space below a body paragraph.
+	ParagraphSpacing float64
+}
+
+// DefaultTheme returns the default theme.
+//
+// Default theme:
+//   - H1: Helvetica-Bold 24pt, H2: Helvetica-Bold 18pt, H3: Helvetica-Bold 14pt
+//   - Body: Helvetica 11pt, black
+//   - Line spacing: 1.2
+//   - Heading spacing: 12pt before, 6pt after
+//   - Paragraph spacing: 8pt
+func DefaultTheme() *Theme {
+	return &Theme{
+		H1:                 TextStyle{Font: HelveticaBold, Size: 24, Color: Black},
+		H2:                 TextStyle{Font: HelveticaBold, Size: 18, Color: Black},
+		H3:                 TextStyle{Font: HelveticaBold, Size: 14, Color: Black},
+		Body:               TextStyle{Font: Helvetica, Size: 11, Color: Black},
+		Primary:            Black,
+		Secondary:          DarkGray,
+		Accent:             Blue,
+		Muted:              Gray,
+		LineSpacing:        1.2,
+		HeadingSpaceBefore: 12,
+		HeadingSpaceAfter:  6,
+		ParagraphSpacing:   8,
+	}
+}
+
+// HeadingStyle returns the text style for the given heading level.
+//
+// Levels below 1 use H1; levels above 3 use H3.
+func (t *Theme) HeadingStyle(level int) TextStyle {
+	switch {
+	case level <= 1:
+		return t.H1
+	case level == 2:
+		return t.H2
+	default:
+		return t.H3
+	}
+}
+
+// SetTheme sets the active theme for the document.
+//
+// The theme applies to existing pages and to pages added later.
+// Passing nil restores the default theme.
+func (c *Creator) SetTheme(theme *Theme) {
+	c.theme = theme
+	for _, page := range c.pages {
+		page.theme = theme
+	}
+}
+
+// Theme returns the active theme (the default theme if none was set).
+func (c *Creator) Theme() *Theme {
+	if c.theme == nil {
+		return DefaultTheme()
+	}
+	return c.theme
+}
+
+// Theme returns the theme in effect for this layout context.
+//
+// Contexts created outside of a Creator page use the default theme.
+func (ctx *LayoutContext) Theme() *Theme {
+	if ctx.theme == nil {
+		return DefaultTheme()
+	}
+	return ctx.theme
+}
+
+// Heading is a heading drawable styled by the active theme.
+//
+// The font, size, color and spacing come from the theme's heading style
+// for the heading level. A heading is never split across pages.
+//
+// Example:
+//
+//	c.Draw(NewHeading("Introduction", 1))
+//	c.Draw(NewHeading("Background", 2))
+type Heading struct {
+	text      string
+	level     int
+	alignment Alignment
+	id        string // ID for references (see Ref)
+	page      *Page  // page the heading was drawn on
+}
+
+// NewHeading creates a heading with the given text and level (1-3).
+func NewHeading(text string, level int) *Heading {
+	return &Heading{
+		text:      text,
+		level:     level,
+		alignment: AlignLeft,
+	}
+}
+
+// SetAlignment sets the heading alignment.
+// Returns the heading for method chaining.
+func (h *Heading) SetAlignment(a Alignment) *Heading {
+	h.alignment = a
+	return h
+}
+
+// Text returns the heading text.
+func (h *Heading) Text() string {
+	return h.text
+}
+
+// Level returns the heading level.
+func (h *Heading) Level() int {
+	return h.level
+}
+
+// Height returns the heading height including theme spacing.
+func (h *Heading) Height(ctx *LayoutContext) float64 {
+	theme := ctx.Theme()
+	return h.spaceBefore(ctx) + h.paragraph(theme).Height(ctx) + theme.HeadingSpaceAfter
+}
+
+// Draw renders the heading at the current cursor position.
+func (h *Heading) Draw(ctx *LayoutContext, page *Page) error {
+	h.page = page
+	theme := ctx.Theme()
+	ctx.CursorY += h.spaceBefore(ctx)
+	if err := h.paragraph(theme).Draw(ctx, page); err != nil {
+		return err
+	}
+	ctx.CursorY += theme.HeadingSpaceAfter
+	return nil
+}
+
+// spaceBefore returns the space above the heading, which is dropped at
+// the top of the content area.
+func (h *Heading) spaceBefore(ctx *LayoutContext) float64 {
+	if ctx.CursorY <= 0 {
+		return 0
+	}
+	return ctx.Theme().HeadingSpaceBefore
+}
+
+// paragraph builds the paragraph used to render the heading.
+func (h *Heading) paragraph(theme *Theme) *Paragraph {
+	style := theme.HeadingStyle(h.level)
+	return NewParagraph(h.text).
+		SetFont(style.Font, style.Size).
+		SetColor(style.Color).
+		SetAlignment(h.alignment).
+		SetLineSpacing(theme.LineSpacing)
+}
+
+// Body is a body text paragraph styled by the active theme.
+//
+// The font, size, color, line spacing and paragraph spacing come from the
+// theme. Body text can be split across pages by Creator.Draw.
+//
+// Example:
+//
+//	c.Draw(NewBody("Revenue grew in every region."))
+type Body struct {
+	text      string
+	alignment Alignment
+
+	// continued marks a head part of a split body (no spacing below).
+	continued bool
+}
+
+// NewBody creates a body text paragraph.
+func NewBody(text string) *Body {
+	return &Body{
+		text:      text,
+		alignment: AlignLeft,
+	}
+}
+
+// SetAlignment sets the text alignment.
+// Returns the body for method chaining.
+func (b *Body) SetAlignment(a Alignment) *Body {
+	b.alignment = a
+	return b
+}
+
+// Text returns the body text.
+func (b *Body) Text() string {
+	return b.text
+}
+
+// Height returns the body height including paragraph spacing.
+func (b *Body) Height(ctx *LayoutContext) float64 {
+	theme := ctx.Theme()
+	return b.paragraph(theme).Height(ctx) + b.spaceAfter(theme)
+}
+
+// Draw renders the body text at the current cursor position.
+func (b *Body) Draw(ctx *LayoutContext, page *Page) error {
+	theme := ctx.Theme()
+	if err := b.paragraph(theme).Draw(ctx, page); err != nil {
+		return err
+	}
+	ctx.CursorY += b.spaceAfter(theme)
+	return nil
+}
+
+// Split divides the body text at a line boundary. It implements
+// SplittableDrawable.
+func (b *Body) Split(ctx *LayoutContext, height float64) (head, tail Drawable) {
+	h, t := b.paragraph(ctx.Theme()).Split(ctx, height)
+	if t == nil {
+		return b, nil
+	}
+	if h == nil {
+		return nil, b
+	}
+	headPart := &Body{text: h.(*Paragraph).Text(), alignment: b.alignment, continued: true}
+	tailPart := &Body{text: t.(*Paragraph).Text(), alignment: b.alignment}
+	return headPart, tailPart
+}
+
+// spaceAfter returns the space below the body text.
+func (b *Body) spaceAfter(theme *Theme) float64 {
+	if b.continued {
+		return 0
+	}
+	return theme.ParagraphSpacing
+}
+
+// paragraph builds the paragraph used to render the body text.
+func (b *Body) paragraph(theme *Theme) *Paragraph {
+	return NewParagraph(b.text).
+		SetFont(theme.Body.Font, theme.Body.Size).
+		SetColor(theme.Body.Color).
+		SetAlignment(b.alignment).
+		SetLineSpacing(theme.LineSpacing)
+}
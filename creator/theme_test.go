@@ -0,0 +1,121 @@
+package creator
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestDefaultTheme(t *testing.T) {
+	theme := DefaultTheme()
+
+	if theme.H1.Font != HelveticaBold || theme.H1.Size != 24 {
+		t.Errorf("H1 = %v %v, want HelveticaBold 24", theme.H1.Font, theme.H1.Size)
+	}
+	if theme.Body.Font != Helvetica || theme.Body.Size != 11 {
+		t.Errorf("Body = %v %v, want Helvetica 11", theme.Body.Font, theme.Body.Size)
+	}
+}
+
+func TestTheme_HeadingStyle(t *testing.T) {
+	theme := DefaultTheme()
+
+	tests := []struct {
+		level int
+		want  float64
+	}{
+		{0, 24}, {1, 24}, {2, 18}, {3, 14}, {6, 14},
+	}
+	for _, tt := range tests {
+		if got := theme.HeadingStyle(tt.level).Size; got != tt.want {
+			t.Errorf("HeadingStyle(%d).Size = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestCreator_SetTheme_AppliesToPages(t *testing.T) {
+	c := New()
+	existing, _ := c.NewPage()
+
+	theme := DefaultTheme()
+	theme.Body.Size = 20
+	c.SetTheme(theme)
+	added, _ := c.NewPage()
+
+	for _, page := range []*Page{existing, added} {
+		if got := page.GetLayoutContext().Theme().Body.Size; got != 20 {
+			t.Errorf("page theme body size = %v, want 20", got)
+		}
+	}
+	if c.Theme() != theme {
+		t.Error("Theme() should return the active theme")
+	}
+}
+
+func TestLayoutContext_Theme_Default(t *testing.T) {
+	ctx := &LayoutContext{}
+	if ctx.Theme().H1.Size != DefaultTheme().H1.Size {
+		t.Error("context without theme should use the default theme")
+	}
+}
+
+func TestHeading_DrawUsesTheme(t *testing.T) {
+	c := New()
+	theme := DefaultTheme()
+	theme.H2 = TextStyle{Font: TimesBold, Size: 16, Color: Blue}
+	c.SetTheme(theme)
+
+	_ = c.Draw(NewHeading("Section", 2))
+
+	op := c.pages[0].TextOperations()[0]
+	if op.Font != TimesBold || op.Size != 16 || op.Color != Blue {
+		t.Errorf("heading style = %v %v %v, want TimesBold 16 Blue", op.Font, op.Size, op.Color)
+	}
+}
+
+func TestHeading_SpaceBeforeSkippedAtTop(t *testing.T) {
+	ctx := &LayoutContext{PageWidth: 595, PageHeight: 842}
+	h := NewHeading("Title", 1)
+
+	atTop := h.Height(ctx)
+	ctx.CursorY = 100
+	below := h.Height(ctx)
+
+	if below-atTop != DefaultTheme().HeadingSpaceBefore {
+		t.Errorf("space before = %v, want %v", below-atTop, DefaultTheme().HeadingSpaceBefore)
+	}
+}
+
+func TestBody_DrawUsesTheme(t *testing.T) {
+	c := New()
+	_ = c.Draw(NewBody("First"))
+	_ = c.Draw(NewBody("Second"))
+
+	ops := c.pages[0].TextOperations()
+	theme := DefaultTheme()
+	wantGap := theme.Body.Size*theme.LineSpacing + theme.ParagraphSpacing
+	if gap := ops[0].Y - ops[1].Y; math.Abs(gap-wantGap) > 1e-9 {
+		t.Errorf("gap between paragraphs = %v, want %v", gap, wantGap)
+	}
+	if ops[0].Size != theme.Body.Size {
+		t.Errorf("body size = %v, want %v", ops[0].Size, theme.Body.Size)
+	}
+}
+
+func TestBody_Split(t *testing.T) {
+	ctx := &LayoutContext{PageWidth: 595, PageHeight: 842, Margins: Margins{Left: 72, Right: 72}}
+	b := NewBody(strings.TrimSpace(strings.Repeat("word ", 300)))
+
+	head, tail := b.Split(ctx, 50)
+	if head == nil || tail == nil {
+		t.Fatal("expected body to split")
+	}
+	if head.Height(ctx) > 50 {
+		t.Errorf("head height = %v, want <= 50", head.Height(ctx))
+	}
+
+	words := len(strings.Fields(head.(*Body).Text())) + len(strings.Fields(tail.(*Body).Text()))
+	if words != 300 {
+		t.Errorf("words after split = %d, want 300", words)
+	}
+}
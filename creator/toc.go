@@ -270,7 +270,7 @@ func (t *TOC) drawEntry(ctx *LayoutContext, page *Page, ch *Chapter) error {
 		}
 	} else {
 		// If page not set yet, just render as text
-		if err := page.AddTextColor(entryText, x, y, t.style.EntryFont, fontSize, t.style.EntryColor); err != nil {
+		if err := page.addTextColor(entryText, x, y, t.style.EntryFont, fontSize, t.style.EntryColor); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,36 @@
+package creator
+
+// PointsPerInch is the number of PDF points in one inch.
+const PointsPerInch = 72.0
+
+// PointsToPixels converts a length in points to pixels at the given
+// resolution in dots per inch.
+//
+// Returns 0 if dpi is not positive.
+//
+// Example:
+//
+//	px := creator.PointsToPixels(144, 300) // 2 inches at 300 DPI = 600 pixels
+func PointsToPixels(pt, dpi float64) float64 {
+	if dpi <= 0 {
+		return 0
+	}
+	return pt * dpi / PointsPerInch
+}
+
+// PixelsToPoints converts a length in pixels at the given resolution in
+// dots per inch to points. Use it to size an image for a target DPI.
+//
+// Returns 0 if dpi is not positive.
+//
+// Example:
+//
+//	// Place a 1200x800 image at 300 DPI: 288x192 points (4x2.67 inches).
+//	w := creator.PixelsToPoints(1200, 300)
+//	h := creator.PixelsToPoints(800, 300)
+func PixelsToPoints(px, dpi float64) float64 {
+	if dpi <= 0 {
+		return 0
+	}
+	return px * PointsPerInch / dpi
+}
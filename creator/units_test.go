@@ -0,0 +1,56 @@
+package creator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointsToPixels(t *testing.T) {
+	tests := []struct {
+		name string
+		pt   float64
+		dpi  float64
+		want float64
+	}{
+		{"one inch at 72 DPI", 72, 72, 72},
+		{"one inch at 300 DPI", 72, 300, 300},
+		{"two inches at 96 DPI", 144, 96, 192},
+		{"zero", 0, 300, 0},
+		{"zero DPI", 72, 0, 0},
+		{"negative DPI", 72, -300, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, PointsToPixels(tt.pt, tt.dpi), 1e-9)
+		})
+	}
+}
+
+func TestPixelsToPoints(t *testing.T) {
+	tests := []struct {
+		name string
+		px   float64
+		dpi  float64
+		want float64
+	}{
+		{"72 pixels at 72 DPI", 72, 72, 72},
+		{"300 pixels at 300 DPI", 300, 300, 72},
+		{"1200 pixels at 300 DPI", 1200, 300, 288},
+		{"96 pixels at 96 DPI", 96, 96, 72},
+		{"zero DPI", 100, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, PixelsToPoints(tt.px, tt.dpi), 1e-9)
+		})
+	}
+}
+
+func TestPointsPixels_RoundTrip(t *testing.T) {
+	for _, dpi := range []float64{72, 96, 150, 300, 600} {
+		assert.InDelta(t, 123.45, PixelsToPoints(PointsToPixels(123.45, dpi), dpi), 1e-9)
+	}
+}
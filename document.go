@@ -7,7 +7,6 @@ import (
 	"github.com/coregx/gxpdf/internal/application/forms"
 	"github.com/coregx/gxpdf/internal/extractor"
 	"github.com/coregx/gxpdf/internal/parser"
-	"github.com/coregx/gxpdf/internal/tabledetect"
 )
 
 // Document represents an opened PDF document.
@@ -145,36 +144,11 @@ func (d *Document) ExtractTablesWithOptions(opts *ExtractionOptions) ([]*Table,
 			return nil, fmt.Errorf("gxpdf: failed to extract text from page %d: %w", pageIndex, err)
 		}
 
-		// Detect tables
-		tableDetector := tabledetect.NewDefaultTableDetector()
-
-		var detectedTables []*tabledetect.TableRegion
-		var graphicsElements []*extractor.GraphicsElement
-
-		switch opts.Method {
-		case MethodLattice:
-			detectedTables, err = tableDetector.DetectTablesLattice(textElements, graphicsElements)
-		case MethodStream:
-			detectedTables, err = tableDetector.DetectTablesStream(textElements)
-		default:
-			detectedTables, err = tableDetector.DetectTables(textElements, graphicsElements)
-		}
-
+		tables, err := opts.extractPageTables(textElements, pageIndex)
 		if err != nil {
 			return nil, fmt.Errorf("gxpdf: failed to detect tables on page %d: %w", pageIndex, err)
 		}
-
-		// Extract table data
-		tableExtractor := tabledetect.NewTableExtractor(textElements)
-		for _, region := range detectedTables {
-			extracted, err := tableExtractor.ExtractTable(region)
-			if err != nil {
-				continue
-			}
-			extracted.PageNum = pageIndex
-
-			allTables = append(allTables, &Table{internal: extracted})
-		}
+		allTables = append(allTables, tables...)
 	}
 
 	return allTables, nil
@@ -285,6 +259,51 @@ func (d *Document) ExtractTextFromPage(pageNum int) (string, error) {
 	return page.ExtractText(), nil
 }
 
+// ExtractTextContext extracts text from the page at index (0-based),
+// stopping early when ctx is done.
+//
+// The context is checked while the page's content is processed; on
+// cancellation the context's error is returned.
+//
+// Example:
+//
+//	text, err := doc.ExtractTextContext(r.Context(), 0)
+//	if errors.Is(err, context.Canceled) {
+//	    return // client went away
+//	}
+func (d *Document) ExtractTextContext(ctx context.Context, index int) (string, error) {
+	page := d.Page(index)
+	if page == nil {
+		return "", fmt.Errorf("gxpdf: page %d out of range (0-%d): %w", index, d.PageCount()-1, ErrPageNotFound)
+	}
+	text, err := page.ExtractTextContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("gxpdf: failed to extract text from page %d: %w", index, err)
+	}
+	return text, nil
+}
+
+// ExtractAllTextContext extracts the text of every page, stopping early
+// when ctx is done.
+//
+// The context is checked between pages and while each page's content is
+// processed. On cancellation it returns the text of the pages completed so
+// far together with the context's error.
+func (d *Document) ExtractAllTextContext(ctx context.Context) ([]string, error) {
+	texts := make([]string, 0, d.PageCount())
+	for i := 0; i < d.PageCount(); i++ {
+		if err := ctx.Err(); err != nil {
+			return texts, err
+		}
+		text, err := d.ExtractTextContext(ctx, i)
+		if err != nil {
+			return texts, err
+		}
+		texts = append(texts, text)
+	}
+	return texts, nil
+}
+
 // ExtractTablesFromPage extracts tables from a specific page (1-based).
 func (d *Document) ExtractTablesFromPage(pageNum int) []*Table {
 	if pageNum < 1 || pageNum > d.PageCount() {
@@ -297,6 +316,23 @@ func (d *Document) ExtractTablesFromPage(pageNum int) []*Table {
 	return page.ExtractTables()
 }
 
+// ExtractTablesWithBoundaries extracts the table on a page (0-based)
+// between known column boundaries, skipping column detection.
+//
+// Use this for fixed-layout forms whose column positions are known.
+// See Page.ExtractTablesWithBoundaries.
+//
+// Example:
+//
+//	tables, err := doc.ExtractTablesWithBoundaries(0, []float64{40, 110, 380, 460, 540})
+func (d *Document) ExtractTablesWithBoundaries(pageIndex int, boundaries []float64) ([]*Table, error) {
+	page := d.Page(pageIndex)
+	if page == nil {
+		return nil, fmt.Errorf("gxpdf: page %d out of range (0-%d): %w", pageIndex, d.PageCount()-1, ErrPageNotFound)
+	}
+	return page.ExtractTablesWithBoundaries(boundaries)
+}
+
 // DocumentInfo contains metadata about a PDF document.
 type DocumentInfo struct {
 	PageCount int
@@ -1,23 +1,44 @@
 package gxpdf
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
 
 // Common errors returned by gxpdf functions.
+//
+// Errors are usually wrapped with context; test for them with errors.Is,
+// and use errors.As with the error types below for details.
 var (
 	// ErrInvalidPDF is returned when the file is not a valid PDF.
-	ErrInvalidPDF = errors.New("gxpdf: invalid PDF file")
+	ErrInvalidPDF = parser.ErrInvalidPDF
+
+	// ErrInvalidHeader is returned when the file has no valid %PDF-X.Y
+	// header. It matches ErrInvalidPDF.
+	ErrInvalidHeader = parser.ErrInvalidHeader
+
+	// ErrObjectNotFound is returned when a PDF object referenced by the
+	// document does not exist.
+	ErrObjectNotFound = parser.ErrObjectNotFound
 
 	// ErrEncrypted is returned when the PDF is encrypted and no password was provided.
-	ErrEncrypted = errors.New("gxpdf: PDF is encrypted")
+	ErrEncrypted = parser.ErrEncrypted
+
+	// ErrPasswordRequired is returned by Open when the PDF is protected by
+	// a user password. It matches ErrEncrypted.
+	ErrPasswordRequired = parser.ErrPasswordRequired
 
-	// ErrWrongPassword is returned when the provided password is incorrect.
-	ErrWrongPassword = errors.New("gxpdf: wrong password")
+	// ErrWrongPassword is returned by OpenWithPassword when the password is
+	// neither the user nor the owner password. It matches
+	// ErrPasswordRequired.
+	ErrWrongPassword = parser.ErrWrongPassword
 
 	// ErrCorrupted is returned when the PDF structure is corrupted.
 	ErrCorrupted = errors.New("gxpdf: PDF file is corrupted")
 
 	// ErrPageNotFound is returned when the requested page does not exist.
-	ErrPageNotFound = errors.New("gxpdf: page not found")
+	ErrPageNotFound = parser.ErrPageNotFound
 
 	// ErrNoTables is returned when no tables were found on the page.
 	ErrNoTables = errors.New("gxpdf: no tables found")
@@ -26,11 +47,37 @@ var (
 	ErrUnsupportedFeature = errors.New("gxpdf: unsupported PDF feature")
 )
 
+// HeaderError reports an invalid file header. It matches ErrInvalidHeader.
+type HeaderError = parser.HeaderError
+
+// ObjectError reports a PDF object that could not be found, with its
+// object number. It matches ErrObjectNotFound.
+type ObjectError = parser.ObjectError
+
+// EncryptedError reports an encrypted document with the parameters of its
+// encryption dictionary. It matches ErrEncrypted, and ErrPasswordRequired
+// if PasswordRequired is set.
+//
+// Example:
+//
+//	var encErr *gxpdf.EncryptedError
+//	if errors.As(err, &encErr) {
+//	    fmt.Printf("encrypted with %s handler, revision %d\n", encErr.Filter, encErr.R)
+//	}
+type EncryptedError = parser.EncryptedError
+
 // IsEncrypted returns true if the error indicates an encrypted PDF.
 func IsEncrypted(err error) bool {
 	return errors.Is(err, ErrEncrypted)
 }
 
+// IsPasswordRequired returns true if the error indicates a PDF that cannot
+// be opened without a password, or with the password given to
+// OpenWithPassword.
+func IsPasswordRequired(err error) bool {
+	return errors.Is(err, ErrPasswordRequired)
+}
+
 // IsCorrupted returns true if the error indicates a corrupted PDF.
 func IsCorrupted(err error) bool {
 	return errors.Is(err, ErrCorrupted)
@@ -36,25 +36,13 @@ func main() {
 	fmt.Printf("Units per em: %d\n", customFont.UnitsPerEm())
 	fmt.Println()
 
-	// Mark some characters as used.
-	testText := "Hello, World! Текст на русском языке. 你好世界"
-	customFont.UseString(testText)
-
 	// Measure text width.
+	testText := "Hello, World! Текст на русском языке. 你好世界"
 	width := customFont.MeasureString(testText, 12)
 	fmt.Printf("Text: %s\n", testText)
 	fmt.Printf("Width at 12pt: %.2f points\n", width)
 	fmt.Println()
 
-	// Build font subset.
-	fmt.Println("Building font subset...")
-	if err := customFont.Build(); err != nil {
-		log.Fatalf("Error building font subset: %v", err)
-	}
-
-	fmt.Println("Font subset built successfully!")
-	fmt.Println()
-
 	// Demonstrate measurement.
 	fmt.Println("Measuring different strings:")
 	testStrings := []string{
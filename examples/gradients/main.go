@@ -1,10 +1,8 @@
 // Package main demonstrates gradient fills in PDF creation.
 //
 // This example shows how to use linear and radial gradients to fill shapes.
-//
-// Note: This is a Phase 1 implementation. Currently, gradients are rendered
-// using the middle color as a solid fill. Full PDF shading pattern support
-// (with smooth color transitions) will be added in Phase 2.
+// Gradients are written as PDF shadings, so colors transition smoothly
+// between the color stops.
 package main
 
 import (
@@ -143,18 +141,6 @@ func main() {
 		log.Fatalf("Failed to draw gradient ellipse: %v", err)
 	}
 
-	// Add footer note
-	err = page.AddText("Note: Phase 1 implementation - gradients currently render as solid colors.",
-		50, 50, creator.Helvetica, 10)
-	if err != nil {
-		log.Fatalf("Failed to add footer: %v", err)
-	}
-	err = page.AddText("Full smooth gradient support coming in Phase 2!",
-		50, 35, creator.Helvetica, 10)
-	if err != nil {
-		log.Fatalf("Failed to add footer: %v", err)
-	}
-
 	// Write PDF to file
 	err = c.WriteToFile("gradients_example.pdf")
 	if err != nil {
@@ -167,6 +153,4 @@ func main() {
 	fmt.Println("  - Circles (radial gradient)")
 	fmt.Println("  - Polygons (diagonal linear gradient)")
 	fmt.Println("  - Ellipses (radial gradient)")
-	fmt.Println("\nNote: Currently using Phase 1 implementation (solid color fallback).")
-	fmt.Println("The API is ready for Phase 2 when full PDF shading support is added.")
 }
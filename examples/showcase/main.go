@@ -142,12 +142,12 @@ func loadFonts() (*Fonts, error) {
 	if boldPath != "" {
 		fonts.Bold, err = creator.LoadFont(boldPath)
 		if err != nil {
-			fonts.Bold = fonts.Regular
+			fonts.Bold = fonts.Regular.Bold()
 		} else {
 			fmt.Printf("Bold font: %s\n", boldPath)
 		}
 	} else {
-		fonts.Bold = fonts.Regular
+		fonts.Bold = fonts.Regular.Bold()
 	}
 
 	cjkPath := findFont([]string{
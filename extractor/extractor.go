@@ -0,0 +1,122 @@
+// Package extractor exposes the text elements and detectors behind table
+// extraction, so that detection can be unit-tested in isolation and fed
+// with elements from a custom extraction pipeline.
+//
+// Coordinates are PDF points with the origin at the bottom-left of the
+// page: Y grows upward, so the first line of a page has the highest Y.
+//
+// Example:
+//
+//	elements := []*extractor.TextElement{
+//	    extractor.NewTextElement("Date", 50, 700, 25, 10),
+//	    extractor.NewTextElement("Amount", 300, 700, 35, 10),
+//	    extractor.NewTextElement("01.02.2025", 50, 685, 50, 10),
+//	    extractor.NewTextElement("-12,00", 305, 685, 30, 10),
+//	}
+//	boundaries := extractor.NewColumnBoundaryDetector().DetectBoundaries(elements)
+//	tables, err := gxpdf.ExtractTablesFromElements(elements, nil)
+package extractor
+
+import (
+	"io"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/tabledetect"
+)
+
+// TextElement is a positioned piece of text on a page. X and Y are the
+// bottom-left corner; Right, Top, CenterX and CenterY give the other edges.
+type TextElement = extractor.TextElement
+
+// Rectangle is an axis-aligned rectangle with its bottom-left corner at
+// (X, Y).
+type Rectangle = extractor.Rectangle
+
+// Point is a position on a page.
+type Point = extractor.Point
+
+// GraphicsElement is a line, rectangle or path drawn on a page. Vertical
+// and horizontal lines are the ruling lines of bordered tables.
+type GraphicsElement = extractor.GraphicsElement
+
+// GraphicsTypeLine marks a GraphicsElement with two Points as a line.
+const GraphicsTypeLine = extractor.GraphicsTypeLine
+
+// NewTextElement creates a text element whose height is its font size,
+// as produced by the text extractor for a single line of text.
+func NewTextElement(text string, x, y, width, fontSize float64) *TextElement {
+	return extractor.NewTextElement(text, x, y, width, fontSize, "", fontSize)
+}
+
+// NewLine creates a line from (x1, y1) to (x2, y2), such as a table
+// ruling line.
+func NewLine(x1, y1, x2, y2 float64) *GraphicsElement {
+	return &GraphicsElement{
+		Type:   GraphicsTypeLine,
+		Points: []Point{extractor.NewPoint(x1, y1), extractor.NewPoint(x2, y2)},
+	}
+}
+
+// ColumnBoundaryDetector detects column boundaries in text elements.
+type ColumnBoundaryDetector = tabledetect.ColumnBoundaryDetector
+
+// NewColumnBoundaryDetector creates a column boundary detector with default
+// settings.
+func NewColumnBoundaryDetector() *ColumnBoundaryDetector {
+	return tabledetect.NewColumnBoundaryDetector()
+}
+
+// DetectionStrategy selects the column detection algorithm of
+// ColumnBoundaryDetector.DetectBoundariesWithStrategy.
+type DetectionStrategy = tabledetect.DetectionStrategy
+
+// Column detection strategies.
+const (
+	StrategyEdgeClustering = tabledetect.StrategyEdgeClustering
+	StrategyWhitespace     = tabledetect.StrategyWhitespace
+	StrategyHeaderBased    = tabledetect.StrategyHeaderBased
+	StrategyLattice        = tabledetect.StrategyLattice
+	StrategyHybrid         = tabledetect.StrategyHybrid
+	StrategyAuto           = tabledetect.StrategyAuto
+)
+
+// TableRegionDetector finds the tabular regions of a page.
+type TableRegionDetector = tabledetect.TableRegionDetector
+
+// NewTableRegionDetector creates a table region detector with default
+// settings.
+func NewTableRegionDetector() *TableRegionDetector {
+	return tabledetect.NewTableRegionDetector()
+}
+
+// Region is a tabular region of a page found by
+// TableRegionDetector.DetectTableRegions.
+type Region = tabledetect.Region
+
+// ReadElementsTSV reads text elements from a TSV fixture: a header line
+// "x y width height size font text" followed by one tab-separated element
+// per line. Lines starting with '#' are comments.
+//
+// Fixtures replay a layout through the detectors without its PDF, e.g. in
+// regression tests for DetectBoundaries.
+func ReadElementsTSV(r io.Reader) ([]*TextElement, error) {
+	return tabledetect.ReadElementsTSV(r)
+}
+
+// WriteElementsTSV writes text elements as a TSV fixture readable by
+// ReadElementsTSV.
+func WriteElementsTSV(w io.Writer, elements []*TextElement) error {
+	return tabledetect.WriteElementsTSV(w, elements)
+}
+
+// WriteDetectionTSV writes column boundaries on a "# boundaries" comment
+// line, followed by the rows of cells assigned to them as TSV.
+//
+// Example:
+//
+//	tables, _ := gxpdf.ExtractTablesFromElements(elements, nil)
+//	boundaries := extractor.NewColumnBoundaryDetector().DetectBoundaries(elements)
+//	err := extractor.WriteDetectionTSV(os.Stdout, boundaries, tables[0].Rows())
+func WriteDetectionTSV(w io.Writer, boundaries []float64, rows [][]string) error {
+	return tabledetect.WriteDetectionTSV(w, boundaries, rows)
+}
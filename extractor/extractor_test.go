@@ -0,0 +1,50 @@
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf"
+	"github.com/coregx/gxpdf/extractor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statementElements() []*extractor.TextElement {
+	return []*extractor.TextElement{
+		extractor.NewTextElement("Date", 50, 700, 25, 10),
+		extractor.NewTextElement("Description", 150, 700, 60, 10),
+		extractor.NewTextElement("Amount", 300, 700, 35, 10),
+		extractor.NewTextElement("01.02.2025", 50, 685, 50, 10),
+		extractor.NewTextElement("Coffee", 150, 685, 35, 10),
+		extractor.NewTextElement("-12,00", 300, 685, 30, 10),
+		extractor.NewTextElement("02.02.2025", 50, 670, 50, 10),
+		extractor.NewTextElement("Salary", 150, 670, 35, 10),
+		extractor.NewTextElement("1500,00", 300, 670, 40, 10),
+	}
+}
+
+func TestNewTextElement(t *testing.T) {
+	elem := extractor.NewTextElement("Amount", 300, 700, 35, 10)
+
+	assert.Equal(t, "Amount", elem.Text)
+	assert.Equal(t, 335.0, elem.Right())
+	assert.Equal(t, 710.0, elem.Top())
+	assert.Equal(t, 10.0, elem.FontSize)
+}
+
+func TestColumnBoundaryDetector_CustomElements(t *testing.T) {
+	boundaries := extractor.NewColumnBoundaryDetector().DetectBoundariesWithStrategy(
+		extractor.StrategyHeaderBased, statementElements(), nil)
+
+	assert.Equal(t, []float64{50, 100, 210, 340}, boundaries)
+}
+
+func TestExtractTablesFromElements(t *testing.T) {
+	opts := gxpdf.DefaultExtractionOptions().WithColumnDetection(gxpdf.ColumnDetectionAuto)
+	tables, err := gxpdf.ExtractTablesFromElements(statementElements(), opts)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+
+	assert.Equal(t, 3, tables[0].ColumnCount())
+	assert.Equal(t, []string{"Date", "Description", "Amount"}, tables[0].Rows()[0])
+}
@@ -0,0 +1,380 @@
+// Package templates maps tables extracted from common business documents
+// to typed records.
+//
+// Example:
+//
+//	doc, _ := gxpdf.Open("statement.pdf")
+//	defer doc.Close()
+//	var statement templates.BankStatement
+//	transactions, err := statement.Transactions(doc.ExtractTables()...)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, tx := range transactions {
+//	    fmt.Println(tx.Date.Format("2006-01-02"), tx.Description, tx.Amount())
+//	}
+package templates
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/coregx/gxpdf"
+)
+
+// ErrNoStatementColumns is returned when no table has a header row naming
+// a date and an amount column.
+var ErrNoStatementColumns = errors.New("no bank statement columns found")
+
+// headerSearchRows is the number of leading rows searched for a header.
+const headerSearchRows = 5
+
+// Transaction is a bank statement entry.
+type Transaction struct {
+	// Date is the transaction date; zero if DateText could not be parsed.
+	Date time.Time
+
+	// DateText is the date as written in the statement.
+	DateText string
+
+	// Description is the transaction description. Continuation lines are
+	// joined with spaces.
+	Description string
+
+	// Debit is the amount paid out, Credit the amount paid in. Both are
+	// positive or zero.
+	Debit  float64
+	Credit float64
+
+	// Balance is the account balance after the transaction; HasBalance
+	// reports whether the statement shows it.
+	Balance    float64
+	HasBalance bool
+
+	// Page is the 0-based page of the table holding the transaction.
+	Page int
+}
+
+// Amount returns the signed amount: Credit minus Debit.
+func (tx Transaction) Amount() float64 {
+	return tx.Credit - tx.Debit
+}
+
+// Columns are the 0-based indices of the statement columns in a table,
+// or -1 for columns the statement does not have.
+type Columns struct {
+	Date        int
+	Description int
+	Debit       int
+	Credit      int
+
+	// Amount is a single signed amount column, used when the statement has
+	// no separate debit and credit columns. Negative amounts are debits;
+	// unsigned amounts are credits, unless the column marks credits with
+	// "+", in which case unsigned amounts are debits.
+	Amount int
+
+	Balance int
+}
+
+// hasAmount reports whether the columns include an amount.
+func (c Columns) hasAmount() bool {
+	return c.Debit >= 0 || c.Credit >= 0 || c.Amount >= 0
+}
+
+// headerKeywords identify column headers by lowercase substrings, in order
+// of precedence: "Сумма операции" is an amount, "Дата операции" a date.
+var headerKeywords = []struct {
+	field    func(c *Columns) *int
+	keywords []string
+}{
+	{func(c *Columns) *int { return &c.Balance }, []string{"balance", "saldo", "остаток", "баланс"}},
+	{func(c *Columns) *int { return &c.Debit }, []string{"debit", "withdrawal", "paid out", "money out", "списание", "расход", "дебет"}},
+	{func(c *Columns) *int { return &c.Credit }, []string{"credit", "deposit", "paid in", "money in", "поступление", "зачисление", "приход", "кредит"}},
+	{func(c *Columns) *int { return &c.Amount }, []string{"amount", "сумма"}},
+	{func(c *Columns) *int { return &c.Date }, []string{"date", "datum", "fecha", "дата"}},
+	{func(c *Columns) *int { return &c.Description }, []string{"description", "details", "narrative", "particulars", "memo", "reference", "transaction", "описание", "назначение", "содержание", "операция"}},
+}
+
+// defaultDateLayouts are the date layouts tried by default.
+var defaultDateLayouts = []string{
+	"02.01.2006",
+	"02.01.06",
+	"2006-01-02",
+	"01/02/2006",
+	"01/02/06",
+	"2 Jan 2006",
+	"02 Jan 2006",
+	"Jan 2, 2006",
+}
+
+// BankStatement maps the transaction tables of a bank statement to
+// transactions.
+//
+// Columns are found by their header names, in English and Russian (e.g.
+// "Date", "Description", "Debit", "Credit", "Balance", "Дата операции",
+// "Сумма"). Tables without a header row continue the columns of the
+// previous table with the same number of columns, as on the continuation
+// pages of a statement.
+//
+// A row with an amount and a date starts a transaction; rows without an
+// amount continue the description of the previous transaction, and rows
+// with an amount but no date (such as totals) are skipped.
+type BankStatement struct {
+	// Columns, if set, are used instead of detecting the columns from the
+	// header row. All rows are then treated as data rows.
+	Columns *Columns
+
+	// DateLayouts are the time layouts tried in order to parse dates.
+	// Default: day-first dotted dates (02.01.2006, 02.01.06), ISO dates,
+	// US slashed dates (01/02/2006) and written-out months.
+	DateLayouts []string
+}
+
+// Transactions returns the transactions of the given tables, in order.
+func (s *BankStatement) Transactions(tables ...*gxpdf.Table) ([]Transaction, error) {
+	p := &statementParser{statement: s}
+	for _, t := range tables {
+		p.parse(t.Rows(), t.PageNumber())
+	}
+	if !p.found {
+		return nil, ErrNoStatementColumns
+	}
+	return p.transactions, nil
+}
+
+// ParseRows returns the transactions of a table given as rows of cells,
+// such as the result of Table.Rows.
+func (s *BankStatement) ParseRows(rows [][]string) ([]Transaction, error) {
+	p := &statementParser{statement: s}
+	p.parse(rows, 0)
+	if !p.found {
+		return nil, ErrNoStatementColumns
+	}
+	return p.transactions, nil
+}
+
+// statementParser collects the transactions of consecutive tables.
+type statementParser struct {
+	statement    *BankStatement
+	transactions []Transaction
+	found        bool    // columns were found in some table
+	columns      Columns // columns of the previous statement table
+	width        int     // column count of the previous statement table
+	creditSigns  bool    // the amount column marks credits with "+" (in any table)
+}
+
+// parse adds the transactions of one table.
+func (p *statementParser) parse(rows [][]string, page int) {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	start := 0
+	switch {
+	case p.statement.Columns != nil:
+		p.columns = *p.statement.Columns
+	default:
+		header, columns, ok := findHeader(rows)
+		switch {
+		case ok:
+			p.columns, start = columns, header+1
+		case p.found && width == p.width:
+			// A continuation table without a header.
+		default:
+			return
+		}
+	}
+	p.found, p.width = true, width
+
+	data := rows[min(start, len(rows)):]
+	if p.columns.Amount >= 0 && !p.creditSigns {
+		for _, row := range data {
+			if strings.HasPrefix(strings.TrimSpace(cell(row, p.columns.Amount)), "+") {
+				p.creditSigns = true
+				break
+			}
+		}
+	}
+
+	for _, row := range data {
+		p.row(row, page)
+	}
+}
+
+// row adds a transaction or continues the description of the previous one.
+func (p *statementParser) row(row []string, page int) {
+	c := p.columns
+	tx := Transaction{
+		DateText:    strings.TrimSpace(cell(row, c.Date)),
+		Description: strings.Join(strings.Fields(cell(row, c.Description)), " "),
+		Page:        page,
+	}
+
+	hasAmount := false
+	if v, ok := parseAmount(cell(row, c.Debit)); ok {
+		tx.Debit, hasAmount = math.Abs(v), true
+	}
+	if v, ok := parseAmount(cell(row, c.Credit)); ok {
+		tx.Credit, hasAmount = math.Abs(v), true
+	}
+	if text := strings.TrimSpace(cell(row, c.Amount)); text != "" {
+		if v, ok := parseAmount(text); ok {
+			hasAmount = true
+			switch {
+			case v < 0, p.creditSigns && !strings.HasPrefix(text, "+"):
+				tx.Debit = math.Abs(v)
+			default:
+				tx.Credit = v
+			}
+		}
+	}
+
+	if !hasAmount {
+		if tx.Description != "" && len(p.transactions) > 0 {
+			prev := &p.transactions[len(p.transactions)-1]
+			prev.Description = strings.TrimSpace(prev.Description + " " + tx.Description)
+		}
+		return
+	}
+	if c.Date >= 0 {
+		if tx.DateText == "" {
+			return // Totals and other summary rows.
+		}
+		tx.Date = p.parseDate(tx.DateText)
+		if tx.Date.IsZero() {
+			return
+		}
+	}
+	if v, ok := parseAmount(cell(row, c.Balance)); ok {
+		tx.Balance, tx.HasBalance = v, true
+	}
+	p.transactions = append(p.transactions, tx)
+}
+
+// parseDate parses a date cell, which may be followed by a time, or
+// returns the zero time.
+func (p *statementParser) parseDate(text string) time.Time {
+	layouts := p.statement.DateLayouts
+	if len(layouts) == 0 {
+		layouts = defaultDateLayouts
+	}
+	candidates := []string{text}
+	if fields := strings.Fields(text); len(fields) > 1 {
+		candidates = append(candidates, fields[0])
+	}
+	for _, candidate := range candidates {
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, candidate); err == nil {
+				return t
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// findHeader returns the index and columns of the first header row naming
+// a date and an amount column.
+func findHeader(rows [][]string) (int, Columns, bool) {
+	for i := 0; i < min(len(rows), headerSearchRows); i++ {
+		columns := Columns{Date: -1, Description: -1, Debit: -1, Credit: -1, Amount: -1, Balance: -1}
+		for j, text := range rows[i] {
+			text = strings.ToLower(text)
+			for _, h := range headerKeywords {
+				if !containsAny(text, h.keywords) {
+					continue
+				}
+				if field := h.field(&columns); *field < 0 {
+					*field = j
+				}
+				break
+			}
+		}
+		if columns.Date >= 0 && columns.hasAmount() {
+			return i, columns, true
+		}
+	}
+	return 0, Columns{}, false
+}
+
+// parseAmount parses a money amount such as "1 650,00", "-350.50",
+// "+1,000.00", "(12.00)" or "$ 5", ignoring currency symbols and codes.
+func parseAmount(text string) (float64, bool) {
+	text = strings.TrimSpace(text)
+	negative := false
+	if strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		negative, text = true, text[1:len(text)-1]
+	}
+
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r >= '0' && r <= '9', r == '.', r == ',':
+			b.WriteRune(r)
+		case r == '-' || r == '−':
+			negative = true
+		case r == '+', unicode.IsSpace(r), r == '\'', unicode.IsLetter(r), unicode.Is(unicode.Sc, r):
+			// Signs, thousands separators and currencies.
+		default:
+			return 0, false
+		}
+	}
+	s := b.String()
+	if strings.IndexFunc(s, unicode.IsDigit) < 0 {
+		return 0, false
+	}
+
+	// The decimal separator is the last of '.' and ',' when both occur; a
+	// lone ',' is a decimal separator before exactly two digits, and
+	// repeated '.' are thousands separators.
+	dot, comma := strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+	switch {
+	case dot >= 0 && comma >= 0:
+		if comma > dot {
+			s = strings.ReplaceAll(s, ".", "")
+			s = strings.Replace(s, ",", ".", 1)
+		} else {
+			s = strings.ReplaceAll(s, ",", "")
+		}
+	case comma >= 0:
+		if strings.Count(s, ",") == 1 && len(s)-comma == 3 {
+			s = strings.Replace(s, ",", ".", 1)
+		} else {
+			s = strings.ReplaceAll(s, ",", "")
+		}
+	case strings.Count(s, ".") > 1:
+		s = strings.ReplaceAll(s, ".", "")
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	if negative {
+		v = -v
+	}
+	return v, true
+}
+
+// cell returns row[i], or "" for a missing column.
+func cell(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
+
+// containsAny reports whether s contains any of the substrings.
+func containsAny(s string, substrings []string) bool {
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
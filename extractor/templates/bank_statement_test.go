@@ -0,0 +1,138 @@
+package templates
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+		ok   bool
+	}{
+		{"1 650,00", 1650, true},
+		{"-350.50", -350.5, true},
+		{"+1,000.00", 1000, true},
+		{"1.234.567,89", 1234567.89, true},
+		{"1,234", 1234, true},
+		{"(12.00)", -12, true},
+		{"$ 5", 5, true},
+		{"2 500,00 ₽", 2500, true},
+		{"−42,10 RUB", -42.1, true},
+		{"", 0, false},
+		{"n/a", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseAmount(tt.text)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseAmount(%q) = %v, %v, want %v, %v", tt.text, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBankStatement_DebitCredit(t *testing.T) {
+	rows := [][]string{
+		{"Account statement", "", "", "", ""},
+		{"Date", "Description", "Debit", "Credit", "Balance"},
+		{"2025-03-01", "Opening deposit", "", "1,000.00", "1,000.00"},
+		{"2025-03-02", "Card payment", "25.50", "", "974.50"},
+		{"", "COFFEE SHOP  BERLIN", "", "", ""},
+		{"", "Total", "25.50", "1,000.00", ""},
+	}
+
+	var statement BankStatement
+	txs, err := statement.ParseRows(rows)
+	if err != nil {
+		t.Fatalf("ParseRows() error = %v", err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("got %d transactions, want 2: %+v", len(txs), txs)
+	}
+
+	if want := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC); !txs[0].Date.Equal(want) {
+		t.Errorf("Date = %v, want %v", txs[0].Date, want)
+	}
+	if txs[0].Credit != 1000 || txs[0].Amount() != 1000 {
+		t.Errorf("first transaction = %+v, want credit 1000", txs[0])
+	}
+	if txs[1].Debit != 25.5 || txs[1].Amount() != -25.5 {
+		t.Errorf("second transaction = %+v, want debit 25.5", txs[1])
+	}
+	if !txs[1].HasBalance || txs[1].Balance != 974.5 {
+		t.Errorf("Balance = %v, %v, want 974.5", txs[1].Balance, txs[1].HasBalance)
+	}
+	if txs[1].Description != "Card payment COFFEE SHOP BERLIN" {
+		t.Errorf("Description = %q, want continuation joined", txs[1].Description)
+	}
+}
+
+func TestBankStatement_SignedAmount(t *testing.T) {
+	rows := [][]string{
+		{"Дата операции", "Описание операции", "Сумма в валюте счёта", "Остаток средств"},
+		{"16.09.2025 14:32", "WILDBERRIES", "1 650,00", "8 350,00"},
+		{"17.09.2025", "Перевод от клиента", "+10 000,00", "18 350,00"},
+	}
+
+	var statement BankStatement
+	txs, err := statement.ParseRows(rows)
+	if err != nil {
+		t.Fatalf("ParseRows() error = %v", err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("got %d transactions, want 2: %+v", len(txs), txs)
+	}
+	if want := time.Date(2025, 9, 16, 0, 0, 0, 0, time.UTC); !txs[0].Date.Equal(want) {
+		t.Errorf("Date = %v, want %v", txs[0].Date, want)
+	}
+	if txs[0].Debit != 1650 {
+		t.Errorf("unsigned amount = %+v, want debit when credits are marked with +", txs[0])
+	}
+	if txs[1].Credit != 10000 || txs[1].Balance != 18350 {
+		t.Errorf("second transaction = %+v, want credit 10000, balance 18350", txs[1])
+	}
+}
+
+func TestBankStatement_ContinuationTable(t *testing.T) {
+	p := &statementParser{statement: &BankStatement{}}
+	p.parse([][]string{
+		{"Date", "Details", "Amount"},
+		{"01.02.2025", "Salary", "3 000,00"},
+	}, 0)
+	p.parse([][]string{
+		{"02.02.2025", "Rent", "-1 200,00"},
+	}, 1)
+	p.parse([][]string{
+		{"Summary", "", "", ""},
+	}, 2)
+
+	if len(p.transactions) != 2 {
+		t.Fatalf("got %d transactions, want 2: %+v", len(p.transactions), p.transactions)
+	}
+	if tx := p.transactions[1]; tx.Page != 1 || tx.Debit != 1200 {
+		t.Errorf("continuation transaction = %+v, want page 1, debit 1200", tx)
+	}
+}
+
+func TestBankStatement_Columns(t *testing.T) {
+	statement := BankStatement{
+		Columns:     &Columns{Date: 0, Description: 1, Debit: -1, Credit: -1, Amount: 2, Balance: -1},
+		DateLayouts: []string{"02/01/2006"},
+	}
+	txs, err := statement.ParseRows([][]string{{"31/01/2025", "Fee", "-2.00"}})
+	if err != nil {
+		t.Fatalf("ParseRows() error = %v", err)
+	}
+	if len(txs) != 1 || txs[0].Date.Month() != time.January || txs[0].Debit != 2 {
+		t.Errorf("transactions = %+v, want one January debit of 2", txs)
+	}
+}
+
+func TestBankStatement_NoColumns(t *testing.T) {
+	var statement BankStatement
+	_, err := statement.ParseRows([][]string{{"Name", "Value"}, {"a", "1"}})
+	if !errors.Is(err, ErrNoStatementColumns) {
+		t.Errorf("ParseRows() error = %v, want ErrNoStatementColumns", err)
+	}
+}
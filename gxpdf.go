@@ -42,8 +42,10 @@
 package gxpdf
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/coregx/gxpdf/internal/parser"
 )
@@ -56,6 +58,10 @@ const Version = "0.1.0-alpha"
 // This is the main entry point for reading PDF files.
 // The returned Document must be closed after use.
 //
+// Encrypted PDFs protected by a user password fail with an error matching
+// ErrPasswordRequired (see IsPasswordRequired; open them with
+// OpenWithPassword); PDFs with only an owner password open normally.
+//
 // Example:
 //
 //	doc, err := gxpdf.Open("document.pdf")
@@ -92,6 +98,84 @@ func OpenWithContext(ctx context.Context, path string) (*Document, error) {
 	}, nil
 }
 
+// OpenWithPassword opens an encrypted PDF file with its user or owner
+// password. Strings and streams are decrypted as they are read, so text
+// and tables are extracted as from an unencrypted file.
+//
+// RC4 (40 to 128 bits), AES-128 and AES-256 encryption with the standard
+// security handler are supported. A wrong password fails with an error
+// matching ErrWrongPassword.
+//
+// Example:
+//
+//	doc, err := gxpdf.OpenWithPassword("statement.pdf", "secret")
+//	if errors.Is(err, gxpdf.ErrWrongPassword) {
+//	    log.Fatal("wrong password")
+//	}
+func OpenWithPassword(path, password string) (*Document, error) {
+	reader, err := parser.OpenPDFWithPassword(path, password)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to open %s: %w", path, err)
+	}
+
+	return &Document{
+		reader: reader,
+		ctx:    context.Background(),
+		path:   path,
+	}, nil
+}
+
+// OpenMapped opens a PDF file with memory-mapped file access.
+//
+// Objects are read from mapped memory instead of with a seek and a read
+// system call each, which speeds up random access to very large files,
+// such as batch extraction over multi-gigabyte archives. On platforms
+// without memory mapping it behaves like Open.
+//
+// The file must not be truncated or modified while the Document is open.
+func OpenMapped(path string) (*Document, error) {
+	reader, err := parser.OpenPDFMapped(path)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to open %s: %w", path, err)
+	}
+
+	return &Document{
+		reader: reader,
+		ctx:    context.Background(),
+		path:   path,
+	}, nil
+}
+
+// OpenBytes opens a PDF held in memory, such as a file fetched from object
+// storage or an embedded test fixture. The slice must not be modified while
+// the Document is open. Path returns "" for such documents.
+//
+// Example:
+//
+//	//go:embed testdata/invoice.pdf
+//	var invoice []byte
+//
+//	doc, err := gxpdf.OpenBytes(invoice)
+func OpenBytes(data []byte) (*Document, error) {
+	return OpenReader(bytes.NewReader(data), int64(len(data)))
+}
+
+// OpenReader opens a PDF of size bytes read from r, without touching the
+// filesystem. Objects are read from r on demand, so r must stay readable
+// and unchanged until the Document is closed; closing the Document does
+// not close r.
+func OpenReader(r io.ReaderAt, size int64) (*Document, error) {
+	reader, err := parser.OpenPDFFrom(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to open PDF: %w", err)
+	}
+
+	return &Document{
+		reader: reader,
+		ctx:    context.Background(),
+	}, nil
+}
+
 // MustOpen opens a PDF file and panics on error.
 //
 // This is useful for initialization in tests or when the file is known to exist.
@@ -0,0 +1,73 @@
+package gxpdf
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// ICCProfile is an ICC color profile embedded in a PDF.
+//
+// Example:
+//
+//	profiles, _ := doc.ExtractICCProfiles()
+//	for _, p := range profiles {
+//	    for _, ref := range p.References {
+//	        fmt.Printf("%s %s: %d components, %d bytes\n", ref.Kind, ref.Name, p.Components, len(p.Data))
+//	    }
+//	}
+type ICCProfile struct {
+	// Data is the decoded profile.
+	Data []byte
+
+	// Components is the number of color components: 1 (gray), 3 (RGB)
+	// or 4 (CMYK).
+	Components int
+
+	// Alternate is the alternate color space name, if any.
+	Alternate string
+
+	// References lists where the profile is referenced.
+	References []ICCReference
+}
+
+// ICCReference is a place in a PDF that references an ICC profile.
+type ICCReference struct {
+	// Kind is "OutputIntent", "ColorSpace" (a page color space resource)
+	// or "Image" (an image's color space).
+	Kind string
+
+	// Page is the 0-based page index, or -1 for output intents.
+	Page int
+
+	// Name is the output intent subtype (e.g. "GTS_PDFX") or the resource
+	// name of the color space or image.
+	Name string
+}
+
+// ExtractICCProfiles extracts the ICC profiles referenced by the document's
+// output intents, page color spaces and images.
+//
+// Each profile is returned once, with all places that reference it.
+func (d *Document) ExtractICCProfiles() ([]ICCProfile, error) {
+	internalProfiles, err := extractor.ExtractICCProfiles(d.reader)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to extract ICC profiles: %w", err)
+	}
+
+	profiles := make([]ICCProfile, len(internalProfiles))
+	for i, p := range internalProfiles {
+		refs := make([]ICCReference, len(p.References))
+		for j, r := range p.References {
+			refs[j] = ICCReference{Kind: r.Kind, Page: r.Page, Name: r.Name}
+		}
+		profiles[i] = ICCProfile{
+			Data:       p.Data,
+			Components: p.Components,
+			Alternate:  p.Alternate,
+			References: refs,
+		}
+	}
+
+	return profiles, nil
+}
@@ -39,6 +39,10 @@ type FieldInfo struct {
 
 	// Options contains choice field options.
 	Options []string
+
+	// Ref is the reference to the field dictionary, or nil if the
+	// dictionary is a direct object.
+	Ref *parser.IndirectReference
 }
 
 // Reader reads form fields from a PDF document.
@@ -104,6 +108,7 @@ func (r *Reader) GetFieldByName(name string) (*FieldInfo, error) {
 
 // parseField parses a field dictionary and its children.
 func (r *Reader) parseField(obj parser.PdfObject, parentName string) ([]*FieldInfo, error) {
+	ref, _ := obj.(*parser.IndirectReference)
 	obj = r.pdfReader.ResolveReferences(obj)
 
 	dict, ok := obj.(*parser.Dictionary)
@@ -120,6 +125,7 @@ func (r *Reader) parseField(obj parser.PdfObject, parentName string) ([]*FieldIn
 
 	// Terminal field - create FieldInfo
 	info := r.createFieldInfo(dict, fieldName)
+	info.Ref = ref
 	return []*FieldInfo{info}, nil
 }
 
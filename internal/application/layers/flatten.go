@@ -0,0 +1,382 @@
+package layers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/coregx/gxpdf/internal/application/rewrite"
+	"github.com/coregx/gxpdf/internal/encoding"
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// Flatten writes a copy of the document without optional content.
+//
+// The content of the layers named in remove is deleted: marked content
+// sequences and XObjects tagged with them, and annotations belonging to
+// them. The content of all other layers is kept and drawn unconditionally.
+// Content tagged with a membership dictionary is deleted when all of its
+// layers are removed.
+//
+// The copy contains the objects reachable from the catalog and the
+// document information. Encrypted documents and layered content streams
+// with inline images are not supported.
+func Flatten(reader *parser.Reader, remove []string, w io.Writer) error {
+	trailer := reader.Trailer()
+	if trailer == nil {
+		return fmt.Errorf("trailer not loaded (call Open first)")
+	}
+	if enc := reader.Encryption(); enc != nil {
+		return enc
+	}
+
+	layers, err := Read(reader)
+	if err != nil {
+		return err
+	}
+	f := &flattener{
+		reader:  reader,
+		copier:  rewrite.NewCopier(reader),
+		removed: make(map[int]bool),
+	}
+	for _, name := range remove {
+		i := slices.IndexFunc(layers, func(l Layer) bool { return l.Name == name })
+		if i < 0 {
+			return fmt.Errorf("unknown layer %q", name)
+		}
+		f.removed[layers[i].ObjectNumber] = true
+	}
+	if root, ok := trailer.Get("Root").(*parser.IndirectReference); ok {
+		f.root = root.Number
+	}
+
+	f.copier.Transform = f.transform
+	return f.copier.Write(w)
+}
+
+// flattener transforms the objects of a copied document, removing optional
+// content.
+type flattener struct {
+	reader  *parser.Reader
+	copier  *rewrite.Copier
+	removed map[int]bool // object numbers of removed layers
+	root    int          // catalog object number
+}
+
+// transform returns the output version of an object.
+func (f *flattener) transform(number int, obj parser.PdfObject) (parser.PdfObject, error) {
+	switch v := obj.(type) {
+	case *parser.Dictionary:
+		if number == f.root {
+			catalog := v.Clone()
+			catalog.Remove("OCProperties")
+			return catalog, nil
+		}
+		if t := v.GetName("Type"); t != nil && t.Value() == "Page" {
+			return f.transformPage(v)
+		}
+		if v.Has("OC") {
+			dict := v.Clone()
+			dict.Remove("OC")
+			return dict, nil
+		}
+	case *parser.Stream:
+		dict := v.Dictionary()
+		if subtype := dict.GetName("Subtype"); subtype != nil && subtype.Value() == "Form" {
+			return f.transformForm(v)
+		}
+		if dict.Has("OC") {
+			clone := v.Clone()
+			clone.Dictionary().Remove("OC")
+			return clone, nil
+		}
+	}
+	return obj, nil
+}
+
+// transformPage rewrites the content streams of a page into one and drops
+// its annotations of removed layers.
+func (f *flattener) transformPage(page *parser.Dictionary) (parser.PdfObject, error) {
+	out := page.Clone()
+
+	if annots, ok := f.resolve(page.Get("Annots")).(*parser.Array); ok {
+		kept := parser.NewArray()
+		for i := 0; i < annots.Len(); i++ {
+			annot, _ := f.resolve(annots.Get(i)).(*parser.Dictionary)
+			if annot != nil && f.isRemoved(annot.Get("OC")) {
+				continue
+			}
+			kept.Append(annots.Get(i))
+		}
+		out.Set("Annots", kept)
+	}
+
+	// Gather the content streams; the first one receives the rewritten content.
+	var refs []*parser.IndirectReference
+	switch c := page.Get("Contents").(type) {
+	case *parser.IndirectReference:
+		if arr, ok := f.resolve(c).(*parser.Array); ok {
+			refs = arrayRefs(arr)
+		} else {
+			refs = []*parser.IndirectReference{c}
+		}
+	case *parser.Array:
+		refs = arrayRefs(c)
+	}
+
+	var content []byte
+	var first *parser.IndirectReference
+	var firstStream *parser.Stream
+	for _, ref := range refs {
+		stream, ok := f.resolve(ref).(*parser.Stream)
+		if !ok {
+			continue
+		}
+		if first == nil {
+			first, firstStream = ref, stream
+		}
+		data, err := decodeStream(stream)
+		if err != nil {
+			return nil, fmt.Errorf("page content stream %d: %w", ref.Number, err)
+		}
+		content = append(append(content, data...), '\n')
+	}
+	if first == nil {
+		return out, nil
+	}
+
+	rewritten, err := f.rewriteContent(content, f.pageResources(page))
+	if err != nil {
+		return nil, fmt.Errorf("page content stream %d: %w", first.Number, err)
+	}
+	f.copier.Set(first.Number, first.Generation, newStream(firstStream.Dictionary(), rewritten))
+	out.Set("Contents", first)
+	return out, nil
+}
+
+// transformForm rewrites the content of a form XObject.
+func (f *flattener) transformForm(form *parser.Stream) (parser.PdfObject, error) {
+	data, err := decodeStream(form)
+	if err != nil {
+		return nil, fmt.Errorf("form XObject: %w", err)
+	}
+	resources, _ := f.resolve(form.Dictionary().Get("Resources")).(*parser.Dictionary)
+	rewritten, err := f.rewriteContent(data, resources)
+	if err != nil {
+		return nil, fmt.Errorf("form XObject: %w", err)
+	}
+	out := newStream(form.Dictionary(), rewritten)
+	out.Dictionary().Remove("OC")
+	return out, nil
+}
+
+// rewriteContent removes the marked content and XObjects of removed layers
+// from a content stream and unwraps the marked content of other layers.
+func (f *flattener) rewriteContent(content []byte, resources *parser.Dictionary) ([]byte, error) {
+	ops, err := extractor.NewContentParser(content).ParseOperators()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse content: %w", err)
+	}
+
+	// Open marked-content sequences: whether each is optional content
+	// (unwrapped) and whether it belongs to a removed layer (skipped).
+	type sequence struct{ optional, removed bool }
+	var open []sequence
+	skipping := func() bool {
+		return slices.ContainsFunc(open, func(s sequence) bool { return s.removed })
+	}
+
+	var buf bytes.Buffer
+	for _, op := range ops {
+		switch op.Name {
+		case "BMC":
+			open = append(open, sequence{})
+			if skipping() {
+				continue
+			}
+		case "BDC":
+			var seq sequence
+			if oc := f.optionalContent(op, resources); oc != nil {
+				seq = sequence{optional: true, removed: f.isRemoved(oc)}
+			}
+			skip := skipping()
+			open = append(open, seq)
+			if skip || seq.optional {
+				continue
+			}
+		case "EMC":
+			if len(open) > 0 {
+				seq := open[len(open)-1]
+				skip := skipping()
+				open = open[:len(open)-1]
+				if skip || seq.optional {
+					continue
+				}
+			}
+		case "Do":
+			if skipping() || f.removedXObject(op, resources) {
+				continue
+			}
+		default:
+			if skipping() {
+				continue
+			}
+		}
+		writeOperator(&buf, op)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// optionalContent returns the optional content group or membership
+// dictionary of a "/OC props BDC" operator, or nil for other marked content.
+func (f *flattener) optionalContent(op *extractor.Operator, resources *parser.Dictionary) parser.PdfObject {
+	if len(op.Operands) < 2 {
+		return nil
+	}
+	if tag, ok := op.Operands[0].(*parser.Name); !ok || tag.Value() != "OC" {
+		return nil
+	}
+	name, ok := op.Operands[1].(*parser.Name)
+	if !ok || resources == nil {
+		return nil
+	}
+	props, ok := f.resolve(resources.Get("Properties")).(*parser.Dictionary)
+	if !ok {
+		return nil
+	}
+	return props.Get(name.Value())
+}
+
+// removedXObject reports whether the XObject painted by a Do operator
+// belongs to a removed layer.
+func (f *flattener) removedXObject(op *extractor.Operator, resources *parser.Dictionary) bool {
+	if len(op.Operands) == 0 || resources == nil {
+		return false
+	}
+	name, ok := op.Operands[0].(*parser.Name)
+	if !ok {
+		return false
+	}
+	xobjects, ok := f.resolve(resources.Get("XObject")).(*parser.Dictionary)
+	if !ok {
+		return false
+	}
+	stream, ok := f.resolve(xobjects.Get(name.Value())).(*parser.Stream)
+	return ok && f.isRemoved(stream.Dictionary().Get("OC"))
+}
+
+// isRemoved reports whether an optional content group or membership
+// dictionary refers to removed layers only.
+func (f *flattener) isRemoved(oc parser.PdfObject) bool {
+	ref, ok := oc.(*parser.IndirectReference)
+	if !ok {
+		return false
+	}
+	if f.removed[ref.Number] {
+		return true
+	}
+	dict, ok := f.resolve(ref).(*parser.Dictionary)
+	if !ok {
+		return false
+	}
+	if t := dict.GetName("Type"); t == nil || t.Value() != "OCMD" {
+		return false
+	}
+	var members []*parser.IndirectReference
+	switch ocgs := dict.Get("OCGs").(type) {
+	case *parser.IndirectReference:
+		if arr, ok := f.resolve(ocgs).(*parser.Array); ok {
+			members = arrayRefs(arr)
+		} else {
+			members = []*parser.IndirectReference{ocgs}
+		}
+	case *parser.Array:
+		members = arrayRefs(ocgs)
+	}
+	if len(members) == 0 {
+		return false
+	}
+	for _, m := range members {
+		if !f.removed[m.Number] {
+			return false
+		}
+	}
+	return true
+}
+
+// pageResources returns the resources of a page, which may be inherited
+// from its ancestors in the page tree.
+func (f *flattener) pageResources(page *parser.Dictionary) *parser.Dictionary {
+	for node, depth := page, 0; node != nil && depth < 64; depth++ {
+		if resources, ok := f.resolve(node.Get("Resources")).(*parser.Dictionary); ok {
+			return resources
+		}
+		node, _ = f.resolve(node.Get("Parent")).(*parser.Dictionary)
+	}
+	return nil
+}
+
+// resolve resolves an indirect reference against the source document.
+func (f *flattener) resolve(obj parser.PdfObject) parser.PdfObject {
+	return resolve(f.reader, obj)
+}
+
+// writeOperator writes an operator and its operands as a content stream line.
+func writeOperator(buf *bytes.Buffer, op *extractor.Operator) {
+	for _, operand := range op.Operands {
+		_, _ = operand.WriteTo(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(op.Name)
+	buf.WriteByte('\n')
+}
+
+// newStream returns an unfiltered stream with a copy of dict.
+func newStream(dict *parser.Dictionary, content []byte) *parser.Stream {
+	d := dict.Clone()
+	d.Remove("Filter")
+	d.Remove("DecodeParms")
+	return parser.NewStream(d, content)
+}
+
+// decodeStream returns the decoded content of a stream; only unfiltered and
+// FlateDecode streams are supported.
+func decodeStream(stream *parser.Stream) ([]byte, error) {
+	var filter string
+	switch f := stream.Dictionary().Get("Filter").(type) {
+	case *parser.Name:
+		filter = f.Value()
+	case *parser.Array:
+		if f.Len() > 1 {
+			return nil, fmt.Errorf("unsupported filter chain of %d filters", f.Len())
+		}
+		if f.Len() == 1 {
+			if name, ok := f.Get(0).(*parser.Name); ok {
+				filter = name.Value()
+			}
+		}
+	}
+
+	switch filter {
+	case "":
+		return stream.Content(), nil
+	case "FlateDecode":
+		return encoding.NewFlateDecoder().Decode(stream.Content())
+	default:
+		return nil, fmt.Errorf("unsupported filter: %s", filter)
+	}
+}
+
+// arrayRefs returns the indirect references in an array.
+func arrayRefs(arr *parser.Array) []*parser.IndirectReference {
+	var refs []*parser.IndirectReference
+	for i := 0; i < arr.Len(); i++ {
+		if ref, ok := arr.Get(i).(*parser.IndirectReference); ok {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,137 @@
+package layers
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// flattenTo flattens the layered test PDF and reopens the result.
+func flattenTo(t *testing.T, remove ...string) *parser.Reader {
+	t.Helper()
+
+	reader, err := parser.OpenPDF(buildLayeredPDF(t))
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	if err := Flatten(reader, remove, &buf); err != nil {
+		t.Fatalf("Flatten() error = %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "flat.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	flat, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF(flattened) error = %v", err)
+	}
+	t.Cleanup(func() { flat.Close() })
+	return flat
+}
+
+// pageText returns the text of the first page, one element per line.
+func pageText(t *testing.T, reader *parser.Reader) string {
+	t.Helper()
+
+	elements, err := extractor.NewTextExtractor(reader).ExtractFromPage(0)
+	if err != nil {
+		t.Fatalf("ExtractFromPage() error = %v", err)
+	}
+	texts := make([]string, 0, len(elements))
+	for _, e := range elements {
+		texts = append(texts, e.Text)
+	}
+	return strings.Join(texts, "\n")
+}
+
+// pageContent returns the content stream of the first page.
+func pageContent(t *testing.T, reader *parser.Reader) string {
+	t.Helper()
+
+	page, err := reader.GetPage(0)
+	if err != nil {
+		t.Fatalf("GetPage() error = %v", err)
+	}
+	ref, _ := page.Get("Contents").(*parser.IndirectReference)
+	if ref == nil {
+		t.Fatalf("Contents = %v, want reference", page.Get("Contents"))
+	}
+	obj, err := reader.GetObject(ref.Number)
+	if err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	return string(obj.(*parser.Stream).Content())
+}
+
+func TestFlatten_RemoveLayer(t *testing.T) {
+	flat := flattenTo(t, "Dims")
+
+	layers, err := Read(flat)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(layers) != 0 {
+		t.Errorf("layers = %+v, want none", layers)
+	}
+
+	text := pageText(t, flat)
+	for _, want := range []string{"Base", "Walls", "Tagged"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text %q lacks %q", text, want)
+		}
+	}
+	for _, unwanted := range []string{"Dims", "Member"} {
+		if strings.Contains(text, unwanted) {
+			t.Errorf("text %q contains removed %q", text, unwanted)
+		}
+	}
+
+	page, err := flat.GetPage(0)
+	if err != nil {
+		t.Fatalf("GetPage() error = %v", err)
+	}
+	if annots, ok := page.Get("Annots").(*parser.Array); !ok || annots.Len() != 0 {
+		t.Errorf("Annots = %v, want empty array", page.Get("Annots"))
+	}
+	content := pageContent(t, flat)
+	if strings.Contains(content, "/OC") || strings.Contains(content, "/X1 Do") {
+		t.Errorf("content still has optional content:\n%s", content)
+	}
+	if !strings.Contains(content, "/Span") {
+		t.Errorf("content lost non-optional marked content:\n%s", content)
+	}
+}
+
+func TestFlatten_KeepAll(t *testing.T) {
+	flat := flattenTo(t)
+	text := pageText(t, flat)
+	for _, want := range []string{"Base", "Walls", "Dims", "Member", "Tagged"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text %q lacks %q", text, want)
+		}
+	}
+	if content := pageContent(t, flat); !strings.Contains(content, "/X1 Do") {
+		t.Errorf("content lost the form XObject:\n%s", content)
+	}
+}
+
+func TestFlatten_UnknownLayer(t *testing.T) {
+	reader, err := parser.OpenPDF(buildLayeredPDF(t))
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	if err := Flatten(reader, []string{"Nope"}, &buf); err == nil {
+		t.Error("Flatten() with unknown layer succeeded, want error")
+	}
+}
@@ -0,0 +1,102 @@
+// Package layers reads the optional content groups (layers) of a PDF and
+// writes copies of it with layers removed or flattened.
+//
+// Reference: PDF 1.7 specification, Section 8.11 (Optional Content).
+package layers
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// Layer is an optional content group.
+type Layer struct {
+	// Name is the layer name shown by viewers.
+	Name string
+
+	// Visible reports whether the layer is shown by default, according to
+	// the default configuration (/D) of the document.
+	Visible bool
+
+	// ObjectNumber is the number of the optional content group object.
+	ObjectNumber int
+}
+
+// Read returns the layers of the document in /OCGs order, or none if the
+// document has no optional content.
+func Read(reader *parser.Reader) ([]Layer, error) {
+	catalog, err := reader.GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog: %w", err)
+	}
+	props, ok := resolve(reader, catalog.Get("OCProperties")).(*parser.Dictionary)
+	if !ok {
+		return nil, nil
+	}
+	ocgs, ok := resolve(reader, props.Get("OCGs")).(*parser.Array)
+	if !ok {
+		return nil, nil
+	}
+
+	// Default visibility: /BaseState (default ON), overridden by /ON and /OFF.
+	config, _ := resolve(reader, props.Get("D")).(*parser.Dictionary)
+	baseOn := true
+	on, off := map[int]bool{}, map[int]bool{}
+	if config != nil {
+		if base := config.GetName("BaseState"); base != nil && base.Value() == "OFF" {
+			baseOn = false
+		}
+		on = refNumbers(reader, config.Get("ON"))
+		off = refNumbers(reader, config.Get("OFF"))
+	}
+
+	var layers []Layer
+	for i := 0; i < ocgs.Len(); i++ {
+		ref, ok := ocgs.Get(i).(*parser.IndirectReference)
+		if !ok {
+			continue
+		}
+		ocg, ok := resolve(reader, ref).(*parser.Dictionary)
+		if !ok {
+			continue
+		}
+		name := ""
+		if s, ok := resolve(reader, ocg.Get("Name")).(*parser.String); ok {
+			name = s.Text()
+		}
+		visible := (baseOn || on[ref.Number]) && !off[ref.Number]
+		layers = append(layers, Layer{Name: name, Visible: visible, ObjectNumber: ref.Number})
+	}
+
+	return layers, nil
+}
+
+// refNumbers returns the object numbers of the references in an array.
+func refNumbers(reader *parser.Reader, obj parser.PdfObject) map[int]bool {
+	numbers := make(map[int]bool)
+	arr, ok := resolve(reader, obj).(*parser.Array)
+	if !ok {
+		return numbers
+	}
+	for i := 0; i < arr.Len(); i++ {
+		if ref, ok := arr.Get(i).(*parser.IndirectReference); ok {
+			numbers[ref.Number] = true
+		}
+	}
+	return numbers
+}
+
+// resolve resolves an indirect reference; other objects are returned as is.
+// Unresolvable references yield nil.
+func resolve(reader *parser.Reader, obj parser.PdfObject) parser.PdfObject {
+	ref, ok := obj.(*parser.IndirectReference)
+	if !ok {
+		return obj
+	}
+	resolved, err := reader.GetObject(ref.Number)
+	if err != nil {
+		return nil
+	}
+	return resolved
+}
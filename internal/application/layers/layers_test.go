@@ -0,0 +1,117 @@
+package layers
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// buildLayeredPDF creates a one-page PDF with the layers "Walls" (object 5,
+// on) and "Dims" (object 6, off by default). Dims tags a marked content
+// sequence, a membership dictionary (object 8), a form XObject (object 9)
+// and an annotation (object 10).
+func buildLayeredPDF(t *testing.T) string {
+	t.Helper()
+
+	content := "BT /F1 12 Tf 10 150 Td (Base) Tj ET\n" +
+		"/OC /L1 BDC BT /F1 12 Tf 10 130 Td (Walls) Tj ET EMC\n" +
+		"/OC /L2 BDC BT /F1 12 Tf 10 110 Td (Dims) Tj ET EMC\n" +
+		"/OC /M BDC BT /F1 12 Tf 10 90 Td (Member) Tj ET EMC\n" +
+		"/Span << /ActualText (x) >> BDC BT /F1 12 Tf 10 70 Td (Tagged) Tj ET EMC\n" +
+		"/X1 Do\n"
+	form := "BT /F1 12 Tf 10 50 Td (Form) Tj ET"
+
+	return writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R /OCProperties << /OCGs [5 0 R 6 0 R] /D << /Order [5 0 R 6 0 R] /OFF [6 0 R] >> >> >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 /Resources << /Font << /F1 7 0 R >> " +
+			"/Properties << /L1 5 0 R /L2 6 0 R /M 8 0 R >> /XObject << /X1 9 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 200 200] /Contents 4 0 R /Annots [10 0 R] >>",
+		stream("", content),
+		"<< /Type /OCG /Name (Walls) >>",
+		"<< /Type /OCG /Name (Dims) >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /OCMD /OCGs [6 0 R] >>",
+		stream("/Type /XObject /Subtype /Form /BBox [0 0 200 200] /OC 6 0 R /Resources << /Font << /F1 7 0 R >> >>", form),
+		"<< /Type /Annot /Subtype /Square /Rect [0 0 10 10] /OC 6 0 R >>",
+	})
+}
+
+// stream formats a stream object with the given extra dictionary entries.
+func stream(entries, content string) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", entries, len(content), content)
+}
+
+// writeTestPDF writes objects numbered from 1 (the first being the catalog)
+// to a PDF file with a valid cross-reference table and returns its path.
+func writeTestPDF(t *testing.T, objects []string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	path := filepath.Join(t.TempDir(), "test.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRead(t *testing.T) {
+	reader, err := parser.OpenPDF(buildLayeredPDF(t))
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	layers, err := Read(reader)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	want := []Layer{
+		{Name: "Walls", Visible: true, ObjectNumber: 5},
+		{Name: "Dims", Visible: false, ObjectNumber: 6},
+	}
+	if len(layers) != len(want) {
+		t.Fatalf("layers = %+v, want %+v", layers, want)
+	}
+	for i := range want {
+		if layers[i] != want[i] {
+			t.Errorf("layers[%d] = %+v, want %+v", i, layers[i], want[i])
+		}
+	}
+}
+
+func TestRead_NoOptionalContent(t *testing.T) {
+	path := writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [] /Count 0 >>",
+	})
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	layers, err := Read(reader)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(layers) != 0 {
+		t.Errorf("layers = %+v, want none", layers)
+	}
+}
@@ -0,0 +1,236 @@
+// Package rewrite writes new PDF files from the objects of a parsed one.
+package rewrite
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// Copier copies the objects reachable from a document's trailer (/Root and
+// /Info) and writes them as a new PDF with a fresh cross-reference table.
+//
+// Objects keep their numbers and generations. Unreachable objects, such as
+// superseded revisions, object streams and xref streams, are dropped;
+// objects stored in object streams are written as regular objects, and
+// stream lengths are written as direct integers.
+type Copier struct {
+	reader *parser.Reader
+
+	// Transform, if set, returns the output version of each copied object.
+	// It must not modify obj, which may be cached by the reader; it may add
+	// other objects with Set.
+	Transform func(number int, obj parser.PdfObject) (parser.PdfObject, error)
+
+	// MissingAsNull writes objects that cannot be read as null instead of
+	// failing.
+	MissingAsNull bool
+
+	objects map[int]parser.PdfObject // output objects by number
+	gens    map[int]int              // generation numbers
+}
+
+// NewCopier creates a copier for the document read by reader.
+func NewCopier(reader *parser.Reader) *Copier {
+	return &Copier{
+		reader:  reader,
+		objects: make(map[int]parser.PdfObject),
+		gens:    make(map[int]int),
+	}
+}
+
+// Set sets the output version of an object, e.g. a content stream rewritten
+// by Transform. Objects set this way are not transformed.
+func (c *Copier) Set(number, generation int, obj parser.PdfObject) {
+	c.objects[number] = obj
+	c.gens[number] = generation
+}
+
+// Write copies the document and writes it to w.
+func (c *Copier) Write(w io.Writer) error {
+	trailer := c.reader.Trailer()
+	if trailer == nil {
+		return fmt.Errorf("trailer not loaded (call Open first)")
+	}
+	if enc := c.reader.Encryption(); enc != nil {
+		return enc
+	}
+
+	root, ok := trailer.Get("Root").(*parser.IndirectReference)
+	if !ok {
+		return fmt.Errorf("trailer has no catalog reference")
+	}
+	newTrailer := parser.NewDictionary()
+	newTrailer.Set("Root", root)
+	if err := c.visit(root); err != nil {
+		return err
+	}
+	if info, ok := trailer.Get("Info").(*parser.IndirectReference); ok {
+		if err := c.visit(info); err != nil {
+			return err
+		}
+		newTrailer.Set("Info", info)
+	}
+	if id, ok := trailer.Get("ID").(*parser.Array); ok && id.Len() == 2 {
+		newTrailer.Set("ID", id)
+	}
+
+	return c.write(w, newTrailer)
+}
+
+// visit copies the object a reference points to, and the objects it refers
+// to in turn.
+func (c *Copier) visit(ref *parser.IndirectReference) error {
+	if _, seen := c.objects[ref.Number]; seen {
+		return nil
+	}
+	obj, err := c.reader.GetObject(ref.Number)
+	if err != nil {
+		if !c.MissingAsNull {
+			return fmt.Errorf("failed to read object %d: %w", ref.Number, err)
+		}
+		c.Set(ref.Number, ref.Generation, parser.NewNull())
+		return nil
+	}
+	c.Set(ref.Number, ref.Generation, obj) // Placeholder against reference cycles.
+
+	if c.Transform != nil {
+		if obj, err = c.Transform(ref.Number, obj); err != nil {
+			return err
+		}
+		c.objects[ref.Number] = obj
+	}
+
+	return c.visitRefs(obj)
+}
+
+// visitRefs visits the references contained in an object.
+func (c *Copier) visitRefs(obj parser.PdfObject) error {
+	switch v := obj.(type) {
+	case *parser.IndirectReference:
+		return c.visit(v)
+	case *parser.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := c.visitRefs(v.Get(i)); err != nil {
+				return err
+			}
+		}
+	case *parser.Dictionary:
+		for _, key := range v.Keys() {
+			if err := c.visitRefs(v.Get(key)); err != nil {
+				return err
+			}
+		}
+	case *parser.Stream:
+		dict := v.Dictionary()
+		for _, key := range dict.Keys() {
+			// Lengths are written directly, so length objects are not needed.
+			if key == "Length" {
+				continue
+			}
+			if err := c.visitRefs(dict.Get(key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// write serializes the copied objects with a cross-reference table.
+func (c *Copier) write(w io.Writer, trailer *parser.Dictionary) error {
+	numbers := make([]int, 0, len(c.objects))
+	for n := range c.objects {
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+	size := 1
+	if len(numbers) > 0 {
+		size = numbers[len(numbers)-1] + 1
+	}
+
+	version := c.reader.Version()
+	if version == "" {
+		version = "1.7"
+	}
+
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+	fmt.Fprintf(cw, "%%PDF-%s\n%%\xe2\xe3\xcf\xd3\n", version)
+
+	offsets := make(map[int]int64, len(numbers))
+	for _, n := range numbers {
+		offsets[n] = cw.n
+		fmt.Fprintf(cw, "%d %d obj\n", n, c.gens[n])
+		if err := writeObject(cw, c.objects[n]); err != nil {
+			return fmt.Errorf("failed to write object %d: %w", n, err)
+		}
+		fmt.Fprintf(cw, "\nendobj\n")
+	}
+
+	xref := cw.n
+	fmt.Fprintf(cw, "xref\n0 %d\n", size)
+	fmt.Fprintf(cw, "%010d %05d f\r\n", 0, 65535)
+	for n := 1; n < size; n++ {
+		if off, ok := offsets[n]; ok {
+			fmt.Fprintf(cw, "%010d %05d n\r\n", off, c.gens[n])
+		} else {
+			fmt.Fprintf(cw, "%010d %05d f\r\n", 0, 0)
+		}
+	}
+	trailer.SetInteger("Size", int64(size))
+	fmt.Fprintf(cw, "trailer\n")
+	if _, err := trailer.WriteTo(cw); err != nil {
+		return fmt.Errorf("failed to write trailer: %w", err)
+	}
+	fmt.Fprintf(cw, "\nstartxref\n%d\n%%%%EOF\n", xref)
+
+	if cw.err != nil {
+		return cw.err
+	}
+	return bw.Flush()
+}
+
+// writeObject writes an object; streams are written with a copy of their
+// dictionary holding the actual length, leaving the original untouched.
+func writeObject(w io.Writer, obj parser.PdfObject) error {
+	stream, ok := obj.(*parser.Stream)
+	if !ok {
+		_, err := obj.WriteTo(w)
+		return err
+	}
+
+	content := stream.Content()
+	dict := stream.Dictionary().Clone()
+	dict.SetInteger("Length", int64(len(content)))
+	if _, err := dict.WriteTo(w); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\nstream\n"); err != nil {
+		return err
+	}
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\nendstream")
+	return err
+}
+
+// countingWriter tracks the number of bytes written and the first error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
@@ -0,0 +1,171 @@
+package rewrite
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+const testContent = "BT /F1 12 Tf 10 50 Td (Copy) Tj ET"
+
+// writeTestPDF writes a one-page PDF to a temporary file and returns its
+// path. The content stream (object 4) has an indirect length (object 5),
+// object 6 is unreachable, and the page refers to the missing object 9.
+func writeTestPDF(t *testing.T) string {
+	t.Helper()
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 200 200] /Contents 4 0 R /Thumb 9 0 R >>",
+		fmt.Sprintf("<< /Length 5 0 R >>\nstream\n%s\nendstream", testContent),
+		fmt.Sprint(len(testContent)),
+		"(unreachable)",
+	}
+	return writeObjectsPDF(t, objects, "/ID [<01> <02>]")
+}
+
+// writeObjectsPDF writes a PDF holding objects, numbered from 1, to a
+// temporary file and returns its path. Object 1 is the catalog; trailer
+// holds additional trailer entries.
+func writeObjectsPDF(t *testing.T, objects []string, trailer string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.5\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R %s >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, trailer, xref)
+
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// copyTestPDF copies the test PDF and returns the reopened copy.
+func copyTestPDF(t *testing.T, configure func(*Copier)) (*parser.Reader, error) {
+	t.Helper()
+
+	reader, err := parser.OpenPDF(writeTestPDF(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	copier := NewCopier(reader)
+	if configure != nil {
+		configure(copier)
+	}
+	var out bytes.Buffer
+	if err := copier.Write(&out); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(t.TempDir(), "out.pdf")
+	if err := os.WriteFile(path, out.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	copied, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("copy does not open: %v", err)
+	}
+	t.Cleanup(func() { copied.Close() })
+	return copied, nil
+}
+
+func TestCopier_MissingObject(t *testing.T) {
+	_, err := copyTestPDF(t, nil)
+	if err == nil || !strings.Contains(err.Error(), "object 9") {
+		t.Errorf("error = %v, want failure reading object 9", err)
+	}
+}
+
+func TestCopier_Write(t *testing.T) {
+	copied, err := copyTestPDF(t, func(c *Copier) { c.MissingAsNull = true })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := copied.Version(); v != "1.5" {
+		t.Errorf("Version() = %q, want 1.5", v)
+	}
+	if _, ok := copied.Trailer().Get("ID").(*parser.Array); !ok {
+		t.Error("trailer /ID not copied")
+	}
+
+	obj, err := copied.GetObject(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, ok := obj.(*parser.Stream)
+	if !ok {
+		t.Fatalf("object 4 is %T, want stream", obj)
+	}
+	if got := stream.Dictionary().GetInteger("Length"); got != int64(len(testContent)) {
+		t.Errorf("/Length = %d, want direct %d", got, len(testContent))
+	}
+	if got := string(stream.Content()); got != testContent {
+		t.Errorf("content = %q, want %q", got, testContent)
+	}
+
+	// The length object and the unreachable object are dropped.
+	for _, n := range []int{5, 6} {
+		if copied.XRefTable().HasObject(n) {
+			if entry, _ := copied.XRefTable().GetEntry(n); entry != nil && entry.Type == parser.XRefEntryInUse {
+				t.Errorf("object %d copied, want dropped", n)
+			}
+		}
+	}
+	if obj, err := copied.GetObject(9); err != nil {
+		t.Errorf("missing object 9 not written: %v", err)
+	} else if _, ok := obj.(*parser.Null); !ok {
+		t.Errorf("object 9 is %T, want null", obj)
+	}
+}
+
+func TestCopier_Transform(t *testing.T) {
+	copied, err := copyTestPDF(t, func(c *Copier) {
+		c.MissingAsNull = true
+		c.Transform = func(number int, obj parser.PdfObject) (parser.PdfObject, error) {
+			if number != 3 {
+				return obj, nil
+			}
+			page := obj.(*parser.Dictionary).Clone()
+			page.Set("Contents", parser.NewIndirectReference(7, 0))
+			c.Set(7, 0, parser.NewStream(parser.NewDictionary(), []byte("0 0 m")))
+			return page, nil
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj, err := copied.GetObject(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream, ok := obj.(*parser.Stream); !ok || string(stream.Content()) != "0 0 m" {
+		t.Errorf("object 7 = %v, want the stream set by Transform", obj)
+	}
+	if copied.XRefTable().HasObject(4) {
+		if entry, _ := copied.XRefTable().GetEntry(4); entry != nil && entry.Type == parser.XRefEntryInUse {
+			t.Error("replaced content stream copied, want dropped")
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package rewrite
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// newFileMode is the permission of files created by WriteFile.
+const newFileMode fs.FileMode = 0o644
+
+// WriteFile writes a file through a temporary file in the same directory
+// that replaces path once write succeeds, so path may be the file being
+// read. On failure path is left untouched.
+//
+// An existing file keeps its permissions; a new file gets 0644.
+func WriteFile(path string, write func(w io.Writer) error) error {
+	mode := newFileMode
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".gxpdf-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := write(tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
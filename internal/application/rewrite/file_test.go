@@ -0,0 +1,86 @@
+package rewrite
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.pdf")
+
+	if err := WriteFile(path, func(w io.Writer) error {
+		_, err := io.WriteString(w, "new")
+		return err
+	}); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != newFileMode {
+		t.Errorf("new file mode = %v, want %v", got, newFileMode)
+	}
+}
+
+func TestWriteFile_KeepsModeOfReplacedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	if err := os.WriteFile(path, []byte("old"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFile(path, func(w io.Writer) error {
+		_, err := io.WriteString(w, "new")
+		return err
+	}); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "new" || info.Mode().Perm() != 0o640 {
+		t.Errorf("file = %q mode %v, want \"new\" mode 0640", data, info.Mode().Perm())
+	}
+}
+
+func TestWriteFile_FailureKeepsOriginal(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "in.pdf")
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	errWrite := errors.New("write failed")
+	if err := WriteFile(path, func(w io.Writer) error {
+		_, _ = io.WriteString(w, "partial")
+		return errWrite
+	}); !errors.Is(err, errWrite) {
+		t.Fatalf("WriteFile() error = %v, want %v", err, errWrite)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "old" {
+		t.Errorf("file = %q, want original", data)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory has %d entries, want the temporary file removed", len(entries))
+	}
+}
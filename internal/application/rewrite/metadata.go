@@ -0,0 +1,66 @@
+package rewrite
+
+import (
+	"io"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// StripMetadata copies the document read by reader to w without its
+// metadata.
+//
+// Entries of the document information dictionary are removed, except those
+// named in keepInfo (e.g. "Title"). Unless keepXMP is set, XMP metadata
+// streams (/Metadata entries of the catalog and of other objects, such as
+// pages and images) are removed as well.
+func StripMetadata(reader *parser.Reader, w io.Writer, keepInfo []string, keepXMP bool) error {
+	s := &metadataStripper{keep: make(map[string]bool, len(keepInfo)), keepXMP: keepXMP}
+	for _, key := range keepInfo {
+		s.keep[key] = true
+	}
+	if trailer := reader.Trailer(); trailer != nil {
+		if info, ok := trailer.Get("Info").(*parser.IndirectReference); ok {
+			s.info = info.Number
+		}
+	}
+
+	copier := NewCopier(reader)
+	copier.Transform = s.transform
+	return copier.Write(w)
+}
+
+// metadataStripper transforms the objects of a copied document, removing
+// metadata.
+type metadataStripper struct {
+	info    int             // information dictionary object number
+	keep    map[string]bool // information entries to keep
+	keepXMP bool
+}
+
+// transform returns the output version of an object.
+func (s *metadataStripper) transform(number int, obj parser.PdfObject) (parser.PdfObject, error) {
+	switch v := obj.(type) {
+	case *parser.Dictionary:
+		if number == s.info {
+			info := parser.NewDictionary()
+			for _, key := range v.Keys() {
+				if s.keep[key] {
+					info.Set(key, v.Get(key))
+				}
+			}
+			return info, nil
+		}
+		if !s.keepXMP && v.Has("Metadata") {
+			dict := v.Clone()
+			dict.Remove("Metadata")
+			return dict, nil
+		}
+	case *parser.Stream:
+		if !s.keepXMP && v.Dictionary().Has("Metadata") {
+			dict := v.Dictionary().Clone()
+			dict.Remove("Metadata")
+			return parser.NewStream(dict, v.Content()), nil
+		}
+	}
+	return obj, nil
+}
@@ -0,0 +1,88 @@
+package rewrite
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+const testXMP = "<x:xmpmeta><dc:creator>Jane Doe</dc:creator></x:xmpmeta>"
+
+// stripTestPDF strips the metadata of a PDF with document information
+// (object 5), document XMP (object 6) and page XMP (object 7), and returns
+// the raw output.
+func stripTestPDF(t *testing.T, keepInfo []string, keepXMP bool) string {
+	t.Helper()
+
+	xmp := fmt.Sprintf("<< /Type /Metadata /Subtype /XML /Length %d >>\nstream\n%s\nendstream",
+		len(testXMP), testXMP)
+	path := writeObjectsPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R /Metadata 6 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 200 200] /Contents 4 0 R /Metadata 7 0 R >>",
+		"<< /Length 0 >>\nstream\n\nendstream",
+		"<< /Title (Report) /Author (Jane Doe) /Producer (Word) /Custom (secret) >>",
+		xmp,
+		xmp,
+	}, "/Info 5 0 R")
+
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	var out bytes.Buffer
+	if err := StripMetadata(reader, &out, keepInfo, keepXMP); err != nil {
+		t.Fatalf("StripMetadata() error = %v", err)
+	}
+
+	// The output must be readable.
+	outPath := filepath.Join(t.TempDir(), "out.pdf")
+	if err := os.WriteFile(outPath, out.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stripped, err := parser.OpenPDF(outPath)
+	if err != nil {
+		t.Fatalf("output does not open: %v", err)
+	}
+	defer stripped.Close()
+	if n, err := stripped.GetPageCount(); err != nil || n != 1 {
+		t.Errorf("GetPageCount() = %d, %v, want 1", n, err)
+	}
+
+	return out.String()
+}
+
+func TestStripMetadata(t *testing.T) {
+	out := stripTestPDF(t, nil, false)
+
+	for _, s := range []string{"Report", "Jane Doe", "Word", "secret", "/Metadata"} {
+		if strings.Contains(out, s) {
+			t.Errorf("output contains %q", s)
+		}
+	}
+	if !strings.Contains(out, "/Info 5 0 R") {
+		t.Error("output has no document information dictionary")
+	}
+}
+
+func TestStripMetadata_Keep(t *testing.T) {
+	out := stripTestPDF(t, []string{"Title", "Producer"}, true)
+
+	for _, s := range []string{"(Report)", "(Word)", "/Metadata 6 0 R", "/Metadata 7 0 R", testXMP} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output does not contain %q", s)
+		}
+	}
+	for _, s := range []string{"/Author", "secret"} {
+		if strings.Contains(out, s) {
+			t.Errorf("output contains %q", s)
+		}
+	}
+}
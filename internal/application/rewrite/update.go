@@ -0,0 +1,261 @@
+package rewrite
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// Update collects changed and new objects of a document and writes them as
+// an incremental update: the original file, byte for byte, followed by the
+// objects, a cross-reference section for them and a trailer whose /Prev
+// points to the original cross-reference section. The section is a
+// cross-reference stream if the original's newest one is, and a table
+// with a trailer dictionary otherwise.
+//
+// Because the original bytes are kept, signatures covering them stay valid
+// and earlier revisions remain recoverable.
+//
+// Reference: PDF 1.7 specification, Section 7.5.6 (Incremental Updates).
+type Update struct {
+	reader *parser.Reader
+
+	objects map[int]parser.PdfObject // changed and new objects by number
+	gens    map[int]int              // generation numbers
+	size    int                      // next free object number
+	info    *parser.IndirectReference
+}
+
+// NewUpdate creates an empty update of the document read by reader.
+func NewUpdate(reader *parser.Reader) *Update {
+	u := &Update{
+		reader:  reader,
+		objects: make(map[int]parser.PdfObject),
+		gens:    make(map[int]int),
+		size:    1,
+	}
+	if trailer := reader.Trailer(); trailer != nil {
+		u.size = max(u.size, int(trailer.GetInteger("Size")))
+		if info, ok := trailer.Get("Info").(*parser.IndirectReference); ok {
+			u.info = info
+		}
+	}
+	if xref := reader.XRefTable(); xref != nil {
+		for _, entry := range xref.GetInUseEntries() {
+			u.size = max(u.size, entry.ObjectNum+1)
+		}
+	}
+	return u
+}
+
+// Set replaces an object of the document, or adds one with a number
+// obtained from Add. The object must not be modified afterwards.
+func (u *Update) Set(number, generation int, obj parser.PdfObject) {
+	u.objects[number] = obj
+	u.gens[number] = generation
+	u.size = max(u.size, number+1)
+}
+
+// Add adds a new object and returns a reference to it.
+func (u *Update) Add(obj parser.PdfObject) *parser.IndirectReference {
+	ref := parser.NewIndirectReference(u.size, 0)
+	u.Set(ref.Number, ref.Generation, obj)
+	return ref
+}
+
+// Object returns the changed or new object with the given number, or nil
+// if the update does not hold it.
+func (u *Update) Object(number int) parser.PdfObject {
+	return u.objects[number]
+}
+
+// Info returns a reference to the document information dictionary, or nil
+// if the document has none.
+func (u *Update) Info() *parser.IndirectReference {
+	return u.info
+}
+
+// SetInfo sets the document information dictionary of the update's
+// trailer, e.g. a dictionary added with Add to a document without one.
+func (u *Update) SetInfo(ref *parser.IndirectReference) {
+	u.info = ref
+}
+
+// Len returns the number of changed and new objects.
+func (u *Update) Len() int {
+	return len(u.objects)
+}
+
+// Write writes the original document followed by the update to w. An
+// empty update writes the original document only.
+//
+// Documents whose cross-reference table was rebuilt by a repair reader
+// have no valid section for /Prev to point to and are rejected, as are
+// encrypted documents.
+func (u *Update) Write(w io.Writer) error {
+	trailer := u.reader.Trailer()
+	if trailer == nil {
+		return fmt.Errorf("trailer not loaded (call Open first)")
+	}
+	if enc := u.reader.Encryption(); enc != nil {
+		return enc
+	}
+	if u.reader.Rebuilt() {
+		return fmt.Errorf("cannot update a document with a rebuilt cross-reference table")
+	}
+
+	bw := bufio.NewWriter(w)
+	original := &tailWriter{w: bw}
+	n, err := u.reader.CopyTo(original)
+	if err != nil {
+		return fmt.Errorf("failed to copy original document: %w", err)
+	}
+	if len(u.objects) == 0 {
+		return bw.Flush()
+	}
+
+	// Offsets in the update count from the %PDF- marker, like the original.
+	cw := &countingWriter{w: bw, n: n - u.reader.HeaderOffset()}
+	if n > 0 && original.last != '\n' && original.last != '\r' {
+		fmt.Fprint(cw, "\n")
+	}
+
+	numbers := make([]int, 0, len(u.objects))
+	for number := range u.objects {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+
+	offsets := make(map[int]int64, len(numbers))
+	for _, number := range numbers {
+		offsets[number] = cw.n
+		fmt.Fprintf(cw, "%d %d obj\n", number, u.gens[number])
+		if err := writeObject(cw, u.objects[number]); err != nil {
+			return fmt.Errorf("failed to write object %d: %w", number, err)
+		}
+		fmt.Fprintf(cw, "\nendobj\n")
+	}
+
+	newTrailer := parser.NewDictionary()
+	newTrailer.SetInteger("Size", int64(u.size))
+	newTrailer.Set("Root", trailer.Get("Root"))
+	if u.info != nil {
+		newTrailer.Set("Info", u.info)
+	}
+	if id, ok := trailer.Get("ID").(*parser.Array); ok && id.Len() == 2 {
+		newTrailer.Set("ID", id)
+	}
+	newTrailer.SetInteger("Prev", u.reader.StartXRef())
+
+	xref := cw.n
+	if name := trailer.GetName("Type"); name != nil && name.Value() == "XRef" {
+		// A document using cross-reference streams may be read by
+		// PDF 1.5 readers only, which need not accept a table in a later
+		// revision (Section 7.5.8.4), so the update uses a stream too.
+		offsets[u.size] = xref
+		numbers = append(numbers, u.size)
+		newTrailer.SetInteger("Size", int64(u.size+1))
+		fmt.Fprintf(cw, "%d 0 obj\n", u.size)
+		if err := writeObject(cw, xrefStream(newTrailer, numbers, offsets, u.gens)); err != nil {
+			return fmt.Errorf("failed to write cross-reference stream: %w", err)
+		}
+		fmt.Fprintf(cw, "\nendobj\n")
+	} else {
+		fmt.Fprintf(cw, "xref\n")
+		for _, sub := range subsections(numbers) {
+			fmt.Fprintf(cw, "%d %d\n", sub[0], len(sub))
+			for _, number := range sub {
+				fmt.Fprintf(cw, "%010d %05d n\r\n", offsets[number], u.gens[number])
+			}
+		}
+		fmt.Fprintf(cw, "trailer\n")
+		if _, err := newTrailer.WriteTo(cw); err != nil {
+			return fmt.Errorf("failed to write trailer: %w", err)
+		}
+		fmt.Fprintf(cw, "\n")
+	}
+	fmt.Fprintf(cw, "startxref\n%d\n%%%%EOF\n", xref)
+
+	if cw.err != nil {
+		return cw.err
+	}
+	return bw.Flush()
+}
+
+// subsections splits sorted object numbers into runs of consecutive
+// numbers, one per cross-reference subsection.
+func subsections(numbers []int) [][]int {
+	var subs [][]int
+	for start := 0; start < len(numbers); {
+		end := start + 1
+		for end < len(numbers) && numbers[end] == numbers[end-1]+1 {
+			end++
+		}
+		subs = append(subs, numbers[start:end])
+		start = end
+	}
+	return subs
+}
+
+// xrefStream creates a cross-reference stream holding the trailer entries
+// of dict and an in-use entry for each of the sorted object numbers.
+//
+// Reference: PDF 1.7 specification, Section 7.5.8 (Cross-Reference Streams).
+func xrefStream(dict *parser.Dictionary, numbers []int, offsets map[int]int64, gens map[int]int) *parser.Stream {
+	// Offsets take as many bytes as the largest one needs.
+	offsetWidth := 1
+	for _, number := range numbers {
+		for offsetWidth < 8 && offsets[number]>>(8*offsetWidth) != 0 {
+			offsetWidth++
+		}
+	}
+
+	index := parser.NewArray()
+	var data []byte
+	for _, sub := range subsections(numbers) {
+		index.AppendAll(parser.NewInteger(int64(sub[0])), parser.NewInteger(int64(len(sub))))
+		for _, number := range sub {
+			data = append(data, 1)
+			for i := offsetWidth - 1; i >= 0; i-- {
+				data = append(data, byte(offsets[number]>>(8*i)))
+			}
+			data = append(data, byte(gens[number]>>8), byte(gens[number]))
+		}
+	}
+
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, _ = zw.Write(data)
+	_ = zw.Close()
+
+	stream := parser.NewDictionary()
+	stream.SetName("Type", "XRef")
+	for _, key := range dict.Keys() {
+		stream.Set(key, dict.Get(key))
+	}
+	stream.Set("Index", index)
+	stream.Set("W", parser.NewArrayFromSlice([]parser.PdfObject{
+		parser.NewInteger(1), parser.NewInteger(int64(offsetWidth)), parser.NewInteger(2),
+	}))
+	stream.SetName("Filter", "FlateDecode")
+	return parser.NewStream(stream, buf.Bytes())
+}
+
+// tailWriter passes writes through and remembers the last byte written.
+type tailWriter struct {
+	w    io.Writer
+	last byte
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	if n > 0 {
+		t.last = p[n-1]
+	}
+	return n, err
+}
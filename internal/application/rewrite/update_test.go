@@ -0,0 +1,202 @@
+package rewrite
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// updateTestPDF applies an update built by configure to the test PDF and
+// returns the original bytes and the reopened result.
+func updateTestPDF(t *testing.T, configure func(*Update)) ([]byte, []byte, *parser.Reader) {
+	t.Helper()
+
+	path := writeTestPDF(t)
+	original, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	update := NewUpdate(reader)
+	configure(update)
+	var out bytes.Buffer
+	if err := update.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	outPath := filepath.Join(t.TempDir(), "out.pdf")
+	if err := os.WriteFile(outPath, out.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := parser.OpenPDF(outPath)
+	if err != nil {
+		t.Fatalf("update does not open: %v", err)
+	}
+	t.Cleanup(func() { updated.Close() })
+	return original, out.Bytes(), updated
+}
+
+func TestUpdate_Write(t *testing.T) {
+	var info *parser.IndirectReference
+	original, out, updated := updateTestPDF(t, func(u *Update) {
+		page := parser.NewDictionary()
+		page.Set("Type", parser.NewName("Page"))
+		page.Set("Parent", parser.NewIndirectReference(2, 0))
+		page.Set("Contents", parser.NewIndirectReference(7, 0))
+		u.Set(3, 0, page)
+		u.Set(7, 0, parser.NewStream(parser.NewDictionary(), []byte("0 0 m")))
+
+		dict := parser.NewDictionary()
+		dict.Set("Title", parser.NewString("Updated"))
+		info = u.Add(dict)
+		u.SetInfo(info)
+	})
+
+	if !bytes.HasPrefix(out, original) {
+		t.Fatal("original bytes not kept")
+	}
+	if info.Number != 8 {
+		t.Errorf("added object number = %d, want 8", info.Number)
+	}
+
+	trailer := updated.Trailer()
+	if got := trailer.GetInteger("Size"); got != 9 {
+		t.Errorf("/Size = %d, want 9", got)
+	}
+	if got := trailer.GetInteger("Prev"); got <= 0 {
+		t.Errorf("/Prev = %d, want the original xref offset", got)
+	}
+	if _, ok := trailer.Get("ID").(*parser.Array); !ok {
+		t.Error("trailer /ID not kept")
+	}
+	if got := updated.GetDocumentInfo().Title; got != "Updated" {
+		t.Errorf("Title = %q, want Updated", got)
+	}
+
+	page, err := updated.GetPage(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref, ok := page.Get("Contents").(*parser.IndirectReference); !ok || ref.Number != 7 {
+		t.Errorf("/Contents = %v, want 7 0 R", page.Get("Contents"))
+	}
+
+	// Unchanged objects are read from the original revision.
+	obj, err := updated.GetObject(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream, ok := obj.(*parser.Stream); !ok || string(stream.Content()) != testContent {
+		t.Errorf("object 4 = %v, want the original content stream", obj)
+	}
+}
+
+func TestUpdate_Empty(t *testing.T) {
+	original, out, _ := updateTestPDF(t, func(*Update) {})
+
+	if !bytes.Equal(out, original) {
+		t.Error("empty update changed the document")
+	}
+}
+
+// writeXRefStreamPDF writes a one-page PDF whose cross-reference section
+// is a stream (object 4) to a temporary file and returns its path.
+func writeXRefStreamPDF(t *testing.T) string {
+	t.Helper()
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 200 200] >>",
+	}
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.5\n")
+	var entries []byte
+	entries = append(entries, 0, 0, 0, 0, 0, 0xff, 0xff)
+	for i, obj := range objects {
+		entries = append(entries, 1)
+		entries = binary.BigEndian.AppendUint32(entries, uint32(buf.Len()))
+		entries = append(entries, 0, 0)
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	entries = append(entries, 1)
+	entries = binary.BigEndian.AppendUint32(entries, uint32(xref))
+	entries = append(entries, 0, 0)
+	fmt.Fprintf(&buf, "4 0 obj\n<< /Type /XRef /Size 5 /W [1 4 2] /Root 1 0 R /Length %d >>\nstream\n", len(entries))
+	buf.Write(entries)
+	fmt.Fprintf(&buf, "\nendstream\nendobj\nstartxref\n%d\n%%%%EOF\n", xref)
+
+	path := filepath.Join(t.TempDir(), "in.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestUpdate_Write_XRefStream(t *testing.T) {
+	reader, err := parser.OpenPDF(writeXRefStreamPDF(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	update := NewUpdate(reader)
+	page := parser.NewDictionary()
+	page.Set("Type", parser.NewName("Page"))
+	page.Set("Parent", parser.NewIndirectReference(2, 0))
+	page.Set("Contents", update.Add(parser.NewStream(parser.NewDictionary(), []byte("0 0 m"))))
+	update.Set(3, 0, page)
+	var out bytes.Buffer
+	if err := update.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	outPath := filepath.Join(t.TempDir(), "out.pdf")
+	if err := os.WriteFile(outPath, out.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := parser.OpenPDF(outPath)
+	if err != nil {
+		t.Fatalf("update does not open: %v", err)
+	}
+	defer updated.Close()
+
+	tail := out.Bytes()[reader.StartXRef():]
+	if bytes.Contains(tail, []byte("\ntrailer")) {
+		t.Error("update uses a cross-reference table, want a stream")
+	}
+	trailer := updated.Trailer()
+	if name := trailer.GetName("Type"); name == nil || name.Value() != "XRef" {
+		t.Errorf("newest section /Type = %v, want /XRef", trailer.Get("Type"))
+	}
+	// Objects 1-4 are the original's, 5 is the content and 6 the stream.
+	if got := trailer.GetInteger("Size"); got != 7 {
+		t.Errorf("/Size = %d, want 7", got)
+	}
+	if got := trailer.GetInteger("Prev"); got != reader.StartXRef() {
+		t.Errorf("/Prev = %d, want %d", got, reader.StartXRef())
+	}
+
+	pageDict, err := updated.GetPage(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, ok := updated.ResolveReferences(pageDict.Get("Contents")).(*parser.Stream)
+	if !ok || string(content.Content()) != "0 0 m" {
+		t.Errorf("/Contents = %v, want the added stream", pageDict.Get("Contents"))
+	}
+	if _, err := updated.GetCatalog(); err != nil {
+		t.Errorf("catalog from the original revision: %v", err)
+	}
+}
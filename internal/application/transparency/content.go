@@ -0,0 +1,289 @@
+package transparency
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// color is a current color. space is DeviceGray, DeviceRGB or DeviceCMYK,
+// or empty for colors that cannot be blended.
+type color struct {
+	space      string
+	components []float64
+}
+
+// graphicsState is the part of the graphics state that decides how colors
+// are blended.
+type graphicsState struct {
+	fill, stroke           color
+	fillAlpha, strokeAlpha float64
+}
+
+// colorOperators are the operators setting a color in a device color space,
+// by space, for filling and stroking.
+var colorOperators = map[string][2]string{
+	"DeviceGray": {"g", "G"},
+	"DeviceRGB":  {"rg", "RG"},
+	"DeviceCMYK": {"k", "K"},
+}
+
+// rewriteContent replaces the colors painted with constant alpha in a
+// content stream by their blend with the background.
+func (f *flattener) rewriteContent(content []byte, resources *parser.Dictionary) ([]byte, error) {
+	ops, err := extractor.NewContentParser(content).ParseOperators()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse content: %w", err)
+	}
+
+	black := color{space: "DeviceGray", components: []float64{0}}
+	state := graphicsState{fill: black, stroke: black, fillAlpha: 1, strokeAlpha: 1}
+	var saved []graphicsState
+
+	var buf bytes.Buffer
+	for _, op := range ops {
+		stroke := false
+		switch op.Name {
+		case "q":
+			saved = append(saved, state)
+		case "Q":
+			if len(saved) > 0 {
+				state = saved[len(saved)-1]
+				saved = saved[:len(saved)-1]
+			}
+		case "gs":
+			writeOperator(&buf, op)
+			fillAlpha, strokeAlpha := f.alphas(op, resources, state)
+			if fillAlpha != state.fillAlpha {
+				state.fillAlpha = fillAlpha
+				f.writeColor(&buf, state.fill, fillAlpha, false)
+			}
+			if strokeAlpha != state.strokeAlpha {
+				state.strokeAlpha = strokeAlpha
+				f.writeColor(&buf, state.stroke, strokeAlpha, true)
+			}
+			continue
+		case "G", "RG", "K":
+			stroke = true
+			fallthrough
+		case "g", "rg", "k":
+			c := color{space: deviceSpaceOf(op.Name)}
+			components, ok := numbers(op.Operands, len(f.background(c.space)))
+			if !ok {
+				state.setColor(stroke, color{})
+				break
+			}
+			c.components = components
+			state.setColor(stroke, c)
+			f.paint(&buf, op, c, state.alpha(stroke), stroke)
+			continue
+		case "CS":
+			stroke = true
+			fallthrough
+		case "cs":
+			writeOperator(&buf, op)
+			c := color{space: f.deviceSpace(op, resources)}
+			if c.space != "" {
+				// The initial color of device spaces is black.
+				c.components = make([]float64, len(f.background(c.space)))
+				if c.space == "DeviceCMYK" {
+					c.components[3] = 1
+				}
+			}
+			state.setColor(stroke, c)
+			if state.alpha(stroke) < 1 {
+				f.writeColor(&buf, c, state.alpha(stroke), stroke)
+			}
+			continue
+		case "SC", "SCN":
+			stroke = true
+			fallthrough
+		case "sc", "scn":
+			c := state.fill
+			if stroke {
+				c = state.stroke
+			}
+			if c.space != "" {
+				if components, ok := numbers(op.Operands, len(c.components)); ok {
+					c.components = components
+					state.setColor(stroke, c)
+					f.paint(&buf, op, c, state.alpha(stroke), stroke)
+					continue
+				}
+			}
+			state.setColor(stroke, color{})
+		}
+		writeOperator(&buf, op)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// setColor sets the fill or stroke color.
+func (s *graphicsState) setColor(stroke bool, c color) {
+	if stroke {
+		s.stroke = c
+	} else {
+		s.fill = c
+	}
+}
+
+// alpha returns the fill or stroke alpha.
+func (s *graphicsState) alpha(stroke bool) float64 {
+	if stroke {
+		return s.strokeAlpha
+	}
+	return s.fillAlpha
+}
+
+// alphas returns the fill and stroke alpha after a gs operator.
+func (f *flattener) alphas(op *extractor.Operator, resources *parser.Dictionary, state graphicsState) (float64, float64) {
+	fill, stroke := state.fillAlpha, state.strokeAlpha
+	if len(op.Operands) == 0 || resources == nil {
+		return fill, stroke
+	}
+	name, ok := op.Operands[0].(*parser.Name)
+	if !ok {
+		return fill, stroke
+	}
+	states, ok := f.resolve(resources.Get("ExtGState")).(*parser.Dictionary)
+	if !ok {
+		return fill, stroke
+	}
+	gs, ok := f.resolve(states.Get(name.Value())).(*parser.Dictionary)
+	if !ok {
+		return fill, stroke
+	}
+	if alpha, ok := number(f.resolve(gs.Get("ca"))); ok {
+		fill = min(max(alpha, 0), 1)
+	}
+	if alpha, ok := number(f.resolve(gs.Get("CA"))); ok {
+		stroke = min(max(alpha, 0), 1)
+	}
+	return fill, stroke
+}
+
+// paint writes a color operator setting c, blended when painted with
+// constant alpha.
+func (f *flattener) paint(buf *bytes.Buffer, op *extractor.Operator, c color, alpha float64, stroke bool) {
+	if alpha >= 1 {
+		writeOperator(buf, op)
+		return
+	}
+	f.writeColor(buf, c, alpha, stroke)
+}
+
+// writeColor writes an operator setting the blend of a color with the
+// background. Colors that cannot be blended are left alone.
+func (f *flattener) writeColor(buf *bytes.Buffer, c color, alpha float64, stroke bool) {
+	background := f.background(c.space)
+	if c.space == "" || len(c.components) != len(background) {
+		return
+	}
+	operands := make([]parser.PdfObject, len(c.components))
+	for i, v := range c.components {
+		blended := alpha*v + (1-alpha)*background[i]
+		operands[i] = parser.NewReal(math.Round(blended*10000) / 10000)
+	}
+	name := colorOperators[c.space][0]
+	if stroke {
+		name = colorOperators[c.space][1]
+	}
+	writeOperator(buf, extractor.NewOperator(name, operands))
+}
+
+// background returns the background color in a device color space, or nil
+// for other spaces.
+func (f *flattener) background(space string) []float64 {
+	components, _ := f.backgroundIn(space)
+	return components
+}
+
+// backgroundIn returns the background color in a device color space.
+func (f *flattener) backgroundIn(space string) ([]float64, bool) {
+	r, g, b := f.backdrop[0], f.backdrop[1], f.backdrop[2]
+	switch space {
+	case "DeviceGray":
+		return []float64{0.3*r + 0.59*g + 0.11*b}, true
+	case "DeviceRGB":
+		return []float64{r, g, b}, true
+	case "DeviceCMYK":
+		k := min(1-r, 1-g, 1-b)
+		if k == 1 {
+			return []float64{0, 0, 0, 1}, true
+		}
+		return []float64{(1 - r - k) / (1 - k), (1 - g - k) / (1 - k), (1 - b - k) / (1 - k), k}, true
+	}
+	return nil, false
+}
+
+// deviceSpace returns the device color space selected by a cs or CS
+// operator, directly or through a /ColorSpace resource, or an empty string
+// for other spaces.
+func (f *flattener) deviceSpace(op *extractor.Operator, resources *parser.Dictionary) string {
+	if len(op.Operands) == 0 {
+		return ""
+	}
+	name, ok := op.Operands[0].(*parser.Name)
+	if !ok {
+		return ""
+	}
+	switch name.Value() {
+	case "DeviceGray", "DeviceRGB", "DeviceCMYK":
+		return name.Value()
+	}
+	if resources == nil {
+		return ""
+	}
+	spaces, ok := f.resolve(resources.Get("ColorSpace")).(*parser.Dictionary)
+	if !ok {
+		return ""
+	}
+	if space, ok := f.resolve(spaces.Get(name.Value())).(*parser.Name); ok {
+		switch space.Value() {
+		case "DeviceGray", "DeviceRGB", "DeviceCMYK":
+			return space.Value()
+		}
+	}
+	return ""
+}
+
+// deviceSpaceOf returns the color space set by a device color operator.
+func deviceSpaceOf(operator string) string {
+	for space, names := range colorOperators {
+		if operator == names[0] || operator == names[1] {
+			return space
+		}
+	}
+	return ""
+}
+
+// numbers returns the values of n numeric operands.
+func numbers(operands []parser.PdfObject, n int) ([]float64, bool) {
+	if len(operands) != n {
+		return nil, false
+	}
+	values := make([]float64, n)
+	for i, operand := range operands {
+		v, ok := number(operand)
+		if !ok {
+			return nil, false
+		}
+		values[i] = v
+	}
+	return values, true
+}
+
+// number returns the value of an integer or real object.
+func number(obj parser.PdfObject) (float64, bool) {
+	switch v := obj.(type) {
+	case *parser.Integer:
+		return float64(v.Value()), true
+	case *parser.Real:
+		return v.Value(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,345 @@
+// Package transparency removes live transparency from PDF documents for
+// print workflows whose standards forbid it, such as PDF/X-1a.
+package transparency
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/coregx/gxpdf/internal/application/rewrite"
+	"github.com/coregx/gxpdf/internal/encoding"
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// Flatten writes a copy of the document without live transparency,
+// compositing translucent content onto an opaque background given as RGB
+// components in the range 0-1 (1, 1, 1 for paper white).
+//
+// Colors in DeviceGray, DeviceRGB and DeviceCMYK painted with a constant
+// alpha below 1 are replaced by their blend with the background. This is
+// exact where the content lies on the background and an approximation
+// where it overlaps other content. Images with 8-bit soft masks are
+// composited onto the background the same way. Constant alpha, blend
+// modes and soft masks are removed from graphics states, and transparency
+// groups from pages and form XObjects; content that cannot be blended,
+// such as shadings, images and colors in other color spaces painted with
+// constant alpha, is painted opaque. Images whose soft masks cannot be
+// composited keep them.
+//
+// The copy contains the objects reachable from the catalog and the
+// document information. Encrypted documents and content streams with
+// inline images are not supported.
+func Flatten(reader *parser.Reader, background [3]float64, w io.Writer) error {
+	if enc := reader.Encryption(); enc != nil {
+		return enc
+	}
+
+	f := &flattener{
+		reader:   reader,
+		copier:   rewrite.NewCopier(reader),
+		backdrop: background,
+		kinds:    make(map[int]objectKind),
+	}
+	f.copier.Transform = f.transform
+	return f.copier.Write(w)
+}
+
+// objectKind is the role of an object that cannot be told from its content,
+// learned from the object referring to it.
+type objectKind int
+
+const (
+	kindUnknown    objectKind = iota
+	kindResources             // resource dictionary
+	kindExtGStates            // ExtGState resource dictionary
+	kindExtGState             // graphics state parameter dictionary
+)
+
+// flattener transforms the objects of a copied document, removing
+// transparency.
+type flattener struct {
+	reader   *parser.Reader
+	copier   *rewrite.Copier
+	backdrop [3]float64         // background RGB components
+	kinds    map[int]objectKind // roles of objects not yet copied
+}
+
+// transform returns the output version of an object.
+func (f *flattener) transform(number int, obj parser.PdfObject) (parser.PdfObject, error) {
+	switch v := obj.(type) {
+	case *parser.Dictionary:
+		switch f.kinds[number] {
+		case kindResources:
+			return f.cleanResources(v), nil
+		case kindExtGStates:
+			return f.cleanExtGStates(v), nil
+		case kindExtGState:
+			return cleanExtGState(v), nil
+		}
+		if t := v.GetName("Type"); t != nil && t.Value() == "Page" {
+			return f.transformPage(v)
+		}
+		if v.Has("Resources") {
+			out := v.Clone()
+			out.Set("Resources", f.resourcesEntry(v.Get("Resources")))
+			return out, nil
+		}
+	case *parser.Stream:
+		dict := v.Dictionary()
+		if dict.GetInteger("PatternType") == 1 {
+			return f.transformForm(v) // Tiling patterns have content like forms.
+		}
+		if subtype := dict.GetName("Subtype"); subtype != nil {
+			switch subtype.Value() {
+			case "Form":
+				return f.transformForm(v)
+			case "Image":
+				return f.transformImage(v)
+			}
+		}
+	}
+	return obj, nil
+}
+
+// transformPage rewrites the content streams of a page into one and drops
+// its transparency group.
+func (f *flattener) transformPage(page *parser.Dictionary) (parser.PdfObject, error) {
+	out := page.Clone()
+	out.Remove("Group")
+	if page.Has("Resources") {
+		out.Set("Resources", f.resourcesEntry(page.Get("Resources")))
+	}
+
+	// Gather the content streams; the first one receives the rewritten content.
+	var refs []*parser.IndirectReference
+	switch c := page.Get("Contents").(type) {
+	case *parser.IndirectReference:
+		if arr, ok := f.resolve(c).(*parser.Array); ok {
+			refs = arrayRefs(arr)
+		} else {
+			refs = []*parser.IndirectReference{c}
+		}
+	case *parser.Array:
+		refs = arrayRefs(c)
+	}
+
+	var content []byte
+	var first *parser.IndirectReference
+	var firstStream *parser.Stream
+	for _, ref := range refs {
+		stream, ok := f.resolve(ref).(*parser.Stream)
+		if !ok {
+			continue
+		}
+		if first == nil {
+			first, firstStream = ref, stream
+		}
+		data, err := f.reader.DecodeStream(stream)
+		if err != nil {
+			return nil, fmt.Errorf("page content stream %d: %w", ref.Number, err)
+		}
+		content = append(append(content, data...), '\n')
+	}
+	if first == nil {
+		return out, nil
+	}
+
+	rewritten, err := f.rewriteContent(content, f.pageResources(page))
+	if err != nil {
+		return nil, fmt.Errorf("page content stream %d: %w", first.Number, err)
+	}
+	stream, err := newStream(firstStream.Dictionary(), rewritten)
+	if err != nil {
+		return nil, err
+	}
+	f.copier.Set(first.Number, first.Generation, stream)
+	out.Set("Contents", first)
+	return out, nil
+}
+
+// transformForm rewrites the content of a form XObject or tiling pattern
+// and drops its transparency group.
+func (f *flattener) transformForm(form *parser.Stream) (parser.PdfObject, error) {
+	dict := form.Dictionary()
+	data, err := f.reader.DecodeStream(form)
+	if err != nil {
+		return nil, fmt.Errorf("form XObject: %w", err)
+	}
+	resources, _ := f.resolve(dict.Get("Resources")).(*parser.Dictionary)
+	rewritten, err := f.rewriteContent(data, resources)
+	if err != nil {
+		return nil, fmt.Errorf("form XObject: %w", err)
+	}
+	out, err := newStream(dict, rewritten)
+	if err != nil {
+		return nil, err
+	}
+	out.Dictionary().Remove("Group")
+	if dict.Has("Resources") {
+		out.Dictionary().Set("Resources", f.resourcesEntry(dict.Get("Resources")))
+	}
+	return out, nil
+}
+
+// transformImage composites an image with a soft mask onto the background.
+// Images whose soft masks cannot be composited are returned unchanged.
+func (f *flattener) transformImage(image *parser.Stream) (parser.PdfObject, error) {
+	dict := image.Dictionary()
+	mask, ok := f.resolve(dict.Get("SMask")).(*parser.Stream)
+	if !ok {
+		return image, nil
+	}
+	maskDict := mask.Dictionary()
+
+	space, _ := f.resolve(dict.Get("ColorSpace")).(*parser.Name)
+	if space == nil || dict.Has("Decode") || maskDict.Has("Matte") {
+		return image, nil
+	}
+	background, ok := f.backgroundIn(space.Value())
+	if !ok {
+		return image, nil
+	}
+	width, height := dict.GetInteger("Width"), dict.GetInteger("Height")
+	if dict.GetInteger("BitsPerComponent") != 8 || maskDict.GetInteger("BitsPerComponent") != 8 ||
+		maskDict.GetInteger("Width") != width || maskDict.GetInteger("Height") != height {
+		return image, nil
+	}
+
+	pixels, err := f.reader.DecodeStream(image)
+	if err != nil {
+		return image, nil
+	}
+	alpha, err := f.reader.DecodeStream(mask)
+	if err != nil {
+		return image, nil
+	}
+	n := len(background)
+	if int64(len(alpha)) != width*height || int64(len(pixels)) != width*height*int64(n) {
+		return image, nil
+	}
+
+	composited := make([]byte, len(pixels))
+	for i, a := range alpha {
+		for j := 0; j < n; j++ {
+			c := float64(a)*float64(pixels[i*n+j]) + float64(255-a)*255*background[j]
+			composited[i*n+j] = byte(math.Round(c / 255))
+		}
+	}
+
+	out, err := newStream(dict, composited)
+	if err != nil {
+		return nil, err
+	}
+	out.Dictionary().Remove("SMask")
+	return out, nil
+}
+
+// resourcesEntry returns the output value of a /Resources entry.
+func (f *flattener) resourcesEntry(obj parser.PdfObject) parser.PdfObject {
+	switch v := obj.(type) {
+	case *parser.IndirectReference:
+		f.kinds[v.Number] = kindResources
+	case *parser.Dictionary:
+		return f.cleanResources(v)
+	}
+	return obj
+}
+
+// cleanResources returns a copy of a resource dictionary whose graphics
+// states have no transparency.
+func (f *flattener) cleanResources(resources *parser.Dictionary) *parser.Dictionary {
+	out := resources.Clone()
+	switch states := resources.Get("ExtGState").(type) {
+	case *parser.IndirectReference:
+		f.kinds[states.Number] = kindExtGStates
+	case *parser.Dictionary:
+		out.Set("ExtGState", f.cleanExtGStates(states))
+	}
+	return out
+}
+
+// cleanExtGStates returns a copy of an ExtGState resource dictionary whose
+// graphics states have no transparency.
+func (f *flattener) cleanExtGStates(states *parser.Dictionary) *parser.Dictionary {
+	out := states.Clone()
+	for _, name := range states.Keys() {
+		switch gs := states.Get(name).(type) {
+		case *parser.IndirectReference:
+			f.kinds[gs.Number] = kindExtGState
+		case *parser.Dictionary:
+			out.Set(name, cleanExtGState(gs))
+		}
+	}
+	return out
+}
+
+// cleanExtGState returns a copy of a graphics state parameter dictionary
+// without transparency parameters.
+func cleanExtGState(gs *parser.Dictionary) *parser.Dictionary {
+	out := gs.Clone()
+	for _, key := range []string{"CA", "ca", "BM", "SMask", "AIS"} {
+		out.Remove(key)
+	}
+	return out
+}
+
+// pageResources returns the resources of a page, which may be inherited
+// from its ancestors in the page tree.
+func (f *flattener) pageResources(page *parser.Dictionary) *parser.Dictionary {
+	for node, depth := page, 0; node != nil && depth < 64; depth++ {
+		if resources, ok := f.resolve(node.Get("Resources")).(*parser.Dictionary); ok {
+			return resources
+		}
+		node, _ = f.resolve(node.Get("Parent")).(*parser.Dictionary)
+	}
+	return nil
+}
+
+// resolve resolves an indirect reference against the source document.
+func (f *flattener) resolve(obj parser.PdfObject) parser.PdfObject {
+	if ref, ok := obj.(*parser.IndirectReference); ok {
+		resolved, err := f.reader.GetObject(ref.Number)
+		if err != nil {
+			return nil
+		}
+		return resolved
+	}
+	return obj
+}
+
+// writeOperator writes an operator and its operands as a content stream line.
+func writeOperator(buf *bytes.Buffer, op *extractor.Operator) {
+	for _, operand := range op.Operands {
+		_, _ = operand.WriteTo(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(op.Name)
+	buf.WriteByte('\n')
+}
+
+// newStream returns a FlateDecode stream holding content with a copy of
+// dict.
+func newStream(dict *parser.Dictionary, content []byte) (*parser.Stream, error) {
+	encoded, err := encoding.NewFlateDecoder().Encode(content)
+	if err != nil {
+		return nil, err
+	}
+	d := dict.Clone()
+	d.Remove("DecodeParms")
+	d.SetName("Filter", "FlateDecode")
+	return parser.NewStream(d, encoded), nil
+}
+
+// arrayRefs returns the indirect references in an array.
+func arrayRefs(arr *parser.Array) []*parser.IndirectReference {
+	var refs []*parser.IndirectReference
+	for i := 0; i < arr.Len(); i++ {
+		if ref, ok := arr.Get(i).(*parser.IndirectReference); ok {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
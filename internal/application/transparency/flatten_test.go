@@ -0,0 +1,187 @@
+package transparency
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+var white = [3]float64{1, 1, 1}
+
+// buildTransparentPDF writes a one-page PDF using transparency: graphics
+// states with constant alpha and a blend mode, a page group, an image with
+// a soft mask (objects 6 and 7) and a form XObject (object 8) with a group
+// and indirect resources.
+func buildTransparentPDF(t *testing.T) string {
+	t.Helper()
+
+	return writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 100 100] /Contents 4 0 R" +
+			" /Group << /S /Transparency /CS /DeviceRGB >>" +
+			" /Resources << /ExtGState << /GS1 5 0 R /GS2 << /CA 0.25 >> >> /XObject << /Im1 6 0 R /Fm1 8 0 R >> >> >>",
+		stream("", "1 0 0 rg /GS1 gs 0 0 10 10 re f q 0 g 0 0 5 5 re f Q /GS2 gs 0 0 1 RG 0 0 10 10 re S /Im1 Do /Fm1 Do"),
+		"<< /Type /ExtGState /ca 0.5 /BM /Multiply >>",
+		stream("/Type /XObject /Subtype /Image /Width 2 /Height 1 /ColorSpace /DeviceRGB /BitsPerComponent 8 /SMask 7 0 R",
+			"\x00\x00\x00\x00\x00\x00"),
+		stream("/Type /XObject /Subtype /Image /Width 2 /Height 1 /ColorSpace /DeviceGray /BitsPerComponent 8",
+			"\xff\x00"),
+		stream("/Type /XObject /Subtype /Form /BBox [0 0 1 1] /Group << /S /Transparency >> /Resources 9 0 R",
+			"/GS3 gs 0 0 1 rg 0 0 1 1 re f"),
+		"<< /ExtGState 10 0 R >>",
+		"<< /GS3 11 0 R >>",
+		"<< /ca 0.2 /SMask /None >>",
+	})
+}
+
+// stream formats a stream object with the given extra dictionary entries.
+func stream(entries, content string) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", entries, len(content), content)
+}
+
+// writeTestPDF writes objects numbered from 1 (the first being the catalog)
+// to a PDF file with a valid cross-reference table and returns its path.
+func writeTestPDF(t *testing.T, objects []string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	path := filepath.Join(t.TempDir(), "test.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// flattenTestPDF flattens the transparent test PDF and reopens the result.
+func flattenTestPDF(t *testing.T) *parser.Reader {
+	t.Helper()
+
+	reader, err := parser.OpenPDF(buildTransparentPDF(t))
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	if err := Flatten(reader, white, &buf); err != nil {
+		t.Fatalf("Flatten() error = %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "flat.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	flat, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF(flattened) error = %v", err)
+	}
+	t.Cleanup(func() { flat.Close() })
+	return flat
+}
+
+// decodedObject returns the decoded content of a stream object.
+func decodedObject(t *testing.T, reader *parser.Reader, number int) string {
+	t.Helper()
+
+	obj, err := reader.GetObject(number)
+	if err != nil {
+		t.Fatalf("GetObject(%d) error = %v", number, err)
+	}
+	stream, ok := obj.(*parser.Stream)
+	if !ok {
+		t.Fatalf("object %d = %T, want stream", number, obj)
+	}
+	data, err := reader.DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("DecodeStream(%d) error = %v", number, err)
+	}
+	return string(data)
+}
+
+func TestFlatten(t *testing.T) {
+	flat := flattenTestPDF(t)
+
+	uses, err := extractor.DetectTransparency(flat)
+	if err != nil {
+		t.Fatalf("DetectTransparency() error = %v", err)
+	}
+	if len(uses) != 0 {
+		t.Errorf("transparency left: %+v", uses)
+	}
+
+	content := decodedObject(t, flat, 4)
+	for _, want := range []string{"1 0 0 rg\n/GS1 gs\n1 0.5 0.5 rg\n", "0.5 g\n", "0.75 0.75 1 RG\n"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("page content lacks %q:\n%s", want, content)
+		}
+	}
+
+	if form := decodedObject(t, flat, 8); !strings.Contains(form, "0.8 0.8 1 rg") {
+		t.Errorf("form content not blended:\n%s", form)
+	}
+
+	if image := decodedObject(t, flat, 6); image != "\x00\x00\x00\xff\xff\xff" {
+		t.Errorf("image = %q, want black and white pixels", image)
+	}
+	if _, err := flat.GetObject(7); err == nil {
+		t.Error("soft mask was copied")
+	}
+}
+
+func TestRewriteContent(t *testing.T) {
+	gs := parser.NewDictionary()
+	gs.SetReal("ca", 0.5)
+	states := parser.NewDictionary()
+	states.Set("Half", gs)
+	spaces := parser.NewDictionary()
+	spaces.SetName("CS0", "DeviceCMYK")
+	resources := parser.NewDictionary()
+	resources.Set("ExtGState", states)
+	resources.Set("ColorSpace", spaces)
+
+	tests := []struct {
+		name       string
+		background [3]float64
+		content    string
+		want       string
+	}{
+		{"opaque unchanged", white, "0.2 g", "0.2 g\n"},
+		{"blend after gs", white, "0 0 1 rg /Half gs", "0 0 1 rg\n/Half gs\n0.5 0.5 1 rg\n"},
+		{"blend after color", white, "/Half gs 0 g", "/Half gs\n0.5 g\n0.5 g\n"},
+		{"black background", [3]float64{0, 0, 0}, "/Half gs 1 g", "/Half gs\n0 g\n0.5 g\n"},
+		{"color space resource", white, "/Half gs /CS0 cs 0 0 0 1 sc", "/Half gs\n0.5 g\n/CS0 cs\n0 0 0 0.5 k\n0 0 0 0.5 k\n"},
+		{"restore", white, "q /Half gs 0 g Q 0 g", "q\n/Half gs\n0.5 g\n0.5 g\nQ\n0 g\n"},
+		{"other space", white, "/Half gs /Pattern cs /P1 scn", "/Half gs\n0.5 g\n/Pattern cs\n/P1 scn\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &flattener{backdrop: tt.background}
+			got, err := f.rewriteContent([]byte(tt.content), resources)
+			if err != nil {
+				t.Fatalf("rewriteContent() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("rewriteContent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,8 +18,87 @@ const (
 	AnnotationTypeStrikeOut
 	// AnnotationTypeStamp represents a rubber stamp annotation.
 	AnnotationTypeStamp
+	// AnnotationTypeFreeText represents a free text (text box) annotation.
+	AnnotationTypeFreeText
+	// AnnotationTypeLine represents a straight line annotation.
+	AnnotationTypeLine
+	// AnnotationTypeSquare represents a rectangle annotation.
+	AnnotationTypeSquare
+	// AnnotationTypeCircle represents an ellipse annotation.
+	AnnotationTypeCircle
+	// AnnotationTypeInk represents a freehand (ink) annotation.
+	AnnotationTypeInk
 )
 
+// AnnotationFlags is the annotation flags bit set (/F entry).
+//
+// Reference: PDF 1.7 specification, Section 12.5.3 (Annotation Flags).
+type AnnotationFlags int
+
+const (
+	// AnnotationFlagInvisible hides unknown annotation types without a handler.
+	AnnotationFlagInvisible AnnotationFlags = 1 << iota
+	// AnnotationFlagHidden hides the annotation on screen and in print.
+	AnnotationFlagHidden
+	// AnnotationFlagPrint prints the annotation with the page.
+	AnnotationFlagPrint
+	// AnnotationFlagNoZoom keeps the appearance at its size when zooming.
+	AnnotationFlagNoZoom
+	// AnnotationFlagNoRotate keeps the appearance upright when the page rotates.
+	AnnotationFlagNoRotate
+	// AnnotationFlagNoView hides the annotation on screen but allows printing.
+	AnnotationFlagNoView
+	// AnnotationFlagReadOnly prevents user interaction with the annotation.
+	AnnotationFlagReadOnly
+	// AnnotationFlagLocked prevents the annotation from being deleted or moved.
+	AnnotationFlagLocked
+	// AnnotationFlagToggleNoView inverts NoView on certain events.
+	AnnotationFlagToggleNoView
+	// AnnotationFlagLockedContents prevents the contents from being modified.
+	AnnotationFlagLockedContents
+)
+
+// Set returns the flags with flag turned on or off.
+func (f AnnotationFlags) Set(flag AnnotationFlags, on bool) AnnotationFlags {
+	if on {
+		return f | flag
+	}
+	return f &^ flag
+}
+
+// Has reports whether all bits of flag are set.
+func (f AnnotationFlags) Has(flag AnnotationFlags) bool {
+	return f&flag == flag
+}
+
+// Annotation is implemented by all annotation types.
+//
+// It identifies annotations independently of their type, e.g. as the
+// target of a reply (see TextAnnotation.InReplyTo).
+type Annotation interface {
+	// Bounds returns the annotation rectangle [x1, y1, x2, y2].
+	Bounds() [4]float64
+}
+
+// Popup is a pop-up window that displays the text of a markup annotation.
+//
+// Reference: PDF 1.7 specification, Section 12.5.6.14 (Popup Annotations).
+type Popup struct {
+	// Rect defines the pop-up window [x1, y1, x2, y2] in PDF coordinates.
+	Rect [4]float64
+
+	// Open indicates if the pop-up should be open by default.
+	Open bool
+}
+
+// validatePopup checks the pop-up rectangle, if there is a pop-up.
+func validatePopup(p *Popup) error {
+	if p != nil && (p.Rect[0] >= p.Rect[2] || p.Rect[1] >= p.Rect[3]) {
+		return ErrInvalidPopupRect
+	}
+	return nil
+}
+
 // LinkAnnotation represents a clickable link in a PDF.
 //
 // Link annotations create clickable areas (hot spots) on PDF pages.
@@ -90,6 +169,11 @@ func NewInternalLinkAnnotation(rect [4]float64, destPage int) *LinkAnnotation {
 	}
 }
 
+// Bounds returns the annotation rectangle.
+func (a *LinkAnnotation) Bounds() [4]float64 {
+	return a.Rect
+}
+
 // Validate checks if the link annotation is valid.
 //
 // Returns an error if:
@@ -148,6 +232,13 @@ type TextAnnotation struct {
 
 	// Open indicates if the pop-up should be open by default.
 	Open bool
+
+	// Flags is the annotation flags bit set (/F), 0 if none.
+	Flags AnnotationFlags
+
+	// InReplyTo is the annotation this note replies to (/IRT), or nil.
+	// Replies are shown in the thread of the annotation they reply to.
+	InReplyTo Annotation
 }
 
 // NewTextAnnotation creates a new text (sticky note) annotation.
@@ -175,6 +266,11 @@ func (a *TextAnnotation) SetOpen(open bool) {
 	a.Open = open
 }
 
+// Bounds returns the annotation rectangle.
+func (a *TextAnnotation) Bounds() [4]float64 {
+	return a.Rect
+}
+
 // Validate checks if the text annotation is valid.
 func (a *TextAnnotation) Validate() error {
 	if a.Rect[0] >= a.Rect[2] || a.Rect[1] >= a.Rect[3] {
@@ -219,6 +315,12 @@ type MarkupAnnotation struct {
 
 	// Contents is the optional note text.
 	Contents string
+
+	// Flags is the annotation flags bit set (/F), 0 if none.
+	Flags AnnotationFlags
+
+	// Popup is the optional pop-up window for Contents.
+	Popup *Popup
 }
 
 // NewMarkupAnnotation creates a new markup annotation.
@@ -258,6 +360,16 @@ func (a *MarkupAnnotation) SetContents(contents string) {
 	a.Contents = contents
 }
 
+// SetPopup adds a pop-up window for the note text.
+func (a *MarkupAnnotation) SetPopup(open bool, rect [4]float64) {
+	a.Popup = &Popup{Rect: rect, Open: open}
+}
+
+// Bounds returns the annotation rectangle.
+func (a *MarkupAnnotation) Bounds() [4]float64 {
+	return a.Rect
+}
+
 // Validate checks if the markup annotation is valid.
 func (a *MarkupAnnotation) Validate() error {
 	if a.Rect[0] >= a.Rect[2] || a.Rect[1] >= a.Rect[3] {
@@ -269,6 +381,9 @@ func (a *MarkupAnnotation) Validate() error {
 	if len(a.QuadPoints) == 0 {
 		return ErrMissingQuadPoints
 	}
+	if err := validatePopup(a.Popup); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -295,6 +410,12 @@ type StampAnnotation struct {
 
 	// Contents is the optional note text.
 	Contents string
+
+	// Flags is the annotation flags bit set (/F), 0 if none.
+	Flags AnnotationFlags
+
+	// Popup is the optional pop-up window for Contents.
+	Popup *Popup
 }
 
 // StampName represents predefined stamp names.
@@ -357,6 +478,16 @@ func (a *StampAnnotation) SetContents(contents string) {
 	a.Contents = contents
 }
 
+// SetPopup adds a pop-up window for the note text.
+func (a *StampAnnotation) SetPopup(open bool, rect [4]float64) {
+	a.Popup = &Popup{Rect: rect, Open: open}
+}
+
+// Bounds returns the annotation rectangle.
+func (a *StampAnnotation) Bounds() [4]float64 {
+	return a.Rect
+}
+
 // Validate checks if the stamp annotation is valid.
 func (a *StampAnnotation) Validate() error {
 	if a.Rect[0] >= a.Rect[2] || a.Rect[1] >= a.Rect[3] {
@@ -368,6 +499,256 @@ func (a *StampAnnotation) Validate() error {
 	if a.Name == "" {
 		return ErrMissingStampName
 	}
+	if err := validatePopup(a.Popup); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FreeTextAnnotation represents a free text annotation (/Subtype /FreeText).
+//
+// Free text annotations display text directly on the page, without a
+// pop-up, like a text box or callout. The text is drawn with one of the
+// Standard 14 fonts.
+//
+// Example:
+//
+//	box := NewFreeTextAnnotation([4]float64{100, 600, 300, 650}, "Check this dimension")
+//	box.SetFont("Helvetica-Bold", 10)
+//	box.SetBackgroundColor([3]float64{1, 1, 0.8})
+type FreeTextAnnotation struct {
+	// Rect defines the text box [x1, y1, x2, y2] in PDF coordinates.
+	Rect [4]float64
+
+	// Contents is the displayed text. Newlines start new lines.
+	Contents string
+
+	// Title is the author name (T field in PDF).
+	Title string
+
+	// FontName is the Standard 14 font name (e.g., "Helvetica").
+	FontName string
+
+	// FontSize is the font size in points.
+	FontSize float64
+
+	// TextColor is the text color in RGB (0.0 to 1.0 range).
+	TextColor [3]float64
+
+	// BorderColor is the border color in RGB (0.0 to 1.0 range).
+	BorderColor [3]float64
+
+	// BorderWidth is the border width in points (0 = no border).
+	BorderWidth float64
+
+	// BackgroundColor is the fill color in RGB, or nil for no background.
+	BackgroundColor *[3]float64
+
+	// Flags is the annotation flags bit set (/F), 0 if none.
+	Flags AnnotationFlags
+}
+
+// NewFreeTextAnnotation creates a new free text annotation.
+//
+// Defaults: Helvetica 12pt black text, 1pt black border, no background.
+//
+// Example:
+//
+//	box := NewFreeTextAnnotation([4]float64{100, 600, 300, 650}, "Note")
+func NewFreeTextAnnotation(rect [4]float64, contents string) *FreeTextAnnotation {
+	return &FreeTextAnnotation{
+		Rect:        rect,
+		Contents:    contents,
+		FontName:    "Helvetica",
+		FontSize:    12,
+		BorderWidth: 1,
+	}
+}
+
+// SetFont sets the font name and size.
+func (a *FreeTextAnnotation) SetFont(name string, size float64) {
+	a.FontName = name
+	a.FontSize = size
+}
+
+// SetTextColor sets the text color.
+func (a *FreeTextAnnotation) SetTextColor(color [3]float64) {
+	a.TextColor = color
+}
+
+// SetBorder sets the border width and color.
+func (a *FreeTextAnnotation) SetBorder(width float64, color [3]float64) {
+	a.BorderWidth = width
+	a.BorderColor = color
+}
+
+// SetBackgroundColor sets the background fill color.
+func (a *FreeTextAnnotation) SetBackgroundColor(color [3]float64) {
+	a.BackgroundColor = &color
+}
+
+// SetAuthor sets the author name.
+func (a *FreeTextAnnotation) SetAuthor(author string) {
+	a.Title = author
+}
+
+// Bounds returns the annotation rectangle.
+func (a *FreeTextAnnotation) Bounds() [4]float64 {
+	return a.Rect
+}
+
+// Validate checks if the free text annotation is valid.
+func (a *FreeTextAnnotation) Validate() error {
+	if a.Rect[0] >= a.Rect[2] || a.Rect[1] >= a.Rect[3] {
+		return ErrInvalidAnnotationRect
+	}
+	if a.FontName == "" {
+		return ErrMissingFontName
+	}
+	if a.FontSize <= 0 {
+		return ErrInvalidFontSize
+	}
+	if a.BorderWidth < 0 {
+		return ErrInvalidBorderWidth
+	}
+	if !isValidColor(a.TextColor) || !isValidColor(a.BorderColor) {
+		return ErrInvalidColor
+	}
+	if a.BackgroundColor != nil && !isValidColor(*a.BackgroundColor) {
+		return ErrInvalidColor
+	}
+	return nil
+}
+
+// ShapeAnnotation represents a shape annotation (line, square, circle, ink).
+//
+// Shape annotations let reviewers draw on a page. Lines use LinePoints,
+// ink annotations use InkList; squares and circles fill Rect.
+//
+// Example:
+//
+//	circle := NewShapeAnnotation(AnnotationTypeCircle, [4]float64{100, 600, 200, 650})
+//	circle.SetColor([3]float64{1, 0, 0})
+//	circle.SetInteriorColor([3]float64{1, 1, 0})
+type ShapeAnnotation struct {
+	// Type is the shape type (Line, Square, Circle, Ink).
+	Type AnnotationType
+
+	// Rect defines the bounding box [x1, y1, x2, y2] in PDF coordinates.
+	// For lines and ink it must enclose the points and the border width.
+	Rect [4]float64
+
+	// LinePoints are the line end points [x1, y1, x2, y2] (Line only).
+	LinePoints [4]float64
+
+	// InkList holds the ink strokes (Ink only).
+	// Each stroke is a list of coordinates [x1, y1, x2, y2, ...].
+	InkList [][]float64
+
+	// Color is the border color in RGB (0.0 to 1.0 range).
+	Color [3]float64
+
+	// InteriorColor is the fill color (Square and Circle only), or nil.
+	InteriorColor *[3]float64
+
+	// BorderWidth is the border width in points (0 = no border).
+	BorderWidth float64
+
+	// Title is the author name (T field in PDF).
+	Title string
+
+	// Contents is the optional note text.
+	Contents string
+
+	// Flags is the annotation flags bit set (/F), 0 if none.
+	Flags AnnotationFlags
+
+	// Popup is the optional pop-up window for Contents.
+	Popup *Popup
+}
+
+// NewShapeAnnotation creates a new shape annotation.
+//
+// Defaults: red 1pt border, no fill.
+//
+// Example:
+//
+//	square := NewShapeAnnotation(AnnotationTypeSquare, [4]float64{100, 600, 200, 650})
+func NewShapeAnnotation(annotType AnnotationType, rect [4]float64) *ShapeAnnotation {
+	return &ShapeAnnotation{
+		Type:        annotType,
+		Rect:        rect,
+		Color:       [3]float64{1, 0, 0}, // Red default
+		BorderWidth: 1,
+	}
+}
+
+// SetColor sets the border color.
+func (a *ShapeAnnotation) SetColor(color [3]float64) {
+	a.Color = color
+}
+
+// SetInteriorColor sets the fill color (Square and Circle only).
+func (a *ShapeAnnotation) SetInteriorColor(color [3]float64) {
+	a.InteriorColor = &color
+}
+
+// SetBorderWidth sets the border width.
+func (a *ShapeAnnotation) SetBorderWidth(width float64) {
+	a.BorderWidth = width
+}
+
+// SetAuthor sets the author name.
+func (a *ShapeAnnotation) SetAuthor(author string) {
+	a.Title = author
+}
+
+// SetContents sets the note text.
+func (a *ShapeAnnotation) SetContents(contents string) {
+	a.Contents = contents
+}
+
+// SetPopup adds a pop-up window for the note text.
+func (a *ShapeAnnotation) SetPopup(open bool, rect [4]float64) {
+	a.Popup = &Popup{Rect: rect, Open: open}
+}
+
+// Bounds returns the annotation rectangle.
+func (a *ShapeAnnotation) Bounds() [4]float64 {
+	return a.Rect
+}
+
+// Validate checks if the shape annotation is valid.
+func (a *ShapeAnnotation) Validate() error {
+	switch a.Type {
+	case AnnotationTypeLine, AnnotationTypeSquare, AnnotationTypeCircle:
+	case AnnotationTypeInk:
+		if len(a.InkList) == 0 {
+			return ErrInvalidInkList
+		}
+		for _, stroke := range a.InkList {
+			if len(stroke) < 4 || len(stroke)%2 != 0 {
+				return ErrInvalidInkList
+			}
+		}
+	default:
+		return ErrInvalidShapeType
+	}
+	if a.Rect[0] >= a.Rect[2] || a.Rect[1] >= a.Rect[3] {
+		return ErrInvalidAnnotationRect
+	}
+	if a.BorderWidth < 0 {
+		return ErrInvalidBorderWidth
+	}
+	if !isValidColor(a.Color) {
+		return ErrInvalidColor
+	}
+	if a.InteriorColor != nil && !isValidColor(*a.InteriorColor) {
+		return ErrInvalidColor
+	}
+	if err := validatePopup(a.Popup); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -403,4 +784,20 @@ var (
 
 	// ErrMissingStampName is returned when stamp annotation has no name.
 	ErrMissingStampName = errors.New("stamp annotation must have a name")
+
+	// ErrMissingFontName is returned when a free text annotation has no font.
+	ErrMissingFontName = errors.New("free text annotation must have a font name")
+
+	// ErrInvalidFontSize is returned when a font size is not positive.
+	ErrInvalidFontSize = errors.New("font size must be positive")
+
+	// ErrInvalidShapeType is returned when a shape annotation has a non-shape type.
+	ErrInvalidShapeType = errors.New("shape annotation must be a line, square, circle, or ink annotation")
+
+	// ErrInvalidInkList is returned when an ink annotation has no strokes or
+	// a stroke with fewer than two points.
+	ErrInvalidInkList = errors.New("ink annotation strokes must have at least two points")
+
+	// ErrInvalidPopupRect is returned when a pop-up rectangle is empty or inverted.
+	ErrInvalidPopupRect = errors.New("popup rectangle must have x1 < x2 and y1 < y2")
 )
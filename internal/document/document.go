@@ -120,6 +120,35 @@ func (d *Document) RemovePage(index int) error {
 	return nil
 }
 
+// AppendDocument moves the pages of other to the end of this document.
+//
+// The pages keep their content and annotations; internal links on them
+// are shifted to keep pointing to the same pages. other is left without
+// pages.
+//
+// Returns an error if other is this document.
+func (d *Document) AppendDocument(other *Document) error {
+	if other == d {
+		return fmt.Errorf("cannot append a document to itself")
+	}
+
+	offset := len(d.pages)
+	for _, page := range other.pages {
+		for _, link := range page.linkAnnotations {
+			if link.IsInternal {
+				link.DestPage += offset
+			}
+		}
+	}
+
+	d.pages = append(d.pages, other.pages...)
+	other.pages = nil
+	d.renumberPages()
+	d.modDate = time.Now()
+
+	return nil
+}
+
 // Page returns the page at the specified index (0-based).
 //
 // Returns an error if the index is out of bounds.
@@ -168,6 +197,31 @@ func (d *Document) SetMetadata(title, author, subject string, keywords ...string
 	d.modDate = time.Now()
 }
 
+// SetCreator sets the application that created the original content
+// (default "gxpdf"). An empty string removes the entry.
+func (d *Document) SetCreator(creator string) {
+	d.creator = creator
+	d.modDate = time.Now()
+}
+
+// SetProducer sets the application that produced the PDF (default
+// "gxpdf (github.com/coregx/gxpdf)"). An empty string removes the entry.
+func (d *Document) SetProducer(producer string) {
+	d.producer = producer
+	d.modDate = time.Now()
+}
+
+// SetCreationDate sets the document creation date.
+//
+// By default the creation date is the time the document was created.
+// Zero times are ignored.
+func (d *Document) SetCreationDate(t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	d.creationDate = t
+}
+
 // Title returns the document title.
 func (d *Document) Title() string {
 	return d.title
@@ -173,6 +173,36 @@ func TestDocument_RemovePage(t *testing.T) {
 	}
 }
 
+func TestDocument_AppendDocument(t *testing.T) {
+	doc := NewDocument()
+	doc.AddPage(A4)
+	doc.AddPage(A4)
+
+	other := NewDocument()
+	first, _ := other.AddPage(Letter)
+	other.AddPage(Letter)
+	internal := NewInternalLinkAnnotation([4]float64{0, 0, 10, 10}, 1)
+	external := NewLinkAnnotation([4]float64{0, 0, 10, 10}, "https://example.com")
+	require.NoError(t, first.AddLinkAnnotation(internal))
+	require.NoError(t, first.AddLinkAnnotation(external))
+
+	require.NoError(t, doc.AppendDocument(other))
+
+	assert.Equal(t, 4, doc.PageCount())
+	assert.Equal(t, 0, other.PageCount())
+	for i := 0; i < doc.PageCount(); i++ {
+		p, _ := doc.Page(i)
+		assert.Equal(t, i, p.Number(), "page %d should have number %d", i, i)
+	}
+	p, _ := doc.Page(2)
+	assert.Same(t, first, p)
+
+	assert.Equal(t, 3, internal.DestPage, "internal link should follow its page")
+	assert.Equal(t, -1, external.DestPage)
+
+	assert.Error(t, doc.AppendDocument(doc))
+}
+
 func TestDocument_Page(t *testing.T) {
 	doc := NewDocument()
 	doc.AddPage(A4)
@@ -329,3 +359,13 @@ func TestDocument_Getters(t *testing.T) {
 	assert.NotZero(t, doc.CreationDate())
 	assert.NotZero(t, doc.ModificationDate())
 }
+
+func TestDocument_SetCreatorAndProducer(t *testing.T) {
+	doc := NewDocument()
+
+	doc.SetCreator("Acme Invoicing")
+	doc.SetProducer("")
+
+	assert.Equal(t, "Acme Invoicing", doc.Creator())
+	assert.Empty(t, doc.Producer())
+}
@@ -31,10 +31,12 @@ type Page struct {
 	contents []content.Content // Content elements on the page
 
 	// Annotations (different types)
-	linkAnnotations   []*LinkAnnotation   // Link annotations
-	textAnnotations   []*TextAnnotation   // Text (sticky note) annotations
-	markupAnnotations []*MarkupAnnotation // Markup annotations (highlight, underline, strikeout)
-	stampAnnotations  []*StampAnnotation  // Stamp annotations
+	linkAnnotations     []*LinkAnnotation     // Link annotations
+	textAnnotations     []*TextAnnotation     // Text (sticky note) annotations
+	markupAnnotations   []*MarkupAnnotation   // Markup annotations (highlight, underline, strikeout)
+	stampAnnotations    []*StampAnnotation    // Stamp annotations
+	freeTextAnnotations []*FreeTextAnnotation // Free text annotations
+	shapeAnnotations    []*ShapeAnnotation    // Shape annotations (line, square, circle, ink)
 
 	// Form fields (interactive form widgets)
 	formFields []*FormField // Form field annotations
@@ -50,15 +52,17 @@ type Page struct {
 //	page := document.NewPage(0, document.A4)
 func NewPage(number int, size PageSize) *Page {
 	return &Page{
-		number:            number,
-		mediaBox:          size.ToRectangle(),
-		rotation:          0,
-		contents:          make([]content.Content, 0),
-		linkAnnotations:   make([]*LinkAnnotation, 0),
-		textAnnotations:   make([]*TextAnnotation, 0),
-		markupAnnotations: make([]*MarkupAnnotation, 0),
-		stampAnnotations:  make([]*StampAnnotation, 0),
-		formFields:        make([]*FormField, 0),
+		number:              number,
+		mediaBox:            size.ToRectangle(),
+		rotation:            0,
+		contents:            make([]content.Content, 0),
+		linkAnnotations:     make([]*LinkAnnotation, 0),
+		textAnnotations:     make([]*TextAnnotation, 0),
+		markupAnnotations:   make([]*MarkupAnnotation, 0),
+		stampAnnotations:    make([]*StampAnnotation, 0),
+		freeTextAnnotations: make([]*FreeTextAnnotation, 0),
+		shapeAnnotations:    make([]*ShapeAnnotation, 0),
+		formFields:          make([]*FormField, 0),
 	}
 }
 
@@ -293,6 +297,52 @@ func (p *Page) AddStampAnnotation(a *StampAnnotation) error {
 	return nil
 }
 
+// AddFreeTextAnnotation adds a free text annotation to the page.
+//
+// Returns an error if:
+// - Annotation is nil
+// - Annotation validation fails
+//
+// Example:
+//
+//	box := NewFreeTextAnnotation([4]float64{100, 600, 300, 650}, "Note")
+//	err := page.AddFreeTextAnnotation(box)
+func (p *Page) AddFreeTextAnnotation(a *FreeTextAnnotation) error {
+	if a == nil {
+		return ErrNilAnnotation
+	}
+
+	if err := a.Validate(); err != nil {
+		return fmt.Errorf("free text annotation validation failed: %w", err)
+	}
+
+	p.freeTextAnnotations = append(p.freeTextAnnotations, a)
+	return nil
+}
+
+// AddShapeAnnotation adds a shape annotation (line, square, circle, ink) to the page.
+//
+// Returns an error if:
+// - Annotation is nil
+// - Annotation validation fails
+//
+// Example:
+//
+//	square := NewShapeAnnotation(AnnotationTypeSquare, [4]float64{100, 600, 200, 650})
+//	err := page.AddShapeAnnotation(square)
+func (p *Page) AddShapeAnnotation(a *ShapeAnnotation) error {
+	if a == nil {
+		return ErrNilAnnotation
+	}
+
+	if err := a.Validate(); err != nil {
+		return fmt.Errorf("shape annotation validation failed: %w", err)
+	}
+
+	p.shapeAnnotations = append(p.shapeAnnotations, a)
+	return nil
+}
+
 // AddFormField adds a form field annotation to the page.
 //
 // Returns an error if:
@@ -362,6 +412,24 @@ func (p *Page) StampAnnotations() []*StampAnnotation {
 	return result
 }
 
+// FreeTextAnnotations returns all free text annotations on the page.
+//
+// The returned slice is a copy to prevent external modifications.
+func (p *Page) FreeTextAnnotations() []*FreeTextAnnotation {
+	result := make([]*FreeTextAnnotation, len(p.freeTextAnnotations))
+	copy(result, p.freeTextAnnotations)
+	return result
+}
+
+// ShapeAnnotations returns all shape annotations on the page.
+//
+// The returned slice is a copy to prevent external modifications.
+func (p *Page) ShapeAnnotations() []*ShapeAnnotation {
+	result := make([]*ShapeAnnotation, len(p.shapeAnnotations))
+	copy(result, p.shapeAnnotations)
+	return result
+}
+
 // FormFields returns all form field annotations on the page.
 //
 // The returned slice is a copy to prevent external modifications.
@@ -374,7 +442,8 @@ func (p *Page) FormFields() []*FormField {
 // AnnotationCount returns the total number of annotations on the page.
 func (p *Page) AnnotationCount() int {
 	return len(p.linkAnnotations) + len(p.textAnnotations) +
-		len(p.markupAnnotations) + len(p.stampAnnotations) + len(p.formFields)
+		len(p.markupAnnotations) + len(p.stampAnnotations) +
+		len(p.freeTextAnnotations) + len(p.shapeAnnotations) + len(p.formFields)
 }
 
 // ClearAnnotations removes all annotations from the page.
@@ -383,6 +452,8 @@ func (p *Page) ClearAnnotations() {
 	p.textAnnotations = make([]*TextAnnotation, 0)
 	p.markupAnnotations = make([]*MarkupAnnotation, 0)
 	p.stampAnnotations = make([]*StampAnnotation, 0)
+	p.freeTextAnnotations = make([]*FreeTextAnnotation, 0)
+	p.shapeAnnotations = make([]*ShapeAnnotation, 0)
 	p.formFields = make([]*FormField, 0)
 }
 
@@ -0,0 +1,69 @@
+package document
+
+import "math"
+
+// TransformAnnotations maps the geometry of the page's annotations and form
+// fields through fn, keeping them on the content they belong to when the
+// page content is transformed (e.g. scaled or moved).
+//
+// Rectangles become the bounding box of their transformed corners.
+func (p *Page) TransformAnnotations(fn func(x, y float64) (float64, float64)) {
+	for _, a := range p.linkAnnotations {
+		a.Rect = transformRect(a.Rect, fn)
+	}
+	for _, a := range p.textAnnotations {
+		a.Rect = transformRect(a.Rect, fn)
+	}
+	for _, a := range p.markupAnnotations {
+		a.Rect = transformRect(a.Rect, fn)
+		for i := range a.QuadPoints {
+			transformPoints(a.QuadPoints[i][:], fn)
+		}
+		transformPopup(a.Popup, fn)
+	}
+	for _, a := range p.stampAnnotations {
+		a.Rect = transformRect(a.Rect, fn)
+		transformPopup(a.Popup, fn)
+	}
+	for _, a := range p.freeTextAnnotations {
+		a.Rect = transformRect(a.Rect, fn)
+	}
+	for _, a := range p.shapeAnnotations {
+		a.Rect = transformRect(a.Rect, fn)
+		if a.Type == AnnotationTypeLine {
+			transformPoints(a.LinePoints[:], fn)
+		}
+		for _, stroke := range a.InkList {
+			transformPoints(stroke, fn)
+		}
+		transformPopup(a.Popup, fn)
+	}
+	for _, f := range p.formFields {
+		f.rect = transformRect(f.rect, fn)
+	}
+}
+
+// transformRect returns the bounding box of the transformed corners of r.
+func transformRect(r [4]float64, fn func(x, y float64) (float64, float64)) [4]float64 {
+	out := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, corner := range [4][2]float64{{r[0], r[1]}, {r[2], r[1]}, {r[0], r[3]}, {r[2], r[3]}} {
+		x, y := fn(corner[0], corner[1])
+		out[0], out[1] = math.Min(out[0], x), math.Min(out[1], y)
+		out[2], out[3] = math.Max(out[2], x), math.Max(out[3], y)
+	}
+	return out
+}
+
+// transformPoints transforms the x, y pairs of points in place.
+func transformPoints(points []float64, fn func(x, y float64) (float64, float64)) {
+	for i := 0; i+1 < len(points); i += 2 {
+		points[i], points[i+1] = fn(points[i], points[i+1])
+	}
+}
+
+// transformPopup transforms the rectangle of a pop-up window, if any.
+func transformPopup(popup *Popup, fn func(x, y float64) (float64, float64)) {
+	if popup != nil {
+		popup.Rect = transformRect(popup.Rect, fn)
+	}
+}
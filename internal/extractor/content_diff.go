@@ -0,0 +1,198 @@
+package extractor
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// ContentChange is a difference between the content streams of two pages:
+// an operator present in only one of them.
+type ContentChange struct {
+	// Page is the 0-based page index.
+	Page int
+
+	// Added is true for an operator only in the second page, false for an
+	// operator only in the first page.
+	Added bool
+
+	// Kind classifies the operator: "text" (text showing), "position"
+	// (text positioning), "shape" (path construction and painting),
+	// "xobject" (images and forms) or "state" (everything else).
+	Kind string
+
+	// Operator is the normalized operator with its operands,
+	// e.g. "100 700 Td" or "(Total) Tj".
+	Operator string
+}
+
+// maxDiffCells bounds the size of the table used to align two operator
+// sequences; larger differing regions are reported as wholly replaced.
+const maxDiffCells = 4_000_000
+
+// PageOperators returns the operators of a page's decoded content streams.
+//
+// Page numbers are 0-based.
+func PageOperators(reader *parser.Reader, pageIndex int) ([]*Operator, error) {
+	page, err := reader.GetPage(pageIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page %d: %w", pageIndex, err)
+	}
+	content, err := NewTextExtractor(reader).getPageContent(page)
+	if err != nil {
+		return nil, err
+	}
+	operators, err := NewContentParser(content).ParseOperators()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse content stream: %w", err)
+	}
+	return operators, nil
+}
+
+// DiffOperators compares the operators of two pages and returns the
+// operators present in only one of them, in content order.
+//
+// Operators are compared in normalized form, so differences in number
+// formatting, whitespace, stream compression and string encoding are
+// ignored. Numbers are rounded to tolerance (0 = compared exactly).
+func DiffOperators(page int, a, b []*Operator, tolerance float64) []ContentChange {
+	as := normalizeOperators(a, tolerance)
+	bs := normalizeOperators(b, tolerance)
+
+	// Trim the common prefix and suffix.
+	start := 0
+	for start < len(as) && start < len(bs) && as[start] == bs[start] {
+		start++
+	}
+	endA, endB := len(as), len(bs)
+	for endA > start && endB > start && as[endA-1] == bs[endB-1] {
+		endA--
+		endB--
+	}
+
+	var changes []ContentChange
+	remove := func(i int) {
+		changes = append(changes, ContentChange{Page: page, Kind: operatorKind(a[i].Name), Operator: as[i]})
+	}
+	add := func(j int) {
+		changes = append(changes, ContentChange{Page: page, Added: true, Kind: operatorKind(b[j].Name), Operator: bs[j]})
+	}
+
+	n, m := endA-start, endB-start
+	if n*m > maxDiffCells {
+		for i := start; i < endA; i++ {
+			remove(i)
+		}
+		for j := start; j < endB; j++ {
+			add(j)
+		}
+		return changes
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// as[start+i:endA] and bs[start+j:endB].
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if as[start+i] == bs[start+j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && as[start+i] == bs[start+j]:
+			i++
+			j++
+		case j == m || (i < n && lcs[i+1][j] >= lcs[i][j+1]):
+			remove(start + i)
+			i++
+		default:
+			add(start + j)
+			j++
+		}
+	}
+
+	return changes
+}
+
+// normalizeOperators returns the normalized text of each operator.
+func normalizeOperators(ops []*Operator, tolerance float64) []string {
+	out := make([]string, len(ops))
+	for i, op := range ops {
+		parts := make([]string, 0, len(op.Operands)+1)
+		for _, operand := range op.Operands {
+			parts = append(parts, normalizeOperand(operand, tolerance))
+		}
+		out[i] = strings.Join(append(parts, op.Name), " ")
+	}
+	return out
+}
+
+// normalizeOperand formats an operand with rounded numbers and strings in
+// literal form.
+func normalizeOperand(obj parser.PdfObject, tolerance float64) string {
+	switch v := obj.(type) {
+	case *parser.Integer:
+		return formatNumber(float64(v.Value()), tolerance)
+	case *parser.Real:
+		return formatNumber(v.Value(), tolerance)
+	case *parser.String:
+		return parser.NewString(v.Value()).String()
+	case *parser.Array:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = normalizeOperand(v.Get(i), tolerance)
+		}
+		return "[" + strings.Join(parts, " ") + "]"
+	case nil:
+		return "null"
+	default:
+		return obj.String()
+	}
+}
+
+// formatNumber rounds v to a multiple of tolerance and formats it without
+// trailing zeros.
+func formatNumber(v, tolerance float64) string {
+	if tolerance <= 0 {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	v = math.Round(v/tolerance) * tolerance
+	digits := max(0, int(math.Ceil(-math.Log10(tolerance))))
+	s := strconv.FormatFloat(v, 'f', digits, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// operatorKind classifies a content stream operator.
+func operatorKind(name string) string {
+	switch name {
+	case "Tj", "TJ", "'", "\"":
+		return "text"
+	case "Td", "TD", "Tm", "T*":
+		return "position"
+	case "m", "l", "c", "v", "y", "h", "re",
+		"S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n", "W", "W*", "sh":
+		return "shape"
+	case "Do", "BI", "ID", "EI":
+		return "xobject"
+	default:
+		return "state"
+	}
+}
@@ -0,0 +1,103 @@
+package extractor
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+func parseTestOperators(t *testing.T, content string) []*Operator {
+	t.Helper()
+	ops, err := NewContentParser([]byte(content)).ParseOperators()
+	if err != nil {
+		t.Fatalf("ParseOperators() error = %v", err)
+	}
+	return ops
+}
+
+func TestDiffOperators(t *testing.T) {
+	a := parseTestOperators(t, "BT /F1 12 Tf 100 700 Td (Hello) Tj ET 10 10 50 50 re f")
+	b := parseTestOperators(t, "BT /F1 12.0 Tf 100.004 700 Td <48656C6C6F> Tj (World) Tj ET 10 10 60 50 re f")
+
+	changes := DiffOperators(0, a, b, 0.01)
+	want := []ContentChange{
+		{Page: 0, Added: true, Kind: "text", Operator: "(World) Tj"},
+		{Page: 0, Added: false, Kind: "shape", Operator: "10 10 50 50 re"},
+		{Page: 0, Added: true, Kind: "shape", Operator: "10 10 60 50 re"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %+v, want %+v", changes, want)
+	}
+	for i, ch := range changes {
+		if ch != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, ch, want[i])
+		}
+	}
+}
+
+func TestDiffOperators_Tolerance(t *testing.T) {
+	a := parseTestOperators(t, "100 700 Td")
+	b := parseTestOperators(t, "100.2 700 Td")
+
+	if changes := DiffOperators(0, a, b, 0.5); len(changes) != 0 {
+		t.Errorf("changes within tolerance = %+v, want none", changes)
+	}
+	if changes := DiffOperators(0, a, b, 0); len(changes) != 2 {
+		t.Errorf("exact comparison changes = %d, want 2", len(changes))
+	}
+}
+
+func TestDiffOperators_MissingPage(t *testing.T) {
+	a := parseTestOperators(t, "0 0 m 10 10 l S")
+
+	changes := DiffOperators(3, a, nil, 0)
+	if len(changes) != 3 {
+		t.Fatalf("changes = %d, want 3", len(changes))
+	}
+	for _, ch := range changes {
+		if ch.Added || ch.Page != 3 || ch.Kind != "shape" {
+			t.Errorf("change = %+v, want removed shape on page 3", ch)
+		}
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		v, tolerance float64
+		want         string
+	}{
+		{100, 0, "100"},
+		{0.1 + 0.2, 0.01, "0.3"},
+		{12.5, 0.01, "12.5"},
+		{-0.001, 0.01, "0"},
+		{99.96, 0.1, "100"},
+	}
+	for _, tt := range tests {
+		if got := formatNumber(tt.v, tt.tolerance); got != tt.want {
+			t.Errorf("formatNumber(%v, %v) = %q, want %q", tt.v, tt.tolerance, got, tt.want)
+		}
+	}
+}
+
+func TestPageOperators(t *testing.T) {
+	content := "BT /F1 12 Tf (Hi) Tj ET"
+	path := writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R >>",
+		"<< /Length 23 >>\nstream\n" + content + "\nendstream",
+	})
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	ops, err := PageOperators(reader, 0)
+	if err != nil {
+		t.Fatalf("PageOperators() error = %v", err)
+	}
+	if len(ops) != 4 || ops[2].Name != "Tj" {
+		t.Errorf("operators = %v, want BT Tf Tj ET", ops)
+	}
+}
@@ -0,0 +1,232 @@
+package extractor
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/encoding"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// ICCProfile is an ICC color profile embedded in a PDF, with the places
+// that reference it.
+//
+// Reference: PDF 1.7 specification, Section 8.6.5.5 (ICCBased Color Spaces)
+// and Section 14.11.5 (Output Intents).
+type ICCProfile struct {
+	// Data is the decoded profile.
+	Data []byte
+
+	// Components is the number of color components (/N): 1, 3 or 4.
+	Components int
+
+	// Alternate is the alternate color space name (/Alternate), if any.
+	Alternate string
+
+	// ObjectNumber is the number of the profile stream object.
+	ObjectNumber int
+
+	// References lists where the profile is referenced, in document order.
+	References []ICCReference
+}
+
+// ICCReference is a place that references an ICC profile.
+type ICCReference struct {
+	// Kind is "OutputIntent", "ColorSpace" (a page color space resource)
+	// or "Image" (an image XObject's color space).
+	Kind string
+
+	// Page is the 0-based page index, or -1 for output intents.
+	Page int
+
+	// Name is the output intent subtype (e.g. "GTS_PDFX") or the resource
+	// name of the color space or image.
+	Name string
+}
+
+// ExtractICCProfiles extracts the ICC profiles referenced by the document's
+// output intents, page color space resources and image XObjects.
+//
+// Each profile stream is returned once, with all places that reference it.
+// Profiles using an unsupported filter are skipped.
+func ExtractICCProfiles(reader *parser.Reader) ([]ICCProfile, error) {
+	c := &iccCollector{reader: reader, index: make(map[int]int)}
+
+	catalog, err := reader.GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog: %w", err)
+	}
+	c.collectOutputIntents(catalog)
+
+	pageCount, err := reader.GetPageCount()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page count: %w", err)
+	}
+	for i := 0; i < pageCount; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get page %d: %w", i, err)
+		}
+		c.collectPage(i, page)
+	}
+
+	return c.profiles, nil
+}
+
+// iccCollector gathers ICC profiles, keyed by profile stream object.
+type iccCollector struct {
+	reader   *parser.Reader
+	profiles []ICCProfile
+	index    map[int]int // object number -> index in profiles
+}
+
+// collectOutputIntents collects the destination profiles of the catalog's
+// output intents.
+func (c *iccCollector) collectOutputIntents(catalog *parser.Dictionary) {
+	intents, ok := c.resolve(catalog.Get("OutputIntents")).(*parser.Array)
+	if !ok {
+		return
+	}
+	for i := 0; i < intents.Len(); i++ {
+		intent, ok := c.resolve(intents.Get(i)).(*parser.Dictionary)
+		if !ok {
+			continue
+		}
+		name := ""
+		if s := intent.GetName("S"); s != nil {
+			name = s.Value()
+		}
+		c.add(intent.Get("DestOutputProfile"), ICCReference{Kind: "OutputIntent", Page: -1, Name: name})
+	}
+}
+
+// collectPage collects the profiles of a page's color space resources and
+// image XObjects.
+func (c *iccCollector) collectPage(pageIndex int, page *parser.Dictionary) {
+	resources, ok := c.resolve(page.Get("Resources")).(*parser.Dictionary)
+	if !ok {
+		return
+	}
+
+	if spaces, ok := c.resolve(resources.Get("ColorSpace")).(*parser.Dictionary); ok {
+		for _, name := range spaces.Keys() {
+			c.addColorSpace(spaces.Get(name), ICCReference{Kind: "ColorSpace", Page: pageIndex, Name: name})
+		}
+	}
+
+	if xobjects, ok := c.resolve(resources.Get("XObject")).(*parser.Dictionary); ok {
+		for _, name := range xobjects.Keys() {
+			stream, ok := c.resolve(xobjects.Get(name)).(*parser.Stream)
+			if !ok {
+				continue
+			}
+			subtype := stream.Dictionary().GetName("Subtype")
+			if subtype == nil || subtype.Value() != "Image" {
+				continue
+			}
+			c.addColorSpace(stream.Dictionary().Get("ColorSpace"), ICCReference{Kind: "Image", Page: pageIndex, Name: name})
+		}
+	}
+}
+
+// addColorSpace adds the profile of an ICCBased color space, or of the
+// ICCBased base of an Indexed color space.
+func (c *iccCollector) addColorSpace(obj parser.PdfObject, ref ICCReference) {
+	arr, ok := c.resolve(obj).(*parser.Array)
+	if !ok || arr.Len() < 2 {
+		return
+	}
+	family, ok := arr.Get(0).(*parser.Name)
+	if !ok {
+		return
+	}
+
+	switch family.Value() {
+	case "ICCBased":
+		c.add(arr.Get(1), ref)
+	case "Indexed":
+		c.addColorSpace(arr.Get(1), ref)
+	}
+}
+
+// add records a reference to the profile stream obj.
+func (c *iccCollector) add(obj parser.PdfObject, ref ICCReference) {
+	objectNumber := 0
+	if r, ok := obj.(*parser.IndirectReference); ok {
+		objectNumber = r.Number
+		if i, seen := c.index[objectNumber]; seen {
+			c.profiles[i].References = append(c.profiles[i].References, ref)
+			return
+		}
+	}
+
+	stream, ok := c.resolve(obj).(*parser.Stream)
+	if !ok {
+		return
+	}
+	data, err := decodeICCStream(stream)
+	if err != nil {
+		return
+	}
+
+	dict := stream.Dictionary()
+	profile := ICCProfile{
+		Data:         data,
+		Components:   int(dict.GetInteger("N")),
+		ObjectNumber: objectNumber,
+		References:   []ICCReference{ref},
+	}
+	if alt, ok := dict.Get("Alternate").(*parser.Name); ok {
+		profile.Alternate = alt.Value()
+	}
+
+	if objectNumber != 0 {
+		c.index[objectNumber] = len(c.profiles)
+	}
+	c.profiles = append(c.profiles, profile)
+}
+
+// resolve resolves an indirect reference (see resolveObject).
+func (c *iccCollector) resolve(obj parser.PdfObject) parser.PdfObject {
+	return resolveObject(c.reader, obj)
+}
+
+// resolveObject resolves an indirect reference; other objects are returned
+// as is. Unresolvable references yield nil.
+func resolveObject(reader *parser.Reader, obj parser.PdfObject) parser.PdfObject {
+	ref, ok := obj.(*parser.IndirectReference)
+	if !ok {
+		return obj
+	}
+	resolved, err := reader.GetObject(ref.Number)
+	if err != nil {
+		return nil
+	}
+	return resolved
+}
+
+// decodeICCStream returns the decoded content of a profile stream.
+func decodeICCStream(stream *parser.Stream) ([]byte, error) {
+	var filter string
+	switch f := stream.Dictionary().Get("Filter").(type) {
+	case *parser.Name:
+		filter = f.Value()
+	case *parser.Array:
+		if f.Len() > 1 {
+			return nil, fmt.Errorf("unsupported filter chain of %d filters", f.Len())
+		}
+		if f.Len() == 1 {
+			if name, ok := f.Get(0).(*parser.Name); ok {
+				filter = name.Value()
+			}
+		}
+	}
+
+	switch filter {
+	case "":
+		return stream.Content(), nil
+	case "FlateDecode":
+		return encoding.NewFlateDecoder().Decode(stream.Content())
+	default:
+		return nil, fmt.Errorf("unsupported filter: %s", filter)
+	}
+}
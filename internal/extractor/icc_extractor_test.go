@@ -0,0 +1,113 @@
+package extractor
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// buildICCPDF creates a one-page PDF whose CMYK profile (object 4) is
+// referenced by an output intent, a page color space and an Indexed image,
+// and whose RGB profile (object 5) is Flate-compressed.
+func buildICCPDF(t *testing.T) string {
+	t.Helper()
+
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	_, _ = zw.Write([]byte("rgb-profile"))
+	_ = zw.Close()
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R /OutputIntents [<< /Type /OutputIntent /S /GTS_PDFX /DestOutputProfile 4 0 R >>] >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] " +
+			"/Resources << /ColorSpace << /CS0 [/ICCBased 4 0 R] /CS1 [/ICCBased 5 0 R] >> /XObject << /Im1 6 0 R >> >> >>",
+		"<< /N 4 /Alternate /DeviceCMYK /Length 12 >>\nstream\ncmyk-profile\nendstream",
+		fmt.Sprintf("<< /N 3 /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()),
+		"<< /Type /XObject /Subtype /Image /Width 1 /Height 1 /BitsPerComponent 8 " +
+			"/ColorSpace [/Indexed [/ICCBased 4 0 R] 0 <00000000>] /Length 1 >>\nstream\n\x00\nendstream",
+	}
+
+	return writeTestPDF(t, objects)
+}
+
+// writeTestPDF writes a PDF of the given objects, numbered from 1 with the
+// catalog first, to a temporary file and returns its path.
+func writeTestPDF(t *testing.T, objects []string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	path := filepath.Join(t.TempDir(), "test.pdf")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestExtractICCProfiles(t *testing.T) {
+	reader, err := parser.OpenPDF(buildICCPDF(t))
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	profiles, err := ExtractICCProfiles(reader)
+	if err != nil {
+		t.Fatalf("ExtractICCProfiles() error = %v", err)
+	}
+	if len(profiles) != 2 {
+		t.Fatalf("profiles = %d, want 2", len(profiles))
+	}
+
+	cmyk := profiles[0]
+	if string(cmyk.Data) != "cmyk-profile" || cmyk.Components != 4 || cmyk.Alternate != "DeviceCMYK" || cmyk.ObjectNumber != 4 {
+		t.Errorf("CMYK profile = %q, N=%d, %q, obj %d", cmyk.Data, cmyk.Components, cmyk.Alternate, cmyk.ObjectNumber)
+	}
+	wantRefs := []ICCReference{
+		{Kind: "OutputIntent", Page: -1, Name: "GTS_PDFX"},
+		{Kind: "ColorSpace", Page: 0, Name: "CS0"},
+		{Kind: "Image", Page: 0, Name: "Im1"},
+	}
+	if len(cmyk.References) != len(wantRefs) {
+		t.Fatalf("CMYK references = %+v, want %+v", cmyk.References, wantRefs)
+	}
+	for i, ref := range cmyk.References {
+		if ref != wantRefs[i] {
+			t.Errorf("reference %d = %+v, want %+v", i, ref, wantRefs[i])
+		}
+	}
+
+	rgb := profiles[1]
+	if string(rgb.Data) != "rgb-profile" || rgb.Components != 3 {
+		t.Errorf("RGB profile = %q, N=%d, want decoded data with N=3", rgb.Data, rgb.Components)
+	}
+	if len(rgb.References) != 1 || rgb.References[0].Name != "CS1" {
+		t.Errorf("RGB references = %+v, want CS1", rgb.References)
+	}
+}
+
+func TestDecodeICCStream_UnsupportedFilter(t *testing.T) {
+	dict := parser.NewDictionary()
+	dict.SetName("Filter", "LZWDecode")
+	if _, err := decodeICCStream(parser.NewStream(dict, []byte("x"))); err == nil {
+		t.Error("decodeICCStream() should fail for an unsupported filter")
+	}
+}
@@ -0,0 +1,247 @@
+package extractor
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// StructElement is an element of a tagged PDF's logical structure tree.
+//
+// Reference: PDF 1.7 specification, Section 14.7 (Logical Structure) and
+// Section 14.8 (Tagged PDF).
+type StructElement struct {
+	// Type is the structure type (/S), e.g. "P", "H1" or "Table".
+	// The root element has type "StructTreeRoot".
+	Type string
+
+	// Role is the standard type that Type is mapped to by the role map,
+	// or "" if Type is not remapped.
+	Role string
+
+	// Title, Alt, ActualText and Lang are the element's /T, /Alt,
+	// /ActualText and /Lang entries.
+	Title      string
+	Alt        string
+	ActualText string
+	Lang       string
+
+	// Page is the 0-based index of the element's page, or -1 if unknown.
+	Page int
+
+	// Text is the text of the element's own marked content, excluding
+	// child elements.
+	Text string
+
+	// Children are the child structure elements in logical order.
+	Children []*StructElement
+}
+
+// ExtractStructureTree returns the logical structure tree of a tagged PDF,
+// rooted at an element of type "StructTreeRoot". It returns nil if the
+// document has no structure tree.
+func ExtractStructureTree(reader *parser.Reader) (*StructElement, error) {
+	catalog, err := reader.GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog: %w", err)
+	}
+	root, ok := resolveObject(reader, catalog.Get("StructTreeRoot")).(*parser.Dictionary)
+	if !ok {
+		return nil, nil
+	}
+
+	w := &structWalker{
+		reader:  reader,
+		roles:   make(map[string]string),
+		pages:   pageIndices(reader),
+		content: make(map[int]map[int][]*TextElement),
+		visited: make(map[int]bool),
+	}
+	if roleMap, ok := resolveObject(reader, root.Get("RoleMap")).(*parser.Dictionary); ok {
+		for _, key := range roleMap.Keys() {
+			if name, ok := roleMap.Get(key).(*parser.Name); ok {
+				w.roles[key] = name.Value()
+			}
+		}
+	}
+
+	tree := &StructElement{Type: "StructTreeRoot", Page: -1}
+	var text []*TextElement
+	w.walkKids(tree, root.Get("K"), -1, &text)
+	return tree, nil
+}
+
+// structWalker builds structure elements from structure dictionaries.
+type structWalker struct {
+	reader  *parser.Reader
+	roles   map[string]string              // role map
+	pages   map[int]int                    // page object number -> page index
+	content map[int]map[int][]*TextElement // page index -> MCID -> text
+	visited map[int]bool                   // structure elements already walked
+}
+
+// walkElement builds the element of a structure element dictionary.
+// page is the inherited page index.
+func (w *structWalker) walkElement(dict *parser.Dictionary, page int) *StructElement {
+	elem := &StructElement{Page: page}
+	if s := dict.GetName("S"); s != nil {
+		elem.Type = s.Value()
+		elem.Role = w.role(elem.Type)
+	}
+	elem.Title = textString(dict.Get("T"))
+	elem.Alt = textString(dict.Get("Alt"))
+	elem.ActualText = textString(dict.Get("ActualText"))
+	elem.Lang = textString(dict.Get("Lang"))
+	if pg, ok := w.pageOf(dict.Get("Pg")); ok {
+		elem.Page = pg
+	}
+
+	var text []*TextElement
+	w.walkKids(elem, dict.Get("K"), elem.Page, &text)
+	elem.Text = joinText(text)
+	return elem
+}
+
+// walkKids adds the children of an element: structure elements become
+// child elements and marked-content references add their text.
+func (w *structWalker) walkKids(parent *StructElement, obj parser.PdfObject, page int, text *[]*TextElement) {
+	if ref, ok := obj.(*parser.IndirectReference); ok {
+		if w.visited[ref.Number] {
+			return
+		}
+		w.visited[ref.Number] = true
+	}
+
+	switch kid := resolveObject(w.reader, obj).(type) {
+	case *parser.Array:
+		for i := 0; i < kid.Len(); i++ {
+			w.walkKids(parent, kid.Get(i), page, text)
+		}
+	case *parser.Integer:
+		*text = append(*text, w.markedContent(page, kid.Int())...)
+	case *parser.Dictionary:
+		kidPage := page
+		if pg, ok := w.pageOf(kid.Get("Pg")); ok {
+			kidPage = pg
+		}
+		typ := ""
+		if t := kid.GetName("Type"); t != nil {
+			typ = t.Value()
+		}
+		switch typ {
+		case "MCR": // Marked-content reference
+			if mcid, ok := kid.Get("MCID").(*parser.Integer); ok {
+				*text = append(*text, w.markedContent(kidPage, mcid.Int())...)
+			}
+		case "OBJR": // Object reference (annotation, XObject)
+		default:
+			parent.Children = append(parent.Children, w.walkElement(kid, page))
+		}
+	}
+}
+
+// role returns the role a structure type is mapped to, following chained
+// mappings, or "" if it is not mapped.
+func (w *structWalker) role(typ string) string {
+	role := ""
+	for seen := 0; seen < len(w.roles); seen++ {
+		mapped, ok := w.roles[typ]
+		if !ok || mapped == typ {
+			break
+		}
+		role, typ = mapped, mapped
+	}
+	return role
+}
+
+// pageOf returns the page index of a /Pg reference.
+func (w *structWalker) pageOf(obj parser.PdfObject) (int, bool) {
+	ref, ok := obj.(*parser.IndirectReference)
+	if !ok {
+		return 0, false
+	}
+	index, ok := w.pages[ref.Number]
+	return index, ok
+}
+
+// markedContent returns the text of a page's marked content with the
+// given MCID. Page text is extracted once.
+func (w *structWalker) markedContent(page, mcid int) []*TextElement {
+	if page < 0 {
+		return nil
+	}
+	byMCID, ok := w.content[page]
+	if !ok {
+		byMCID = make(map[int][]*TextElement)
+		elements, err := NewTextExtractor(w.reader).ExtractFromPage(page)
+		if err == nil {
+			for _, elem := range elements {
+				if elem.MCID >= 0 {
+					byMCID[elem.MCID] = append(byMCID[elem.MCID], elem)
+				}
+			}
+		}
+		w.content[page] = byMCID
+	}
+	return byMCID[mcid]
+}
+
+// pageIndices maps the object numbers of the page objects to their page
+// indices, in page tree order.
+func pageIndices(reader *parser.Reader) map[int]int {
+	indices := make(map[int]int)
+	pages, err := reader.GetPages()
+	if err != nil {
+		return indices
+	}
+
+	visited := make(map[int]bool)
+	var walk func(node *parser.Dictionary)
+	walk = func(node *parser.Dictionary) {
+		kids, ok := resolveObject(reader, node.Get("Kids")).(*parser.Array)
+		if !ok {
+			return
+		}
+		for i := 0; i < kids.Len(); i++ {
+			ref, ok := kids.Get(i).(*parser.IndirectReference)
+			if !ok || visited[ref.Number] {
+				continue
+			}
+			visited[ref.Number] = true
+			kid, ok := resolveObject(reader, ref).(*parser.Dictionary)
+			if !ok {
+				continue
+			}
+			if t := kid.GetName("Type"); t != nil && t.Value() == "Pages" {
+				walk(kid)
+				continue
+			}
+			indices[ref.Number] = len(indices)
+		}
+	}
+	walk(pages)
+	return indices
+}
+
+// joinText joins text elements, separating elements on different lines
+// with a space.
+func joinText(elements []*TextElement) string {
+	var b strings.Builder
+	for i, elem := range elements {
+		if i > 0 && math.Abs(elem.Y-elements[i-1].Y) > 0.5 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(elem.Text)
+	}
+	return b.String()
+}
+
+// textString returns the value of a text string object, or "".
+func textString(obj parser.PdfObject) string {
+	if s, ok := obj.(*parser.String); ok {
+		return s.Text()
+	}
+	return ""
+}
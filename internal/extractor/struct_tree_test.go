@@ -0,0 +1,100 @@
+package extractor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+func TestExtractStructureTree(t *testing.T) {
+	content := "/P << /MCID 0 >> BDC BT /F1 12 Tf 72 700 Td (Hello) Tj ET EMC " +
+		"/H1 /MC1 BDC BT /F1 12 Tf 72 680 Td (Intro) Tj ET EMC " +
+		"BT /F1 12 Tf 72 660 Td (Untagged) Tj ET"
+	path := writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R /StructTreeRoot 5 0 R /MarkInfo << /Marked true >> >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R " +
+			"/Resources << /Font << /F1 9 0 R >> /Properties << /MC1 << /MCID 1 >> >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		"<< /Type /StructTreeRoot /K 6 0 R /RoleMap << /Para /P >> >>",
+		"<< /Type /StructElem /S /Document /Lang (en-US) /K [7 0 R 8 0 R] >>",
+		"<< /Type /StructElem /S /Para /Pg 3 0 R /Alt (Greeting) /K 0 >>",
+		"<< /Type /StructElem /S /H1 /Pg 3 0 R /T (Heading) /K << /Type /MCR /MCID 1 >> >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	})
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	root, err := ExtractStructureTree(reader)
+	if err != nil {
+		t.Fatalf("ExtractStructureTree() error = %v", err)
+	}
+	if root == nil || root.Type != "StructTreeRoot" || len(root.Children) != 1 {
+		t.Fatalf("root = %+v, want StructTreeRoot with 1 child", root)
+	}
+
+	doc := root.Children[0]
+	if doc.Type != "Document" || doc.Lang != "en-US" || doc.Page != -1 || len(doc.Children) != 2 {
+		t.Fatalf("document element = %+v", doc)
+	}
+
+	para := doc.Children[0]
+	if para.Type != "Para" || para.Role != "P" || para.Alt != "Greeting" || para.Page != 0 || para.Text != "Hello" {
+		t.Errorf("paragraph = %+v", para)
+	}
+	heading := doc.Children[1]
+	if heading.Type != "H1" || heading.Role != "" || heading.Title != "Heading" || heading.Text != "Intro" {
+		t.Errorf("heading = %+v", heading)
+	}
+}
+
+func TestExtractStructureTree_Untagged(t *testing.T) {
+	path := writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] >>",
+	})
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	root, err := ExtractStructureTree(reader)
+	if err != nil || root != nil {
+		t.Errorf("ExtractStructureTree() = %+v, %v, want nil, nil", root, err)
+	}
+}
+
+func TestTextExtractor_MarkedContent(t *testing.T) {
+	te := NewTextExtractor(parser.NewReader("dummy.pdf"))
+	ops := []*Operator{
+		NewOperator("BDC", []parser.PdfObject{parser.NewName("P"), mcidDict(3)}),
+		NewOperator("BMC", []parser.PdfObject{parser.NewName("Span")}),
+		NewOperator("Tj", []parser.PdfObject{parser.NewString("In")}),
+		NewOperator("EMC", nil),
+		NewOperator("EMC", nil),
+		NewOperator("Tj", []parser.PdfObject{parser.NewString("Out")}),
+		NewOperator("EMC", nil), // Unbalanced EMC is ignored.
+	}
+	for _, op := range ops {
+		te.processOperator(op)
+	}
+
+	if len(te.elements) != 2 || te.elements[0].MCID != 3 || te.elements[1].MCID != -1 {
+		for _, e := range te.elements {
+			t.Logf("%q MCID %d", e.Text, e.MCID)
+		}
+		t.Error("text should carry the MCID of its enclosing marked content")
+	}
+}
+
+func mcidDict(mcid int64) *parser.Dictionary {
+	d := parser.NewDictionary()
+	d.SetInteger("MCID", mcid)
+	return d
+}
@@ -30,6 +30,7 @@ type TextElement struct {
 	Height   float64 // Height of text (in points)
 	FontName string  // Font name (e.g., "/F1", "/Helvetica")
 	FontSize float64 // Font size in points
+	MCID     int     // Marked-content ID of the enclosing tagged content (-1 = none)
 }
 
 // NewTextElement creates a new TextElement with the given properties.
@@ -42,6 +43,7 @@ func NewTextElement(text string, x, y, width, height float64, fontName string, f
 		Height:   height,
 		FontName: fontName,
 		FontSize: fontSize,
+		MCID:     -1,
 	}
 }
 
@@ -2,6 +2,7 @@ package extractor
 
 import (
 	"compress/zlib"
+	"context"
 	"fmt"
 	"io"
 	"log/slog"
@@ -32,6 +33,7 @@ type TextExtractor struct {
 	elements      []*TextElement
 	fontDecoders  map[string]*FontDecoder // fontName -> FontDecoder
 	pageResources *parser.Dictionary      // Current page resources
+	markedContent []int                   // MCIDs of the open marked-content sequences (-1 = none)
 }
 
 // NewTextExtractor creates a new TextExtractor for the given PDF reader.
@@ -50,10 +52,28 @@ func NewTextExtractor(reader *parser.Reader) *TextExtractor {
 //
 // Returns a slice of TextElements with position information, or error if extraction fails.
 func (te *TextExtractor) ExtractFromPage(pageNum int) ([]*TextElement, error) {
+	return te.ExtractFromPageContext(context.Background(), pageNum)
+}
+
+// ctxCheckInterval is the number of operators processed between checks of
+// the context in ExtractFromPageContext.
+const ctxCheckInterval = 256
+
+// ExtractFromPageContext is like ExtractFromPage but stops when ctx is done.
+//
+// The context is checked before the content stream is parsed and
+// periodically while its operators are processed; on cancellation the
+// context's error is returned.
+func (te *TextExtractor) ExtractFromPageContext(ctx context.Context, pageNum int) ([]*TextElement, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Reset state
 	te.elements = []*TextElement{}
 	te.textState = NewTextState()
 	te.fontDecoders = make(map[string]*FontDecoder)
+	te.markedContent = nil
 
 	// Get page
 	page, err := te.reader.GetPage(pageNum)
@@ -75,6 +95,10 @@ func (te *TextExtractor) ExtractFromPage(pageNum int) ([]*TextElement, error) {
 		return []*TextElement{}, nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Parse content stream operators
 	contentParser := NewContentParser(contentData)
 	operators, err := contentParser.ParseOperators()
@@ -83,7 +107,12 @@ func (te *TextExtractor) ExtractFromPage(pageNum int) ([]*TextElement, error) {
 	}
 
 	// Process operators to extract text
-	for _, op := range operators {
+	for i, op := range operators {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
 		te.processOperator(op)
 	}
 
@@ -264,6 +293,26 @@ func (te *TextExtractor) processOperator(op *Operator) {
 	case "ET": // End text
 		// Text object complete - nothing to do
 
+	// Marked content (Section 14.6)
+	case "BMC": // Begin marked content
+		te.markedContent = append(te.markedContent, te.currentMCID())
+
+	case "BDC": // Begin marked content with properties
+		mcid := te.currentMCID()
+		if len(op.Operands) >= 2 {
+			if props := te.markedContentProperties(op.Operands[1]); props != nil {
+				if id, ok := props.Get("MCID").(*parser.Integer); ok {
+					mcid = id.Int()
+				}
+			}
+		}
+		te.markedContent = append(te.markedContent, mcid)
+
+	case "EMC": // End marked content
+		if len(te.markedContent) > 0 {
+			te.markedContent = te.markedContent[:len(te.markedContent)-1]
+		}
+
 	// Text state operators (Section 9.3)
 	case "Tc": // Set character spacing
 		if len(op.Operands) >= 1 {
@@ -414,12 +463,42 @@ func (te *TextExtractor) addTextBytes(glyphBytes []byte) {
 
 	// Create text element with decoded text
 	elem := NewTextElement(decodedText, x, y, width, height, te.textState.FontName, te.textState.FontSize)
+	elem.MCID = te.currentMCID()
 	te.elements = append(te.elements, elem)
 
 	// Advance text position
 	te.textState.AdvanceX(width)
 }
 
+// currentMCID returns the MCID of the innermost open marked-content
+// sequence, or -1 if there is none.
+func (te *TextExtractor) currentMCID() int {
+	if len(te.markedContent) == 0 {
+		return -1
+	}
+	return te.markedContent[len(te.markedContent)-1]
+}
+
+// markedContentProperties returns the property list of a BDC operator:
+// an inline dictionary or a name in the page's /Properties resources.
+func (te *TextExtractor) markedContentProperties(obj parser.PdfObject) *parser.Dictionary {
+	switch v := obj.(type) {
+	case *parser.Dictionary:
+		return v
+	case *parser.Name:
+		if te.pageResources == nil {
+			return nil
+		}
+		props, ok := resolveObject(te.reader, te.pageResources.Get("Properties")).(*parser.Dictionary)
+		if !ok {
+			return nil
+		}
+		dict, _ := resolveObject(te.reader, props.Get(v.Value())).(*parser.Dictionary)
+		return dict
+	}
+	return nil
+}
+
 // processTextArray processes a TJ array with positioning adjustments.
 //
 // The TJ operator takes an array that can contain:
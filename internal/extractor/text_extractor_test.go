@@ -0,0 +1,76 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// cancelAfter is a context whose Err reports cancellation from the n-th call
+// on, simulating a cancellation while operators are processed.
+type cancelAfter struct {
+	context.Context
+	n     int
+	calls int
+}
+
+func (c *cancelAfter) Err() error {
+	c.calls++
+	if c.calls >= c.n {
+		return context.Canceled
+	}
+	return nil
+}
+
+// buildTextPDF creates a one-page PDF showing lines strings.
+func buildTextPDF(t *testing.T, lines int) string {
+	t.Helper()
+
+	var content strings.Builder
+	for i := 0; i < lines; i++ {
+		fmt.Fprintf(&content, "BT /F1 12 Tf 72 %d Td (Line %d) Tj ET\n", 700-i%600, i)
+	}
+	return writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	})
+}
+
+func TestTextExtractor_ExtractFromPageContext(t *testing.T) {
+	reader, err := parser.OpenPDF(buildTextPDF(t, 200))
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	elements, err := NewTextExtractor(reader).ExtractFromPageContext(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("ExtractFromPageContext() error = %v", err)
+	}
+	if len(elements) != 200 {
+		t.Errorf("elements = %d, want 200", len(elements))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewTextExtractor(reader).ExtractFromPageContext(ctx, 0); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled ExtractFromPageContext() error = %v, want context.Canceled", err)
+	}
+
+	// Cancel once operator processing has started (1000 operators: the
+	// checks before and after loading the page pass).
+	midway := &cancelAfter{Context: context.Background(), n: 4}
+	if _, err := NewTextExtractor(reader).ExtractFromPageContext(midway, 0); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExtractFromPageContext() canceled midway error = %v, want context.Canceled", err)
+	}
+	if midway.calls != 4 {
+		t.Errorf("context checked %d times, want 4", midway.calls)
+	}
+}
@@ -0,0 +1,186 @@
+package extractor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// TransparencyUse is a place where a PDF uses transparency.
+//
+// Reference: PDF 1.7 specification, Section 11 (Transparency).
+type TransparencyUse struct {
+	// Page is the 0-based page index.
+	Page int
+
+	// Kind is the kind of transparency:
+	//   - "Alpha": a graphics state with constant alpha (/CA or /ca) below 1
+	//   - "BlendMode": a graphics state with a blend mode other than Normal
+	//   - "SoftMask": a graphics state or image with a soft mask
+	//   - "Group": a page or form XObject transparency group
+	Kind string
+
+	// Resource is the resource path of the graphics state or XObject,
+	// e.g. "ExtGState/GS1" or "XObject/Fm1/XObject/Im2"; empty for the page
+	// group.
+	Resource string
+
+	// Detail describes the value found, e.g. "ca 0.5" or "BM Multiply".
+	Detail string
+}
+
+// DetectTransparency finds the uses of transparency in the document's
+// pages: graphics states with constant alpha below 1, blend modes or soft
+// masks, images with soft masks, and transparency groups. Form XObjects
+// are searched recursively.
+func DetectTransparency(reader *parser.Reader) ([]TransparencyUse, error) {
+	pageCount, err := reader.GetPageCount()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page count: %w", err)
+	}
+
+	d := &transparencyDetector{reader: reader}
+	for i := 0; i < pageCount; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get page %d: %w", i, err)
+		}
+		d.page = i
+		d.visited = make(map[int]bool)
+		d.checkGroup(page, "")
+		d.checkResources(page.Get("Resources"), "")
+	}
+
+	return d.uses, nil
+}
+
+// transparencyDetector collects transparency uses page by page.
+type transparencyDetector struct {
+	reader  *parser.Reader
+	uses    []TransparencyUse
+	page    int
+	visited map[int]bool // form XObjects searched on the current page
+}
+
+// report records a transparency use on the current page.
+func (d *transparencyDetector) report(kind, resource, detail string) {
+	d.uses = append(d.uses, TransparencyUse{Page: d.page, Kind: kind, Resource: resource, Detail: detail})
+}
+
+// checkGroup reports a transparency group of a page or form XObject.
+func (d *transparencyDetector) checkGroup(dict *parser.Dictionary, resource string) {
+	group, ok := resolveObject(d.reader, dict.Get("Group")).(*parser.Dictionary)
+	if !ok {
+		return
+	}
+	if s := group.GetName("S"); s != nil && s.Value() == "Transparency" {
+		d.report("Group", resource, "S Transparency")
+	}
+}
+
+// checkResources checks the graphics states and XObjects of a resource
+// dictionary; prefix is the resource path of its owner.
+func (d *transparencyDetector) checkResources(obj parser.PdfObject, prefix string) {
+	resources, ok := resolveObject(d.reader, obj).(*parser.Dictionary)
+	if !ok {
+		return
+	}
+
+	if states, ok := resolveObject(d.reader, resources.Get("ExtGState")).(*parser.Dictionary); ok {
+		for _, name := range states.Keys() {
+			if gs, ok := resolveObject(d.reader, states.Get(name)).(*parser.Dictionary); ok {
+				d.checkExtGState(gs, prefix+"ExtGState/"+name)
+			}
+		}
+	}
+
+	if xobjects, ok := resolveObject(d.reader, resources.Get("XObject")).(*parser.Dictionary); ok {
+		for _, name := range xobjects.Keys() {
+			d.checkXObject(xobjects.Get(name), prefix+"XObject/"+name)
+		}
+	}
+}
+
+// checkExtGState reports constant alpha, blend modes and soft masks of a
+// graphics state.
+func (d *transparencyDetector) checkExtGState(gs *parser.Dictionary, resource string) {
+	for _, key := range []string{"CA", "ca"} {
+		if alpha, ok := numberValue(gs.Get(key)); ok && alpha < 1 {
+			d.report("Alpha", resource, key+" "+strconv.FormatFloat(alpha, 'g', -1, 64))
+		}
+	}
+
+	if bm := blendModeName(resolveObject(d.reader, gs.Get("BM"))); bm != "" && bm != "Normal" && bm != "Compatible" {
+		d.report("BlendMode", resource, "BM "+bm)
+	}
+
+	switch mask := resolveObject(d.reader, gs.Get("SMask")).(type) {
+	case *parser.Name:
+		if mask.Value() != "None" {
+			d.report("SoftMask", resource, "SMask "+mask.Value())
+		}
+	case *parser.Dictionary:
+		d.report("SoftMask", resource, "SMask")
+	}
+}
+
+// checkXObject checks an image's soft mask, or a form XObject's group and
+// resources.
+func (d *transparencyDetector) checkXObject(obj parser.PdfObject, resource string) {
+	if ref, ok := obj.(*parser.IndirectReference); ok {
+		if d.visited[ref.Number] {
+			return
+		}
+		d.visited[ref.Number] = true
+	}
+	stream, ok := resolveObject(d.reader, obj).(*parser.Stream)
+	if !ok {
+		return
+	}
+	dict := stream.Dictionary()
+	subtype := dict.GetName("Subtype")
+	if subtype == nil {
+		return
+	}
+
+	switch subtype.Value() {
+	case "Image":
+		if dict.Get("SMask") != nil {
+			d.report("SoftMask", resource, "SMask")
+		}
+		if dict.GetInteger("SMaskInData") > 0 {
+			d.report("SoftMask", resource, "SMaskInData")
+		}
+	case "Form":
+		d.checkGroup(dict, resource)
+		d.checkResources(dict.Get("Resources"), resource+"/")
+	}
+}
+
+// numberValue returns the value of an integer or real object.
+func numberValue(obj parser.PdfObject) (float64, bool) {
+	switch v := obj.(type) {
+	case *parser.Integer:
+		return float64(v.Value()), true
+	case *parser.Real:
+		return v.Value(), true
+	}
+	return 0, false
+}
+
+// blendModeName returns the blend mode of a /BM value: a name, or the first
+// name of an array of alternatives.
+func blendModeName(obj parser.PdfObject) string {
+	switch v := obj.(type) {
+	case *parser.Name:
+		return v.Value()
+	case *parser.Array:
+		if v.Len() > 0 {
+			if name, ok := v.Get(0).(*parser.Name); ok {
+				return name.Value()
+			}
+		}
+	}
+	return ""
+}
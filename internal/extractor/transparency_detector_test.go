@@ -0,0 +1,67 @@
+package extractor
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+func TestDetectTransparency(t *testing.T) {
+	path := writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] " +
+			"/Resources << /ExtGState << /GS0 << /CA 1 /ca 1 /BM /Normal >> /GS1 << /ca 0.5 /BM /Multiply /SMask /None >> >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Group << /S /Transparency >> " +
+			"/Resources << /XObject << /Fm1 5 0 R >> >> >>",
+		"<< /Type /XObject /Subtype /Form /BBox [0 0 10 10] /Resources << /XObject << /Im1 6 0 R /Fm1 5 0 R >> >> /Length 0 >>\nstream\n\nendstream",
+		"<< /Type /XObject /Subtype /Image /Width 1 /Height 1 /BitsPerComponent 8 /ColorSpace /DeviceGray /SMask 7 0 R /Length 1 >>\nstream\n\x00\nendstream",
+		"<< /Type /XObject /Subtype /Image /Width 1 /Height 1 /BitsPerComponent 8 /ColorSpace /DeviceGray /Length 1 >>\nstream\n\x00\nendstream",
+	})
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	uses, err := DetectTransparency(reader)
+	if err != nil {
+		t.Fatalf("DetectTransparency() error = %v", err)
+	}
+
+	want := []TransparencyUse{
+		{Page: 0, Kind: "Alpha", Resource: "ExtGState/GS1", Detail: "ca 0.5"},
+		{Page: 0, Kind: "BlendMode", Resource: "ExtGState/GS1", Detail: "BM Multiply"},
+		{Page: 1, Kind: "Group", Resource: "", Detail: "S Transparency"},
+		{Page: 1, Kind: "SoftMask", Resource: "XObject/Fm1/XObject/Im1", Detail: "SMask"},
+	}
+	if len(uses) != len(want) {
+		t.Fatalf("uses = %+v, want %+v", uses, want)
+	}
+	for i, u := range uses {
+		if u != want[i] {
+			t.Errorf("use %d = %+v, want %+v", i, u, want[i])
+		}
+	}
+}
+
+func TestDetectTransparency_None(t *testing.T) {
+	path := writeTestPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /ExtGState << /GS0 << /LW 2 >> >> >> >>",
+	})
+	reader, err := parser.OpenPDF(path)
+	if err != nil {
+		t.Fatalf("OpenPDF() error = %v", err)
+	}
+	defer reader.Close()
+
+	uses, err := DetectTransparency(reader)
+	if err != nil {
+		t.Fatalf("DetectTransparency() error = %v", err)
+	}
+	if len(uses) != 0 {
+		t.Errorf("uses = %+v, want none", uses)
+	}
+}
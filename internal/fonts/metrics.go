@@ -23,17 +23,35 @@ type FontMetrics struct {
 	CharWidths map[rune]int
 }
 
+// widthAliases maps characters that WinAnsiEncoding draws with the glyph
+// of another character: no-break space uses "space" and soft hyphen uses
+// "hyphen" (PDF 1.7, Annex D.2, notes 6 and 7).
+var widthAliases = map[rune]rune{
+	0x00A0: ' ',
+	0x00AD: '-',
+}
+
 // GetCharWidth returns the width of a character in font units (1000 units = 1 em).
 // If the character is not found, returns the default width.
 func (m *FontMetrics) GetCharWidth(ch rune) int {
 	if w, ok := m.CharWidths[ch]; ok {
 		return w
 	}
+	if alias, ok := widthAliases[ch]; ok {
+		if w, ok := m.CharWidths[alias]; ok {
+			return w
+		}
+	}
 	return m.DefaultWidth
 }
 
 // MeasureString returns the width of a string in points at the given font size.
 // Formula: width_points = (sum of char widths) * size / 1000.
+//
+// Widths are the per-glyph advance widths from the Adobe AFM files.
+// Kerning is out of scope: AFM kerning pairs are not stored or applied,
+// and text is drawn without kerning adjustments, so the measured width
+// matches the drawn width.
 func (m *FontMetrics) MeasureString(text string, size float64) float64 {
 	var totalWidth int
 	for _, ch := range text {
@@ -3,6 +3,9 @@ package fonts
 import (
 	"math"
 	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
 )
 
 // TestGetCharWidthHelvetica tests character width retrieval for Helvetica.
@@ -340,3 +343,41 @@ func TestExtendedLatinCharacters(t *testing.T) {
 func floatEquals(a, b, tolerance float64) bool {
 	return math.Abs(a-b) <= tolerance
 }
+
+// TestWinAnsiCoverage tests that the text fonts have an AFM width for every
+// character in WinAnsiEncoding, so no character falls back to DefaultWidth.
+func TestWinAnsiCoverage(t *testing.T) {
+	textFonts := []string{
+		"Helvetica", "Helvetica-Bold", "Helvetica-Oblique", "Helvetica-BoldOblique",
+		"Times-Roman", "Times-Bold", "Times-Italic", "Times-BoldItalic",
+		"Courier", "Courier-Bold", "Courier-Oblique", "Courier-BoldOblique",
+	}
+
+	for _, name := range textFonts {
+		m := GetMetrics(name)
+		for b := 0x20; b <= 0xFF; b++ {
+			r := charmap.Windows1252.DecodeByte(byte(b))
+			if r == 0x7F || r == utf8.RuneError {
+				continue // Undefined in WinAnsiEncoding.
+			}
+			if _, ok := m.CharWidths[r]; !ok {
+				if _, ok := widthAliases[r]; !ok {
+					t.Errorf("%s: no width for %U", name, r)
+				}
+			}
+		}
+	}
+}
+
+// TestWidthAliases tests that no-break space and soft hyphen use the widths
+// of the glyphs WinAnsiEncoding draws them with.
+func TestWidthAliases(t *testing.T) {
+	m := TimesRoman.GetMetrics()
+
+	if got := m.GetCharWidth('\u00A0'); got != 250 {
+		t.Errorf("no-break space width = %d, want 250 (space)", got)
+	}
+	if got := m.GetCharWidth('\u00AD'); got != 333 {
+		t.Errorf("soft hyphen width = %d, want 333 (hyphen)", got)
+	}
+}
@@ -0,0 +1,133 @@
+package fonts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// ttcTag is the tag at the start of a TrueType Collection file.
+const ttcTag = "ttcf"
+
+// isCollection reports whether data is a TrueType Collection (.ttc).
+func isCollection(data []byte) bool {
+	return len(data) >= 4 && string(data[:4]) == ttcTag
+}
+
+// fontOffsets returns the offsets of the font directories in a font file.
+//
+// A single font has one directory at offset 0. A TrueType Collection
+// starts with a header listing the directory of each font:
+//   - tag (4 bytes): "ttcf"
+//   - majorVersion, minorVersion (2 bytes each)
+//   - numFonts (4 bytes)
+//   - offsetTable (4 bytes each): directory offset of each font
+//
+// Reference: OpenType specification, "Font Collections".
+func fontOffsets(data []byte) ([]uint32, error) {
+	if !isCollection(data) {
+		return []uint32{0}, nil
+	}
+
+	if len(data) < 12 {
+		return nil, fmt.Errorf("truncated collection header")
+	}
+	numFonts := binary.BigEndian.Uint32(data[8:12])
+	if numFonts == 0 {
+		return nil, fmt.Errorf("collection has no fonts")
+	}
+	if uint64(len(data)) < 12+uint64(numFonts)*4 {
+		return nil, fmt.Errorf("truncated collection header")
+	}
+
+	offsets := make([]uint32, numFonts)
+	for i := range offsets {
+		start := 12 + i*4
+		offsets[i] = binary.BigEndian.Uint32(data[start : start+4])
+	}
+
+	return offsets, nil
+}
+
+// buildFontFile builds a standalone font file from parsed tables.
+//
+// Fonts in a collection share tables, so their data cannot be embedded
+// as-is. The tables are written in tag order, each aligned to 4 bytes,
+// with fresh checksums and head.checkSumAdjustment.
+func buildFontFile(tables map[string]*TTFTable) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	// Copy head so the shared file data is not modified.
+	tableData := make(map[string][]byte, len(tables))
+	for _, tag := range tags {
+		data := tables[tag].Data
+		if tag == "head" && len(data) >= 12 {
+			data = append([]byte(nil), data...)
+			binary.BigEndian.PutUint32(data[8:12], 0) // checkSumAdjustment
+		}
+		tableData[tag] = data
+	}
+
+	//nolint:gosec // A font has far fewer than 65536 tables.
+	numTables := uint16(len(tags))
+	searchRange, entrySelector := uint16(1), uint16(0)
+	for searchRange*2 <= numTables {
+		searchRange *= 2
+		entrySelector++
+	}
+	searchRange *= 16
+
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.BigEndian, uint32(0x00010000))
+	_ = binary.Write(&buf, binary.BigEndian, numTables)
+	_ = binary.Write(&buf, binary.BigEndian, searchRange)
+	_ = binary.Write(&buf, binary.BigEndian, entrySelector)
+	_ = binary.Write(&buf, binary.BigEndian, numTables*16-searchRange)
+
+	offset := uint32(12 + 16*len(tags))
+	headOffset := uint32(0)
+	for _, tag := range tags {
+		data := tableData[tag]
+		if tag == "head" {
+			headOffset = offset
+		}
+		buf.WriteString(tag)
+		_ = binary.Write(&buf, binary.BigEndian, tableChecksum(data))
+		_ = binary.Write(&buf, binary.BigEndian, offset)
+		//nolint:gosec // Table length comes from a 32-bit field.
+		_ = binary.Write(&buf, binary.BigEndian, uint32(len(data)))
+		//nolint:gosec // Table length comes from a 32-bit field.
+		offset += (uint32(len(data)) + 3) &^ 3
+	}
+
+	for _, tag := range tags {
+		data := tableData[tag]
+		buf.Write(data)
+		buf.Write(make([]byte, (4-len(data)%4)%4))
+	}
+
+	file := buf.Bytes()
+	if _, ok := tableData["head"]; ok && len(tableData["head"]) >= 12 {
+		adjustment := 0xB1B0AFBA - tableChecksum(file)
+		binary.BigEndian.PutUint32(file[headOffset+8:headOffset+12], adjustment)
+	}
+
+	return file
+}
+
+// tableChecksum computes a font table checksum: the sum of the data as
+// big-endian uint32 values, zero-padded to a multiple of 4 bytes.
+func tableChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
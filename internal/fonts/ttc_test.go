@@ -0,0 +1,161 @@
+package fonts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// makeCollection builds a TrueType Collection from standalone font files.
+// Each font's table offsets are shifted to its position in the collection.
+func makeCollection(fonts ...[]byte) []byte {
+	header := 12 + 4*len(fonts)
+
+	var body bytes.Buffer
+	offsets := make([]uint32, len(fonts))
+	for i, font := range fonts {
+		//nolint:gosec // Test data is small.
+		start := uint32(header + body.Len())
+		offsets[i] = start
+
+		shifted := append([]byte(nil), font...)
+		numTables := int(binary.BigEndian.Uint16(shifted[4:6]))
+		for t := 0; t < numTables; t++ {
+			pos := 12 + t*16 + 8
+			offset := binary.BigEndian.Uint32(shifted[pos:])
+			binary.BigEndian.PutUint32(shifted[pos:], offset+start)
+		}
+		body.Write(shifted)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(ttcTag)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(1)) // majorVersion.
+	_ = binary.Write(&buf, binary.BigEndian, uint16(0)) // minorVersion.
+	//nolint:gosec // Test data is small.
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(fonts)))
+	for _, offset := range offsets {
+		_ = binary.Write(&buf, binary.BigEndian, offset)
+	}
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+func TestFontOffsets(t *testing.T) {
+	offsets, err := fontOffsets([]byte{0x00, 0x01, 0x00, 0x00})
+	if err != nil {
+		t.Fatalf("fontOffsets failed: %v", err)
+	}
+	if len(offsets) != 1 || offsets[0] != 0 {
+		t.Errorf("single font: got %v, want [0]", offsets)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(ttcTag)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(2))
+	_ = binary.Write(&buf, binary.BigEndian, uint16(0))
+	_ = binary.Write(&buf, binary.BigEndian, uint32(2))
+	_ = binary.Write(&buf, binary.BigEndian, uint32(32))
+	_ = binary.Write(&buf, binary.BigEndian, uint32(256))
+
+	offsets, err = fontOffsets(buf.Bytes())
+	if err != nil {
+		t.Fatalf("fontOffsets failed: %v", err)
+	}
+	if len(offsets) != 2 || offsets[0] != 32 || offsets[1] != 256 {
+		t.Errorf("collection: got %v, want [32 256]", offsets)
+	}
+
+	// Truncated offset table.
+	if _, err := fontOffsets(buf.Bytes()[:18]); err == nil {
+		t.Error("expected error for truncated collection header")
+	}
+}
+
+func TestBuildFontFile(t *testing.T) {
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head[8:12], 0x12345678) // stale checkSumAdjustment.
+	tables := map[string]*TTFTable{
+		"head": {Tag: "head", Data: head},
+		"cmap": {Tag: "cmap", Data: []byte{1, 2, 3, 4, 5}},
+		"OS/2": {Tag: "OS/2", Data: []byte{9, 9, 9}},
+	}
+
+	file := buildFontFile(tables)
+
+	font := &TTFFont{Tables: make(map[string]*TTFTable)}
+	if err := font.parseFontDirectory(bytes.NewReader(file)); err != nil {
+		t.Fatalf("parseFontDirectory failed: %v", err)
+	}
+	if err := font.loadTables(file); err != nil {
+		t.Fatalf("loadTables failed: %v", err)
+	}
+
+	for tag, table := range tables {
+		got, ok := font.Tables[tag]
+		if !ok {
+			t.Errorf("table %s missing", tag)
+			continue
+		}
+		if tag != "head" && !bytes.Equal(got.Data, table.Data) {
+			t.Errorf("table %s: got %v, want %v", tag, got.Data, table.Data)
+		}
+		if got.Offset%4 != 0 {
+			t.Errorf("table %s not 4-byte aligned: offset %d", tag, got.Offset)
+		}
+	}
+
+	// The whole file sums to the magic number once checkSumAdjustment is set.
+	if sum := tableChecksum(file); sum != 0xB1B0AFBA {
+		t.Errorf("file checksum = 0x%08X, want 0xB1B0AFBA", sum)
+	}
+
+	// The source table is not modified.
+	if binary.BigEndian.Uint32(head[8:12]) != 0x12345678 {
+		t.Error("buildFontFile modified the source head table")
+	}
+}
+
+func TestLoadTTFCollection(t *testing.T) {
+	data, err := os.ReadFile("C:/Windows/Fonts/arial.ttf")
+	if err != nil {
+		t.Skipf("test font not available: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "test.ttc")
+	if err := os.WriteFile(path, makeCollection(data, data), 0o600); err != nil {
+		t.Fatalf("write collection: %v", err)
+	}
+
+	fonts, err := LoadTTFCollection(path)
+	if err != nil {
+		t.Fatalf("LoadTTFCollection failed: %v", err)
+	}
+	if len(fonts) != 2 {
+		t.Fatalf("got %d fonts, want 2", len(fonts))
+	}
+
+	second, err := LoadTTFIndex(path, 1)
+	if err != nil {
+		t.Fatalf("LoadTTFIndex failed: %v", err)
+	}
+	if second.PostScriptName == "" || len(second.CharToGlyph) == 0 {
+		t.Error("font from collection not parsed")
+	}
+
+	// The embedded data must be a standalone font.
+	standalone := &TTFFont{
+		Tables:      make(map[string]*TTFTable),
+		GlyphWidths: make(map[uint16]uint16),
+		CharToGlyph: make(map[rune]uint16),
+	}
+	if err := standalone.parse(second.FontData, 0); err != nil {
+		t.Fatalf("FontData is not a standalone font: %v", err)
+	}
+
+	if _, err := LoadTTFIndex(path, 2); err == nil {
+		t.Error("expected error for out-of-range index")
+	}
+}
@@ -119,14 +119,69 @@ type TTFTable struct {
 //  4. Extracts glyph metrics
 //  5. Builds character-to-glyph mapping
 //
+// For a TrueType Collection (.ttc), the first font is loaded; use
+// LoadTTFIndex to select another one.
+//
 // Returns an error if the file is not a valid TTF/OTF font.
 func LoadTTF(path string) (*TTFFont, error) {
+	return LoadTTFIndex(path, 0)
+}
+
+// LoadTTFIndex loads the font at index from a font file.
+//
+// For a TrueType Collection (.ttc), index selects the font in the
+// collection. A single font file only has index 0.
+func LoadTTFIndex(path string, index int) (*TTFFont, error) {
+	//nolint:gosec // Font file path is provided by user, not arbitrary.
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read font file: %w", err)
+	}
+
+	offsets, err := fontOffsets(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse TTF: %w", err)
+	}
+	if index < 0 || index >= len(offsets) {
+		return nil, fmt.Errorf("font index %d out of range (file has %d fonts)", index, len(offsets))
+	}
+
+	return parseFontAt(path, data, offsets[index], isCollection(data))
+}
+
+// LoadTTFCollection loads all fonts from a font file.
+//
+// For a TrueType Collection (.ttc), the fonts are returned in collection
+// order. A single font file returns one font.
+func LoadTTFCollection(path string) ([]*TTFFont, error) {
 	//nolint:gosec // Font file path is provided by user, not arbitrary.
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("read font file: %w", err)
 	}
 
+	offsets, err := fontOffsets(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse TTF: %w", err)
+	}
+
+	fonts := make([]*TTFFont, 0, len(offsets))
+	for i, offset := range offsets {
+		font, err := parseFontAt(path, data, offset, isCollection(data))
+		if err != nil {
+			return nil, fmt.Errorf("font %d: %w", i, err)
+		}
+		fonts = append(fonts, font)
+	}
+
+	return fonts, nil
+}
+
+// parseFontAt parses the font whose directory starts at offset.
+//
+// Fonts from a collection share tables with other fonts, so their
+// FontData is rebuilt as a standalone font file for embedding.
+func parseFontAt(path string, data []byte, offset uint32, inCollection bool) (*TTFFont, error) {
 	font := &TTFFont{
 		FilePath:    path,
 		Tables:      make(map[string]*TTFTable),
@@ -135,16 +190,28 @@ func LoadTTF(path string) (*TTFFont, error) {
 		FontData:    data,
 	}
 
-	if err := font.parse(data); err != nil {
+	if err := font.parse(data, offset); err != nil {
 		return nil, fmt.Errorf("parse TTF: %w", err)
 	}
 
+	if inCollection {
+		font.FontData = buildFontFile(font.Tables)
+	}
+
 	return font, nil
 }
 
 // parse parses the font file structure.
-func (f *TTFFont) parse(data []byte) error {
-	r := bytes.NewReader(data)
+//
+// offset is the position of the font directory: 0 for a single font, or
+// the directory offset of one font in a collection. Table offsets are
+// always relative to the beginning of the file.
+func (f *TTFFont) parse(data []byte, offset uint32) error {
+	//nolint:gosec // len(data) from file size, typically < 2GB.
+	if offset >= uint32(len(data)) {
+		return fmt.Errorf("font directory offset out of bounds")
+	}
+	r := bytes.NewReader(data[offset:])
 
 	// Parse font directory.
 	if err := f.parseFontDirectory(r); err != nil {
@@ -0,0 +1,44 @@
+package fonts
+
+import (
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// EncodeWinAnsi encodes text in WinAnsiEncoding, the encoding used for the
+// standard 14 text fonts (all except Symbol and ZapfDingbats).
+//
+// The result is a byte string with one byte per character, suitable for a
+// PDF string operand. Characters outside WinAnsiEncoding (e.g. Cyrillic or
+// CJK) cannot be drawn with standard fonts and are replaced with '?';
+// use an embedded TrueType font for them.
+//
+// Example:
+//
+//	EncodeWinAnsi("Café") // "Caf\xe9"
+//
+// Reference: PDF 1.7 Spec, Annex D.2 (Latin Character Set and Encodings).
+func EncodeWinAnsi(text string) string {
+	var buf strings.Builder
+	buf.Grow(len(text))
+	for _, r := range text {
+		b, ok := charmap.Windows1252.EncodeRune(r)
+		if !ok {
+			b = '?'
+		}
+		buf.WriteByte(b)
+	}
+	return buf.String()
+}
+
+// CanEncodeWinAnsi reports whether every character in text is in
+// WinAnsiEncoding.
+func CanEncodeWinAnsi(text string) bool {
+	for _, r := range text {
+		if _, ok := charmap.Windows1252.EncodeRune(r); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package fonts
+
+import "testing"
+
+func TestEncodeWinAnsi(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"ASCII", "Hello", "Hello"},
+		{"Latin-1", "Café ñ ü", "Caf\xe9 \xf1 \xfc"},
+		{"WinAnsi extras", "€ – “ok” …", "\x80 \x96 \x93ok\x94 \x85"},
+		{"Not encodable", "Привет", "??????"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodeWinAnsi(tt.text); got != tt.want {
+				t.Errorf("EncodeWinAnsi(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanEncodeWinAnsi(t *testing.T) {
+	if !CanEncodeWinAnsi("Café €5") {
+		t.Error("CanEncodeWinAnsi(\"Café €5\") = false, want true")
+	}
+	if CanEncodeWinAnsi("Café 中") {
+		t.Error("CanEncodeWinAnsi(\"Café 中\") = true, want false")
+	}
+}
@@ -311,12 +311,15 @@ func (d *Dictionary) GetBoolean(key string) bool {
 	return false
 }
 
-// GetString is a convenience method to get a String value.
+// GetString is a convenience method to get a String value decoded as a
+// text string (UTF-16BE with BOM, UTF-8 with BOM, or PDFDocEncoding).
 // Returns empty string if key doesn't exist or value is not a String.
+//
+// Use Get and String.Bytes for binary strings such as IDs or encryption keys.
 func (d *Dictionary) GetString(key string) string {
 	obj := d.Get(key)
 	if s, ok := obj.(*String); ok {
-		return s.Value()
+		return s.Text()
 	}
 	return ""
 }
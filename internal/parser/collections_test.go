@@ -285,6 +285,20 @@ func TestDictionary_ConvenienceMethods(t *testing.T) {
 	assert.Equal(t, "Test", dict.GetString("Title"))
 }
 
+func TestDictionary_GetString_TextEncodings(t *testing.T) {
+	dict := NewDictionary()
+	dict.Set("UTF16", NewStringBytes([]byte{0xFE, 0xFF, 0x04, 0x1F, 0x04, 0x40, 0x04, 0x38}))
+	dict.Set("Hex", NewHexString("\xfe\xff\x65\xe5"))
+	dict.Set("DocEnc", NewStringBytes([]byte{'N', 0x92, ' ', 0x84, ' ', 'g', 'r', 0xFC, 0xDF}))
+	dict.Set("Name", NewName("NotAString"))
+
+	assert.Equal(t, "При", dict.GetString("UTF16"))
+	assert.Equal(t, "日", dict.GetString("Hex"))
+	assert.Equal(t, "N™ — grüß", dict.GetString("DocEnc"))
+	assert.Equal(t, "", dict.GetString("Name"))
+	assert.Equal(t, "", dict.GetString("Missing"))
+}
+
 func TestDictionary_Has(t *testing.T) {
 	dict := NewDictionary()
 	dict.Set("Type", NewName("Page"))
@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PdfDateToTime parses a PDF date string.
+//
+// Format: D:YYYYMMDDHHmmSSOHH'mm'
+//
+// Only the year is required; omitted fields default to the earliest value
+// (January 1st, 00:00:00). O is the relationship to UT: '+', '-' or 'Z'.
+// Dates without a time zone are interpreted as UTC. The "D:" prefix and
+// the apostrophes in the offset are optional, as many producers omit them.
+//
+// Example:
+//
+//	t, err := PdfDateToTime("D:20250127123045+03'00'")
+//
+// Reference: PDF 1.7 specification, Section 7.9.4 (Dates).
+func PdfDateToTime(s string) (time.Time, error) {
+	orig := s
+	s = strings.TrimPrefix(strings.TrimSpace(s), "D:")
+
+	// Split the local time digits from the time zone.
+	digits := s
+	zone := ""
+	if i := strings.IndexAny(s, "Zz+-"); i >= 0 {
+		digits, zone = s[:i], s[i:]
+	}
+
+	if len(digits) < 4 || len(digits) > 14 || len(digits)%2 != 0 {
+		return time.Time{}, fmt.Errorf("invalid PDF date %q", orig)
+	}
+
+	// Year, month, day, hour, minute, second.
+	fields := [6]int{0, 1, 1, 0, 0, 0}
+	limits := [6][2]int{{0, 9999}, {1, 12}, {1, 31}, {0, 23}, {0, 59}, {0, 59}}
+	for i, start := 0, 0; start < len(digits); i++ {
+		size := 2
+		if i == 0 {
+			size = 4
+		}
+		v, err := strconv.Atoi(digits[start : start+size])
+		if err != nil || v < limits[i][0] || v > limits[i][1] {
+			return time.Time{}, fmt.Errorf("invalid PDF date %q", orig)
+		}
+		fields[i] = v
+		start += size
+	}
+
+	loc, err := parsePdfDateZone(zone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid PDF date %q: %w", orig, err)
+	}
+
+	t := time.Date(fields[0], time.Month(fields[1]), fields[2], fields[3], fields[4], fields[5], 0, loc)
+	if t.Day() != fields[2] {
+		return time.Time{}, fmt.Errorf("invalid PDF date %q: day out of range", orig)
+	}
+	return t, nil
+}
+
+// parsePdfDateZone parses the time zone part of a PDF date: "Z", "+HH'mm'",
+// "-HH'mm", "+HH" or "" (UTC).
+func parsePdfDateZone(zone string) (*time.Location, error) {
+	if zone == "" {
+		return time.UTC, nil
+	}
+
+	sign := zone[0]
+	rest := strings.ReplaceAll(zone[1:], "'", "")
+	if sign == 'Z' || sign == 'z' {
+		// Some producers write Z00'00'; the offset is zero either way.
+		if strings.Trim(rest, "0") != "" {
+			return nil, fmt.Errorf("invalid time zone %q", zone)
+		}
+		return time.UTC, nil
+	}
+
+	if len(rest) != 2 && len(rest) != 4 {
+		return nil, fmt.Errorf("invalid time zone %q", zone)
+	}
+	hours, err := strconv.Atoi(rest[:2])
+	if err != nil || hours > 23 {
+		return nil, fmt.Errorf("invalid time zone %q", zone)
+	}
+	minutes := 0
+	if len(rest) == 4 {
+		minutes, err = strconv.Atoi(rest[2:])
+		if err != nil || minutes > 59 {
+			return nil, fmt.Errorf("invalid time zone %q", zone)
+		}
+	}
+
+	offset := hours*3600 + minutes*60
+	if sign == '-' {
+		offset = -offset
+	}
+	return time.FixedZone("", offset), nil
+}
+
+// TimeToPdfDate formats a time as a PDF date string.
+//
+// Format: D:YYYYMMDDHHmmSSOHH'mm'
+//
+// Example:
+//
+//	TimeToPdfDate(t) // "D:20250127123045+03'00'"
+//
+// Reference: PDF 1.7 specification, Section 7.9.4 (Dates).
+func TimeToPdfDate(t time.Time) string {
+	_, offset := t.Zone()
+
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	return fmt.Sprintf("D:%04d%02d%02d%02d%02d%02d%s%02d'%02d'",
+		t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(),
+		sign, offset/3600, (offset%3600)/60)
+}
@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPdfDateToTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   time.Time
+		offset int
+	}{
+		{"full with offset", "D:20250127123045+03'00'", time.Date(2025, 1, 27, 12, 30, 45, 0, time.FixedZone("", 3*3600)), 3 * 3600},
+		{"negative offset", "D:20250127123045-05'30'", time.Date(2025, 1, 27, 12, 30, 45, 0, time.FixedZone("", -(5*3600+30*60))), -(5*3600 + 30*60)},
+		{"utc", "D:20250127123045Z", time.Date(2025, 1, 27, 12, 30, 45, 0, time.UTC), 0},
+		{"utc with zero offset", "D:20250127123045Z00'00'", time.Date(2025, 1, 27, 12, 30, 45, 0, time.UTC), 0},
+		{"no apostrophes", "D:20250127123045+0300", time.Date(2025, 1, 27, 12, 30, 45, 0, time.FixedZone("", 3*3600)), 3 * 3600},
+		{"no trailing apostrophe", "D:20250127123045+03'00", time.Date(2025, 1, 27, 12, 30, 45, 0, time.FixedZone("", 3*3600)), 3 * 3600},
+		{"hours only offset", "D:20250127123045+03", time.Date(2025, 1, 27, 12, 30, 45, 0, time.FixedZone("", 3*3600)), 3 * 3600},
+		{"no prefix", "20250127123045", time.Date(2025, 1, 27, 12, 30, 45, 0, time.UTC), 0},
+		{"no zone", "D:20250127123045", time.Date(2025, 1, 27, 12, 30, 45, 0, time.UTC), 0},
+		{"year only", "D:2025", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+		{"year and month", "D:202503", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), 0},
+		{"date only", "D:20250127", time.Date(2025, 1, 27, 0, 0, 0, 0, time.UTC), 0},
+		{"leap day", "D:20240229", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PdfDateToTime(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v, want %v", got, tt.want)
+			_, offset := got.Zone()
+			assert.Equal(t, tt.offset, offset)
+		})
+	}
+}
+
+func TestPdfDateToTime_Invalid(t *testing.T) {
+	inputs := []string{
+		"",
+		"D:",
+		"D:25",
+		"D:2025012",
+		"D:2025132",
+		"D:20251301",
+		"D:20250132",
+		"D:20250230",
+		"D:20250127250000",
+		"D:20250127126000",
+		"D:2025012712304567",
+		"D:2025ab27",
+		"D:20250127123045+3",
+		"D:20250127123045+24'00'",
+		"D:20250127123045+03'75'",
+		"D:20250127123045Z01'00'",
+		"not a date",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			_, err := PdfDateToTime(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTimeToPdfDate(t *testing.T) {
+	tests := []struct {
+		name string
+		time time.Time
+		want string
+	}{
+		{"utc", time.Date(2025, 1, 27, 12, 30, 45, 0, time.UTC), "D:20250127123045+00'00'"},
+		{"positive offset", time.Date(2025, 1, 27, 12, 30, 45, 0, time.FixedZone("", 3*3600)), "D:20250127123045+03'00'"},
+		{"negative offset", time.Date(2025, 12, 1, 8, 5, 9, 0, time.FixedZone("", -(9*3600+30*60))), "D:20251201080509-09'30'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TimeToPdfDate(tt.time))
+		})
+	}
+}
+
+func TestPdfDate_RoundTrip(t *testing.T) {
+	want := time.Date(2025, 6, 15, 23, 59, 59, 0, time.FixedZone("", -(3*3600+30*60)))
+
+	got, err := PdfDateToTime(TimeToPdfDate(want))
+	require.NoError(t, err)
+	assert.True(t, want.Equal(got))
+	assert.Equal(t, TimeToPdfDate(want), TimeToPdfDate(got))
+}
@@ -0,0 +1,213 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/security"
+)
+
+// decryptor decrypts the strings and streams of objects read from a
+// document encrypted with the Standard Security Handler.
+//
+// Reference: PDF 1.7 specification, Section 7.6 (Encryption).
+type decryptor struct {
+	strings *security.Decryptor // for strings (/StrF)
+	streams *security.Decryptor // for stream data (/StmF)
+
+	// encryptNum is the object number of the encryption dictionary, whose
+	// strings are not encrypted.
+	encryptNum int
+
+	// encryptMetadata is false if metadata streams are stored unencrypted.
+	encryptMetadata bool
+}
+
+// OpenWithPassword opens an encrypted PDF file with its user or owner
+// password and decrypts the objects read from it (see Open).
+//
+// Documents encrypted with the Standard Security Handler are supported
+// with RC4 (40 to 128 bits), AES-128 and AES-256 (revisions 2 to 6). Open
+// uses the empty password, which opens documents without a user password.
+//
+// Returns an error matching ErrWrongPassword if password is neither the
+// user nor the owner password.
+func (r *Reader) OpenWithPassword(password string) error {
+	r.password = password
+	return r.Open()
+}
+
+// OpenPDFWithPassword opens an encrypted PDF file with its user or owner
+// password (see Reader.OpenWithPassword).
+func OpenPDFWithPassword(filename, password string) (*Reader, error) {
+	reader := NewReader(filename)
+	if err := reader.OpenWithPassword(password); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// checkPassword authenticates the reader's password if the document is
+// encrypted with the Standard Security Handler, and sets up decryption of
+// the objects read afterwards.
+//
+// Returns an *EncryptedError matching ErrPasswordRequired, and
+// ErrWrongPassword if a password was given, if the password is neither the
+// user nor the owner password. Documents using other
+// security handlers, unknown revisions or crypt filters are left
+// encrypted.
+func (r *Reader) checkPassword() error {
+	enc := r.Encryption()
+	if enc == nil || enc.Filter != "Standard" {
+		return nil
+	}
+	encryptRef := r.trailer.Get("Encrypt")
+	dict, err := r.resolveDictionary(encryptRef)
+	if err != nil {
+		return fmt.Errorf("failed to resolve encryption dictionary: %w", err)
+	}
+
+	params := &security.EncryptionDict{
+		Filter: enc.Filter,
+		V:      enc.V,
+		R:      enc.R,
+		Length: enc.Length,
+		P:      int32(dict.GetInteger("P")), //nolint:gosec // /P is a 32-bit flag set
+		O:      stringBytes(dict.Get("O")),
+		U:      stringBytes(dict.Get("U")),
+		OE:     stringBytes(dict.Get("OE")),
+		UE:     stringBytes(dict.Get("UE")),
+	}
+	if enc.V >= 4 {
+		params.CFM = cryptFilterMethod(dict, "StmF")
+	}
+	encryptMetadata := true
+	if b, ok := dict.Get("EncryptMetadata").(*Boolean); ok {
+		encryptMetadata = b.Value()
+	}
+	var fileID []byte
+	if ids := r.trailer.GetArray("ID"); ids != nil && ids.Len() > 0 {
+		fileID = stringBytes(ids.Get(0))
+	}
+
+	d, err := security.NewDecryptor(params, fileID, encryptMetadata, r.password)
+	if errors.Is(err, security.ErrInvalidPassword) {
+		enc.PasswordRequired = true
+		enc.WrongPassword = r.password != ""
+		return enc
+	}
+	if err != nil {
+		// Unsupported encryption is left to the operations that need the content.
+		return nil //nolint:nilerr // See above.
+	}
+
+	// Crypt filters (/V 4 and 5) may encrypt strings and streams with
+	// different methods, or leave either unencrypted (/Identity).
+	strs := d
+	if enc.V >= 4 {
+		if strs, err = d.WithMethod(cryptFilterMethod(dict, "StrF")); err != nil {
+			return nil //nolint:nilerr // Unsupported encryption, see above.
+		}
+	}
+
+	r.decryptor = &decryptor{strings: strs, streams: d, encryptMetadata: encryptMetadata}
+	if ref, ok := encryptRef.(*IndirectReference); ok {
+		r.decryptor.encryptNum = ref.Number
+	}
+
+	// Objects read to get here were not decrypted.
+	r.mu.Lock()
+	r.objectCache = make(map[int]PdfObject)
+	r.objStmCache = make(map[int]map[int]PdfObject)
+	r.mu.Unlock()
+	return nil
+}
+
+// cryptFilterMethod returns the method (/CFM) of the crypt filter named by
+// key (/StmF or /StrF) of an encryption dictionary with /V 4 or 5. An
+// absent key or the /Identity filter leaves the data unencrypted.
+func cryptFilterMethod(dict *Dictionary, key string) string {
+	name := dict.GetName(key)
+	if name == nil || name.Value() == "Identity" {
+		return security.MethodNone
+	}
+	filters := dict.GetDictionary("CF")
+	if filters == nil {
+		return security.MethodNone
+	}
+	filter := filters.GetDictionary(name.Value())
+	if filter == nil {
+		return security.MethodNone
+	}
+	if method := filter.GetName("CFM"); method != nil {
+		return method.Value()
+	}
+	return security.MethodNone
+}
+
+// decrypt decrypts the strings and stream data of an object read from the
+// file in place. It does nothing for documents that are not encrypted.
+func (r *Reader) decrypt(obj PdfObject, objNum, generation int) error {
+	if r.decryptor == nil || objNum == r.decryptor.encryptNum {
+		return nil
+	}
+	return r.decryptor.decryptObject(obj, objNum, generation)
+}
+
+// decryptObject decrypts obj and the objects it contains.
+func (d *decryptor) decryptObject(obj PdfObject, objNum, generation int) error {
+	switch o := obj.(type) {
+	case *String:
+		value, err := d.strings.Decrypt(objNum, generation, o.value)
+		if err != nil {
+			return err
+		}
+		o.value = value
+
+	case *Array:
+		for _, elem := range o.elements {
+			if err := d.decryptObject(elem, objNum, generation); err != nil {
+				return err
+			}
+		}
+
+	case *Dictionary:
+		// The /Contents of signature dictionaries are not encrypted.
+		signature := o.Has("ByteRange")
+		for _, key := range o.keys {
+			if signature && key == "Contents" {
+				continue
+			}
+			if err := d.decryptObject(o.entries[key], objNum, generation); err != nil {
+				return err
+			}
+		}
+
+	case *Stream:
+		// Cross-reference streams are not encrypted, nor metadata streams
+		// if /EncryptMetadata is false.
+		if typ := o.dict.GetName("Type"); typ != nil {
+			if typ.Value() == "XRef" || (typ.Value() == "Metadata" && !d.encryptMetadata) {
+				return nil
+			}
+		}
+		if err := d.decryptObject(o.dict, objNum, generation); err != nil {
+			return err
+		}
+		content, err := d.streams.Decrypt(objNum, generation, o.content)
+		if err != nil {
+			return err
+		}
+		o.content = content
+	}
+	return nil
+}
+
+// stringBytes returns the bytes of a string object, or nil for other
+// objects.
+func stringBytes(obj PdfObject) []byte {
+	if s, ok := obj.(*String); ok {
+		return s.Bytes()
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned (wrapped) by Reader. Use errors.Is to test for
+// them and errors.As to get the typed errors carrying details.
+var (
+	// ErrInvalidPDF is returned when the file is not a valid PDF.
+	ErrInvalidPDF = errors.New("invalid PDF file")
+
+	// ErrInvalidHeader is returned when the file has no valid %PDF-X.Y
+	// header (see HeaderError). It matches ErrInvalidPDF.
+	ErrInvalidHeader = fmt.Errorf("%w: invalid PDF header", ErrInvalidPDF)
+
+	// ErrMissingStartXRef is returned when the startxref keyword cannot be
+	// found. It matches ErrInvalidPDF.
+	ErrMissingStartXRef = fmt.Errorf("%w: startxref keyword not found", ErrInvalidPDF)
+
+	// ErrObjectNotFound is returned when an object is not in the
+	// cross-reference table or its object stream (see ObjectError).
+	ErrObjectNotFound = errors.New("object not found")
+
+	// ErrPageNotFound is returned when a page index is out of range.
+	ErrPageNotFound = errors.New("page not found")
+
+	// ErrEncrypted is returned by operations that do not support encrypted
+	// documents (see EncryptedError).
+	ErrEncrypted = errors.New("PDF is encrypted")
+
+	// ErrPasswordRequired is returned by Open for encrypted documents that
+	// cannot be opened without a password (see EncryptedError). It matches
+	// ErrEncrypted.
+	ErrPasswordRequired = fmt.Errorf("%w: password required", ErrEncrypted)
+
+	// ErrWrongPassword is returned by OpenWithPassword for a password that
+	// is neither the user nor the owner password. It matches
+	// ErrPasswordRequired.
+	ErrWrongPassword = fmt.Errorf("%w: wrong password", ErrPasswordRequired)
+)
+
+// HeaderError reports an invalid file header. It matches ErrInvalidHeader.
+type HeaderError struct {
+	// Header is the start of the file (up to 20 bytes) or the header line.
+	Header string
+
+	// Reason describes what is wrong with the header.
+	Reason string
+}
+
+func (e *HeaderError) Error() string {
+	return fmt.Sprintf("invalid PDF header: %q (%s)", e.Header, e.Reason)
+}
+
+// Unwrap returns ErrInvalidHeader.
+func (e *HeaderError) Unwrap() error {
+	return ErrInvalidHeader
+}
+
+// ObjectError reports an object that could not be found. It matches
+// ErrObjectNotFound.
+type ObjectError struct {
+	// Number is the object number.
+	Number int
+
+	// Reason describes why the object is missing, e.g. "not found in xref
+	// table" or "is free (deleted)".
+	Reason string
+}
+
+func (e *ObjectError) Error() string {
+	return fmt.Sprintf("object %d %s", e.Number, e.Reason)
+}
+
+// Unwrap returns ErrObjectNotFound.
+func (e *ObjectError) Unwrap() error {
+	return ErrObjectNotFound
+}
+
+// EncryptedError reports an encrypted document with the parameters of its
+// encryption dictionary. It matches ErrEncrypted, and ErrPasswordRequired
+// if PasswordRequired is set.
+type EncryptedError struct {
+	// Filter is the security handler name, usually "Standard".
+	Filter string
+
+	// V is the encryption algorithm version (/V).
+	V int
+
+	// R is the standard security handler revision (/R), or 0.
+	R int
+
+	// Length is the key length in bits (/Length), or 0 if unspecified.
+	Length int
+
+	// PasswordRequired reports that the document has a user password, so
+	// it cannot be opened with the empty password.
+	PasswordRequired bool
+
+	// WrongPassword reports that the password given to OpenWithPassword is
+	// neither the user nor the owner password. PasswordRequired is set
+	// too.
+	WrongPassword bool
+}
+
+func (e *EncryptedError) Error() string {
+	if e.WrongPassword {
+		return fmt.Sprintf("PDF is encrypted and the password is wrong (filter %s, V %d, R %d)", e.Filter, e.V, e.R)
+	}
+	if e.PasswordRequired {
+		return fmt.Sprintf("PDF is encrypted and requires a password (filter %s, V %d, R %d)", e.Filter, e.V, e.R)
+	}
+	return fmt.Sprintf("PDF is encrypted (filter %s, V %d, R %d)", e.Filter, e.V, e.R)
+}
+
+// Unwrap returns ErrWrongPassword, ErrPasswordRequired or ErrEncrypted.
+func (e *EncryptedError) Unwrap() error {
+	if e.WrongPassword {
+		return ErrWrongPassword
+	}
+	if e.PasswordRequired {
+		return ErrPasswordRequired
+	}
+	return ErrEncrypted
+}
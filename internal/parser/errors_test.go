@@ -0,0 +1,263 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors_Matching(t *testing.T) {
+	assert.ErrorIs(t, ErrInvalidHeader, ErrInvalidPDF)
+	assert.ErrorIs(t, ErrMissingStartXRef, ErrInvalidPDF)
+	assert.NotErrorIs(t, ErrObjectNotFound, ErrInvalidPDF)
+
+	wrapped := fmt.Errorf("loading: %w", &EncryptedError{Filter: "Standard", V: 2, R: 3, Length: 128})
+	assert.ErrorIs(t, wrapped, ErrEncrypted)
+	var encErr *EncryptedError
+	require.ErrorAs(t, wrapped, &encErr)
+	assert.Equal(t, 3, encErr.R)
+	assert.Equal(t, "PDF is encrypted (filter Standard, V 2, R 3)", encErr.Error())
+
+	objErr := &ObjectError{Number: 7, Reason: "is free (deleted)"}
+	assert.Equal(t, "object 7 is free (deleted)", objErr.Error())
+	assert.ErrorIs(t, objErr, ErrObjectNotFound)
+}
+
+// writeEncryptedPDF writes an empty document whose trailer refers to an RC4
+// encryption dictionary for the given passwords. Only the dictionary is
+// needed to detect encryption; the document has no encrypted strings.
+func writeEncryptedPDF(t *testing.T, userPassword, ownerPassword string) string {
+	t.Helper()
+
+	const fileID = "0123456789abcdef"
+	enc, err := security.NewRC4Encryptor(&security.EncryptionConfig{
+		UserPassword:  userPassword,
+		OwnerPassword: ownerPassword,
+		KeyLength:     128,
+		FileID:        fileID,
+	})
+	require.NoError(t, err)
+	dict := enc.GetEncryptionDict()
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [] /Count 0 >>",
+		fmt.Sprintf("<< /Filter /Standard /V %d /R %d /Length %d /O <%x> /U <%x> /P %d >>",
+			dict.V, dict.R, dict.Length, dict.O, dict.U, dict.P),
+	}
+	var b strings.Builder
+	b.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R /Encrypt 3 0 R /ID [<%x> <%x>] >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, fileID, fileID, xref)
+
+	path := filepath.Join(t.TempDir(), "encrypted.pdf")
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o600))
+	return path
+}
+
+func TestReader_Encryption(t *testing.T) {
+	plain, err := OpenPDF(getTestFilePath(minimalPDF))
+	require.NoError(t, err)
+	defer plain.Close()
+	assert.Nil(t, plain.Encryption())
+
+	// Owner password only: opens with the empty user password.
+	reader, err := OpenPDF(writeEncryptedPDF(t, "", "owner"))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	enc := reader.Encryption()
+	require.NotNil(t, enc)
+	assert.Equal(t, EncryptedError{Filter: "Standard", V: 2, R: 3, Length: 128}, *enc)
+}
+
+func TestReader_Open_PasswordRequired(t *testing.T) {
+	_, err := OpenPDF(writeEncryptedPDF(t, "user", "owner"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrPasswordRequired)
+	assert.ErrorIs(t, err, ErrEncrypted)
+	assert.NotErrorIs(t, err, ErrInvalidPDF)
+
+	var encErr *EncryptedError
+	require.ErrorAs(t, err, &encErr)
+	assert.True(t, encErr.PasswordRequired)
+	assert.Equal(t, 3, encErr.R)
+}
+
+// writeEncryptedContentPDF writes a one-page document encrypted with RC4
+// for the given passwords, whose Info title and page content are encrypted.
+func writeEncryptedContentPDF(t *testing.T, keyLength int, userPassword, ownerPassword string) string {
+	t.Helper()
+
+	const fileID = "0123456789abcdef"
+	enc, err := security.NewRC4Encryptor(&security.EncryptionConfig{
+		UserPassword:  userPassword,
+		OwnerPassword: ownerPassword,
+		KeyLength:     keyLength,
+		FileID:        fileID,
+	})
+	require.NoError(t, err)
+	dict := enc.GetEncryptionDict()
+
+	// RC4 is symmetric: decrypting encrypts.
+	d, err := security.NewDecryptor(dict, []byte(fileID), true, userPassword)
+	require.NoError(t, err)
+	encrypt := func(num int, data string) []byte {
+		encrypted, err := d.Decrypt(num, 0, []byte(data))
+		require.NoError(t, err)
+		return encrypted
+	}
+
+	content := encrypt(4, "BT /F1 12 Tf 72 720 Td (Secret balance) Tj ET")
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R " +
+			"/Resources << /Font << /F1 << /Type /Font /Subtype /Type1 /BaseFont /Helvetica >> >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		fmt.Sprintf("<< /Title <%x> >>", encrypt(5, "Statement")),
+		fmt.Sprintf("<< /Filter /Standard /V %d /R %d /Length %d /O <%x> /U <%x> /P %d >>",
+			dict.V, dict.R, dict.Length, dict.O, dict.U, dict.P),
+	}
+	return writeEncryptedObjects(t, objects, fileID)
+}
+
+// writeEncryptedObjects writes a PDF holding objects, numbered from 1, to
+// a temporary file and returns its path. Objects 5 and 6 are the Info
+// dictionary and the encryption dictionary.
+func writeEncryptedObjects(t *testing.T, objects []string, fileID string) string {
+	t.Helper()
+
+	var b strings.Builder
+	b.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R /Encrypt 6 0 R /ID [<%x> <%x>] >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, fileID, fileID, xref)
+
+	path := filepath.Join(t.TempDir(), "encrypted.pdf")
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o600))
+	return path
+}
+
+// writeCryptFilterPDF writes a one-page document encrypted with RC4 crypt
+// filters (/V 4) whose strings use the filter strF and streams the filter
+// stmF, each /StdCF or /Identity.
+func writeCryptFilterPDF(t *testing.T, strF, stmF string) string {
+	t.Helper()
+
+	const fileID = "0123456789abcdef"
+	enc, err := security.NewRC4Encryptor(&security.EncryptionConfig{
+		UserPassword: "user",
+		KeyLength:    128,
+		FileID:       fileID,
+	})
+	require.NoError(t, err)
+	dict := *enc.GetEncryptionDict()
+	dict.V, dict.R = 4, 4 // Revision 4 derives the key like revision 3.
+
+	d, err := security.NewDecryptor(&dict, []byte(fileID), true, "user")
+	require.NoError(t, err)
+	encrypt := func(filter string, num int, data string) []byte {
+		if filter == "Identity" {
+			return []byte(data)
+		}
+		encrypted, err := d.Decrypt(num, 0, []byte(data))
+		require.NoError(t, err)
+		return encrypted
+	}
+
+	content := encrypt(stmF, 4, "BT /F1 12 Tf 72 720 Td (Secret balance) Tj ET")
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		fmt.Sprintf("<< /Title <%x> >>", encrypt(strF, 5, "Statement")),
+		fmt.Sprintf("<< /Filter /Standard /V 4 /R 4 /Length 128 /O <%x> /U <%x> /P %d "+
+			"/CF << /StdCF << /CFM /V2 /Length 16 >> >> /StrF /%s /StmF /%s >>",
+			dict.O, dict.U, dict.P, strF, stmF),
+	}
+	return writeEncryptedObjects(t, objects, fileID)
+}
+
+func TestReader_OpenWithPassword_CryptFilters(t *testing.T) {
+	for _, filters := range [][2]string{
+		{"StdCF", "StdCF"},
+		{"Identity", "StdCF"},
+		{"StdCF", "Identity"},
+	} {
+		strF, stmF := filters[0], filters[1]
+		t.Run("StrF "+strF+" StmF "+stmF, func(t *testing.T) {
+			reader, err := OpenPDFWithPassword(writeCryptFilterPDF(t, strF, stmF), "user")
+			require.NoError(t, err)
+			defer reader.Close()
+
+			assert.Equal(t, "Statement", reader.GetDocumentInfo().Title)
+
+			page, err := reader.GetPage(0)
+			require.NoError(t, err)
+			content, err := reader.GetObject(page.Get("Contents").(*IndirectReference).Number)
+			require.NoError(t, err)
+			assert.Contains(t, string(content.(*Stream).Content()), "(Secret balance) Tj")
+		})
+	}
+}
+
+func TestReader_OpenWithPassword(t *testing.T) {
+	for _, keyLength := range []int{40, 128} {
+		path := writeEncryptedContentPDF(t, keyLength, "user", "owner")
+
+		for _, password := range []string{"user", "owner"} {
+			t.Run(fmt.Sprintf("%d-bit %s", keyLength, password), func(t *testing.T) {
+				reader, err := OpenPDFWithPassword(path, password)
+				require.NoError(t, err)
+				defer reader.Close()
+
+				assert.Equal(t, "Statement", reader.GetDocumentInfo().Title)
+
+				page, err := reader.GetPage(0)
+				require.NoError(t, err)
+				content, err := reader.GetObject(page.Get("Contents").(*IndirectReference).Number)
+				require.NoError(t, err)
+				assert.Contains(t, string(content.(*Stream).Content()), "(Secret balance) Tj")
+			})
+		}
+
+		_, err := OpenPDFWithPassword(path, "wrong")
+		assert.ErrorIs(t, err, ErrWrongPassword)
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+	}
+}
+
+func TestReader_Open_DecryptsWithoutUserPassword(t *testing.T) {
+	reader, err := OpenPDF(writeEncryptedContentPDF(t, 128, "", "owner"))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.Equal(t, "Statement", reader.GetDocumentInfo().Title)
+}
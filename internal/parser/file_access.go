@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// fileAccess is the random access to a PDF file used by Reader.
+//
+// Reader serializes access with its fileMu, so implementations need not be
+// safe for concurrent use.
+type fileAccess interface {
+	io.ReadSeekCloser
+
+	// Size returns the file size in bytes.
+	Size() int64
+}
+
+// osFile reads a file through seek and read system calls.
+type osFile struct {
+	*os.File
+	size int64
+}
+
+// openOSFile opens a file for seek-and-read access.
+func openOSFile(filename string) (fileAccess, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	return &osFile{File: file, size: info.Size()}, nil
+}
+
+// Size returns the file size in bytes.
+func (f *osFile) Size() int64 {
+	return f.size
+}
+
+// sectionFile reads a PDF from an io.ReaderAt, such as a byte slice in
+// memory or an object in remote storage.
+type sectionFile struct {
+	*io.SectionReader
+}
+
+// Close does nothing: the io.ReaderAt belongs to the caller.
+func (f sectionFile) Close() error {
+	return nil
+}
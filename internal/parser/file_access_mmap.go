@@ -0,0 +1,65 @@
+//go:build unix
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// MemoryMapSupported reports whether memory-mapped readers map the file on
+// this platform.
+const MemoryMapSupported = true
+
+// mappedFile serves reads from a read-only memory mapping of a file, so
+// random access costs no system calls once the pages are resident.
+type mappedFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+// openMappedFile maps a file into memory for reading.
+func openMappedFile(filename string) (fileAccess, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	size := info.Size()
+	if size == 0 {
+		// Empty files cannot be mapped.
+		return &mappedFile{Reader: bytes.NewReader(nil)}, nil
+	}
+	if int64(int(size)) != size {
+		return nil, fmt.Errorf("file too large to map: %d bytes", size)
+	}
+
+	data, err := syscall.Mmap(int(file.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map file: %w", err)
+	}
+	return &mappedFile{Reader: bytes.NewReader(data), data: data}, nil
+}
+
+// Size returns the file size in bytes.
+func (f *mappedFile) Size() int64 {
+	return f.Reader.Size()
+}
+
+// Close unmaps the file.
+func (f *mappedFile) Close() error {
+	if f.data == nil {
+		return nil
+	}
+	data := f.data
+	f.data = nil
+	f.Reader = bytes.NewReader(nil)
+	return syscall.Munmap(data)
+}
@@ -0,0 +1,13 @@
+//go:build !unix
+
+package parser
+
+// MemoryMapSupported reports whether memory-mapped readers map the file on
+// this platform.
+const MemoryMapSupported = false
+
+// openMappedFile falls back to seek-and-read access on platforms without
+// memory mapping support.
+func openMappedFile(filename string) (fileAccess, error) {
+	return openOSFile(filename)
+}
@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMappedReader_MatchesReader tests that memory-mapped access yields the
+// same objects as regular file access.
+func TestMappedReader_MatchesReader(t *testing.T) {
+	for _, name := range []string{minimalPDF, multipagePDF, nestedPagesPDF} {
+		t.Run(name, func(t *testing.T) {
+			regular, err := OpenPDF(getTestFilePath(name))
+			require.NoError(t, err)
+			defer regular.Close()
+
+			mapped, err := OpenPDFMapped(getTestFilePath(name))
+			require.NoError(t, err)
+			defer mapped.Close()
+
+			assert.Equal(t, regular.Version(), mapped.Version())
+			assert.Equal(t, regular.file.Size(), mapped.file.Size())
+			for _, entry := range regular.xrefTable.GetInUseEntries() {
+				num := entry.ObjectNum
+				want, err := regular.GetObject(num)
+				if err != nil {
+					continue
+				}
+				got, err := mapped.GetObject(num)
+				require.NoError(t, err, "object %d", num)
+				assert.Equal(t, want.String(), got.String(), "object %d", num)
+			}
+		})
+	}
+}
+
+// TestMappedReader_Close tests that closing a mapped reader releases the
+// mapping and is idempotent.
+func TestMappedReader_Close(t *testing.T) {
+	reader, err := OpenPDFMapped(getTestFilePath(minimalPDF))
+	require.NoError(t, err)
+
+	require.NoError(t, reader.Close())
+	assert.Nil(t, reader.file)
+	assert.NoError(t, reader.Close())
+}
+
+// TestMappedReader_EmptyFile tests that an empty file is rejected like with
+// regular access.
+func TestMappedReader_EmptyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.pdf")
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	_, err := OpenPDFMapped(path)
+	assert.Error(t, err)
+}
+
+// TestReaderFrom_MatchesReader tests that reading from an io.ReaderAt
+// yields the same objects as regular file access.
+func TestReaderFrom_MatchesReader(t *testing.T) {
+	for _, name := range []string{minimalPDF, multipagePDF, nestedPagesPDF} {
+		t.Run(name, func(t *testing.T) {
+			regular, err := OpenPDF(getTestFilePath(name))
+			require.NoError(t, err)
+			defer regular.Close()
+
+			data, err := os.ReadFile(getTestFilePath(name))
+			require.NoError(t, err)
+			inMemory, err := OpenPDFFrom(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			defer inMemory.Close()
+
+			assert.Equal(t, regular.Version(), inMemory.Version())
+			for _, entry := range regular.xrefTable.GetInUseEntries() {
+				num := entry.ObjectNum
+				want, err := regular.GetObject(num)
+				if err != nil {
+					continue
+				}
+				got, err := inMemory.GetObject(num)
+				require.NoError(t, err, "object %d", num)
+				assert.Equal(t, want.String(), got.String(), "object %d", num)
+			}
+		})
+	}
+}
+
+// TestReaderFrom_Invalid tests that empty and non-PDF data are rejected.
+func TestReaderFrom_Invalid(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte("not a pdf")} {
+		_, err := OpenPDFFrom(bytes.NewReader(data), int64(len(data)))
+		assert.Error(t, err)
+	}
+}
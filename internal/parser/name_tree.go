@@ -0,0 +1,142 @@
+package parser
+
+import "fmt"
+
+// maxTreeDepth limits the depth of name and number trees, protecting against
+// malformed files with deeply nested /Kids.
+const maxTreeDepth = 64
+
+// NameTreeEntry is a key-value pair of a name tree.
+type NameTreeEntry struct {
+	// Name is the key, decoded as a text string.
+	Name string
+
+	// Value is the value, not resolved if it is an indirect reference.
+	Value PdfObject
+}
+
+// NumberTreeEntry is a key-value pair of a number tree.
+type NumberTreeEntry struct {
+	// Number is the key.
+	Number int64
+
+	// Value is the value, not resolved if it is an indirect reference.
+	Value PdfObject
+}
+
+// ParseNameTree returns the entries of the name tree rooted at root, in
+// tree order (sorted by key in well-formed files).
+//
+// Name trees map strings to objects, e.g. named destinations, embedded
+// files and document-level JavaScript. Malformed leaf entries are skipped;
+// unresolvable nodes and reference cycles are errors.
+//
+// Reference: PDF 1.7 specification, Section 7.9.6 (Name Trees).
+func (r *Reader) ParseNameTree(root PdfObject) ([]NameTreeEntry, error) {
+	var entries []NameTreeEntry
+	err := r.walkTree(root, "Names", func(key, value PdfObject) {
+		if s, ok := key.(*String); ok {
+			entries = append(entries, NameTreeEntry{Name: s.Text(), Value: value})
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("name tree: %w", err)
+	}
+	return entries, nil
+}
+
+// ParseNumberTree returns the entries of the number tree rooted at root, in
+// tree order (sorted by key in well-formed files).
+//
+// Number trees map integers to objects, e.g. page labels and the structure
+// parent tree. Malformed leaf entries are skipped; unresolvable nodes and
+// reference cycles are errors.
+//
+// Reference: PDF 1.7 specification, Section 7.9.7 (Number Trees).
+func (r *Reader) ParseNumberTree(root PdfObject) ([]NumberTreeEntry, error) {
+	var entries []NumberTreeEntry
+	err := r.walkTree(root, "Nums", func(key, value PdfObject) {
+		if n, ok := key.(*Integer); ok {
+			entries = append(entries, NumberTreeEntry{Number: n.Value(), Value: value})
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("number tree: %w", err)
+	}
+	return entries, nil
+}
+
+// GetNameTree returns the entries of a name tree of the catalog's /Names
+// dictionary, such as "Dests", "EmbeddedFiles" or "JavaScript".
+//
+// Returns nil if the document has no such tree.
+//
+// Reference: PDF 1.7 specification, Section 7.7.4 (Name Dictionary).
+func (r *Reader) GetNameTree(category string) ([]NameTreeEntry, error) {
+	if r.catalog == nil {
+		return nil, fmt.Errorf("catalog not loaded (call Open first)")
+	}
+
+	namesObj := r.catalog.Get("Names")
+	if namesObj == nil {
+		return nil, nil
+	}
+	names, err := r.resolveDictionary(namesObj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve /Names: %w", err)
+	}
+
+	root := names.Get(category)
+	if root == nil {
+		return nil, nil
+	}
+	return r.ParseNameTree(root)
+}
+
+// walkTree calls fn for each key-value pair in the leaves of a name or
+// number tree; leafKey is "Names" or "Nums".
+func (r *Reader) walkTree(root PdfObject, leafKey string, fn func(key, value PdfObject)) error {
+	visited := make(map[int]bool)
+
+	var walk func(obj PdfObject, depth int) error
+	walk = func(obj PdfObject, depth int) error {
+		if depth > maxTreeDepth {
+			return fmt.Errorf("tree deeper than %d levels", maxTreeDepth)
+		}
+		if ref, ok := obj.(*IndirectReference); ok {
+			if visited[ref.Number] {
+				return fmt.Errorf("cycle at object %d", ref.Number)
+			}
+			visited[ref.Number] = true
+		}
+		node, err := r.resolveDictionary(obj)
+		if err != nil {
+			return fmt.Errorf("failed to resolve node: %w", err)
+		}
+
+		if leaf := node.Get(leafKey); leaf != nil {
+			pairs, err := r.resolveArray(leaf)
+			if err != nil {
+				return fmt.Errorf("failed to resolve /%s: %w", leafKey, err)
+			}
+			for i := 0; i+1 < pairs.Len(); i += 2 {
+				fn(r.resolveReferences(pairs.Get(i)), pairs.Get(i+1))
+			}
+		}
+
+		if kidsObj := node.Get("Kids"); kidsObj != nil {
+			kids, err := r.resolveArray(kidsObj)
+			if err != nil {
+				return fmt.Errorf("failed to resolve /Kids: %w", err)
+			}
+			for i := 0; i < kids.Len(); i++ {
+				if err := walk(kids.Get(i), depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	return walk(root, 0)
+}
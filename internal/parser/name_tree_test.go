@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openObjectsPDF writes objects numbered from 1 (the first being the
+// catalog) to a PDF with a valid cross-reference table and opens it.
+func openObjectsPDF(t *testing.T, objects []string) *Reader {
+	t.Helper()
+
+	var b strings.Builder
+	b.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	path := filepath.Join(t.TempDir(), "tree.pdf")
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o600))
+	reader, err := OpenPDF(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { reader.Close() })
+	return reader
+}
+
+// treeTestObjects are a document with a two-level JavaScript name tree, a
+// flat EmbeddedFiles name tree and a page label number tree.
+var treeTestObjects = []string{
+	"<< /Type /Catalog /Pages 2 0 R /Names 4 0 R " +
+		"/PageLabels << /Kids [10 0 R] >> >>",
+	"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+	"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 100 100] >>",
+	"<< /JavaScript 5 0 R /EmbeddedFiles << /Names [(data.csv) 8 0 R] >> >>",
+	"<< /Kids [6 0 R 7 0 R] >>",
+	"<< /Limits [(a) (b)] /Names [(a) << /S /JavaScript /JS (one) >> (b) 9 0 R] >>",
+	"<< /Limits [(c) (c)] /Names [(c) << /S /JavaScript /JS (three) >>] >>",
+	"<< /Type /Filespec /F (data.csv) >>",
+	"<< /S /JavaScript /JS (two) >>",
+	"<< /Limits [0 4] /Nums [0 << /S /r >> 4 << /S /D /St 1 >>] >>",
+}
+
+func TestReader_ParseNameTree(t *testing.T) {
+	reader := openObjectsPDF(t, treeTestObjects)
+
+	entries, err := reader.GetNameTree("JavaScript")
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	ref, ok := entries[1].Value.(*IndirectReference)
+	require.True(t, ok, "values should not be resolved")
+	assert.Equal(t, 9, ref.Number)
+
+	files, err := reader.GetNameTree("EmbeddedFiles")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "data.csv", files[0].Name)
+
+	dests, err := reader.GetNameTree("Dests")
+	require.NoError(t, err)
+	assert.Nil(t, dests)
+}
+
+func TestReader_ParseNumberTree(t *testing.T) {
+	reader := openObjectsPDF(t, treeTestObjects)
+	catalog, err := reader.GetCatalog()
+	require.NoError(t, err)
+
+	entries, err := reader.ParseNumberTree(catalog.Get("PageLabels"))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(0), entries[0].Number)
+	assert.Equal(t, int64(4), entries[1].Number)
+
+	label, ok := entries[1].Value.(*Dictionary)
+	require.True(t, ok)
+	assert.Equal(t, "D", label.GetName("S").Value())
+}
+
+func TestReader_ParseNameTree_Cycle(t *testing.T) {
+	reader := openObjectsPDF(t, []string{
+		"<< /Type /Catalog /Pages 2 0 R /Names << /Dests 4 0 R >> >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 100 100] >>",
+		"<< /Kids [5 0 R] >>",
+		"<< /Kids [4 0 R] >>",
+	})
+
+	_, err := reader.GetNameTree("Dests")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cycle")
+}
+
+func TestReader_GetNameTree_NotOpened(t *testing.T) {
+	reader := NewReader("test.pdf")
+	_, err := reader.GetNameTree("Dests")
+	require.Error(t, err)
+}
@@ -125,6 +125,10 @@ func Clone(obj PdfObject) PdfObject {
 		return o.Clone()
 	case *Dictionary:
 		return o.Clone()
+	case *Stream:
+		return o.Clone()
+	case *IndirectReference:
+		return o.Clone()
 	default:
 		// For unknown types, return nil
 		return nil
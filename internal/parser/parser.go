@@ -20,6 +20,10 @@ type Parser struct {
 	current Token
 	peek    Token
 	hasPeek bool
+
+	// ignoreLength makes stream parsing scan for 'endstream' instead of
+	// trusting /Length (used when repairing damaged files).
+	ignoreLength bool
 }
 
 // NewParser creates a new parser that reads from the given reader.
@@ -327,17 +331,6 @@ func (p *Parser) parseStreamContent(dict *Dictionary) (*Stream, error) {
 			p.current.Type, p.current.Line, p.current.Column)
 	}
 
-	// Get stream length from dictionary
-	length := dict.GetInteger("Length")
-	if length <= 0 {
-		// If length is not set or invalid, we need to scan for 'endstream'
-		// This is a fallback for malformed PDFs
-		return p.parseStreamUntilEndstream(dict)
-	}
-
-	// Read exactly 'length' bytes from the underlying reader
-	content := make([]byte, length)
-
 	// We need to read raw bytes from the lexer's reader
 	// Skip the newline after 'stream' keyword first
 	reader := p.getReaderFromLexer()
@@ -358,6 +351,16 @@ func (p *Parser) parseStreamContent(dict *Dictionary) (*Stream, error) {
 		_ = reader.UnreadByte()
 	}
 
+	// Get stream length from dictionary
+	length := dict.GetInteger("Length")
+	if length <= 0 || p.ignoreLength {
+		// If length is not set or invalid, we need to scan for 'endstream'
+		// This is a fallback for malformed PDFs
+		return p.parseStreamUntilEndstream(dict)
+	}
+
+	// Read exactly 'length' bytes from the underlying reader
+	content := make([]byte, length)
 	n, err := io.ReadFull(reader, content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read stream content: %w", err)
@@ -422,6 +425,13 @@ func (p *Parser) parseStreamUntilEndstream(dict *Dictionary) (*Stream, error) {
 				// Found endstream - trim it from content
 				contentLen := len(content) - (len(lookback) - idx)
 				content = content[:contentLen]
+				// The end-of-line marker before endstream is not part of the data.
+				if n := len(content); n > 0 && content[n-1] == '\n' {
+					content = content[:n-1]
+				}
+				if n := len(content); n > 0 && content[n-1] == '\r' {
+					content = content[:n-1]
+				}
 				break
 			}
 		}
@@ -6,10 +6,10 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
-	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/coregx/gxpdf/internal/encoding"
 	"github.com/coregx/gxpdf/logging"
@@ -52,7 +52,7 @@ const maxXRefChainDepth = 100
 //
 // Reference: PDF 1.7 specification, Section 7.5 (File Structure).
 type Reader struct {
-	file      *os.File
+	file      fileAccess
 	filename  string
 	version   string
 	xrefTable *XRefTable
@@ -60,6 +60,10 @@ type Reader struct {
 	catalog   *Dictionary
 	pages     *Dictionary
 
+	// startXRef is the offset of the newest cross-reference section, as
+	// given by the startxref keyword.
+	startXRef int64
+
 	// headerOffset is the number of bytes before the %PDF- marker.
 	// Some PDFs have leading whitespace that shifts all internal byte offsets.
 	// This offset must be added to all file positions read from the PDF.
@@ -76,6 +80,29 @@ type Reader struct {
 
 	// File access mutex (for seek and read operations)
 	fileMu sync.Mutex
+
+	// mapped selects memory-mapped file access (see NewMappedReader).
+	mapped bool
+
+	// password opens encrypted documents (see OpenWithPassword);
+	// decryptor decrypts the objects read once it is authenticated.
+	password  string
+	decryptor *decryptor
+
+	// source and sourceSize replace the file when set (see NewReaderFrom).
+	source     io.ReaderAt
+	sourceSize int64
+
+	// repair enables recovery from damaged files (see NewRepairReader);
+	// rebuilt reports that the xref table was reconstructed.
+	repair  bool
+	rebuilt bool
+
+	// scanned maps object numbers to the offsets found by scanning the file
+	// and scannedTrailers holds the trailers found (repair mode only, built
+	// on first use).
+	scanned         map[int]scannedObject
+	scannedTrailers []*Dictionary
 }
 
 // NewReader creates a new PDF document reader.
@@ -90,6 +117,32 @@ func NewReader(filename string) *Reader {
 	}
 }
 
+// NewMappedReader creates a PDF document reader that memory-maps the file
+// when it is opened.
+//
+// Object lookups then read from mapped memory instead of issuing a seek and
+// a read system call each, which speeds up random access to very large
+// files. Where memory mapping is unavailable (see MemoryMapSupported) the
+// reader falls back to regular file access.
+func NewMappedReader(filename string) *Reader {
+	r := NewReader(filename)
+	r.mapped = true
+	return r
+}
+
+// NewReaderFrom creates a PDF document reader for size bytes read from r,
+// e.g. a bytes.Reader over a PDF fetched over HTTP or embedded in the
+// binary. No file is touched.
+//
+// r must not be modified while the reader is in use. Close does not close
+// r.
+func NewReaderFrom(r io.ReaderAt, size int64) *Reader {
+	reader := NewReader("")
+	reader.source = r
+	reader.sourceSize = size
+	return reader
+}
+
 // Open opens the PDF file and parses its structure.
 //
 // Steps performed:
@@ -105,11 +158,19 @@ func NewReader(filename string) *Reader {
 // Reference: PDF 1.7 specification, Section 7.5 (File Structure).
 func (r *Reader) Open() error {
 	// Open file
-	file, err := os.Open(r.filename)
-	if err != nil {
-		return fmt.Errorf("failed to open file: %w", err)
+	if r.source != nil {
+		r.file = sectionFile{io.NewSectionReader(r.source, 0, r.sourceSize)}
+	} else {
+		open := openOSFile
+		if r.mapped {
+			open = openMappedFile
+		}
+		file, err := open(r.filename)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		r.file = file
 	}
-	r.file = file
 
 	// Read and validate header, get offset of leading whitespace
 	version, headerOffset, err := r.readHeader()
@@ -120,28 +181,58 @@ func (r *Reader) Open() error {
 	r.version = version
 	r.headerOffset = headerOffset
 
-	// Find startxref offset
-	startxrefOffset, err := r.findStartXRef()
-	if err != nil {
-		_ = r.Close()
-		return fmt.Errorf("failed to find startxref: %w", err)
+	// Find startxref offset, then parse XRef and trailer
+	if err := r.loadXRef(); err != nil {
+		if !r.repair {
+			_ = r.Close()
+			return err
+		}
+		if rerr := r.rebuildXRef(err); rerr != nil {
+			_ = r.Close()
+			return rerr
+		}
 	}
 
-	// Parse XRef and trailer
-	if err := r.parseXRefAndTrailer(startxrefOffset); err != nil {
+	// Authenticate the password of encrypted documents
+	if err := r.checkPassword(); err != nil {
 		_ = r.Close()
-		return fmt.Errorf("failed to parse xref table: %w", err)
+		return err
 	}
 
 	// Load catalog
 	if err := r.loadCatalog(); err != nil {
-		_ = r.Close()
-		return fmt.Errorf("failed to load catalog: %w", err)
+		err = fmt.Errorf("failed to load catalog: %w", err)
+		if !r.repair || r.rebuilt {
+			_ = r.Close()
+			return err
+		}
+		if rerr := r.rebuildXRef(err); rerr != nil {
+			_ = r.Close()
+			return rerr
+		}
+		if err := r.loadCatalog(); err != nil {
+			_ = r.Close()
+			return fmt.Errorf("failed to load catalog: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// loadXRef finds the startxref offset and parses the cross-reference
+// sections and trailer it leads to.
+func (r *Reader) loadXRef() error {
+	startxrefOffset, err := r.findStartXRef()
+	if err != nil {
+		return fmt.Errorf("failed to find startxref: %w", err)
+	}
+	if err := r.parseXRefAndTrailer(startxrefOffset); err != nil {
+		return fmt.Errorf("failed to parse xref table: %w", err)
+	}
+	r.startXRef = startxrefOffset
+	return nil
+}
+
 // Close closes the PDF file and releases resources.
 func (r *Reader) Close() error {
 	if r.file != nil {
@@ -195,7 +286,7 @@ func (r *Reader) readHeader() (version string, headerOffset int64, err error) {
 		return "", 0, fmt.Errorf("failed to read header: %w", err)
 	}
 	if n == 0 {
-		return "", 0, fmt.Errorf("empty file")
+		return "", 0, fmt.Errorf("%w: empty file", ErrInvalidPDF)
 	}
 	buf = buf[:n]
 
@@ -209,19 +300,19 @@ func (r *Reader) readHeader() (version string, headerOffset int64, err error) {
 		if len(preview) > 20 {
 			preview = preview[:20]
 		}
-		return "", 0, fmt.Errorf("invalid PDF header: %q (expected %%PDF-X.Y)", preview)
+		return "", 0, &HeaderError{Header: preview, Reason: "expected %PDF-X.Y"}
 	}
 
 	// Verify only whitespace (and optional UTF-8 BOM) before the marker
 	prefix := content[:idx]
 	// Strip UTF-8 BOM if present
 	prefix = strings.TrimPrefix(prefix, "\xef\xbb\xbf")
-	if strings.TrimLeft(prefix, " \t\r\n") != "" {
+	if strings.TrimLeft(prefix, " \t\r\n") != "" && !r.repair {
 		preview := content
 		if len(preview) > 20 {
 			preview = preview[:20]
 		}
-		return "", 0, fmt.Errorf("invalid PDF header: %q (expected %%PDF-X.Y)", preview)
+		return "", 0, &HeaderError{Header: preview, Reason: "expected %PDF-X.Y"}
 	}
 
 	headerOffset = int64(idx)
@@ -238,7 +329,7 @@ func (r *Reader) readHeader() (version string, headerOffset int64, err error) {
 	// Extract version (e.g., "1.7" from "%PDF-1.7")
 	version = strings.TrimPrefix(header, pdfMarker)
 	if len(version) < 3 {
-		return "", 0, fmt.Errorf("invalid PDF version in header: %q", header)
+		return "", 0, &HeaderError{Header: header, Reason: "invalid PDF version"}
 	}
 
 	return version, headerOffset, nil
@@ -264,15 +355,9 @@ func (r *Reader) readHeader() (version string, headerOffset int64, err error) {
 //
 // Reference: PDF 1.7 specification, Section 7.5.5 (File Trailer).
 func (r *Reader) findStartXRef() (int64, error) {
-	// Get file size
-	fileInfo, err := r.file.Stat()
-	if err != nil {
-		return 0, fmt.Errorf("failed to stat file: %w", err)
-	}
-
-	size := fileInfo.Size()
+	size := r.file.Size()
 	if size == 0 {
-		return 0, fmt.Errorf("file is empty")
+		return 0, fmt.Errorf("%w: file is empty", ErrInvalidPDF)
 	}
 
 	// Progressive search sizes: 2KB, 64KB, 1MB, then entire file
@@ -297,7 +382,7 @@ func (r *Reader) findStartXRef() (int64, error) {
 		}
 	}
 
-	return 0, fmt.Errorf("startxref keyword not found in file")
+	return 0, ErrMissingStartXRef
 }
 
 // searchForStartXRef searches for startxref in the last searchSize bytes.
@@ -594,7 +679,7 @@ func (r *Reader) GetObject(objectNum int) (PdfObject, error) {
 	// Get XRef entry
 	entry, ok := r.xrefTable.GetEntry(objectNum)
 	if !ok {
-		return nil, fmt.Errorf("object %d not found in xref table", objectNum)
+		return nil, &ObjectError{Number: objectNum, Reason: "not found in xref table"}
 	}
 
 	// Handle different entry types
@@ -608,7 +693,7 @@ func (r *Reader) GetObject(objectNum int) (PdfObject, error) {
 		return r.getCompressedObject(objectNum, entry)
 
 	case XRefEntryFree:
-		return nil, fmt.Errorf("object %d is free (deleted)", objectNum)
+		return nil, &ObjectError{Number: objectNum, Reason: "is free (deleted)"}
 
 	default:
 		return nil, fmt.Errorf("object %d has unknown entry type: %s", objectNum, entry.Type)
@@ -624,7 +709,11 @@ func (r *Reader) GetObject(objectNum int) (PdfObject, error) {
 func (r *Reader) getInUseObject(objectNum int, entry *XRefEntry) (PdfObject, error) {
 	indirectObj, err := r.parseObjectAtOffset(entry.Offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse object %d: %w", objectNum, err)
+		recovered := r.recoverObject(objectNum)
+		if recovered == nil {
+			return nil, fmt.Errorf("failed to parse object %d: %w", objectNum, err)
+		}
+		indirectObj = recovered
 	}
 
 	// Check for object number mismatch and attempt recovery
@@ -651,6 +740,14 @@ func (r *Reader) getInUseObject(objectNum int, entry *XRefEntry) (PdfObject, err
 			}
 		}
 
+		// Strategy 3 (repair mode): Look the object up in a scan of the whole file
+		if recoveredObj == nil {
+			recoveredObj = r.recoverObject(objectNum)
+			if recoveredObj != nil {
+				recoveryStrategy = "file-scan"
+			}
+		}
+
 		if recoveredObj != nil {
 			logging.Logger().Warn("xref recovery: object number mismatch",
 				slog.Int("expected", objectNum),
@@ -673,6 +770,9 @@ func (r *Reader) getInUseObject(objectNum int, entry *XRefEntry) (PdfObject, err
 
 	// Get the object (do NOT auto-resolve references to avoid circular refs)
 	obj := indirectObj.Object
+	if err := r.decrypt(obj, objectNum, indirectObj.Generation); err != nil {
+		return nil, fmt.Errorf("failed to decrypt object %d: %w", objectNum, err)
+	}
 
 	// Cache the object (write lock)
 	r.mu.Lock()
@@ -694,7 +794,21 @@ func (r *Reader) parseObjectAtOffset(offset int64) (*IndirectObject, error) {
 	}
 
 	parser := NewParser(r.file)
-	return parser.ParseIndirectObject()
+	obj, err := parser.ParseIndirectObject()
+	if err == nil || !r.repair {
+		return obj, err
+	}
+
+	// Repair mode: retry ignoring /Length, in case it is wrong
+	if _, serr := r.file.Seek(adjustedOffset, io.SeekStart); serr != nil {
+		return nil, err
+	}
+	parser = NewParser(r.file)
+	parser.ignoreLength = true
+	if obj, rerr := parser.ParseIndirectObject(); rerr == nil {
+		return obj, nil
+	}
+	return nil, err
 }
 
 // scanForObject searches for an object with the given number near the specified offset.
@@ -794,7 +908,7 @@ func (r *Reader) getCompressedObject(objectNum int, entry *XRefEntry) (PdfObject
 			return obj, nil
 		}
 		r.mu.RUnlock()
-		return nil, fmt.Errorf("object %d not found in ObjStm %d at index %d", objectNum, objStmNum, objIndex)
+		return nil, &ObjectError{Number: objectNum, Reason: fmt.Sprintf("not found in ObjStm %d at index %d", objStmNum, objIndex)}
 	}
 	r.mu.RUnlock()
 
@@ -807,7 +921,7 @@ func (r *Reader) getCompressedObject(objectNum int, entry *XRefEntry) (PdfObject
 		if obj, ok := objStmObjects[objectNum]; ok {
 			return obj, nil
 		}
-		return nil, fmt.Errorf("object %d not found in ObjStm %d at index %d", objectNum, objStmNum, objIndex)
+		return nil, &ObjectError{Number: objectNum, Reason: fmt.Sprintf("not found in ObjStm %d at index %d", objStmNum, objIndex)}
 	}
 
 	// Load the ObjStm object (it must be in-use, not compressed itself)
@@ -841,6 +955,9 @@ func (r *Reader) getCompressedObject(objectNum int, entry *XRefEntry) (PdfObject
 	if !ok {
 		return nil, fmt.Errorf("ObjStm %d is not a stream (got %T)", objStmNum, indirectObj.Object)
 	}
+	if err := r.decrypt(stream, objStmNum, indirectObj.Generation); err != nil {
+		return nil, fmt.Errorf("failed to decrypt ObjStm %d: %w", objStmNum, err)
+	}
 
 	// Verify it's an Object Stream
 	dict := stream.Dictionary()
@@ -883,7 +1000,7 @@ func (r *Reader) getCompressedObject(objectNum int, entry *XRefEntry) (PdfObject
 	// Return the requested object
 	obj, ok := objStmObjects[objectNum]
 	if !ok {
-		return nil, fmt.Errorf("object %d not found in ObjStm %d (contains %d objects)", objectNum, objStmNum, len(objStmObjects))
+		return nil, &ObjectError{Number: objectNum, Reason: fmt.Sprintf("not found in ObjStm %d (contains %d objects)", objStmNum, len(objStmObjects))}
 	}
 
 	return obj, nil
@@ -1129,14 +1246,15 @@ func (r *Reader) GetPage(pageNum int) (*Dictionary, error) {
 		return nil, fmt.Errorf("invalid page number: %d (must be >= 0)", pageNum)
 	}
 
-	// Traverse page tree
-	page, err := r.getPageFromNode(r.pages, &pageNum)
+	// Traverse page tree (getPageFromNode consumes the index)
+	index := pageNum
+	page, err := r.getPageFromNode(r.pages, &index)
 	if err != nil {
 		return nil, err
 	}
 
 	if page == nil {
-		return nil, fmt.Errorf("page %d not found (page count: %d)", pageNum, r.pages.GetInteger("Count"))
+		return nil, fmt.Errorf("%w: page %d (page count: %d)", ErrPageNotFound, pageNum, r.pages.GetInteger("Count"))
 	}
 
 	return page, nil
@@ -1252,6 +1370,12 @@ func (r *Reader) ResolveReferences(obj PdfObject) PdfObject {
 	return r.resolveReferences(obj)
 }
 
+// DecodeStream returns the content of a stream with its filters applied.
+// This is the exported version of decodeStream.
+func (r *Reader) DecodeStream(stream *Stream) ([]byte, error) {
+	return r.decodeStream(stream)
+}
+
 // Version returns the PDF version string from the file header.
 //
 // Returns empty string if Open() has not been called.
@@ -1274,6 +1398,35 @@ func (r *Reader) Trailer() *Dictionary {
 	return r.trailer
 }
 
+// StartXRef returns the offset of the newest cross-reference section, the
+// /Prev of an incremental update appended to the file.
+//
+// Offsets are relative to the %PDF- marker, like all offsets in the file.
+func (r *Reader) StartXRef() int64 {
+	return r.startXRef
+}
+
+// HeaderOffset returns the number of bytes before the %PDF- marker, which
+// PDF offsets do not count.
+func (r *Reader) HeaderOffset() int64 {
+	return r.headerOffset
+}
+
+// CopyTo writes the bytes of the PDF file to w unchanged and returns the
+// number of bytes written.
+func (r *Reader) CopyTo(w io.Writer) (int64, error) {
+	r.fileMu.Lock()
+	defer r.fileMu.Unlock()
+
+	if r.file == nil {
+		return 0, fmt.Errorf("file not open (call Open first)")
+	}
+	if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek to start: %w", err)
+	}
+	return io.Copy(w, r.file)
+}
+
 // XRefTable returns the cross-reference table.
 //
 // The xref table maps object numbers to byte offsets in the file.
@@ -1283,6 +1436,31 @@ func (r *Reader) XRefTable() *XRefTable {
 	return r.xrefTable
 }
 
+// Encryption describes the document's encryption, or returns nil if the
+// document is not encrypted.
+//
+// Operations that cannot handle encrypted documents return the result as
+// their error, so callers can detect it with errors.Is(err, ErrEncrypted).
+func (r *Reader) Encryption() *EncryptedError {
+	if r.trailer == nil {
+		return nil
+	}
+	enc := r.trailer.Get("Encrypt")
+	if enc == nil {
+		return nil
+	}
+	e := &EncryptedError{}
+	if dict, err := r.resolveDictionary(enc); err == nil {
+		if filter := dict.GetName("Filter"); filter != nil {
+			e.Filter = filter.Value()
+		}
+		e.V = int(dict.GetInteger("V"))
+		e.R = int(dict.GetInteger("R"))
+		e.Length = int(dict.GetInteger("Length"))
+	}
+	return e
+}
+
 // DocInfo contains document metadata from the Info dictionary.
 type DocInfo struct {
 	Version   string
@@ -1293,6 +1471,10 @@ type DocInfo struct {
 	Creator   string
 	Producer  string
 	Encrypted bool
+
+	// CreationDate and ModDate are zero when absent or malformed.
+	CreationDate time.Time
+	ModDate      time.Time
 }
 
 // GetDocumentInfo returns document metadata from the Info dictionary.
@@ -1327,7 +1509,7 @@ func (r *Reader) GetDocumentInfo() DocInfo {
 		return info
 	}
 
-	// Extract string fields using GetString helper
+	// Extract string fields using GetString helper (decodes text strings)
 	info.Title = dict.GetString("Title")
 	info.Author = dict.GetString("Author")
 	info.Subject = dict.GetString("Subject")
@@ -1335,6 +1517,14 @@ func (r *Reader) GetDocumentInfo() DocInfo {
 	info.Creator = dict.GetString("Creator")
 	info.Producer = dict.GetString("Producer")
 
+	// Dates are lenient: malformed values are left zero.
+	if t, err := PdfDateToTime(dict.GetString("CreationDate")); err == nil {
+		info.CreationDate = t
+	}
+	if t, err := PdfDateToTime(dict.GetString("ModDate")); err == nil {
+		info.ModDate = t
+	}
+
 	return info
 }
 
@@ -1356,6 +1546,25 @@ func OpenPDF(filename string) (*Reader, error) {
 	return reader, nil
 }
 
+// OpenPDFMapped opens a PDF file with memory-mapped access (see
+// NewMappedReader).
+func OpenPDFMapped(filename string) (*Reader, error) {
+	reader := NewMappedReader(filename)
+	if err := reader.Open(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// OpenPDFFrom opens a PDF read from an io.ReaderAt (see NewReaderFrom).
+func OpenPDFFrom(r io.ReaderAt, size int64) (*Reader, error) {
+	reader := NewReaderFrom(r, size)
+	if err := reader.Open(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
 // ReadPDFInfo is a convenience function that reads basic PDF information
 // without loading the entire document structure.
 //
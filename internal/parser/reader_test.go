@@ -128,6 +128,12 @@ func TestReader_Open_InvalidHeader(t *testing.T) {
 	err = reader.Open()
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid PDF header")
+	assert.ErrorIs(t, err, ErrInvalidHeader)
+	assert.ErrorIs(t, err, ErrInvalidPDF)
+
+	var headerErr *HeaderError
+	require.ErrorAs(t, err, &headerErr)
+	assert.Equal(t, "NOT A PDF\n", headerErr.Header)
 }
 
 // TestReader_Open_MissingStartXRef tests opening a PDF without startxref.
@@ -145,6 +151,7 @@ func TestReader_Open_MissingStartXRef(t *testing.T) {
 	err = reader.Open()
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "startxref")
+	assert.ErrorIs(t, err, ErrMissingStartXRef)
 }
 
 // TestReader_Close tests closing the reader.
@@ -214,6 +221,11 @@ func TestReader_GetObject_NotFound(t *testing.T) {
 	_, err = reader.GetObject(999)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+
+	var objErr *ObjectError
+	require.ErrorAs(t, err, &objErr)
+	assert.Equal(t, 999, objErr.Number)
 }
 
 // TestReader_GetObject_Caching tests that objects are cached.
@@ -313,6 +325,8 @@ func TestReader_GetPage_InvalidIndex(t *testing.T) {
 	// Index too large
 	_, err = reader.GetPage(999)
 	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrPageNotFound)
+	assert.Contains(t, err.Error(), "page 999")
 }
 
 // TestReader_GetPage_NotOpened tests calling GetPage before Open.
@@ -0,0 +1,255 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/coregx/gxpdf/logging"
+)
+
+// scannedObject is an object header found by scanning the file.
+type scannedObject struct {
+	offset     int64 // relative to the %PDF- header, like xref offsets
+	generation int
+}
+
+// objectHeaderPattern matches "N G obj" headers. The object keyword must
+// not be followed by a regular character (e.g. "objx").
+var objectHeaderPattern = regexp.MustCompile(`(\d{1,10})[\x00\t\n\f\r ]+(\d{1,5})[\x00\t\n\f\r ]+obj(?:[^A-Za-z0-9]|$)`)
+
+// trailerPattern matches trailer dictionaries.
+var trailerPattern = regexp.MustCompile(`trailer[\x00\t\n\f\r ]*<<`)
+
+// NewRepairReader creates a PDF document reader that recovers from damage
+// instead of failing:
+//
+//   - Garbage before the %PDF- header is tolerated.
+//   - A missing or broken cross-reference table is rebuilt by scanning the
+//     file for objects, and the trailer from trailer dictionaries, xref
+//     streams or the catalog object.
+//   - Objects not found at their xref offset are looked up in a scan of the
+//     whole file.
+//   - Streams with a wrong /Length are read up to their endstream keyword.
+//
+// Scanning reads the whole file into memory. Recovered objects bypass xref
+// validation (see getInUseObject), so repair mode is meant for sanitizing
+// files, not for security-sensitive processing.
+func NewRepairReader(filename string) *Reader {
+	r := NewReader(filename)
+	r.repair = true
+	return r
+}
+
+// OpenPDFRepair opens a PDF file in repair mode (see NewRepairReader).
+func OpenPDFRepair(filename string) (*Reader, error) {
+	reader := NewRepairReader(filename)
+	if err := reader.Open(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// Rebuilt reports whether the cross-reference table was reconstructed by
+// scanning the file (repair mode only).
+func (r *Reader) Rebuilt() bool {
+	return r.rebuilt
+}
+
+// rebuildXRef reconstructs the cross-reference table and trailer by
+// scanning the file. cause is the error that made the rebuild necessary.
+func (r *Reader) rebuildXRef(cause error) error {
+	logging.Logger().Warn("rebuilding xref table", slog.String("cause", cause.Error()))
+
+	scanned, err := r.scanObjects()
+	if err != nil {
+		return fmt.Errorf("%w (rebuilding xref failed: %v)", cause, err)
+	}
+	if len(scanned) == 0 {
+		return fmt.Errorf("%w (rebuilding xref failed: no objects found)", cause)
+	}
+
+	table := NewXRefTable()
+	for num, obj := range scanned {
+		table.AddEntry(NewXRefEntry(num, XRefEntryInUse, obj.offset, obj.generation))
+	}
+
+	r.mu.Lock()
+	r.xrefTable = table
+	r.objectCache = make(map[int]PdfObject)
+	r.objStmCache = make(map[int]map[int]PdfObject)
+	r.mu.Unlock()
+	r.rebuilt = true
+
+	// Trailer entries, later ones (incremental updates) overriding earlier.
+	trailer := NewDictionary()
+	merge := func(dict *Dictionary) {
+		for _, key := range []string{"Root", "Info", "ID", "Encrypt"} {
+			if v := dict.Get(key); v != nil {
+				trailer.Set(key, v)
+			}
+		}
+	}
+	for _, dict := range r.scannedTrailers {
+		merge(dict)
+	}
+
+	// Visit objects in file order: xref stream dictionaries are trailers,
+	// and the objects stored in object streams need compressed entries.
+	nums := make([]int, 0, len(scanned))
+	for num := range scanned {
+		nums = append(nums, num)
+	}
+	sort.Slice(nums, func(i, j int) bool { return scanned[nums[i]].offset < scanned[nums[j]].offset })
+
+	catalog := 0
+	for _, num := range nums {
+		obj, err := r.GetObject(num)
+		if err != nil {
+			continue
+		}
+		var dict *Dictionary
+		switch v := obj.(type) {
+		case *Dictionary:
+			dict = v
+		case *Stream:
+			dict = v.Dictionary()
+		default:
+			continue
+		}
+		switch t := dict.GetName("Type"); {
+		case t == nil:
+		case t.Value() == "XRef":
+			merge(dict)
+		case t.Value() == "ObjStm":
+			r.addObjectStreamEntries(table, num, obj.(*Stream))
+		case t.Value() == "Catalog":
+			catalog = num
+		}
+	}
+
+	if _, ok := trailer.Get("Root").(*IndirectReference); !ok {
+		if catalog == 0 {
+			return fmt.Errorf("%w (rebuilding xref failed: no catalog found)", cause)
+		}
+		trailer.Set("Root", NewIndirectReference(catalog, scanned[catalog].generation))
+	}
+	trailer.SetInteger("Size", int64(nums[len(nums)-1]+1))
+	table.SetTrailer(trailer)
+	r.trailer = trailer
+
+	return nil
+}
+
+// addObjectStreamEntries adds compressed entries for the objects stored in
+// an object stream, unless they are also stored directly.
+func (r *Reader) addObjectStreamEntries(table *XRefTable, objStmNum int, stream *Stream) {
+	dict := stream.Dictionary()
+	data, err := r.decodeStream(stream)
+	if err != nil {
+		return
+	}
+	objects, err := NewParser(bytes.NewReader(nil)).ParseObjectStream(data,
+		int(dict.GetInteger("N")), int(dict.GetInteger("First")))
+	if err != nil {
+		return
+	}
+	for num := range objects {
+		if !table.HasObject(num) {
+			// The index is not used for lookups; objects are found by number.
+			table.AddEntry(NewXRefEntry(num, XRefEntryCompressed, int64(objStmNum), 0))
+		}
+	}
+}
+
+// recoverObject parses an object at the offset found by scanning the file,
+// or returns nil if the reader is not in repair mode or the object is not
+// found.
+func (r *Reader) recoverObject(objectNum int) *IndirectObject {
+	if !r.repair {
+		return nil
+	}
+	scanned, err := r.scanObjects()
+	if err != nil {
+		return nil
+	}
+	found, ok := scanned[objectNum]
+	if !ok {
+		return nil
+	}
+	obj, err := r.parseObjectAtOffset(found.offset)
+	if err != nil || obj.Number != objectNum {
+		return nil
+	}
+	return obj
+}
+
+// scanObjects returns the object headers in the file, the last occurrence
+// of each object number winning as with incremental updates. The trailer
+// dictionaries are collected in scannedTrailers, in file order. The result
+// is computed once.
+func (r *Reader) scanObjects() (map[int]scannedObject, error) {
+	r.fileMu.Lock()
+	defer r.fileMu.Unlock()
+
+	if r.scanned != nil {
+		return r.scanned, nil
+	}
+	data, err := r.readAllLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	scanned := make(map[int]scannedObject)
+	for _, m := range objectHeaderPattern.FindAllSubmatchIndex(data, -1) {
+		// The number must start a token.
+		if m[2] > 0 && isRegularByte(data[m[2]-1]) {
+			continue
+		}
+		num, err1 := strconv.Atoi(string(data[m[2]:m[3]]))
+		gen, err2 := strconv.Atoi(string(data[m[4]:m[5]]))
+		if err1 != nil || err2 != nil || num <= 0 {
+			continue
+		}
+		scanned[num] = scannedObject{offset: int64(m[2]) - r.headerOffset, generation: gen}
+	}
+
+	for _, m := range trailerPattern.FindAllIndex(data, -1) {
+		obj, err := NewParser(bytes.NewReader(data[m[0]+len("trailer"):])).ParseObject()
+		if err != nil {
+			continue
+		}
+		if dict, ok := obj.(*Dictionary); ok {
+			r.scannedTrailers = append(r.scannedTrailers, dict)
+		}
+	}
+
+	r.scanned = scanned
+	return scanned, nil
+}
+
+// readAllLocked reads the whole file. The caller must hold fileMu.
+func (r *Reader) readAllLocked() ([]byte, error) {
+	if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek to start: %w", err)
+	}
+	data, err := io.ReadAll(r.file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, nil
+}
+
+// isRegularByte reports whether b is a PDF regular character (neither
+// whitespace nor a delimiter).
+func isRegularByte(b byte) bool {
+	switch b {
+	case 0, '\t', '\n', '\f', '\r', ' ', '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return false
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repairTestContent = "BT /F1 12 Tf 100 700 Td (Hello) Tj ET"
+
+// buildRepairTestPDF builds a one-page PDF whose content stream has the given
+// /Length. The xref offsets are shifted by shift bytes; with xref false the
+// file ends after the last object.
+func buildRepairTestPDF(length string, shift int, xref bool) string {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R >>",
+		"<< /Length " + length + " >>\nstream\n" + repairTestContent + "\nendstream",
+		"<< /Title (Repair Test) >>",
+	}
+
+	var b strings.Builder
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	if !xref {
+		return b.String()
+	}
+
+	start := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off+shift)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, start)
+	return b.String()
+}
+
+// writeRepairTestPDF writes data to a temporary file and returns its path.
+func writeRepairTestPDF(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "repair.pdf")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+// assertRepairTestPDF checks that the document built by buildRepairTestPDF
+// was read correctly.
+func assertRepairTestPDF(t *testing.T, reader *Reader) {
+	t.Helper()
+
+	count, err := reader.GetPageCount()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	obj, err := reader.GetObject(4)
+	require.NoError(t, err)
+	stream, ok := obj.(*Stream)
+	require.True(t, ok, "object 4 should be a stream, got %T", obj)
+	assert.Equal(t, repairTestContent, strings.TrimSpace(string(stream.Content())))
+}
+
+func TestRepairReader_IntactFile(t *testing.T) {
+	data := buildRepairTestPDF(fmt.Sprint(len(repairTestContent)), 0, true)
+	reader, err := OpenPDFRepair(writeRepairTestPDF(t, data))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.False(t, reader.Rebuilt())
+	assertRepairTestPDF(t, reader)
+}
+
+func TestRepairReader_GarbagePrefix(t *testing.T) {
+	data := "garbage\x00\x01 from a mail gateway\n" +
+		buildRepairTestPDF(fmt.Sprint(len(repairTestContent)), 0, true)
+	path := writeRepairTestPDF(t, data)
+
+	_, err := OpenPDF(path)
+	require.Error(t, err, "strict reader should reject the prefix")
+
+	reader, err := OpenPDFRepair(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.Equal(t, "1.4", reader.Version())
+	assertRepairTestPDF(t, reader)
+}
+
+func TestRepairReader_MissingXRef(t *testing.T) {
+	path := writeRepairTestPDF(t, buildRepairTestPDF(fmt.Sprint(len(repairTestContent)), 0, false))
+
+	_, err := OpenPDF(path)
+	require.ErrorIs(t, err, ErrMissingStartXRef)
+
+	reader, err := OpenPDFRepair(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assert.True(t, reader.Rebuilt())
+	assertRepairTestPDF(t, reader)
+
+	root, ok := reader.Trailer().Get("Root").(*IndirectReference)
+	require.True(t, ok, "rebuilt trailer should have /Root")
+	assert.Equal(t, 1, root.Number)
+}
+
+func TestRepairReader_WrongLength(t *testing.T) {
+	for _, length := range []string{"5", "9999", "-1"} {
+		t.Run(length, func(t *testing.T) {
+			path := writeRepairTestPDF(t, buildRepairTestPDF(length, 0, true))
+			reader, err := OpenPDFRepair(path)
+			require.NoError(t, err)
+			defer reader.Close()
+
+			assertRepairTestPDF(t, reader)
+		})
+	}
+}
+
+func TestRepairReader_WrongOffsets(t *testing.T) {
+	path := writeRepairTestPDF(t, buildRepairTestPDF(fmt.Sprint(len(repairTestContent)), 7, true))
+
+	reader, err := OpenPDFRepair(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	assertRepairTestPDF(t, reader)
+
+	obj, err := reader.GetObject(5)
+	require.NoError(t, err)
+	info, ok := obj.(*Dictionary)
+	require.True(t, ok)
+	assert.Equal(t, "Repair Test", info.GetString("Title"))
+}
+
+func TestRepairReader_NotAPDF(t *testing.T) {
+	path := writeRepairTestPDF(t, "just some text\n")
+
+	_, err := OpenPDFRepair(path)
+	require.ErrorIs(t, err, ErrInvalidPDF)
+}
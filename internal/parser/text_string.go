@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Byte order marks that identify Unicode text strings.
+var (
+	utf16BEBOM = []byte{0xFE, 0xFF}
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+)
+
+// pdfDocEncoding maps the PDFDocEncoding bytes that differ from Latin-1.
+//
+// Bytes 0x20-0x7E are ASCII and 0xA1-0xFF are Latin-1; 0x9F is undefined.
+//
+// Reference: PDF 1.7 specification, Annex D.2 (Latin Character Set and Encodings).
+var pdfDocEncoding = map[byte]rune{
+	0x18: '˘', 0x19: 'ˇ', 0x1A: 'ˆ', 0x1B: '˙',
+	0x1C: '˝', 0x1D: '˛', 0x1E: '˚', 0x1F: '˜',
+	0x80: '•', 0x81: '†', 0x82: '‡', 0x83: '…',
+	0x84: '—', 0x85: '–', 0x86: 'ƒ', 0x87: '⁄',
+	0x88: '‹', 0x89: '›', 0x8A: '−', 0x8B: '‰',
+	0x8C: '„', 0x8D: '“', 0x8E: '”', 0x8F: '‘',
+	0x90: '’', 0x91: '‚', 0x92: '™', 0x93: 'ﬁ',
+	0x94: 'ﬂ', 0x95: 'Ł', 0x96: 'Œ', 0x97: 'Š',
+	0x98: 'Ÿ', 0x99: 'Ž', 0x9A: 'ı', 0x9B: 'ł',
+	0x9C: 'œ', 0x9D: 'š', 0x9E: 'ž', 0xA0: '€',
+}
+
+// NewTextString creates a String holding text as a PDF text string: as is
+// for ASCII text, and as UTF-16BE with a byte order mark otherwise.
+//
+// Reference: PDF 1.7 specification, Section 7.9.2.2 (Text String Type).
+func NewTextString(text string) *String {
+	ascii := true
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return NewString(text)
+	}
+
+	b := bytes.Clone(utf16BEBOM)
+	for _, u := range utf16.Encode([]rune(text)) {
+		b = append(b, byte(u>>8), byte(u))
+	}
+	return NewStringBytes(b)
+}
+
+// Text returns the string decoded as a PDF text string, in UTF-8.
+//
+// Text strings (document info, outline titles, annotation contents) are
+// encoded as UTF-16BE with a byte order mark, UTF-8 with a byte order
+// mark (PDF 2.0), or PDFDocEncoding. Use Bytes for binary strings.
+//
+// Reference: PDF 1.7 specification, Section 7.9.2.2 (Text String Type).
+func (s *String) Text() string {
+	return decodeTextString(s.value)
+}
+
+// decodeTextString decodes the bytes of a PDF text string to UTF-8.
+//
+// Strings without a byte order mark that are valid UTF-8 and contain
+// multi-byte sequences are returned as is: some producers write raw UTF-8,
+// and such byte sequences are implausible in PDFDocEncoded text.
+func decodeTextString(b []byte) string {
+	switch {
+	case bytes.HasPrefix(b, utf16BEBOM):
+		return decodeUTF16BE(b[len(utf16BEBOM):])
+	case bytes.HasPrefix(b, utf8BOM):
+		return string(b[len(utf8BOM):])
+	case utf8.Valid(b) && !hasPDFDocControls(b):
+		return string(b)
+	}
+	return decodePDFDocEncoding(b)
+}
+
+// decodeUTF16BE decodes UTF-16BE bytes, including surrogate pairs.
+// A trailing odd byte is ignored.
+func decodeUTF16BE(b []byte) string {
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
+
+// decodePDFDocEncoding decodes PDFDocEncoding bytes.
+func decodePDFDocEncoding(b []byte) string {
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for _, c := range b {
+		if r, ok := pdfDocEncoding[c]; ok {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune(rune(c))
+		}
+	}
+	return sb.String()
+}
+
+// hasPDFDocControls reports whether b contains the control-range bytes
+// that PDFDocEncoding maps to diacritics (0x18-0x1F).
+func hasPDFDocControls(b []byte) bool {
+	for _, c := range b {
+		if c >= 0x18 && c <= 0x1F {
+			return true
+		}
+	}
+	return false
+}
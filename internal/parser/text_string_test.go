@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeTextString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{"ascii", []byte("Annual Report"), "Annual Report"},
+		{"utf16be cyrillic", []byte{0xFE, 0xFF, 0x04, 0x1E, 0x04, 0x42, 0x04, 0x47, 0x04, 0x51, 0x04, 0x42}, "Отчёт"},
+		{"utf16be surrogate pair", []byte{0xFE, 0xFF, 0xD8, 0x3D, 0xDE, 0x00}, "😀"},
+		{"utf16be odd trailing byte", []byte{0xFE, 0xFF, 0x00, 0x41, 0x00}, "A"},
+		{"utf8 bom", []byte{0xEF, 0xBB, 0xBF, 0xE6, 0x97, 0xA5}, "日"},
+		{"pdfdoc latin1", []byte{'C', 'a', 'f', 0xE9}, "Café"},
+		{"pdfdoc specials", []byte{0x80, ' ', 0x92, ' ', 0xA0, ' ', 0x8D, 'x', 0x8E}, "• ™ € “x”"},
+		{"pdfdoc diacritics", []byte{'a', 0x18}, "a˘"},
+		{"raw utf8 without bom", []byte("Привет"), "Привет"},
+		{"empty", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, decodeTextString(tt.input))
+		})
+	}
+}
+
+func TestString_Text(t *testing.T) {
+	s := NewStringBytes([]byte{0xFE, 0xFF, 0x00, 0x48, 0x00, 0x69})
+	assert.Equal(t, "Hi", s.Text())
+	assert.Equal(t, "\xfe\xff\x00H\x00i", s.Value(), "Value returns raw bytes")
+}
+
+func TestNewTextString(t *testing.T) {
+	assert.Equal(t, "Report", NewTextString("Report").Value(), "ASCII is kept as is")
+
+	s := NewTextString("Отчёт €")
+	assert.Equal(t, []byte{0xFE, 0xFF}, s.Bytes()[:2])
+	assert.Equal(t, "Отчёт €", s.Text())
+}
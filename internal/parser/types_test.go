@@ -280,6 +280,7 @@ func TestClone(t *testing.T) {
 		{"real", NewReal(3.14)},
 		{"string", NewString("hello")},
 		{"name", NewName("Type")},
+		{"reference", NewIndirectReference(5, 0)},
 	}
 
 	for _, tt := range tests {
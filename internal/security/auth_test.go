@@ -0,0 +1,86 @@
+package security
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestAuthenticateUser_RC4(t *testing.T) {
+	for _, keyLength := range []int{40, 128} {
+		enc, err := NewRC4Encryptor(&EncryptionConfig{
+			UserPassword:  "user",
+			OwnerPassword: "owner",
+			KeyLength:     keyLength,
+			FileID:        "file-id",
+		})
+		if err != nil {
+			t.Fatalf("NewRC4Encryptor() error = %v", err)
+		}
+		dict := enc.GetEncryptionDict()
+
+		for password, want := range map[string]bool{"user": true, "": false, "wrong": false} {
+			got, err := AuthenticateUser(dict, []byte("file-id"), true, password)
+			if err != nil {
+				t.Fatalf("AuthenticateUser(%d-bit, %q) error = %v", keyLength, password, err)
+			}
+			if got != want {
+				t.Errorf("AuthenticateUser(%d-bit, %q) = %v, want %v", keyLength, password, got, want)
+			}
+		}
+	}
+}
+
+func TestAuthenticateUser_RC4EmptyUserPassword(t *testing.T) {
+	enc, err := NewRC4Encryptor(&EncryptionConfig{
+		OwnerPassword: "owner",
+		KeyLength:     128,
+		FileID:        "file-id",
+	})
+	if err != nil {
+		t.Fatalf("NewRC4Encryptor() error = %v", err)
+	}
+
+	ok, err := AuthenticateUser(enc.GetEncryptionDict(), []byte("file-id"), true, "")
+	if err != nil || !ok {
+		t.Errorf("AuthenticateUser(\"\") = %v, %v, want true", ok, err)
+	}
+}
+
+func TestAuthenticateUser_AES256(t *testing.T) {
+	validationSalt := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	keySalt := []byte{9, 10, 11, 12, 13, 14, 15, 16}
+
+	// Revision 5: SHA-256(password + validation salt).
+	sum := sha256.Sum256(append([]byte("secret"), validationSalt...))
+	r5 := &EncryptionDict{R: 5, U: append(append(sum[:], validationSalt...), keySalt...)}
+
+	// Revision 6: /U for "secret", computed independently with the
+	// Algorithm 2.B reference implementation.
+	u, _ := hex.DecodeString("f73c954722fb8e39ecd42d6fbba64c7b7c9e2066d3d250ccc990bc183b4ab5b8" +
+		"0102030405060708090a0b0c0d0e0f10")
+	r6 := &EncryptionDict{R: 6, U: u}
+
+	for _, dict := range []*EncryptionDict{r5, r6} {
+		for password, want := range map[string]bool{"secret": true, "": false} {
+			got, err := AuthenticateUser(dict, nil, true, password)
+			if err != nil {
+				t.Fatalf("AuthenticateUser(R%d, %q) error = %v", dict.R, password, err)
+			}
+			if got != want {
+				t.Errorf("AuthenticateUser(R%d, %q) = %v, want %v", dict.R, password, got, want)
+			}
+		}
+	}
+}
+
+func TestAuthenticateUser_Unsupported(t *testing.T) {
+	_, err := AuthenticateUser(&EncryptionDict{R: 7}, nil, true, "")
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("AuthenticateUser(R7) error = %v, want ErrUnsupportedVersion", err)
+	}
+	if _, err := AuthenticateUser(&EncryptionDict{R: 6, U: make([]byte, 32)}, nil, true, ""); err == nil {
+		t.Error("AuthenticateUser(R6, short /U) succeeded, want error")
+	}
+}
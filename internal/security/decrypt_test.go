@@ -0,0 +1,154 @@
+package security
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"testing"
+)
+
+func TestNewDecryptor_RC4(t *testing.T) {
+	for _, keyLength := range []int{40, 128} {
+		enc, err := NewRC4Encryptor(&EncryptionConfig{
+			UserPassword:  "user",
+			OwnerPassword: "owner",
+			KeyLength:     keyLength,
+			FileID:        "file-id",
+		})
+		if err != nil {
+			t.Fatalf("NewRC4Encryptor() error = %v", err)
+		}
+		dict := enc.GetEncryptionDict()
+		want := enc.computeEncryptionKey("user")
+
+		for password, owner := range map[string]bool{"user": false, "owner": true} {
+			d, err := NewDecryptor(dict, []byte("file-id"), true, password)
+			if err != nil {
+				t.Fatalf("NewDecryptor(%d-bit, %q) error = %v", keyLength, password, err)
+			}
+			if !bytes.Equal(d.key, want) {
+				t.Errorf("NewDecryptor(%d-bit, %q) key = %x, want %x", keyLength, password, d.key, want)
+			}
+			if d.Owner() != owner {
+				t.Errorf("NewDecryptor(%d-bit, %q).Owner() = %v, want %v", keyLength, password, d.Owner(), owner)
+			}
+		}
+
+		if _, err := NewDecryptor(dict, []byte("file-id"), true, "wrong"); !errors.Is(err, ErrInvalidPassword) {
+			t.Errorf("NewDecryptor(%d-bit, wrong) error = %v, want ErrInvalidPassword", keyLength, err)
+		}
+	}
+}
+
+func TestDecryptor_Decrypt(t *testing.T) {
+	plain := []byte("BT /F1 12 Tf (Statement) Tj ET")
+	key := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	rc4 := &Decryptor{key: key, method: MethodRC4}
+	encrypted := make([]byte, len(plain))
+	if err := encryptRC4(rc4.objectKey(7, 0), plain, encrypted); err != nil {
+		t.Fatal(err)
+	}
+
+	aesV2 := &Decryptor{key: key, method: MethodAESV2}
+	encryptedV2, err := encryptAES(aesV2.objectKey(7, 0), plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aesV3 := &Decryptor{key: bytes.Repeat(key, 2), method: MethodAESV3}
+	encryptedV3, err := encryptAES(aesV3.key, plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		d    *Decryptor
+		data []byte
+	}{
+		{rc4, encrypted},
+		{aesV2, encryptedV2},
+		{aesV3, encryptedV3},
+		{&Decryptor{method: MethodNone}, plain},
+	}
+	for _, tt := range tests {
+		got, err := tt.d.Decrypt(7, 0, tt.data)
+		if err != nil {
+			t.Fatalf("Decrypt(%s) error = %v", tt.d.method, err)
+		}
+		if !bytes.Equal(got, plain) {
+			t.Errorf("Decrypt(%s) = %q, want %q", tt.d.method, got, plain)
+		}
+	}
+
+	// The object key depends on the object number.
+	if got, _ := rc4.Decrypt(8, 0, encrypted); bytes.Equal(got, plain) {
+		t.Error("Decrypt(RC4) with another object number returned the plain text")
+	}
+}
+
+func TestNewDecryptor_AES256(t *testing.T) {
+	fileKey := bytes.Repeat([]byte{0x5a}, 32)
+
+	for _, revision := range []int{5, 6} {
+		dict := &EncryptionDict{R: revision, V: 5, Length: 256}
+		dict.U, dict.UE = passwordEntries(t, revision, "user", []byte("uvalsalt"), []byte("ukeysalt"), nil, fileKey)
+		dict.O, dict.OE = passwordEntries(t, revision, "owner", []byte("ovalsalt"), []byte("okeysalt"), dict.U, fileKey)
+
+		for password, owner := range map[string]bool{"user": false, "owner": true} {
+			d, err := NewDecryptor(dict, nil, true, password)
+			if err != nil {
+				t.Fatalf("NewDecryptor(R%d, %q) error = %v", revision, password, err)
+			}
+			if !bytes.Equal(d.key, fileKey) || d.method != MethodAESV3 || d.Owner() != owner {
+				t.Errorf("NewDecryptor(R%d, %q) = key %x, method %s, owner %v", revision, password, d.key, d.method, d.Owner())
+			}
+		}
+
+		if _, err := NewDecryptor(dict, nil, true, ""); !errors.Is(err, ErrInvalidPassword) {
+			t.Errorf("NewDecryptor(R%d, \"\") error = %v, want ErrInvalidPassword", revision, err)
+		}
+	}
+}
+
+func TestNewDecryptor_Unsupported(t *testing.T) {
+	if _, err := NewDecryptor(&EncryptionDict{R: 7}, nil, true, ""); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("NewDecryptor(R7) error = %v, want ErrUnsupportedVersion", err)
+	}
+
+	enc, err := NewRC4Encryptor(&EncryptionConfig{KeyLength: 128, FileID: "file-id"})
+	if err != nil {
+		t.Fatalf("NewRC4Encryptor() error = %v", err)
+	}
+	dict := *enc.GetEncryptionDict()
+	dict.CFM = "Custom"
+	if _, err := NewDecryptor(&dict, []byte("file-id"), true, ""); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("NewDecryptor(CFM Custom) error = %v, want ErrUnsupportedVersion", err)
+	}
+
+	dict.CFM = ""
+	d, err := NewDecryptor(&dict, []byte("file-id"), true, "")
+	if err != nil {
+		t.Fatalf("NewDecryptor() error = %v", err)
+	}
+	if _, err := d.WithMethod("Custom"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("WithMethod(Custom) error = %v, want ErrUnsupportedVersion", err)
+	}
+}
+
+// passwordEntries computes /U and /UE (udata nil) or /O and /OE for a
+// password and file encryption key (Algorithms 8 and 9).
+func passwordEntries(t *testing.T, revision int, password string, validationSalt, keySalt, udata, fileKey []byte) (hash, wrapped []byte) {
+	t.Helper()
+
+	hash = append(append(hashAES256(revision, []byte(password), validationSalt, udata), validationSalt...), keySalt...)
+
+	block, err := aes.NewCipher(hashAES256(revision, []byte(password), keySalt, udata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapped = make([]byte, 32)
+	cipher.NewCBCEncrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(wrapped, fileKey)
+	return hash, wrapped
+}
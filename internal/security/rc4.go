@@ -85,6 +85,11 @@ type EncryptionDict struct {
 	// U is the user password hash (32 bytes for RC4, variable for AES).
 	U []byte
 
+	// OE and UE are the file encryption key encrypted with the owner and
+	// the user password (32 bytes, revisions 5 and 6 only).
+	OE []byte
+	UE []byte
+
 	// CFM is the crypt filter method (empty for RC4, "AESV2" for AES-128, "AESV3" for AES-256).
 	CFM string
 }
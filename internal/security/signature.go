@@ -0,0 +1,167 @@
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// byteRangePlaceholder is the /ByteRange entry written by
+// SignaturePlaceholder. The names keep the array valid PDF until
+// PrepareSignature replaces them with the actual offsets.
+const byteRangePlaceholder = "/ByteRange [0 /********** /********** /**********]"
+
+// signatureScanChunk is the chunk size for scanning files for placeholders.
+const signatureScanChunk = 64 * 1024
+
+// ErrNoSignaturePlaceholder is returned when a file contains no signature
+// placeholder to prepare.
+var ErrNoSignaturePlaceholder = errors.New("no signature placeholder found")
+
+// SignatureFile is a written PDF file whose signature placeholder is filled
+// in place, such as an *os.File opened for reading and writing.
+type SignatureFile interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// SignaturePlaceholder returns the /ByteRange and /Contents entries of a
+// signature dictionary, reserving room for a signature (e.g. a DER-encoded
+// CMS object) of up to size bytes.
+//
+// The entries must be written verbatim, in this order, into the signature
+// dictionary of the output; PrepareSignature locates them afterwards.
+func SignaturePlaceholder(size int) string {
+	return byteRangePlaceholder + " /Contents <" + strings.Repeat("0", 2*size) + ">"
+}
+
+// SignatureRange describes the bytes of a file covered by a signature.
+type SignatureRange struct {
+	// ByteRange is the /ByteRange of the signature: offset and length of
+	// the bytes before the /Contents hex string, and offset and length of
+	// the bytes after it.
+	ByteRange [4]int64
+}
+
+// PrepareSignature locates the last signature placeholder written with
+// SignaturePlaceholder in a file of the given size, fills in its
+// /ByteRange and returns the range covered by the signature.
+//
+// The file is scanned in chunks, so large files are not read into memory.
+func PrepareSignature(f SignatureFile, size int64) (*SignatureRange, error) {
+	offset, err := findLast(f, size, []byte(byteRangePlaceholder))
+	if err != nil {
+		return nil, err
+	}
+
+	// The /Contents hex string follows the placeholder.
+	const prefix = " /Contents <"
+	contents := offset + int64(len(byteRangePlaceholder))
+	head := make([]byte, len(prefix))
+	if _, err := f.ReadAt(head, contents); err != nil || string(head) != prefix {
+		return nil, fmt.Errorf("signature placeholder at offset %d has no /Contents", offset)
+	}
+	start := contents + int64(len(prefix)) - 1 // Offset of '<'.
+	n, err := hexStringEnd(io.NewSectionReader(f, start+1, size-start-1))
+	if err != nil {
+		return nil, fmt.Errorf("signature placeholder at offset %d: %w", offset, err)
+	}
+	end := start + 1 + n
+
+	r := &SignatureRange{ByteRange: [4]int64{0, start, end, size - end}}
+
+	// Keep the entry length so that no offsets change.
+	entry := fmt.Sprintf("/ByteRange [0 %d %d %d]", r.ByteRange[1], r.ByteRange[2], r.ByteRange[3])
+	entry += strings.Repeat(" ", len(byteRangePlaceholder)-len(entry))
+	if _, err := f.WriteAt([]byte(entry), offset); err != nil {
+		return nil, fmt.Errorf("failed to write /ByteRange: %w", err)
+	}
+	return r, nil
+}
+
+// Capacity returns the maximum size in bytes of a signature that fits into
+// the /Contents placeholder.
+func (r *SignatureRange) Capacity() int {
+	return int(r.ByteRange[2]-r.ByteRange[1]-2) / 2
+}
+
+// Reader returns a reader of the signed bytes of f: the file without the
+// /Contents hex string. Use it to compute digests other than SHA-256 or to
+// stream the content to a signing service.
+func (r *SignatureRange) Reader(f io.ReaderAt) io.Reader {
+	return io.MultiReader(
+		io.NewSectionReader(f, r.ByteRange[0], r.ByteRange[1]),
+		io.NewSectionReader(f, r.ByteRange[2], r.ByteRange[3]),
+	)
+}
+
+// Digest returns the SHA-256 digest of the signed bytes of f.
+func (r *SignatureRange) Digest(f io.ReaderAt) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r.Reader(f)); err != nil {
+		return nil, fmt.Errorf("failed to hash signed bytes: %w", err)
+	}
+	return h.Sum(nil), nil
+}
+
+// Embed writes a signature into the /Contents placeholder of f. Unused
+// room is left zero-padded.
+func (r *SignatureRange) Embed(f io.WriterAt, signature []byte) error {
+	if len(signature) > r.Capacity() {
+		return fmt.Errorf("signature of %d bytes exceeds placeholder capacity of %d bytes",
+			len(signature), r.Capacity())
+	}
+	encoded := make([]byte, hex.EncodedLen(len(signature)))
+	hex.Encode(encoded, signature)
+	if _, err := f.WriteAt(encoded, r.ByteRange[1]+1); err != nil {
+		return fmt.Errorf("failed to write signature: %w", err)
+	}
+	return nil
+}
+
+// findLast returns the offset of the last occurrence of pattern in the
+// first size bytes of f.
+func findLast(f io.ReaderAt, size int64, pattern []byte) (int64, error) {
+	buf := make([]byte, signatureScanChunk+len(pattern)-1)
+	for end := size; end > 0; {
+		// Chunks overlap so that matches across chunk borders are found.
+		start := max(end-signatureScanChunk, 0)
+		n, err := f.ReadAt(buf[:min(end+int64(len(pattern))-1, size)-start], start)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, fmt.Errorf("failed to read file: %w", err)
+		}
+		if i := bytes.LastIndex(buf[:n], pattern); i >= 0 {
+			return start + int64(i), nil
+		}
+		end = start
+	}
+	return 0, ErrNoSignaturePlaceholder
+}
+
+// hexStringEnd returns the offset just past the '>' closing a hex string
+// whose '<' has already been consumed.
+func hexStringEnd(r io.Reader) (int64, error) {
+	br := bufio.NewReader(r)
+	for n := int64(1); ; n++ {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("unterminated /Contents: %w", err)
+		}
+		if b == '>' {
+			return n, nil
+		}
+		if !isHexDigit(b) {
+			return 0, fmt.Errorf("invalid /Contents byte %q", b)
+		}
+	}
+}
+
+// isHexDigit reports whether b is a hexadecimal digit.
+func isHexDigit(b byte) bool {
+	return '0' <= b && b <= '9' || 'a' <= b && b <= 'f' || 'A' <= b && b <= 'F'
+}
@@ -0,0 +1,127 @@
+package security
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSignaturePDF writes a PDF-like file with a signature placeholder,
+// followed by filler bytes, and returns it opened for reading and writing.
+func writeSignaturePDF(t *testing.T, filler int) (*os.File, int64) {
+	t.Helper()
+
+	data := "%PDF-1.7\n" +
+		"5 0 obj\n<< /Type /Sig /Filter /Adobe.PPKLite /SubFilter /adbe.pkcs7.detached " +
+		SignaturePlaceholder(16) + " >>\nendobj\n" +
+		strings.Repeat("%", filler) + "\n" +
+		"trailer\n<< /Root 1 0 R >>\n%%EOF\n"
+
+	path := filepath.Join(t.TempDir(), "sign.pdf")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f, int64(len(data))
+}
+
+func TestPrepareSignature(t *testing.T) {
+	// The filler sizes put the placeholder into the last scanned chunk,
+	// across the border of two chunks, and into an earlier chunk.
+	for _, filler := range []int{0, signatureScanChunk - 110, 2 * signatureScanChunk} {
+		t.Run(fmt.Sprint(filler), func(t *testing.T) {
+			f, size := writeSignaturePDF(t, filler)
+
+			r, err := PrepareSignature(f, size)
+			if err != nil {
+				t.Fatalf("PrepareSignature() error = %v", err)
+			}
+			data, _ := io.ReadAll(io.NewSectionReader(f, 0, size))
+
+			br := r.ByteRange
+			if br[0] != 0 || br[1]+br[3] != size-(br[2]-br[1]) || br[2]+br[3] != size {
+				t.Fatalf("ByteRange = %v inconsistent with size %d", br, size)
+			}
+			contents := string(data[br[1]:br[2]])
+			if contents != "<"+strings.Repeat("0", 32)+">" {
+				t.Errorf("excluded bytes = %q, want the /Contents hex string", contents)
+			}
+			want := fmt.Sprintf("/ByteRange [0 %d %d %d]", br[1], br[2], br[3])
+			if !bytes.Contains(data, []byte(want)) {
+				t.Errorf("file does not contain %q", want)
+			}
+			if r.Capacity() != 16 {
+				t.Errorf("Capacity() = %d, want 16", r.Capacity())
+			}
+
+			digest, err := r.Digest(f)
+			if err != nil {
+				t.Fatalf("Digest() error = %v", err)
+			}
+			signed := append(append([]byte{}, data[:br[1]]...), data[br[2]:]...)
+			if sum := sha256.Sum256(signed); !bytes.Equal(digest, sum[:]) {
+				t.Errorf("Digest() = %x, want %x", digest, sum)
+			}
+		})
+	}
+}
+
+func TestSignatureRange_Embed(t *testing.T) {
+	f, size := writeSignaturePDF(t, 10)
+	r, err := PrepareSignature(f, size)
+	if err != nil {
+		t.Fatalf("PrepareSignature() error = %v", err)
+	}
+	digest, _ := r.Digest(f)
+
+	if err := r.Embed(f, []byte{0xde, 0xad, 0xbe, 0xef}); err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	data, _ := io.ReadAll(io.NewSectionReader(f, 0, size))
+	want := "<deadbeef" + strings.Repeat("0", 24) + ">"
+	if got := string(data[r.ByteRange[1]:r.ByteRange[2]]); got != want {
+		t.Errorf("/Contents = %q, want %q", got, want)
+	}
+
+	// Embedding does not change the signed bytes.
+	if after, _ := r.Digest(f); !bytes.Equal(after, digest) {
+		t.Error("digest changed after Embed()")
+	}
+
+	if err := r.Embed(f, make([]byte, 17)); err == nil {
+		t.Error("Embed() of oversized signature: expected error")
+	}
+}
+
+func TestPrepareSignature_NoPlaceholder(t *testing.T) {
+	data := []byte("%PDF-1.7\n%%EOF\n")
+	f, err := os.Create(filepath.Join(t.TempDir(), "plain.pdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	f.Write(data)
+
+	if _, err := PrepareSignature(f, int64(len(data))); !errors.Is(err, ErrNoSignaturePlaceholder) {
+		t.Errorf("PrepareSignature() error = %v, want ErrNoSignaturePlaceholder", err)
+	}
+
+	// A prepared placeholder is not prepared again.
+	sf, size := writeSignaturePDF(t, 0)
+	if _, err := PrepareSignature(sf, size); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := PrepareSignature(sf, size); !errors.Is(err, ErrNoSignaturePlaceholder) {
+		t.Errorf("second PrepareSignature() error = %v, want ErrNoSignaturePlaceholder", err)
+	}
+}
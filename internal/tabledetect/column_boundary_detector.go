@@ -19,10 +19,20 @@ import (
 //
 // Inspired by Tabula's "cell-boundary-first" approach.
 type ColumnBoundaryDetector struct {
-	minColumnWidth float64 // Minimum width for a column (default: 30pt)
-	minGapWidth    float64 // Minimum gap between columns (default: 10pt)
+	minColumnWidth float64       // Minimum width for a column (default: 30pt)
+	minGapWidth    float64       // Minimum gap between columns (default: 10pt)
+	headerRows     int           // Fixed header row count (0 = detect)
+	isHeaderRow    HeaderRowFunc // Header row predicate (nil = detect)
+	noHeader       bool          // All rows are data rows (continuation pages)
 }
 
+// HeaderRowFunc reports whether a text line belongs to the table header.
+//
+// index is the 0-based line index from the top of the table and words are
+// the texts of the line from left to right. The header is the leading run
+// of lines for which the function returns true.
+type HeaderRowFunc func(index int, words []string) bool
+
 // NewColumnBoundaryDetector creates a new detector with default settings.
 func NewColumnBoundaryDetector() *ColumnBoundaryDetector {
 	return &ColumnBoundaryDetector{
@@ -38,6 +48,37 @@ func NewColumnBoundaryDetector() *ColumnBoundaryDetector {
 	}
 }
 
+// WithHeaderRows fixes the number of header lines instead of detecting
+// them with detectMultiLineHeader. Columns are then derived from the
+// header (see detectBoundariesHeaderBased). Zero restores detection.
+func (cbd *ColumnBoundaryDetector) WithHeaderRows(n int) *ColumnBoundaryDetector {
+	cbd.headerRows = max(n, 0)
+	return cbd
+}
+
+// WithHeaderRowFunc selects the header lines with fn instead of detecting
+// them. Columns are then derived from the header. It takes precedence over
+// WithHeaderRows; nil restores detection.
+func (cbd *ColumnBoundaryDetector) WithHeaderRowFunc(fn HeaderRowFunc) *ColumnBoundaryDetector {
+	cbd.isHeaderRow = fn
+	return cbd
+}
+
+// WithNoHeader treats every row as a data row, for tables without a header
+// such as the continuation pages of a statement whose header is on a prior
+// page. Columns are then detected from data-row edges only, and the first
+// rows are never taken for a header. It takes precedence over
+// WithHeaderRows and WithHeaderRowFunc.
+func (cbd *ColumnBoundaryDetector) WithNoHeader(noHeader bool) *ColumnBoundaryDetector {
+	cbd.noHeader = noHeader
+	return cbd
+}
+
+// hasHeaderOverride reports whether the caller fixed the header rows.
+func (cbd *ColumnBoundaryDetector) hasHeaderOverride() bool {
+	return !cbd.noHeader && (cbd.headerRows > 0 || cbd.isHeaderRow != nil)
+}
+
 // ColumnBoundary represents a vertical boundary (column edge).
 type ColumnBoundary struct {
 	X          float64 // X-coordinate of boundary
@@ -78,6 +119,11 @@ func (cbd *ColumnBoundaryDetector) DetectBoundaries(elements []*extractor.TextEl
 	// Conclusion: Keep using simple edge clustering for now.
 	// Lattice mode needs investigation - VTB has ruling lines but detector finds 8 columns.
 
+	// Known header rows beat the heuristics: derive columns from the header.
+	if cbd.hasHeaderOverride() {
+		return cbd.detectBoundariesHeaderBased(elements)
+	}
+
 	// Use edge clustering (proven to work at 66.7%)
 	boundaries := cbd.detectBoundariesEdgeClustering(elements)
 
@@ -109,7 +155,12 @@ func (cbd *ColumnBoundaryDetector) DetectBoundariesWithRulingLines(
 	}
 
 	// Get all text-based boundaries
-	textBoundaries := cbd.detectBoundariesEdgeClustering(elements)
+	var textBoundaries []float64
+	if cbd.hasHeaderOverride() {
+		textBoundaries = cbd.detectBoundariesHeaderBased(elements)
+	} else {
+		textBoundaries = cbd.detectBoundariesEdgeClustering(elements)
+	}
 
 	// If no ruling lines, fall back to text-only
 	if len(rulingLineXPositions) == 0 {
@@ -387,7 +438,18 @@ func (cbd *ColumnBoundaryDetector) detectBoundariesHeaderBased(elements []*extra
 	// Step 2: Detect multi-line header
 	// Header rows have fewer elements than data rows
 	// Heuristic: First N rows where count < 50% of max row count
-	headerRowIndices := cbd.detectMultiLineHeader(rows)
+	// Callers may fix the header rows instead (WithHeaderRows, WithHeaderRowFunc)
+	// or declare that there is none (WithNoHeader).
+	var headerRowIndices []int
+	switch {
+	case cbd.noHeader:
+		// No header: all rows are data rows.
+	case cbd.hasHeaderOverride():
+		sortRowsTopToBottom(rows)
+		headerRowIndices = cbd.overriddenHeaderRows(rows)
+	default:
+		headerRowIndices = cbd.detectMultiLineHeader(rows)
+	}
 
 	// Step 3: Collect ALL elements from header rows
 	var headerElements []*extractor.TextElement
@@ -629,6 +691,43 @@ func (cbd *ColumnBoundaryDetector) detectMultiLineHeader(rows [][]*extractor.Tex
 	return allHeaderIndices
 }
 
+// overriddenHeaderRows returns the indices of the leading rows selected by
+// WithHeaderRowFunc or WithHeaderRows. rows must be sorted top to bottom.
+func (cbd *ColumnBoundaryDetector) overriddenHeaderRows(rows [][]*extractor.TextElement) []int {
+	var indices []int
+	for i, row := range rows {
+		if cbd.isHeaderRow != nil {
+			if !cbd.isHeaderRow(i, rowWords(row)) {
+				break
+			}
+		} else if i >= cbd.headerRows {
+			break
+		}
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// sortRowsTopToBottom sorts rows by descending Y (PDF coordinates) and the
+// elements of each row by X.
+func sortRowsTopToBottom(rows [][]*extractor.TextElement) {
+	for _, row := range rows {
+		sort.SliceStable(row, func(i, j int) bool { return row[i].X < row[j].X })
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return len(rows[i]) > 0 && len(rows[j]) > 0 && rows[i][0].Y > rows[j][0].Y
+	})
+}
+
+// rowWords returns the texts of a row's elements.
+func rowWords(row []*extractor.TextElement) []string {
+	words := make([]string, len(row))
+	for i, elem := range row {
+		words[i] = elem.Text
+	}
+	return words
+}
+
 // createRegionsFromHeaderElements clusters header element X positions to create column regions.
 //
 // Algorithm:
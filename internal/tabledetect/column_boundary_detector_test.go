@@ -203,3 +203,62 @@ func newTextElement(text string, x, y, width, fontSize float64) *extractor.TextE
 		FontName: "Arial",
 	}
 }
+
+func TestColumnBoundaryDetector_HeaderOverride(t *testing.T) {
+	// Two header lines above two data rows of a 3-column table. Data rows
+	// are listed first to check that rows are ordered top to bottom.
+	elements := []*extractor.TextElement{
+		newTextElement("01.02", 50, 80, 30, 10),
+		newTextElement("Salary", 150, 80, 50, 10),
+		newTextElement("3000", 260, 80, 30, 10),
+		newTextElement("02.02", 50, 68, 30, 10),
+		newTextElement("Rent", 150, 68, 30, 10),
+		newTextElement("-1200", 260, 68, 30, 10),
+		newTextElement("Date", 50, 107, 30, 10),
+		newTextElement("Transaction", 150, 107, 60, 10),
+		newTextElement("Amount", 250, 107, 40, 10),
+		newTextElement("details", 150, 95, 40, 10),
+		newTextElement("RUB", 260, 95, 30, 10),
+	}
+
+	var seen [][]string
+	detector := NewColumnBoundaryDetector().WithHeaderRowFunc(func(index int, words []string) bool {
+		seen = append(seen, words)
+		return index < 2
+	})
+	boundaries := detector.DetectBoundaries(elements)
+
+	assert.Equal(t, [][]string{{"Date", "Transaction", "Amount"}, {"details", "RUB"}, {"01.02", "Salary", "3000"}}, seen)
+	assert.Equal(t, 3, len(boundaries)-1, "header should give 3 columns: %v", boundaries)
+
+	rows := NewColumnBoundaryDetector().WithHeaderRows(2).overriddenHeaderRows([][]*extractor.TextElement{{}, {}, {}})
+	assert.Equal(t, []int{0, 1}, rows)
+}
+
+func TestColumnBoundaryDetector_NoHeader(t *testing.T) {
+	// A continuation page without a header. The second line holds the only
+	// value of the last column; the header heuristic takes the first lines
+	// for a header and loses it.
+	elements := []*extractor.TextElement{
+		newTextElement("02.02.2025", 50, 100, 50, 10),
+		newTextElement("Rent", 150, 100, 40, 10),
+		newTextElement("-1 200,00", 300, 100, 45, 10),
+		newTextElement("12 800,00", 400, 88, 45, 10),
+		newTextElement("03.02.2025", 50, 76, 50, 10),
+		newTextElement("Coffee", 150, 76, 30, 10),
+		newTextElement("-3,50", 320, 76, 25, 10),
+		newTextElement("04.02.2025", 50, 64, 50, 10),
+		newTextElement("Salary", 150, 64, 30, 10),
+		newTextElement("3 000,00", 305, 64, 40, 10),
+		newTextElement("05.02.2025", 50, 52, 50, 10),
+		newTextElement("Fee", 150, 52, 30, 10),
+		newTextElement("-1,00", 320, 52, 25, 10),
+	}
+
+	detected := NewColumnBoundaryDetector().detectBoundariesHeaderBased(elements)
+	assert.NotContains(t, detected, 445.0)
+
+	detector := NewColumnBoundaryDetector().WithHeaderRows(2).WithNoHeader(true)
+	assert.False(t, detector.hasHeaderOverride(), "NoHeader should take precedence")
+	assert.Equal(t, []float64{50, 100, 190, 345, 445}, detector.detectBoundariesHeaderBased(elements))
+}
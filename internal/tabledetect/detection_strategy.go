@@ -0,0 +1,200 @@
+package tabledetect
+
+import (
+	"sort"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// DetectionStrategy selects the algorithm used to find column boundaries.
+//
+// Different document families favor different strategies: edge clustering
+// suits bank statements with aligned text (VTB, Alfa-Bank), whitespace
+// analysis suits loosely aligned reports, and the ruling-line strategies
+// suit bordered tables.
+type DetectionStrategy int
+
+const (
+	// StrategyEdgeClustering clusters the left and right edges of text.
+	StrategyEdgeClustering DetectionStrategy = iota
+
+	// StrategyWhitespace places boundaries at vertical whitespace valleys
+	// of the projection profile.
+	StrategyWhitespace
+
+	// StrategyHeaderBased derives columns from the header rows and extends
+	// them with overlapping data rows (Tabula's basic algorithm).
+	StrategyHeaderBased
+
+	// StrategyLattice uses the X positions of vertical ruling lines.
+	StrategyLattice
+
+	// StrategyHybrid snaps vertical ruling lines to nearby text edges,
+	// falling back to edge clustering without ruling lines.
+	StrategyHybrid
+
+	// StrategyAuto runs every other strategy and keeps the boundaries with
+	// the highest consistency (see AutoDetect).
+	StrategyAuto
+)
+
+// AllDetectionStrategies lists every detection strategy except StrategyAuto.
+var AllDetectionStrategies = []DetectionStrategy{
+	StrategyEdgeClustering,
+	StrategyWhitespace,
+	StrategyHeaderBased,
+	StrategyLattice,
+	StrategyHybrid,
+}
+
+// String returns the name of the detection strategy.
+func (s DetectionStrategy) String() string {
+	switch s {
+	case StrategyEdgeClustering:
+		return "EdgeClustering"
+	case StrategyWhitespace:
+		return "Whitespace"
+	case StrategyHeaderBased:
+		return "HeaderBased"
+	case StrategyLattice:
+		return "Lattice"
+	case StrategyHybrid:
+		return "Hybrid"
+	case StrategyAuto:
+		return "Auto"
+	default:
+		return "Unknown"
+	}
+}
+
+// StrategyResult is the outcome of one detection strategy.
+type StrategyResult struct {
+	Strategy    DetectionStrategy // Strategy that produced the boundaries
+	Boundaries  []float64         // Column boundaries, sorted left to right
+	ColumnCount int               // Number of columns (len(Boundaries) - 1)
+	TableType   TableType         // Regular or Irregular, from ValidateConsistency
+	Consistency float64           // Share of rows matching ColumnCount (0-1)
+}
+
+// DetectBoundariesWithStrategy detects column boundaries with the given
+// strategy.
+//
+// graphics are only used by StrategyLattice and StrategyHybrid and may be
+// nil for the text-based strategies.
+//
+// Returns sorted X coordinates, or an empty slice if the strategy finds no
+// columns.
+func (cbd *ColumnBoundaryDetector) DetectBoundariesWithStrategy(
+	strategy DetectionStrategy,
+	elements []*extractor.TextElement,
+	graphics []*extractor.GraphicsElement,
+) []float64 {
+	if len(elements) == 0 {
+		return []float64{}
+	}
+
+	var boundaries []float64
+	switch strategy {
+	case StrategyEdgeClustering:
+		boundaries = cbd.detectBoundariesEdgeClustering(elements)
+	case StrategyWhitespace:
+		boundaries = cbd.detectBoundariesWhitespace(elements)
+	case StrategyHeaderBased:
+		boundaries = cbd.detectBoundariesHeaderBased(elements)
+	case StrategyLattice:
+		boundaries = cbd.verticalRulingLinePositions(graphics)
+	case StrategyHybrid:
+		boundaries = cbd.DetectBoundariesWithRulingLines(elements, cbd.verticalRulingLinePositions(graphics))
+	case StrategyAuto:
+		boundaries = cbd.AutoDetect(elements, graphics).Boundaries
+	}
+	if boundaries == nil {
+		return []float64{}
+	}
+
+	sort.Float64s(boundaries)
+	return boundaries
+}
+
+// AutoDetect runs every strategy, scores it with ValidateConsistency and
+// returns the result with the highest consistency, preferring more columns
+// and then the order of AllDetectionStrategies on ties (so edge clustering
+// wins when all strategies agree).
+//
+// The result has no boundaries when no strategy finds 2 or more columns.
+func (cbd *ColumnBoundaryDetector) AutoDetect(
+	elements []*extractor.TextElement,
+	graphics []*extractor.GraphicsElement,
+) StrategyResult {
+	best := cbd.ScoreStrategies(elements, graphics)[0]
+	if best.ColumnCount < 2 {
+		return StrategyResult{Strategy: StrategyAuto, Boundaries: []float64{}, TableType: IrregularTable}
+	}
+	return best
+}
+
+// ScoreStrategies runs each strategy (all strategies if none are given) and
+// scores its boundaries with ValidateConsistency. StrategyAuto is ignored.
+//
+// Results are sorted by descending consistency, then by descending column
+// count. Strategies finding fewer than 2 columns score 0, since a single
+// column trivially matches every row.
+func (cbd *ColumnBoundaryDetector) ScoreStrategies(
+	elements []*extractor.TextElement,
+	graphics []*extractor.GraphicsElement,
+	strategies ...DetectionStrategy,
+) []StrategyResult {
+	if len(strategies) == 0 {
+		strategies = AllDetectionStrategies
+	}
+
+	results := make([]StrategyResult, 0, len(strategies))
+	for _, strategy := range strategies {
+		if strategy == StrategyAuto {
+			continue
+		}
+		boundaries := cbd.DetectBoundariesWithStrategy(strategy, elements, graphics)
+		result := StrategyResult{
+			Strategy:    strategy,
+			Boundaries:  boundaries,
+			ColumnCount: max(len(boundaries)-1, 0),
+			TableType:   IrregularTable,
+		}
+		if result.ColumnCount >= 2 {
+			result.TableType, result.Consistency = cbd.ValidateConsistency(elements, boundaries, result.ColumnCount)
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Consistency != results[j].Consistency {
+			return results[i].Consistency > results[j].Consistency
+		}
+		return results[i].ColumnCount > results[j].ColumnCount
+	})
+
+	return results
+}
+
+// verticalRulingLinePositions returns the deduplicated X positions of the
+// vertical ruling lines in graphics, sorted left to right.
+func (cbd *ColumnBoundaryDetector) verticalRulingLinePositions(graphics []*extractor.GraphicsElement) []float64 {
+	if len(graphics) == 0 {
+		return nil
+	}
+
+	lines, err := NewDefaultRulingLineDetector().DetectRulingLines(graphics)
+	if err != nil {
+		return nil
+	}
+
+	var positions []float64
+	for _, line := range lines {
+		if !line.IsHorizontal {
+			positions = append(positions, (line.Start.X+line.End.X)/2)
+		}
+	}
+	sort.Float64s(positions)
+
+	return cbd.deduplicateBoundaries(positions, 2.0)
+}
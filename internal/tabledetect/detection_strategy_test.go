@@ -0,0 +1,108 @@
+package tabledetect
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// threeColumnElements returns a 3-column table of 4 rows.
+func threeColumnElements() []*extractor.TextElement {
+	var elements []*extractor.TextElement
+	for i, y := range []float64{100, 88, 76, 64} {
+		elements = append(elements,
+			newTextElement("Date", 50, y, 40, 10),
+			newTextElement("Description", 150, y, 60, 10),
+			newTextElement("1.00", 260+float64(i%2), y, 30, 10),
+		)
+	}
+	return elements
+}
+
+// verticalLine returns a vertical ruling line at x.
+func verticalLine(x float64) *extractor.GraphicsElement {
+	return &extractor.GraphicsElement{
+		Type:   extractor.GraphicsTypeLine,
+		Points: []extractor.Point{extractor.NewPoint(x, 50), extractor.NewPoint(x, 120)},
+	}
+}
+
+func TestDetectionStrategy_String(t *testing.T) {
+	for _, s := range AllDetectionStrategies {
+		assert.NotEqual(t, "Unknown", s.String())
+	}
+	assert.Equal(t, "Unknown", DetectionStrategy(99).String())
+}
+
+func TestColumnBoundaryDetector_DetectBoundariesWithStrategy_Lattice(t *testing.T) {
+	graphics := []*extractor.GraphicsElement{
+		verticalLine(300), verticalLine(40), verticalLine(140), verticalLine(250), verticalLine(250.5),
+	}
+
+	detector := NewColumnBoundaryDetector()
+	boundaries := detector.DetectBoundariesWithStrategy(StrategyLattice, threeColumnElements(), graphics)
+	assert.InDeltaSlice(t, []float64{40, 140, 250, 300}, boundaries, 0.5, "close lines should be merged")
+
+	assert.Empty(t, detector.DetectBoundariesWithStrategy(StrategyLattice, threeColumnElements(), nil))
+	assert.NotEmpty(t, detector.DetectBoundariesWithStrategy(StrategyHybrid, threeColumnElements(), nil),
+		"hybrid should fall back to text without ruling lines")
+}
+
+func TestColumnBoundaryDetector_ScoreStrategies(t *testing.T) {
+	graphics := []*extractor.GraphicsElement{
+		verticalLine(40), verticalLine(140), verticalLine(250), verticalLine(300),
+	}
+
+	results := NewColumnBoundaryDetector().ScoreStrategies(threeColumnElements(), graphics)
+	require.Len(t, results, len(AllDetectionStrategies))
+
+	for i := 1; i < len(results); i++ {
+		assert.GreaterOrEqual(t, results[i-1].Consistency, results[i].Consistency, "results should be sorted")
+	}
+
+	best := results[0]
+	assert.Equal(t, 1.0, best.Consistency)
+	assert.Equal(t, RegularTable, best.TableType)
+	assert.Equal(t, len(best.Boundaries)-1, best.ColumnCount)
+
+	only := NewColumnBoundaryDetector().ScoreStrategies(threeColumnElements(), nil, StrategyLattice)
+	require.Len(t, only, 1)
+	assert.Equal(t, StrategyLattice, only[0].Strategy)
+	assert.Zero(t, only[0].Consistency, "no columns should score 0")
+}
+
+func TestTableDetector_DetectTablesWithStrategy(t *testing.T) {
+	graphics := []*extractor.GraphicsElement{
+		verticalLine(40), verticalLine(140), verticalLine(250), verticalLine(300),
+	}
+
+	regions, err := NewDefaultTableDetector().DetectTablesWithStrategy(threeColumnElements(), graphics, StrategyLattice)
+	require.NoError(t, err)
+	require.Len(t, regions, 1)
+	assert.Equal(t, []float64{40, 140, 250, 300}, regions[0].Columns)
+	assert.True(t, regions[0].HasRulingLines)
+
+	regions, err = NewDefaultTableDetector().DetectTablesWithStrategy(nil, graphics, StrategyLattice)
+	require.NoError(t, err)
+	assert.Empty(t, regions)
+}
+
+func TestColumnBoundaryDetector_AutoDetect(t *testing.T) {
+	detector := NewColumnBoundaryDetector()
+	elements := threeColumnElements()
+
+	graphics := []*extractor.GraphicsElement{
+		verticalLine(40), verticalLine(140), verticalLine(250), verticalLine(300),
+	}
+	result := detector.AutoDetect(elements, graphics)
+	assert.NotEqual(t, StrategyAuto, result.Strategy)
+	assert.Equal(t, 1.0, result.Consistency)
+	assert.GreaterOrEqual(t, result.ColumnCount, 2)
+	assert.Equal(t, result.Boundaries, detector.DetectBoundariesWithStrategy(StrategyAuto, elements, graphics))
+
+	empty := detector.AutoDetect([]*extractor.TextElement{newTextElement("x", 10, 10, 5, 10)}, nil)
+	assert.Equal(t, StrategyAuto, empty.Strategy)
+	assert.Empty(t, empty.Boundaries)
+}
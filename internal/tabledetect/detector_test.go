@@ -403,3 +403,46 @@ func TestTableRegion_String(t *testing.T) {
 	assert.Contains(t, str, "200")
 	assert.Contains(t, str, "100")
 }
+
+func TestTableDetector_DetectTableWithColumns(t *testing.T) {
+	textElements := []*extractor.TextElement{
+		extractor.NewTextElement("Page 1", 10, 300, 30, 10, "/F1", 10), // Left of the table
+		extractor.NewTextElement("Code", 60, 250, 30, 10, "/F1", 10),
+		extractor.NewTextElement("Name", 110, 250, 30, 10, "/F1", 10),
+		extractor.NewTextElement("Qty", 210, 250, 20, 10, "/F1", 10),
+		extractor.NewTextElement("A-1", 60, 230, 20, 10, "/F1", 10),
+		extractor.NewTextElement("Bolt", 110, 230, 30, 10, "/F1", 10),
+		extractor.NewTextElement("12", 210, 230, 10, 10, "/F1", 10),
+		extractor.NewTextElement("B-2", 60, 210, 20, 10, "/F1", 10),
+		extractor.NewTextElement("Nut", 110, 210, 20, 10, "/F1", 10),
+		extractor.NewTextElement("40", 210, 210, 10, 10, "/F1", 10),
+	}
+
+	td := NewDefaultTableDetector()
+	region, err := td.DetectTableWithColumns(textElements, []float64{250, 50, 100, 200})
+	require.NoError(t, err)
+	require.NotNil(t, region)
+	assert.Equal(t, []float64{50, 100, 200, 250}, region.Columns)
+	assert.Equal(t, MethodStream, region.Method)
+
+	tbl, err := NewTableExtractor(textElements).ExtractTable(region)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Code", "Name", "Qty"},
+		{"A-1", "Bolt", "12"},
+		{"B-2", "Nut", "40"},
+	}, tbl.ToStringGrid())
+}
+
+func TestTableDetector_DetectTableWithColumns_Invalid(t *testing.T) {
+	td := NewDefaultTableDetector()
+
+	_, err := td.DetectTableWithColumns(nil, []float64{50})
+	assert.Error(t, err)
+
+	region, err := td.DetectTableWithColumns([]*extractor.TextElement{
+		extractor.NewTextElement("outside", 300, 100, 30, 10, "/F1", 10),
+	}, []float64{50, 100})
+	require.NoError(t, err)
+	assert.Nil(t, region)
+}
@@ -0,0 +1,153 @@
+package tabledetect
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// Fixture format
+//
+// Text elements are stored as TSV (tab-separated values), one element per
+// line, with a header line naming the columns:
+//
+//	x	y	width	height	size	font	text
+//	50	700	25	10	10	/F1	Date
+//	300	700	35	10	10	/F1	Amount
+//
+// Lines starting with '#' are comments, so a fixture can carry notes on the
+// layout it covers and the boundaries it is expected to produce. The text
+// comes last so that text starting with '#' is not taken for a comment.
+// Fixtures let layouts be replayed through the detectors without the PDF
+// they came from.
+
+// elementsTSVHeader is the header line of a text element fixture.
+var elementsTSVHeader = []string{"x", "y", "width", "height", "size", "font", "text"}
+
+// WriteElementsTSV writes text elements as a TSV fixture (see
+// ReadElementsTSV).
+func WriteElementsTSV(w io.Writer, elements []*extractor.TextElement) error {
+	tsv := newTSVWriter(w)
+
+	if err := tsv.Write(elementsTSVHeader); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+	for i, elem := range elements {
+		record := []string{
+			formatCoordinate(elem.X),
+			formatCoordinate(elem.Y),
+			formatCoordinate(elem.Width),
+			formatCoordinate(elem.Height),
+			formatCoordinate(elem.FontSize),
+			elem.FontName,
+			elem.Text,
+		}
+		if err := tsv.Write(record); err != nil {
+			return fmt.Errorf("failed to write element %d: %w", i, err)
+		}
+	}
+
+	tsv.Flush()
+	return tsv.Error()
+}
+
+// ReadElementsTSV reads text elements from a TSV fixture written by
+// WriteElementsTSV or by hand. The header line is required; the font may
+// be empty.
+func ReadElementsTSV(r io.Reader) ([]*extractor.TextElement, error) {
+	tsv := csv.NewReader(r)
+	tsv.Comma = '\t'
+	tsv.Comment = '#'
+	tsv.LazyQuotes = true
+
+	header, err := tsv.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	if len(header) != len(elementsTSVHeader) {
+		return nil, fmt.Errorf("invalid header: expected %d columns, got %d", len(elementsTSVHeader), len(header))
+	}
+
+	var elements []*extractor.TextElement
+	for {
+		record, err := tsv.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		elem, err := parseElementRecord(record)
+		if err != nil {
+			line, _ := tsv.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		elements = append(elements, elem)
+	}
+
+	return elements, nil
+}
+
+// WriteDetectionTSV writes detected column boundaries and the cells
+// assigned to them as TSV, for comparing detector output across changes.
+//
+// The boundaries go on a leading comment line, followed by one line per
+// row of cells:
+//
+//	# boundaries	50	100	210	340
+//	Date	Description	Amount
+//	01.02.2025	Coffee	-12,00
+func WriteDetectionTSV(w io.Writer, boundaries []float64, rows [][]string) error {
+	line := "# boundaries"
+	for _, b := range boundaries {
+		line += "\t" + formatCoordinate(b)
+	}
+	if _, err := io.WriteString(w, line+"\n"); err != nil {
+		return fmt.Errorf("failed to write boundaries: %w", err)
+	}
+
+	tsv := newTSVWriter(w)
+	for i, row := range rows {
+		if err := tsv.Write(row); err != nil {
+			return fmt.Errorf("failed to write row %d: %w", i, err)
+		}
+	}
+
+	tsv.Flush()
+	return tsv.Error()
+}
+
+// parseElementRecord parses one fixture line into a text element.
+func parseElementRecord(record []string) (*extractor.TextElement, error) {
+	var values [5]float64
+	for i := range values {
+		v, err := strconv.ParseFloat(record[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", elementsTSVHeader[i], record[i])
+		}
+		values[i] = v
+	}
+
+	return extractor.NewTextElement(record[6], values[0], values[1], values[2], values[3], record[5], values[4]), nil
+}
+
+// newTSVWriter returns a CSV writer using tabs as separators.
+func newTSVWriter(w io.Writer) *csv.Writer {
+	tsv := csv.NewWriter(w)
+	tsv.Comma = '\t'
+	return tsv
+}
+
+// formatCoordinate formats a coordinate with the fewest digits that
+// round-trip.
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,63 @@
+package tabledetect
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementsTSV_RoundTrip(t *testing.T) {
+	elements := []*extractor.TextElement{
+		extractor.NewTextElement("Date", 50, 700, 25, 10, "/F1", 10),
+		extractor.NewTextElement("#1 tab\there", 50.25, 685.5, 48.125, 9, "", 9),
+		extractor.NewTextElement(`"quoted"`, 300, 685.5, 30, 9, "/F2", 8.5),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteElementsTSV(&buf, elements))
+
+	read, err := ReadElementsTSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, elements, read)
+}
+
+func TestReadElementsTSV_Errors(t *testing.T) {
+	_, err := ReadElementsTSV(strings.NewReader("text\tx\ty\n"))
+	assert.Error(t, err, "short header")
+
+	_, err = ReadElementsTSV(strings.NewReader("x\ty\twidth\theight\tsize\tfont\ttext\n50\tabc\t25\t10\t10\t\tDate\n"))
+	assert.ErrorContains(t, err, `line 2: invalid y "abc"`)
+
+	elements, err := ReadElementsTSV(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, elements)
+}
+
+func TestReadElementsTSV_Fixture(t *testing.T) {
+	f, err := os.Open("testdata/bank_statement.tsv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	elements, err := ReadElementsTSV(f)
+	require.NoError(t, err)
+	require.Len(t, elements, 9)
+
+	boundaries := NewColumnBoundaryDetector().DetectBoundariesWithStrategy(StrategyHeaderBased, elements, nil)
+	assert.Equal(t, []float64{50, 100, 210, 340}, boundaries)
+}
+
+func TestWriteDetectionTSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteDetectionTSV(&buf, []float64{50, 100.5, 340}, [][]string{
+		{"Date", "Amount"},
+		{"01.02.2025", "-12,00"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "# boundaries\t50\t100.5\t340\nDate\tAmount\n01.02.2025\t-12,00\n", buf.String())
+}
@@ -0,0 +1,230 @@
+package tabledetect
+
+import (
+	"math"
+	"strings"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	domaintable "github.com/coregx/gxpdf/internal/models/table"
+)
+
+// RowMergeStrategy selects how continuation rows of multi-line cells are
+// merged back into the row they continue.
+type RowMergeStrategy int
+
+const (
+	// RowMergeNone keeps the rows produced by detection.
+	RowMergeNone RowMergeStrategy = iota
+
+	// RowMergeByGap merges a row into the previous one when the vertical
+	// gap between their text is at most RowMergeOptions.MaxGap.
+	RowMergeByGap
+
+	// RowMergeUntilFullRow merges every row that is not full into the
+	// previous full row. A full row has at least RowMergeOptions.MinFilled
+	// non-empty cells (e.g. date, description and amount of a bank
+	// statement transaction); wrapped description lines have fewer.
+	RowMergeUntilFullRow
+)
+
+// String returns the name of the row merge strategy.
+func (s RowMergeStrategy) String() string {
+	switch s {
+	case RowMergeNone:
+		return "None"
+	case RowMergeByGap:
+		return "ByGap"
+	case RowMergeUntilFullRow:
+		return "UntilFullRow"
+	default:
+		return "Unknown"
+	}
+}
+
+// RowMergeOptions configures MergeRows.
+type RowMergeOptions struct {
+	// Strategy selects which rows are merged.
+	Strategy RowMergeStrategy
+
+	// MaxGap is the largest vertical gap (in points) between the text of
+	// two rows for RowMergeByGap to merge them.
+	// Default: 0 (half the average text height of the table)
+	MaxGap float64
+
+	// MinFilled is the number of non-empty cells that makes a row full
+	// for RowMergeUntilFullRow.
+	// Default: 0 (more than half of the columns)
+	MinFilled int
+
+	// Separator joins the text of merged cells, including the lines of
+	// cells that already span several lines.
+	// Default: "" (a single space)
+	Separator string
+}
+
+// MergeRows merges the continuation rows of tbl according to opts and
+// returns the resulting table. tbl is returned unchanged for RowMergeNone
+// or when no rows are merged.
+//
+// Merged cells keep the row index of the first row and cover the bounds of
+// all merged rows.
+func (te *TableExtractor) MergeRows(tbl *domaintable.Table, opts RowMergeOptions) *domaintable.Table {
+	if tbl == nil || tbl.RowCount < 2 || opts.Strategy == RowMergeNone {
+		return tbl
+	}
+
+	// groups[i] lists the source rows of output row i.
+	var groups [][]int
+	switch opts.Strategy {
+	case RowMergeByGap:
+		groups = te.groupRowsByGap(tbl, opts.MaxGap)
+	case RowMergeUntilFullRow:
+		groups = groupRowsUntilFull(tbl, opts.MinFilled)
+	default:
+		return tbl
+	}
+	if len(groups) == tbl.RowCount {
+		return tbl
+	}
+
+	separator := opts.Separator
+	if separator == "" {
+		separator = " "
+	}
+
+	merged, err := domaintable.NewTable(len(groups), tbl.ColCount)
+	if err != nil {
+		return tbl
+	}
+	merged.PageNum = tbl.PageNum
+	merged.Bounds = tbl.Bounds
+	merged.Method = tbl.Method
+
+	for r, group := range groups {
+		for c := 0; c < tbl.ColCount; c++ {
+			first := tbl.GetCell(group[0], c)
+			if first == nil {
+				continue
+			}
+			var parts []string
+			bounds := first.Bounds
+			for _, src := range group {
+				cell := tbl.GetCell(src, c)
+				if cell == nil {
+					continue
+				}
+				for _, line := range strings.Split(cell.Text, "\n") {
+					if line = strings.TrimSpace(line); line != "" {
+						parts = append(parts, line)
+					}
+				}
+				bounds = unionBounds(bounds, cell.Bounds)
+			}
+			cell := domaintable.NewCellWithBounds(strings.Join(parts, separator), r, c, bounds)
+			cell = cell.WithAlignment(first.TextAlign)
+			if err := merged.SetCell(r, c, cell); err != nil {
+				return tbl
+			}
+		}
+	}
+
+	return merged
+}
+
+// groupRowsByGap groups rows whose text is at most maxGap points below
+// the text of the previous row.
+func (te *TableExtractor) groupRowsByGap(tbl *domaintable.Table, maxGap float64) [][]int {
+	type textSpan struct {
+		top, bottom float64
+		ok          bool
+	}
+
+	spans := make([]textSpan, tbl.RowCount)
+	totalHeight, count := 0.0, 0
+	for r := 0; r < tbl.RowCount; r++ {
+		span := textSpan{top: math.Inf(-1), bottom: math.Inf(1)}
+		for c := 0; c < tbl.ColCount; c++ {
+			cell := tbl.GetCell(r, c)
+			if cell == nil {
+				continue
+			}
+			b := cell.Bounds
+			for _, elem := range te.cellExtractor.FindElementsInBounds(extractor.NewRectangle(b.X, b.Y, b.Width, b.Height)) {
+				span.top = math.Max(span.top, elem.Top())
+				span.bottom = math.Min(span.bottom, elem.Bottom())
+				span.ok = true
+				totalHeight += elem.Height
+				count++
+			}
+		}
+		spans[r] = span
+	}
+
+	if maxGap <= 0 {
+		if count == 0 {
+			return singleRowGroups(tbl.RowCount)
+		}
+		maxGap = totalHeight / float64(count) / 2
+	}
+
+	groups := [][]int{{0}}
+	last := spans[0] // Text span of the last row with text.
+	for r := 1; r < tbl.RowCount; r++ {
+		span := spans[r]
+		if span.ok && last.ok && last.bottom-span.top <= maxGap {
+			groups[len(groups)-1] = append(groups[len(groups)-1], r)
+		} else {
+			groups = append(groups, []int{r})
+		}
+		if span.ok {
+			last = span
+		}
+	}
+	return groups
+}
+
+// groupRowsUntilFull groups every row with fewer than minFilled non-empty
+// cells with the previous full row. Rows before the first full row are
+// kept as they are.
+func groupRowsUntilFull(tbl *domaintable.Table, minFilled int) [][]int {
+	if minFilled <= 0 {
+		minFilled = tbl.ColCount/2 + 1
+	}
+
+	var groups [][]int
+	seenFull := false
+	for r := 0; r < tbl.RowCount; r++ {
+		filled := 0
+		for _, cell := range tbl.GetRow(r) {
+			if cell != nil && !cell.IsEmpty() {
+				filled++
+			}
+		}
+		full := filled >= minFilled
+		if seenFull && !full {
+			groups[len(groups)-1] = append(groups[len(groups)-1], r)
+			continue
+		}
+		seenFull = seenFull || full
+		groups = append(groups, []int{r})
+	}
+	return groups
+}
+
+// singleRowGroups returns one group per row.
+func singleRowGroups(n int) [][]int {
+	groups := make([][]int, n)
+	for i := range groups {
+		groups[i] = []int{i}
+	}
+	return groups
+}
+
+// unionBounds returns the smallest rectangle covering a and b.
+func unionBounds(a, b domaintable.Rectangle) domaintable.Rectangle {
+	left := math.Min(a.Left(), b.Left())
+	bottom := math.Min(a.Bottom(), b.Bottom())
+	right := math.Max(a.Right(), b.Right())
+	top := math.Max(a.Top(), b.Top())
+	return domaintable.NewRectangle(left, bottom, right-left, top-bottom)
+}
@@ -0,0 +1,88 @@
+package tabledetect
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statementTable extracts a 3-column statement table with a header, two
+// transactions and a wrapped description line after the first one.
+func statementTable(t *testing.T) (*TableExtractor, *TableRegion) {
+	t.Helper()
+
+	textElements := []*extractor.TextElement{
+		extractor.NewTextElement("Date", 10, 285, 30, 10, "/F1", 10),
+		extractor.NewTextElement("Description", 110, 285, 60, 10, "/F1", 10),
+		extractor.NewTextElement("Amount", 210, 285, 40, 10, "/F1", 10),
+
+		extractor.NewTextElement("01.02.2025", 10, 255, 50, 10, "/F1", 10),
+		extractor.NewTextElement("Card payment", 110, 255, 60, 10, "/F1", 10),
+		extractor.NewTextElement("-12,00", 210, 255, 40, 10, "/F1", 10),
+		extractor.NewTextElement("COFFEE SHOP", 110, 243, 60, 10, "/F1", 10),
+
+		extractor.NewTextElement("02.02.2025", 10, 215, 50, 10, "/F1", 10),
+		extractor.NewTextElement("Salary", 110, 215, 60, 10, "/F1", 10),
+		extractor.NewTextElement("3 000,00", 210, 215, 40, 10, "/F1", 10),
+	}
+	region := &TableRegion{
+		Bounds:  extractor.NewRectangle(0, 210, 300, 90),
+		Method:  MethodStream,
+		Rows:    []float64{210, 240, 253, 280, 300},
+		Columns: []float64{0, 100, 200, 300},
+	}
+	return NewTableExtractor(textElements), region
+}
+
+func TestMergeRows_UntilFullRow(t *testing.T) {
+	te, region := statementTable(t)
+	tbl, err := te.ExtractTable(region)
+	require.NoError(t, err)
+	require.Equal(t, 4, tbl.RowCount)
+
+	merged := te.MergeRows(tbl, RowMergeOptions{Strategy: RowMergeUntilFullRow})
+	require.Equal(t, 3, merged.RowCount)
+	assert.Equal(t, [][]string{
+		{"Date", "Description", "Amount"},
+		{"01.02.2025", "Card payment COFFEE SHOP", "-12,00"},
+		{"02.02.2025", "Salary", "3 000,00"},
+	}, merged.ToStringGrid())
+
+	cell := merged.GetCell(1, 1)
+	assert.Equal(t, 1, cell.Row)
+	assert.InDelta(t, 240.0, cell.Bounds.Bottom(), 0.001)
+	assert.InDelta(t, 280.0, cell.Bounds.Top(), 0.001)
+}
+
+func TestMergeRows_ByGap(t *testing.T) {
+	te, region := statementTable(t)
+	tbl, err := te.ExtractTable(region)
+	require.NoError(t, err)
+
+	// The wrapped line is 2pt below the transaction; rows are 20pt apart.
+	merged := te.MergeRows(tbl, RowMergeOptions{Strategy: RowMergeByGap, MaxGap: 5})
+	require.Equal(t, 3, merged.RowCount)
+	assert.Equal(t, "Card payment COFFEE SHOP", merged.GetCell(1, 1).Text)
+
+	merged = te.MergeRows(tbl, RowMergeOptions{Strategy: RowMergeByGap, MaxGap: 1})
+	assert.Same(t, tbl, merged, "no rows should be merged below the gap")
+}
+
+func TestMergeRows_Separator(t *testing.T) {
+	te, region := statementTable(t)
+	tbl, err := te.ExtractTable(region)
+	require.NoError(t, err)
+
+	merged := te.MergeRows(tbl, RowMergeOptions{Strategy: RowMergeUntilFullRow, Separator: " / "})
+	assert.Equal(t, "Card payment / COFFEE SHOP", merged.GetCell(1, 1).Text)
+}
+
+func TestMergeRows_None(t *testing.T) {
+	te, region := statementTable(t)
+	tbl, err := te.ExtractTable(region)
+	require.NoError(t, err)
+
+	assert.Same(t, tbl, te.MergeRows(tbl, RowMergeOptions{}))
+}
@@ -3,6 +3,7 @@ package tabledetect
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/coregx/gxpdf/internal/extractor"
 )
@@ -369,3 +370,95 @@ func (td *DefaultTableDetector) DetectTablesStream(
 ) ([]*TableRegion, error) {
 	return td.detectStream(textElements)
 }
+
+// DetectTablesWithStrategy detects a stream table whose columns are found
+// with the given detection strategy instead of the whitespace analyzer's
+// default. Rows are detected by the whitespace analyzer.
+//
+// Use this when a document family is known to favor a strategy; see
+// ColumnBoundaryDetector.ScoreStrategies to compare them.
+func (td *DefaultTableDetector) DetectTablesWithStrategy(
+	textElements []*extractor.TextElement,
+	graphics []*extractor.GraphicsElement,
+	strategy DetectionStrategy,
+) ([]*TableRegion, error) {
+	if len(textElements) == 0 {
+		return []*TableRegion{}, nil
+	}
+
+	columns := td.columnBoundaryDetector().DetectBoundariesWithStrategy(strategy, textElements, graphics)
+	rows := td.whitespaceAnalyzer.DetectRows(textElements)
+
+	// Need at least 2 rows and 2 columns for a table
+	if len(columns) < 2 || len(rows) < 2 {
+		return []*TableRegion{}, nil
+	}
+
+	region := NewTableRegion(td.calculateBoundsFromText(textElements), MethodStream)
+	region.Rows = rows
+	region.Columns = columns
+	region.HasRulingLines = strategy == StrategyLattice
+
+	return []*TableRegion{region}, nil
+}
+
+// columnBoundaryDetector returns the column detector of the whitespace
+// analyzer, so that its settings (such as header row overrides) apply.
+func (td *DefaultTableDetector) columnBoundaryDetector() *ColumnBoundaryDetector {
+	if wa, ok := td.whitespaceAnalyzer.(*DefaultWhitespaceAnalyzer); ok && wa.columnBoundaryDetector != nil {
+		return wa.columnBoundaryDetector
+	}
+	return NewColumnBoundaryDetector()
+}
+
+// DetectTableWithColumns builds a stream table region from known column
+// boundaries, skipping column detection.
+//
+// Text elements are assigned to columns by their left edge (see
+// ColumnBoundaryDetector.AssignToColumns); elements left of the first or
+// right of the last boundary are outside the table. Rows are detected from
+// the remaining elements.
+//
+// Parameters:
+//   - textElements: Text elements extracted from the page
+//   - columns: Column boundaries (X coordinates), at least 2
+//
+// Returns the table region, or nil if no text lies between the boundaries.
+func (td *DefaultTableDetector) DetectTableWithColumns(
+	textElements []*extractor.TextElement,
+	columns []float64,
+) (*TableRegion, error) {
+	if len(columns) < 2 {
+		return nil, fmt.Errorf("need at least 2 column boundaries, got %d", len(columns))
+	}
+	columns = append([]float64(nil), columns...)
+	sort.Float64s(columns)
+
+	var inside []*extractor.TextElement
+	assigned := NewColumnBoundaryDetector().AssignToColumns(textElements, columns)
+	for col := 0; col < len(columns)-1; col++ {
+		for _, elem := range assigned[col] {
+			if elem.X >= columns[0] {
+				inside = append(inside, elem)
+			}
+		}
+	}
+	if len(inside) == 0 {
+		return nil, nil
+	}
+
+	rows := td.whitespaceAnalyzer.DetectRows(inside)
+	if len(rows) < 2 {
+		return nil, nil
+	}
+
+	textBounds := td.calculateBoundsFromText(inside)
+	bounds := extractor.NewRectangle(columns[0], textBounds.Y, columns[len(columns)-1]-columns[0], textBounds.Height)
+
+	region := NewTableRegion(bounds, MethodStream)
+	region.Rows = rows
+	region.Columns = columns
+	region.HasRulingLines = false
+
+	return region, nil
+}
@@ -0,0 +1,473 @@
+package tabledetect
+
+import (
+	"math"
+	"sort"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// TableRegionDetector finds the vertical extent of the tabular part of a
+// page, so that page headers, footers and prose around a table do not
+// pollute column detection.
+//
+// Algorithm (row regularity):
+//  1. Group text elements into lines, top to bottom
+//  2. Split each line into cells at horizontal gaps wider than the font size
+//  3. Find stable columns: cell edges (left or right, for right-aligned
+//     amounts) shared by several multi-cell lines
+//  4. A line is REGULAR if at least 2 of its cells sit on stable columns;
+//     a single-cell line on a stable column is a CONTINUATION (wrapped text)
+//  5. The table is the longest run of regular and continuation lines that
+//     starts and ends with a regular line
+//
+// Page headers such as "Bank XYZ ... Page 1 of 3" have at most one cell on
+// a table column, and prose lines have a single wide cell, so both fall
+// outside the run.
+type TableRegionDetector struct {
+	minRows        int     // Minimum regular lines in a table (default: 3)
+	edgeTolerance  float64 // Tolerance for matching cell edges (default: 3pt)
+	minEdgeSupport float64 // Share of multi-cell lines a stable edge needs (default: 0.3)
+	gapFactor      float64 // Gap, in median line pitches, that separates tables (default: 2.5)
+}
+
+// NewTableRegionDetector creates a new TableRegionDetector with default settings.
+func NewTableRegionDetector() *TableRegionDetector {
+	return &TableRegionDetector{
+		minRows:        3,
+		edgeTolerance:  3.0,
+		minEdgeSupport: 0.3,
+		gapFactor:      2.5,
+	}
+}
+
+// WithMinRows sets the minimum number of regular lines in a table.
+func (d *TableRegionDetector) WithMinRows(n int) *TableRegionDetector {
+	d.minRows = max(n, 1)
+	return d
+}
+
+// WithEdgeTolerance sets the tolerance (in points) for matching cell edges
+// to stable columns.
+func (d *TableRegionDetector) WithEdgeTolerance(tol float64) *TableRegionDetector {
+	d.edgeTolerance = tol
+	return d
+}
+
+// WithGapFactor sets the vertical gap, in median line pitches, that
+// separates two tables in DetectTableRegions.
+func (d *TableRegionDetector) WithGapFactor(factor float64) *TableRegionDetector {
+	d.gapFactor = factor
+	return d
+}
+
+// DetectRegion returns the bounding rectangle of the tabular region, or nil
+// if no run of at least minRows regular lines exists.
+func (d *TableRegionDetector) DetectRegion(elements []*extractor.TextElement) *extractor.Rectangle {
+	lines := d.tableLines(elements)
+	if lines == nil {
+		return nil
+	}
+	rect := linesBounds(lines)
+	return &rect
+}
+
+// TableElements returns the elements of the tabular region, in their
+// original order, or nil if there is no tabular region.
+func (d *TableRegionDetector) TableElements(elements []*extractor.TextElement) []*extractor.TextElement {
+	lines := d.tableLines(elements)
+	if lines == nil {
+		return nil
+	}
+	return newRegion(elements, lines).Elements
+}
+
+// regionLine is a text line split into cells.
+type regionLine struct {
+	elements []*extractor.TextElement // Elements sorted by X
+	cells    []lineCell               // Cells sorted by X
+}
+
+// lineCell is a horizontal run of text within a line.
+type lineCell struct {
+	left, right float64
+}
+
+// lineKind classifies a line by its regularity.
+type lineKind int
+
+const (
+	lineIrregular    lineKind = iota // Prose, page headers and footers
+	lineContinuation                 // Single cell on a stable column (wrapped text)
+	lineRegular                      // At least 2 cells on stable columns
+)
+
+// tableLines returns the lines of the longest regular run, or nil.
+func (d *TableRegionDetector) tableLines(elements []*extractor.TextElement) []regionLine {
+	lines := splitRegionLines(elements)
+	runs := d.regularRuns(lines)
+	if len(runs) == 0 {
+		return nil
+	}
+
+	best := runs[0]
+	for _, run := range runs[1:] {
+		if len(run) > len(best) {
+			best = run
+		}
+	}
+	return best
+}
+
+// regularRuns returns the runs of regular and continuation lines that start
+// and end with a regular line and contain at least minRows regular lines.
+func (d *TableRegionDetector) regularRuns(lines []regionLine) [][]regionLine {
+	kinds := d.classifyLines(lines)
+
+	var runs [][]regionLine
+	start, regular := -1, 0
+	flush := func(end int) {
+		// Trim trailing continuation lines.
+		for end > start && kinds[end-1] != lineRegular {
+			end--
+		}
+		if regular >= d.minRows {
+			runs = append(runs, lines[start:end])
+		}
+		start, regular = -1, 0
+	}
+
+	for i, kind := range kinds {
+		switch {
+		case kind == lineRegular:
+			if start < 0 {
+				start = i
+			}
+			regular++
+		case kind == lineContinuation && start >= 0:
+			// Wrapped cell text inside the table.
+		default:
+			if start >= 0 {
+				flush(i)
+			}
+		}
+	}
+	if start >= 0 {
+		flush(len(kinds))
+	}
+
+	return runs
+}
+
+// classifyLines finds the stable columns of the lines and classifies each
+// line against them.
+func (d *TableRegionDetector) classifyLines(lines []regionLine) []lineKind {
+	var lefts, rights []float64
+	multiCell := 0
+	for _, line := range lines {
+		if len(line.cells) < 2 {
+			continue
+		}
+		multiCell++
+		for _, cell := range line.cells {
+			lefts = append(lefts, cell.left)
+			rights = append(rights, cell.right)
+		}
+	}
+
+	minSupport := max(2, int(math.Ceil(float64(multiCell)*d.minEdgeSupport)))
+	stableLefts := stableEdges(lefts, d.edgeTolerance, minSupport)
+	stableRights := stableEdges(rights, d.edgeTolerance, minSupport)
+
+	kinds := make([]lineKind, len(lines))
+	for i, line := range lines {
+		aligned := 0
+		for _, cell := range line.cells {
+			if nearAny(cell.left, stableLefts, d.edgeTolerance) || nearAny(cell.right, stableRights, d.edgeTolerance) {
+				aligned++
+			}
+		}
+		switch {
+		case aligned >= 2:
+			kinds[i] = lineRegular
+		case aligned == 1 && len(line.cells) == 1:
+			kinds[i] = lineContinuation
+		default:
+			kinds[i] = lineIrregular
+		}
+	}
+	return kinds
+}
+
+// splitRegionLines groups elements into lines sorted top to bottom and
+// splits each line into cells at gaps wider than the font size.
+func splitRegionLines(elements []*extractor.TextElement) []regionLine {
+	if len(elements) == 0 {
+		return nil
+	}
+
+	rows := NewColumnBoundaryDetector().groupElementsByRow(elements)
+	sortRowsTopToBottom(rows)
+
+	lines := make([]regionLine, 0, len(rows))
+	for _, row := range rows {
+		if len(row) == 0 {
+			continue
+		}
+		line := regionLine{elements: row}
+		for _, elem := range row {
+			gap := elem.FontSize
+			if gap <= 0 {
+				gap = elem.Height
+			}
+			if n := len(line.cells); n > 0 && elem.X-line.cells[n-1].right <= gap {
+				line.cells[n-1].right = math.Max(line.cells[n-1].right, elem.Right())
+				continue
+			}
+			line.cells = append(line.cells, lineCell{left: elem.X, right: elem.Right()})
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// stableEdges clusters edge positions and returns the centers of clusters
+// with at least minSupport edges.
+func stableEdges(edges []float64, tolerance float64, minSupport int) []float64 {
+	if len(edges) == 0 {
+		return nil
+	}
+	sorted := append([]float64(nil), edges...)
+	sort.Float64s(sorted)
+
+	var stable []float64
+	start := 0
+	for i := 1; i <= len(sorted); i++ {
+		if i < len(sorted) && sorted[i]-sorted[i-1] <= tolerance {
+			continue
+		}
+		if i-start >= minSupport {
+			sum := 0.0
+			for _, v := range sorted[start:i] {
+				sum += v
+			}
+			stable = append(stable, sum/float64(i-start))
+		}
+		start = i
+	}
+	return stable
+}
+
+// nearAny reports whether x is within tolerance of any of the positions.
+func nearAny(x float64, positions []float64, tolerance float64) bool {
+	for _, p := range positions {
+		if math.Abs(x-p) <= tolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// linesBounds returns the bounding rectangle of the lines' elements.
+func linesBounds(lines []regionLine) extractor.Rectangle {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, line := range lines {
+		for _, elem := range line.elements {
+			minX = math.Min(minX, elem.Left())
+			minY = math.Min(minY, elem.Bottom())
+			maxX = math.Max(maxX, elem.Right())
+			maxY = math.Max(maxY, elem.Top())
+		}
+	}
+	return extractor.NewRectangle(minX, minY, maxX-minX, maxY-minY)
+}
+
+// Region is a tabular region of a page.
+type Region struct {
+	Bounds   extractor.Rectangle      // Bounding rectangle of the elements
+	Elements []*extractor.TextElement // Elements of the region, in their original order
+}
+
+// DetectTableRegions segments a page into its distinct tables, so that
+// each can be detected independently.
+//
+// Algorithm:
+//  1. Split the lines into blocks at vertical gaps larger than gapFactor
+//     times the median line pitch, unless a vertical ruling line from
+//     graphics crosses the gap (one bordered table)
+//  2. Find the regular runs of each block (see TableRegionDetector)
+//  3. Split runs where the column structure changes: a regular line whose
+//     cell edges mostly differ from the previous regular line's, followed
+//     by a line with the new structure (two stacked tables without a gap)
+//
+// graphics may be nil. Returns the regions top to bottom, or nil if the
+// page has no tabular region.
+func (d *TableRegionDetector) DetectTableRegions(
+	elements []*extractor.TextElement,
+	graphics []*extractor.GraphicsElement,
+) []Region {
+	lines := splitRegionLines(elements)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var regions []Region
+	for _, block := range d.splitAtGaps(lines, verticalRulings(graphics)) {
+		for _, run := range d.regularRuns(block) {
+			for _, table := range d.splitAtStructureChanges(run) {
+				regions = append(regions, newRegion(elements, table))
+			}
+		}
+	}
+	return regions
+}
+
+// splitAtGaps splits lines (top to bottom) at large vertical gaps that no
+// vertical ruling line crosses.
+func (d *TableRegionDetector) splitAtGaps(lines []regionLine, rulings []*RulingLine) [][]regionLine {
+	if len(lines) < 2 {
+		return [][]regionLine{lines}
+	}
+
+	pitches := make([]float64, 0, len(lines)-1)
+	for i := 1; i < len(lines); i++ {
+		pitches = append(pitches, lineTop(lines[i-1])-lineTop(lines[i]))
+	}
+	sorted := append([]float64(nil), pitches...)
+	sort.Float64s(sorted)
+	maxPitch := sorted[len(sorted)/2] * d.gapFactor
+
+	var blocks [][]regionLine
+	start := 0
+	for i, pitch := range pitches {
+		if pitch <= maxPitch || rulingCrosses(rulings, lineBottom(lines[i]), lineTop(lines[i+1])) {
+			continue
+		}
+		blocks = append(blocks, lines[start:i+1])
+		start = i + 1
+	}
+	return append(blocks, lines[start:])
+}
+
+// splitAtStructureChanges splits a run where the column structure of its
+// regular lines changes. Parts with fewer than minRows regular lines are
+// kept with the previous part.
+func (d *TableRegionDetector) splitAtStructureChanges(run []regionLine) [][]regionLine {
+	var regular []int // Indices of multi-cell lines in run
+	for i, line := range run {
+		if len(line.cells) >= 2 {
+			regular = append(regular, i)
+		}
+	}
+
+	var parts [][]regionLine
+	start, count := 0, 0
+	for k, i := range regular {
+		if k > 0 && k+1 < len(regular) && count >= d.minRows &&
+			!d.sameStructure(run[regular[k-1]], run[i]) &&
+			d.sameStructure(run[i], run[regular[k+1]]) &&
+			d.countRegular(run[i:]) >= d.minRows {
+			parts = append(parts, run[start:i])
+			start, count = i, 0
+		}
+		count++
+	}
+	return append(parts, run[start:])
+}
+
+// sameStructure reports whether at least half of the cells of b share a
+// left or right edge with a cell of a.
+func (d *TableRegionDetector) sameStructure(a, b regionLine) bool {
+	var lefts, rights []float64
+	for _, cell := range a.cells {
+		lefts = append(lefts, cell.left)
+		rights = append(rights, cell.right)
+	}
+
+	shared := 0
+	for _, cell := range b.cells {
+		if nearAny(cell.left, lefts, d.edgeTolerance) || nearAny(cell.right, rights, d.edgeTolerance) {
+			shared++
+		}
+	}
+	return 2*shared >= len(b.cells)
+}
+
+// countRegular returns the number of multi-cell lines.
+func (d *TableRegionDetector) countRegular(lines []regionLine) int {
+	n := 0
+	for _, line := range lines {
+		if len(line.cells) >= 2 {
+			n++
+		}
+	}
+	return n
+}
+
+// newRegion returns the region of lines, with elements in the order of all.
+func newRegion(all []*extractor.TextElement, lines []regionLine) Region {
+	inRegion := make(map[*extractor.TextElement]bool)
+	for _, line := range lines {
+		for _, elem := range line.elements {
+			inRegion[elem] = true
+		}
+	}
+
+	region := Region{Bounds: linesBounds(lines)}
+	for _, elem := range all {
+		if inRegion[elem] {
+			region.Elements = append(region.Elements, elem)
+		}
+	}
+	return region
+}
+
+// verticalRulings returns the vertical ruling lines of graphics.
+func verticalRulings(graphics []*extractor.GraphicsElement) []*RulingLine {
+	if len(graphics) == 0 {
+		return nil
+	}
+	lines, err := NewDefaultRulingLineDetector().DetectRulingLines(graphics)
+	if err != nil {
+		return nil
+	}
+
+	var vertical []*RulingLine
+	for _, line := range lines {
+		if !line.IsHorizontal {
+			vertical = append(vertical, line)
+		}
+	}
+	return vertical
+}
+
+// rulingCrosses reports whether a vertical ruling line spans from y1 down
+// to y2.
+func rulingCrosses(rulings []*RulingLine, y1, y2 float64) bool {
+	for _, r := range rulings {
+		bottom, top := math.Min(r.Start.Y, r.End.Y), math.Max(r.Start.Y, r.End.Y)
+		if bottom <= math.Min(y1, y2) && top >= math.Max(y1, y2) {
+			return true
+		}
+	}
+	return false
+}
+
+// lineTop returns the highest Y of a line's elements.
+func lineTop(line regionLine) float64 {
+	top := math.Inf(-1)
+	for _, elem := range line.elements {
+		top = math.Max(top, elem.Top())
+	}
+	return top
+}
+
+// lineBottom returns the lowest Y of a line's elements.
+func lineBottom(line regionLine) float64 {
+	bottom := math.Inf(1)
+	for _, elem := range line.elements {
+		bottom = math.Min(bottom, elem.Bottom())
+	}
+	return bottom
+}
@@ -0,0 +1,134 @@
+package tabledetect
+
+import (
+	"testing"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statementPage returns a page with a header line, a prose paragraph, a
+// statement table with a wrapped description and a footer.
+func statementPage() []*extractor.TextElement {
+	return []*extractor.TextElement{
+		// Page header: 2 cells, neither on a table column.
+		newTextElement("ACME BANK", 60, 780, 60, 10),
+		newTextElement("Page 1 of 3", 480, 780, 55, 10),
+
+		// Prose: words separated by normal spaces form one cell.
+		newTextElement("Dear", 50, 740, 22, 10),
+		newTextElement("customer,", 75, 740, 45, 10),
+		newTextElement("here", 123, 740, 20, 10),
+		newTextElement("is", 146, 740, 8, 10),
+		newTextElement("your", 157, 740, 20, 10),
+		newTextElement("statement.", 180, 740, 48, 10),
+
+		// Table.
+		newTextElement("Date", 50, 700, 25, 10),
+		newTextElement("Description", 150, 700, 55, 10),
+		newTextElement("Amount", 310, 700, 35, 10),
+		newTextElement("01.02.2025", 50, 685, 50, 10),
+		newTextElement("Card payment", 150, 685, 60, 10),
+		newTextElement("-12,00", 315, 685, 30, 10),
+		newTextElement("COFFEE SHOP", 150, 673, 60, 10),
+		newTextElement("02.02.2025", 50, 658, 50, 10),
+		newTextElement("Salary", 150, 658, 30, 10),
+		newTextElement("3 000,00", 305, 658, 40, 10),
+		newTextElement("03.02.2025", 50, 643, 50, 10),
+		newTextElement("Rent", 150, 643, 20, 10),
+		newTextElement("-1 200,00", 300, 643, 45, 10),
+
+		// Footer.
+		newTextElement("Thank you for banking with us", 200, 60, 140, 10),
+	}
+}
+
+func TestTableRegionDetector_DetectRegion(t *testing.T) {
+	region := NewTableRegionDetector().DetectRegion(statementPage())
+	require.NotNil(t, region)
+
+	assert.InDelta(t, 50.0, region.Left(), 0.001)
+	assert.InDelta(t, 345.0, region.Right(), 0.001)
+	assert.InDelta(t, 643.0, region.Bottom(), 0.001)
+	assert.InDelta(t, 710.0, region.Top(), 0.001)
+}
+
+func TestTableRegionDetector_TableElements(t *testing.T) {
+	elements := NewTableRegionDetector().TableElements(statementPage())
+
+	var texts []string
+	for _, elem := range elements {
+		texts = append(texts, elem.Text)
+	}
+	assert.Len(t, elements, 13)
+	assert.Contains(t, texts, "COFFEE SHOP", "wrapped lines should stay in the table")
+	assert.NotContains(t, texts, "ACME BANK")
+	assert.NotContains(t, texts, "customer,")
+	assert.NotContains(t, texts, "Thank you for banking with us")
+}
+
+func TestTableRegionDetector_NoTable(t *testing.T) {
+	prose := []*extractor.TextElement{
+		newTextElement("Just", 50, 700, 20, 10),
+		newTextElement("a", 73, 700, 5, 10),
+		newTextElement("letter.", 81, 700, 30, 10),
+		newTextElement("Regards", 50, 685, 40, 10),
+	}
+	d := NewTableRegionDetector()
+	assert.Nil(t, d.DetectRegion(prose))
+	assert.Nil(t, d.TableElements(prose))
+	assert.Nil(t, d.DetectRegion(nil))
+}
+
+// tableLinesAt returns n rows of a table with cells at xs, starting at y
+// and going down by 15pt.
+func tableLinesAt(y float64, n int, xs ...float64) []*extractor.TextElement {
+	var elements []*extractor.TextElement
+	for i := 0; i < n; i++ {
+		for _, x := range xs {
+			elements = append(elements, newTextElement("cell", x, y-float64(i)*15, 30, 10))
+		}
+	}
+	return elements
+}
+
+func TestTableRegionDetector_DetectTableRegions_Gap(t *testing.T) {
+	elements := append(tableLinesAt(700, 4, 50, 150, 300), tableLinesAt(550, 3, 50, 150, 300)...)
+
+	regions := NewTableRegionDetector().DetectTableRegions(elements, nil)
+	require.Len(t, regions, 2)
+	assert.Len(t, regions[0].Elements, 12)
+	assert.Len(t, regions[1].Elements, 9)
+	assert.Greater(t, regions[0].Bounds.Bottom(), regions[1].Bounds.Top(), "regions should be top to bottom")
+}
+
+func TestTableRegionDetector_DetectTableRegions_StructureChange(t *testing.T) {
+	// Two stacked tables at the same line pitch with different columns.
+	elements := append(tableLinesAt(700, 4, 50, 150, 300), tableLinesAt(640, 4, 50, 220, 400, 480)...)
+
+	regions := NewTableRegionDetector().DetectTableRegions(elements, nil)
+	require.Len(t, regions, 2)
+	assert.Len(t, regions[0].Elements, 12)
+	assert.Len(t, regions[1].Elements, 16)
+}
+
+func TestTableRegionDetector_DetectTableRegions_RulingBridgesGap(t *testing.T) {
+	elements := append(tableLinesAt(700, 4, 50, 150, 300), tableLinesAt(550, 3, 50, 150, 300)...)
+	graphics := []*extractor.GraphicsElement{{
+		Type:   extractor.GraphicsTypeLine,
+		Points: []extractor.Point{extractor.NewPoint(40, 500), extractor.NewPoint(40, 720)},
+	}}
+
+	regions := NewTableRegionDetector().DetectTableRegions(elements, graphics)
+	require.Len(t, regions, 1)
+	assert.Len(t, regions[0].Elements, 21)
+}
+
+func TestTableRegionDetector_DetectTableRegions_SingleTable(t *testing.T) {
+	regions := NewTableRegionDetector().DetectTableRegions(statementPage(), nil)
+	require.Len(t, regions, 1)
+	assert.Len(t, regions[0].Elements, 13)
+
+	assert.Nil(t, NewTableRegionDetector().DetectTableRegions(nil, nil))
+}
@@ -79,6 +79,33 @@ func (wa *DefaultWhitespaceAnalyzer) WithProjectionAnalyzer(analyzer ProjectionA
 	return wa
 }
 
+// WithHeaderRows fixes the number of header lines used for column
+// detection (0 = detect). See ColumnBoundaryDetector.WithHeaderRows.
+func (wa *DefaultWhitespaceAnalyzer) WithHeaderRows(n int) *DefaultWhitespaceAnalyzer {
+	if wa.columnBoundaryDetector != nil {
+		wa.columnBoundaryDetector.WithHeaderRows(n)
+	}
+	return wa
+}
+
+// WithHeaderRowFunc selects the header lines used for column detection
+// (nil = detect). See ColumnBoundaryDetector.WithHeaderRowFunc.
+func (wa *DefaultWhitespaceAnalyzer) WithHeaderRowFunc(fn HeaderRowFunc) *DefaultWhitespaceAnalyzer {
+	if wa.columnBoundaryDetector != nil {
+		wa.columnBoundaryDetector.WithHeaderRowFunc(fn)
+	}
+	return wa
+}
+
+// WithNoHeader treats every row as a data row for column detection.
+// See ColumnBoundaryDetector.WithNoHeader.
+func (wa *DefaultWhitespaceAnalyzer) WithNoHeader(noHeader bool) *DefaultWhitespaceAnalyzer {
+	if wa.columnBoundaryDetector != nil {
+		wa.columnBoundaryDetector.WithNoHeader(noHeader)
+	}
+	return wa
+}
+
 // DetectColumns finds vertical alignment patterns (column boundaries).
 //
 // Returns a slice of X coordinates representing column boundaries,
@@ -6,11 +6,12 @@ import (
 	"strings"
 
 	"github.com/coregx/gxpdf/internal/document"
+	"github.com/coregx/gxpdf/internal/fonts"
 )
 
 // WriteAllAnnotations writes all annotations from a page and returns annotation objects.
 //
-// This handles link, text, markup, and stamp annotations.
+// This handles link, text, markup, stamp, free text, and shape annotations.
 //
 // Returns:
 //   - annotObjs: Array of annotation indirect objects
@@ -33,10 +34,18 @@ func (w *PdfWriter) WriteAllAnnotations(
 		annotRefs = append(annotRefs, refs...)
 	}
 
-	// Write text annotations.
-	textAnnots := page.TextAnnotations()
-	if len(textAnnots) > 0 {
-		objs, refs, err := w.writeTextAnnotations(textAnnots)
+	// Write text annotations. Replies are written last, so that the
+	// annotations they reply to already have object numbers.
+	var notes, replies []*document.TextAnnotation
+	for _, annot := range page.TextAnnotations() {
+		if annot.InReplyTo != nil {
+			replies = append(replies, annot)
+		} else {
+			notes = append(notes, annot)
+		}
+	}
+	if len(notes) > 0 {
+		objs, refs, err := w.writeTextAnnotations(notes)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -66,6 +75,35 @@ func (w *PdfWriter) WriteAllAnnotations(
 		annotRefs = append(annotRefs, refs...)
 	}
 
+	// Write free text annotations.
+	freeTextAnnots := page.FreeTextAnnotations()
+	if len(freeTextAnnots) > 0 {
+		objs, refs, err := w.writeFreeTextAnnotations(freeTextAnnots)
+		if err != nil {
+			return nil, nil, err
+		}
+		annotObjs = append(annotObjs, objs...)
+		annotRefs = append(annotRefs, refs...)
+	}
+
+	// Write shape annotations.
+	shapeAnnots := page.ShapeAnnotations()
+	if len(shapeAnnots) > 0 {
+		objs, refs := w.writeShapeAnnotations(shapeAnnots)
+		annotObjs = append(annotObjs, objs...)
+		annotRefs = append(annotRefs, refs...)
+	}
+
+	// Write replies.
+	if len(replies) > 0 {
+		objs, refs, err := w.writeTextAnnotations(replies)
+		if err != nil {
+			return nil, nil, err
+		}
+		annotObjs = append(annotObjs, objs...)
+		annotRefs = append(annotRefs, refs...)
+	}
+
 	return annotObjs, annotRefs, nil
 }
 
@@ -107,6 +145,7 @@ func (w *PdfWriter) writeLinkAnnotations(
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create link annotation %d: %w", objNum, err)
 		}
+		w.recordAnnotation(annot, objNum)
 
 		annotObjs = append(annotObjs, annotObj)
 	}
@@ -129,7 +168,8 @@ func (w *PdfWriter) writeTextAnnotations(
 		objNum := w.allocateObjNum()
 		annotRefs = append(annotRefs, objNum)
 
-		annotObj := createTextAnnotationObject(objNum, annot)
+		annotObj := createTextAnnotationObject(objNum, annot, w.annotNums[annot.InReplyTo])
+		w.recordAnnotation(annot, objNum)
 		annotObjs = append(annotObjs, annotObj)
 	}
 
@@ -151,8 +191,14 @@ func (w *PdfWriter) writeMarkupAnnotations(
 		objNum := w.allocateObjNum()
 		annotRefs = append(annotRefs, objNum)
 
-		annotObj := createMarkupAnnotationObject(objNum, annot)
+		popupObj := w.createPopup(objNum, annot.Popup)
+		annotObj := createMarkupAnnotationObject(objNum, annot, popupNum(popupObj))
+		w.recordAnnotation(annot, objNum)
 		annotObjs = append(annotObjs, annotObj)
+		if popupObj != nil {
+			annotObjs = append(annotObjs, popupObj)
+			annotRefs = append(annotRefs, popupObj.Number)
+		}
 	}
 
 	return annotObjs, annotRefs, nil
@@ -173,13 +219,79 @@ func (w *PdfWriter) writeStampAnnotations(
 		objNum := w.allocateObjNum()
 		annotRefs = append(annotRefs, objNum)
 
-		annotObj := createStampAnnotationObject(objNum, annot)
+		popupObj := w.createPopup(objNum, annot.Popup)
+		annotObj := createStampAnnotationObject(objNum, annot, popupNum(popupObj))
+		w.recordAnnotation(annot, objNum)
 		annotObjs = append(annotObjs, annotObj)
+		if popupObj != nil {
+			annotObjs = append(annotObjs, popupObj)
+			annotRefs = append(annotRefs, popupObj.Number)
+		}
+	}
+
+	return annotObjs, annotRefs, nil
+}
+
+// writeFreeTextAnnotations writes free text annotations.
+//
+// Each annotation is followed by its appearance stream object, which is not
+// listed in the returned references.
+func (w *PdfWriter) writeFreeTextAnnotations(
+	annotations []*document.FreeTextAnnotation,
+) ([]*IndirectObject, []int, error) {
+	if len(annotations) == 0 {
+		return nil, nil, nil
+	}
+
+	annotObjs := make([]*IndirectObject, 0, 2*len(annotations))
+	annotRefs := make([]int, 0, len(annotations))
+
+	for _, annot := range annotations {
+		objNum := w.allocateObjNum()
+		apObjNum := w.allocateObjNum()
+		annotRefs = append(annotRefs, objNum)
+
+		apObj, err := createFreeTextAppearance(apObjNum, annot)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create free text annotation %d: %w", objNum, err)
+		}
+
+		annotObjs = append(annotObjs, createFreeTextAnnotationObject(objNum, apObjNum, annot), apObj)
+		w.recordAnnotation(annot, objNum)
 	}
 
 	return annotObjs, annotRefs, nil
 }
 
+// writeShapeAnnotations writes shape annotations.
+//
+// Each annotation is followed by its appearance stream object, which is not
+// listed in the returned references, and by its pop-up, which is.
+func (w *PdfWriter) writeShapeAnnotations(
+	annotations []*document.ShapeAnnotation,
+) ([]*IndirectObject, []int) {
+	annotObjs := make([]*IndirectObject, 0, 2*len(annotations))
+	annotRefs := make([]int, 0, len(annotations))
+
+	for _, annot := range annotations {
+		objNum := w.allocateObjNum()
+		apObjNum := w.allocateObjNum()
+		annotRefs = append(annotRefs, objNum)
+
+		popupObj := w.createPopup(objNum, annot.Popup)
+		annotObjs = append(annotObjs,
+			createShapeAnnotationObject(objNum, apObjNum, annot, popupNum(popupObj)),
+			createShapeAppearance(apObjNum, annot))
+		w.recordAnnotation(annot, objNum)
+		if popupObj != nil {
+			annotObjs = append(annotObjs, popupObj)
+			annotRefs = append(annotRefs, popupObj.Number)
+		}
+	}
+
+	return annotObjs, annotRefs
+}
+
 // createLinkAnnotationObject creates a link annotation indirect object.
 //
 // PDF annotation format (external link):
@@ -253,8 +365,10 @@ func createLinkAnnotationObject(objNum int, annot *document.LinkAnnotation) (*In
 //	  /T (John Doe)
 //	  /C [1 1 0]
 //	  /Open false
+//	  /IRT 12 0 R  % replies only
+//	  /RT /R
 //	>>
-func createTextAnnotationObject(objNum int, annot *document.TextAnnotation) *IndirectObject {
+func createTextAnnotationObject(objNum int, annot *document.TextAnnotation, irtNum int) *IndirectObject {
 	var buf bytes.Buffer
 
 	buf.WriteString("<<")
@@ -267,6 +381,8 @@ func createTextAnnotationObject(objNum int, annot *document.TextAnnotation) *Ind
 		annot.Rect[0], annot.Rect[1], annot.Rect[2], annot.Rect[3],
 	))
 
+	writeAnnotationFlags(&buf, annot.Flags)
+
 	// Contents (pop-up text).
 	if annot.Contents != "" {
 		escapedContents := EscapePDFString(annot.Contents)
@@ -290,6 +406,11 @@ func createTextAnnotationObject(objNum int, annot *document.TextAnnotation) *Ind
 		buf.WriteString(" /Open false")
 	}
 
+	// Reply: /RT /R marks a reply, as opposed to a grouped annotation.
+	if irtNum != 0 {
+		buf.WriteString(fmt.Sprintf(" /IRT %d 0 R /RT /R", irtNum))
+	}
+
 	buf.WriteString(" >>")
 
 	return NewIndirectObject(objNum, 0, buf.Bytes())
@@ -307,8 +428,9 @@ func createTextAnnotationObject(objNum int, annot *document.TextAnnotation) *Ind
 //	  /C [1 1 0]
 //	  /T (John Doe)
 //	  /Contents (Note text)
+//	  /Popup 13 0 R
 //	>>
-func createMarkupAnnotationObject(objNum int, annot *document.MarkupAnnotation) *IndirectObject {
+func createMarkupAnnotationObject(objNum int, annot *document.MarkupAnnotation, popupNum int) *IndirectObject {
 	var buf bytes.Buffer
 
 	buf.WriteString("<<")
@@ -332,6 +454,8 @@ func createMarkupAnnotationObject(objNum int, annot *document.MarkupAnnotation)
 		annot.Rect[0], annot.Rect[1], annot.Rect[2], annot.Rect[3],
 	))
 
+	writeAnnotationFlags(&buf, annot.Flags)
+
 	// QuadPoints.
 	if len(annot.QuadPoints) > 0 {
 		buf.WriteString(" /QuadPoints [")
@@ -360,6 +484,9 @@ func createMarkupAnnotationObject(objNum int, annot *document.MarkupAnnotation)
 		buf.WriteString(fmt.Sprintf(" /Contents (%s)", escapedContents))
 	}
 
+	// Pop-up window.
+	writePopupRef(&buf, popupNum)
+
 	buf.WriteString(" >>")
 
 	return NewIndirectObject(objNum, 0, buf.Bytes())
@@ -378,7 +505,7 @@ func createMarkupAnnotationObject(objNum int, annot *document.MarkupAnnotation)
 //	  /T (John Doe)
 //	  /Contents (Approved on 2025-01-06)
 //	>>
-func createStampAnnotationObject(objNum int, annot *document.StampAnnotation) *IndirectObject {
+func createStampAnnotationObject(objNum int, annot *document.StampAnnotation, popupNum int) *IndirectObject {
 	var buf bytes.Buffer
 
 	buf.WriteString("<<")
@@ -391,6 +518,8 @@ func createStampAnnotationObject(objNum int, annot *document.StampAnnotation) *I
 		annot.Rect[0], annot.Rect[1], annot.Rect[2], annot.Rect[3],
 	))
 
+	writeAnnotationFlags(&buf, annot.Flags)
+
 	// Name (stamp type).
 	buf.WriteString(fmt.Sprintf(" /Name /%s", annot.Name))
 
@@ -410,7 +539,469 @@ func createStampAnnotationObject(objNum int, annot *document.StampAnnotation) *I
 		buf.WriteString(fmt.Sprintf(" /Contents (%s)", escapedContents))
 	}
 
+	// Pop-up window.
+	writePopupRef(&buf, popupNum)
+
+	buf.WriteString(" >>")
+
+	return NewIndirectObject(objNum, 0, buf.Bytes())
+}
+
+// writeAnnotationFlags writes the /F entry if any flags are set.
+func writeAnnotationFlags(buf *bytes.Buffer, flags document.AnnotationFlags) {
+	if flags != 0 {
+		buf.WriteString(fmt.Sprintf(" /F %d", flags))
+	}
+}
+
+// freeTextFontResource is the font resource name used by free text
+// annotations, both in /DA and in the appearance stream.
+const freeTextFontResource = "Helv"
+
+// freeTextPadding is the space between the border and the text.
+const freeTextPadding = 2.0
+
+// createFreeTextAnnotationObject creates a free text annotation indirect object.
+//
+// PDF annotation format:
+//
+//	<<
+//	  /Type /Annot
+//	  /Subtype /FreeText
+//	  /Rect [x1 y1 x2 y2]
+//	  /Contents (Callout text)
+//	  /DA (/Helv 12 Tf 0 0 0 rg)
+//	  /Q 0
+//	  /BS << /W 1 /S /S >>
+//	  /C [1 1 0.8]
+//	  /AP << /N 12 0 R >>
+//	>>
+//
+// Reference: PDF 1.7 specification, Section 12.5.6.6 (Free Text Annotations).
+func createFreeTextAnnotationObject(objNum, apObjNum int, annot *document.FreeTextAnnotation) *IndirectObject {
+	var buf bytes.Buffer
+
+	buf.WriteString("<<")
+	buf.WriteString(" /Type /Annot")
+	buf.WriteString(" /Subtype /FreeText")
+
+	// Rectangle.
+	buf.WriteString(fmt.Sprintf(
+		" /Rect [%.2f %.2f %.2f %.2f]",
+		annot.Rect[0], annot.Rect[1], annot.Rect[2], annot.Rect[3],
+	))
+
+	writeAnnotationFlags(&buf, annot.Flags)
+
+	// Contents (displayed text).
+	buf.WriteString(fmt.Sprintf(" /Contents %s", EncodeTextString(annot.Contents)))
+
+	// Title (author).
+	if annot.Title != "" {
+		buf.WriteString(fmt.Sprintf(" /T %s", EncodeTextString(annot.Title)))
+	}
+
+	// Default appearance, used by viewers that regenerate the appearance.
+	buf.WriteString(fmt.Sprintf(" /DA (/%s %.2f Tf %.2f %.2f %.2f rg)",
+		freeTextFontResource, annot.FontSize,
+		annot.TextColor[0], annot.TextColor[1], annot.TextColor[2]))
+	buf.WriteString(" /Q 0")
+
+	// Border style.
+	buf.WriteString(fmt.Sprintf(" /BS << /W %.2f /S /S >>", annot.BorderWidth))
+
+	// Background color.
+	if bg := annot.BackgroundColor; bg != nil {
+		buf.WriteString(fmt.Sprintf(" /C [%.2f %.2f %.2f]", bg[0], bg[1], bg[2]))
+	}
+
+	// Normal appearance.
+	buf.WriteString(fmt.Sprintf(" /AP << /N %d 0 R >>", apObjNum))
+
+	buf.WriteString(" >>")
+
+	return NewIndirectObject(objNum, 0, buf.Bytes())
+}
+
+// createFreeTextAppearance creates the appearance stream (a form XObject)
+// of a free text annotation: background, border, and text wrapped to the
+// box width. Lines that do not fit the box height are clipped.
+func createFreeTextAppearance(objNum int, annot *document.FreeTextAnnotation) (*IndirectObject, error) {
+	font, err := getStandard14Font(annot.FontName)
+	if err != nil {
+		return nil, err
+	}
+
+	width := annot.Rect[2] - annot.Rect[0]
+	height := annot.Rect[3] - annot.Rect[1]
+	bw := annot.BorderWidth
+
+	csw := NewContentStreamWriter()
+
+	// Background.
+	if bg := annot.BackgroundColor; bg != nil {
+		csw.SetFillColorRGB(bg[0], bg[1], bg[2])
+		csw.Rectangle(0, 0, width, height)
+		csw.Fill()
+	}
+
+	// Border, inset so that it stays inside the bounding box.
+	if bw > 0 {
+		csw.SetLineWidth(bw)
+		csw.SetStrokeColorRGB(annot.BorderColor[0], annot.BorderColor[1], annot.BorderColor[2])
+		csw.Rectangle(bw/2, bw/2, width-bw, height-bw)
+		csw.Stroke()
+	}
+
+	// Text, clipped to the area inside the border.
+	inset := bw + freeTextPadding
+	lines := wrapFreeText(annot.Contents, font.Name, annot.FontSize, width-2*inset)
+	if len(lines) > 0 {
+		leading := annot.FontSize * 1.2
+		metrics := font.GetMetrics()
+		ascent := annot.FontSize * 0.8
+		if metrics != nil && metrics.Ascender > 0 {
+			ascent = annot.FontSize * float64(metrics.Ascender) / 1000
+		}
+
+		csw.SaveState()
+		csw.Rectangle(bw, bw, width-2*bw, height-2*bw)
+		csw.Clip()
+		csw.EndPath()
+		csw.BeginText()
+		csw.SetFont(freeTextFontResource, annot.FontSize)
+		csw.SetFillColorRGB(annot.TextColor[0], annot.TextColor[1], annot.TextColor[2])
+		csw.SetLeading(leading)
+		csw.MoveTextPosition(inset, height-inset-ascent)
+		for i, line := range lines {
+			if i > 0 {
+				csw.MoveToNextLine()
+			}
+			if line != "" {
+				csw.ShowText(encodeStandardText(annot.FontName, line))
+			}
+		}
+		csw.EndText()
+		csw.RestoreState()
+	}
+
+	resources := fmt.Sprintf("<< /Font << /%s << /Type /Font /Subtype /Type1 /BaseFont /%s",
+		freeTextFontResource, font.Name)
+	if !font.IsSymbolic {
+		resources += " /Encoding /WinAnsiEncoding"
+	}
+	resources += " >> >> >>"
+
+	bbox := [4]float64{0, 0, width, height}
+	return createFormXObject(objNum, bbox, resources, csw.Bytes()), nil
+}
+
+// createFormXObject creates a form XObject stream, as used for annotation
+// appearance streams. resources is the /Resources dictionary, or "" if the
+// content uses no resources.
+//
+// Reference: PDF 1.7 specification, Section 8.10 (Form XObjects).
+func createFormXObject(objNum int, bbox [4]float64, resources string, content []byte) *IndirectObject {
+	var buf bytes.Buffer
+	buf.WriteString("<< /Type /XObject /Subtype /Form")
+	buf.WriteString(fmt.Sprintf(" /BBox [%.2f %.2f %.2f %.2f]", bbox[0], bbox[1], bbox[2], bbox[3]))
+	if resources != "" {
+		buf.WriteString(" /Resources " + resources)
+	}
+	buf.WriteString(fmt.Sprintf(" /Length %d >>\n", len(content)))
+	buf.WriteString("stream\n")
+	buf.Write(content)
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("endstream")
+
+	return NewIndirectObject(objNum, 0, buf.Bytes())
+}
+
+// wrapFreeText splits text into lines that fit maxWidth, breaking at
+// spaces. Newlines in the text always start a new line; words wider than
+// maxWidth are placed on a line of their own.
+func wrapFreeText(text, fontName string, size, maxWidth float64) []string {
+	if text == "" {
+		return nil
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		line := words[0]
+		for _, word := range words[1:] {
+			candidate := line + " " + word
+			if fonts.MeasureString(fontName, candidate, size) <= maxWidth {
+				line = candidate
+				continue
+			}
+			lines = append(lines, line)
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// createShapeAnnotationObject creates a shape annotation indirect object.
+//
+// PDF annotation format (square):
+//
+//	<<
+//	  /Type /Annot
+//	  /Subtype /Square
+//	  /Rect [x1 y1 x2 y2]
+//	  /C [1 0 0]
+//	  /IC [1 1 0]
+//	  /BS << /W 1 /S /S >>
+//	  /AP << /N 12 0 R >>
+//	>>
+//
+// Lines add /L [x1 y1 x2 y2]; ink annotations add /InkList [[x1 y1 ...] ...].
+//
+// Reference: PDF 1.7 specification, Sections 12.5.6.7 (Line Annotations),
+// 12.5.6.8 (Square and Circle Annotations), and 12.5.6.13 (Ink Annotations).
+func createShapeAnnotationObject(objNum, apObjNum int, annot *document.ShapeAnnotation, popupNum int) *IndirectObject {
+	var buf bytes.Buffer
+
+	buf.WriteString("<<")
+	buf.WriteString(" /Type /Annot")
+
+	// Subtype based on annotation type.
+	switch annot.Type {
+	case document.AnnotationTypeLine:
+		buf.WriteString(" /Subtype /Line")
+	case document.AnnotationTypeCircle:
+		buf.WriteString(" /Subtype /Circle")
+	case document.AnnotationTypeInk:
+		buf.WriteString(" /Subtype /Ink")
+	default:
+		buf.WriteString(" /Subtype /Square")
+	}
+
+	// Rectangle.
+	buf.WriteString(fmt.Sprintf(
+		" /Rect [%.2f %.2f %.2f %.2f]",
+		annot.Rect[0], annot.Rect[1], annot.Rect[2], annot.Rect[3],
+	))
+
+	writeAnnotationFlags(&buf, annot.Flags)
+
+	// Geometry.
+	switch annot.Type {
+	case document.AnnotationTypeLine:
+		buf.WriteString(fmt.Sprintf(" /L [%.2f %.2f %.2f %.2f]",
+			annot.LinePoints[0], annot.LinePoints[1], annot.LinePoints[2], annot.LinePoints[3]))
+	case document.AnnotationTypeInk:
+		buf.WriteString(" /InkList [")
+		for i, stroke := range annot.InkList {
+			if i > 0 {
+				buf.WriteString(" ")
+			}
+			coords := make([]string, len(stroke))
+			for j, v := range stroke {
+				coords[j] = fmt.Sprintf("%.2f", v)
+			}
+			buf.WriteString("[" + strings.Join(coords, " ") + "]")
+		}
+		buf.WriteString("]")
+	}
+
+	// Colors.
+	buf.WriteString(fmt.Sprintf(" /C [%.2f %.2f %.2f]",
+		annot.Color[0], annot.Color[1], annot.Color[2]))
+	if ic := annot.InteriorColor; ic != nil && hasShapeInterior(annot.Type) {
+		buf.WriteString(fmt.Sprintf(" /IC [%.2f %.2f %.2f]", ic[0], ic[1], ic[2]))
+	}
+
+	// Border style.
+	buf.WriteString(fmt.Sprintf(" /BS << /W %.2f /S /S >>", annot.BorderWidth))
+
+	// Title (author).
+	if annot.Title != "" {
+		buf.WriteString(fmt.Sprintf(" /T %s", EncodeTextString(annot.Title)))
+	}
+
+	// Contents (note).
+	if annot.Contents != "" {
+		buf.WriteString(fmt.Sprintf(" /Contents %s", EncodeTextString(annot.Contents)))
+	}
+
+	// Normal appearance.
+	buf.WriteString(fmt.Sprintf(" /AP << /N %d 0 R >>", apObjNum))
+
+	// Pop-up window.
+	writePopupRef(&buf, popupNum)
+
+	buf.WriteString(" >>")
+
+	return NewIndirectObject(objNum, 0, buf.Bytes())
+}
+
+// createShapeAppearance creates the appearance stream of a shape annotation.
+//
+// The bounding box is the annotation rectangle, so the shape is drawn in
+// page coordinates. Squares and circles are inset by half the border width
+// to keep the border inside the rectangle.
+func createShapeAppearance(objNum int, annot *document.ShapeAnnotation) *IndirectObject {
+	csw := NewContentStreamWriter()
+
+	bw := annot.BorderWidth
+	stroke := bw > 0
+	fill := annot.InteriorColor != nil && hasShapeInterior(annot.Type)
+
+	if stroke {
+		csw.SetLineWidth(bw)
+		csw.SetStrokeColorRGB(annot.Color[0], annot.Color[1], annot.Color[2])
+	}
+	if fill {
+		ic := annot.InteriorColor
+		csw.SetFillColorRGB(ic[0], ic[1], ic[2])
+	}
+
+	x1, y1, x2, y2 := annot.Rect[0]+bw/2, annot.Rect[1]+bw/2, annot.Rect[2]-bw/2, annot.Rect[3]-bw/2
+
+	switch annot.Type {
+	case document.AnnotationTypeLine:
+		if stroke {
+			csw.MoveTo(annot.LinePoints[0], annot.LinePoints[1])
+			csw.LineTo(annot.LinePoints[2], annot.LinePoints[3])
+			csw.Stroke()
+		}
+	case document.AnnotationTypeInk:
+		if stroke {
+			csw.SetLineCap(1)  // Round cap
+			csw.SetLineJoin(1) // Round join
+			for _, points := range annot.InkList {
+				csw.MoveTo(points[0], points[1])
+				for i := 2; i+1 < len(points); i += 2 {
+					csw.LineTo(points[i], points[i+1])
+				}
+			}
+			csw.Stroke()
+		}
+	case document.AnnotationTypeCircle:
+		appendEllipsePath(csw, (x1+x2)/2, (y1+y2)/2, (x2-x1)/2, (y2-y1)/2)
+		paintShape(csw, fill, stroke)
+	default:
+		csw.Rectangle(x1, y1, x2-x1, y2-y1)
+		paintShape(csw, fill, stroke)
+	}
+
+	return createFormXObject(objNum, annot.Rect, "", csw.Bytes())
+}
+
+// hasShapeInterior reports whether the shape type supports a fill color.
+func hasShapeInterior(t document.AnnotationType) bool {
+	return t == document.AnnotationTypeSquare || t == document.AnnotationTypeCircle
+}
+
+// paintShape fills and/or strokes the current path.
+func paintShape(csw *ContentStreamWriter, fill, stroke bool) {
+	switch {
+	case fill && stroke:
+		csw.FillAndStroke()
+	case fill:
+		csw.Fill()
+	case stroke:
+		csw.Stroke()
+	default:
+		csw.EndPath()
+	}
+}
+
+// appendEllipsePath appends a closed ellipse path made of four Bézier curves.
+func appendEllipsePath(csw *ContentStreamWriter, cx, cy, rx, ry float64) {
+	// kappa = 4/3 * (sqrt(2) - 1) ≈ 0.5522847498
+	const kappa = 0.5522847498
+	kx := rx * kappa
+	ky := ry * kappa
+
+	csw.MoveTo(cx+rx, cy)
+	csw.CurveTo(cx+rx, cy+ky, cx+kx, cy+ry, cx, cy+ry)
+	csw.CurveTo(cx-kx, cy+ry, cx-rx, cy+ky, cx-rx, cy)
+	csw.CurveTo(cx-rx, cy-ky, cx-kx, cy-ry, cx, cy-ry)
+	csw.CurveTo(cx+kx, cy-ry, cx+rx, cy-ky, cx+rx, cy)
+	csw.ClosePath()
+}
+
+// createPopup allocates and creates the pop-up annotation of the annotation
+// parentNum, or returns nil if there is no pop-up.
+func (w *PdfWriter) createPopup(parentNum int, popup *document.Popup) *IndirectObject {
+	if popup == nil {
+		return nil
+	}
+	return createPopupAnnotationObject(w.allocateObjNum(), parentNum, popup)
+}
+
+// popupNum returns the object number of a pop-up, or 0 if there is none.
+func popupNum(popupObj *IndirectObject) int {
+	if popupObj == nil {
+		return 0
+	}
+	return popupObj.Number
+}
+
+// writePopupRef writes the /Popup entry if the annotation has a pop-up.
+func writePopupRef(buf *bytes.Buffer, popupNum int) {
+	if popupNum != 0 {
+		buf.WriteString(fmt.Sprintf(" /Popup %d 0 R", popupNum))
+	}
+}
+
+// createPopupAnnotationObject creates a pop-up annotation indirect object.
+//
+// PDF annotation format:
+//
+//	<<
+//	  /Type /Annot
+//	  /Subtype /Popup
+//	  /Rect [x1 y1 x2 y2]
+//	  /Parent 12 0 R
+//	  /Open false
+//	>>
+//
+// Reference: PDF 1.7 specification, Section 12.5.6.14 (Popup Annotations).
+func createPopupAnnotationObject(objNum, parentNum int, popup *document.Popup) *IndirectObject {
+	var buf bytes.Buffer
+
+	buf.WriteString("<<")
+	buf.WriteString(" /Type /Annot")
+	buf.WriteString(" /Subtype /Popup")
+
+	// Rectangle.
+	buf.WriteString(fmt.Sprintf(
+		" /Rect [%.2f %.2f %.2f %.2f]",
+		popup.Rect[0], popup.Rect[1], popup.Rect[2], popup.Rect[3],
+	))
+
+	// Parent (annotation whose text is shown).
+	buf.WriteString(fmt.Sprintf(" /Parent %d 0 R", parentNum))
+
+	// Open flag.
+	if popup.Open {
+		buf.WriteString(" /Open true")
+	} else {
+		buf.WriteString(" /Open false")
+	}
+
 	buf.WriteString(" >>")
 
 	return NewIndirectObject(objNum, 0, buf.Bytes())
 }
+
+// recordAnnotation remembers the object number of an annotation, so that
+// replies to it can reference it.
+func (w *PdfWriter) recordAnnotation(annot document.Annotation, objNum int) {
+	if w.annotNums == nil {
+		w.annotNums = make(map[document.Annotation]int)
+	}
+	w.annotNums[annot] = objNum
+}
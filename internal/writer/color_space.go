@@ -0,0 +1,39 @@
+package writer
+
+import "fmt"
+
+// IndexedColorSpace represents an Indexed color space: a palette (lookup
+// table) of colors in a device base color space.
+type IndexedColorSpace struct {
+	// ID identifies the color space; color spaces with the same ID are
+	// listed once in a page's resources.
+	ID string
+
+	// Base is the base color space of the palette (e.g., "DeviceRGB").
+	Base string
+
+	// Palette holds the color components of each entry, one byte each.
+	Palette []byte
+}
+
+// IndexedColor represents a color selected by index from an Indexed color
+// space.
+type IndexedColor struct {
+	Space *IndexedColorSpace
+	Index int
+}
+
+// Array returns the color space as a PDF array, e.g.
+// [/Indexed /DeviceRGB 1 <FF00000000FF>] for a red and blue palette.
+func (cs *IndexedColorSpace) Array() string {
+	return indexedColorSpaceArray(cs.Base, cs.Palette)
+}
+
+// indexedColorSpaceArray formats an Indexed color space array over a base
+// device color space. The palette is written as a hexadecimal string.
+//
+// Reference: PDF 1.7 specification, Section 8.6.6.3 (Indexed Color Spaces).
+func indexedColorSpaceArray(base string, palette []byte) string {
+	hival := len(palette)/colorSpaceComponents(base) - 1
+	return fmt.Sprintf("[/Indexed /%s %d <%X>]", base, hival, palette)
+}
@@ -0,0 +1,10 @@
+package writer
+
+import "testing"
+
+func TestIndexedColorSpace_Array(t *testing.T) {
+	cs := &IndexedColorSpace{Base: "DeviceRGB", Palette: []byte{255, 0, 0, 0, 0, 255}}
+	if got, want := cs.Array(), "[/Indexed /DeviceRGB 1 <FF00000000FF>]"; got != want {
+		t.Errorf("Array() = %q, want %q", got, want)
+	}
+}
@@ -4,6 +4,8 @@ package writer
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/coregx/gxpdf/internal/encoding"
@@ -28,18 +30,122 @@ import (
 type ContentStreamWriter struct {
 	buf         bytes.Buffer
 	compression CompressionLevel // Compression level (default: DefaultCompression)
+	precision   int              // Decimal places for numbers (default: DefaultPrecision)
 }
 
+// DefaultPrecision is the default number of decimal places written for
+// coordinates and lengths.
+const DefaultPrecision = 2
+
+// finePrecision is the number of decimal places written for color
+// components and the scale, rotation and skew terms of matrices. The
+// precision setting does not apply to them: rounded to a coordinate's
+// precision they would visibly shift colors and distort rotations.
+const finePrecision = 4
+
+// MaxPrecision is the largest supported number of decimal places.
+const MaxPrecision = 6
+
 // NewContentStreamWriter creates a new content stream writer.
 //
-// By default, compression is enabled with DefaultCompression level.
-// Use SetCompression to change the compression level.
+// By default, compression is enabled with DefaultCompression level and
+// numbers are written with DefaultPrecision decimal places.
+// Use SetCompression and SetPrecision to change these.
 func NewContentStreamWriter() *ContentStreamWriter {
 	return &ContentStreamWriter{
 		compression: DefaultCompression,
+		precision:   DefaultPrecision,
 	}
 }
 
+// SetPrecision sets the number of decimal places written for coordinates
+// and lengths. Values are clamped to [0, MaxPrecision]. Color components
+// and matrix scale and skew terms keep at least 4 decimal places.
+//
+// Lower precision produces smaller, more deterministic content streams;
+// 2 decimals (1/7200 inch) is finer than any printer resolution.
+func (csw *ContentStreamWriter) SetPrecision(digits int) {
+	csw.precision = clampPrecision(digits)
+}
+
+// Precision returns the number of decimal places written for numbers.
+func (csw *ContentStreamWriter) Precision() int {
+	return csw.precision
+}
+
+// clampPrecision limits a precision to the supported range.
+func clampPrecision(digits int) int {
+	return max(0, min(digits, MaxPrecision))
+}
+
+// num formats a coordinate or length with the writer's precision.
+func (csw *ContentStreamWriter) num(v float64) string {
+	return formatNumber(v, csw.precision, csw.precision)
+}
+
+// fine formats a color component or a matrix scale or skew term with at
+// least finePrecision decimal places. Trailing zeros beyond the writer's
+// precision are dropped, so exact values look like coordinates.
+func (csw *ContentStreamWriter) fine(v float64) string {
+	return formatNumber(v, max(csw.precision, finePrecision), csw.precision)
+}
+
+// formatNumber formats v with digits decimal places, dropping trailing
+// zeros beyond minDigits.
+//
+// Values that round to zero are written without a sign ("0.00", not
+// "-0.00") so output does not depend on floating-point noise.
+func formatNumber(v float64, digits, minDigits int) string {
+	s := strconv.FormatFloat(v, 'f', digits, 64)
+	if digits > minDigits {
+		dot := len(s) - digits - 1
+		end := len(s)
+		for end > dot+1+minDigits && s[end-1] == '0' {
+			end--
+		}
+		if end == dot+1 {
+			end = dot
+		}
+		s = s[:end]
+	}
+	if s[0] == '-' && strings.Trim(s[1:], "0.") == "" {
+		return s[1:]
+	}
+	return s
+}
+
+// round rounds a number to the writer's precision, i.e. to the value a
+// reader gets back after the number is written.
+func (csw *ContentStreamWriter) round(v float64) float64 {
+	scale := math.Pow10(csw.precision)
+	return math.Round(v*scale) / scale
+}
+
+// nums formats coordinates or lengths with the writer's precision,
+// separated by spaces.
+func (csw *ContentStreamWriter) nums(vs ...float64) string {
+	parts := make([]string, len(vs))
+	for i, v := range vs {
+		parts[i] = csw.num(v)
+	}
+	return strings.Join(parts, " ")
+}
+
+// fines formats color components with fine, separated by spaces.
+func (csw *ContentStreamWriter) fines(vs ...float64) string {
+	parts := make([]string, len(vs))
+	for i, v := range vs {
+		parts[i] = csw.fine(v)
+	}
+	return strings.Join(parts, " ")
+}
+
+// matrix formats a transformation matrix: the scale, rotation and skew
+// terms a to d with fine, the translation e and f as coordinates.
+func (csw *ContentStreamWriter) matrix(a, b, c, d, e, f float64) string {
+	return csw.fines(a, b, c, d) + " " + csw.nums(e, f)
+}
+
 // Bytes returns the accumulated content stream data.
 func (csw *ContentStreamWriter) Bytes() []byte {
 	return csw.buf.Bytes()
@@ -94,7 +200,7 @@ func (csw *ContentStreamWriter) EndText() {
 //
 // Reference: PDF 1.7 Spec, Section 9.3 (Text State Parameters and Operators).
 func (csw *ContentStreamWriter) SetFont(fontName string, size float64) {
-	csw.writeOp(fmt.Sprintf("/%s %.2f", fontName, size), "Tf")
+	csw.writeOp("/"+fontName+" "+csw.num(size), "Tf")
 }
 
 // MoveTextPosition moves to the start of the next line (Td operator).
@@ -105,7 +211,7 @@ func (csw *ContentStreamWriter) SetFont(fontName string, size float64) {
 //
 // Reference: PDF 1.7 Spec, Section 9.4.2 (Text-Positioning Operators).
 func (csw *ContentStreamWriter) MoveTextPosition(tx, ty float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f", tx, ty), "Td")
+	csw.writeOp(csw.nums(tx, ty), "Td")
 }
 
 // MoveTextPositionSetLeading moves to next line and sets leading (TD operator).
@@ -116,7 +222,7 @@ func (csw *ContentStreamWriter) MoveTextPosition(tx, ty float64) {
 //
 // Reference: PDF 1.7 Spec, Section 9.4.2 (Text-Positioning Operators).
 func (csw *ContentStreamWriter) MoveTextPositionSetLeading(tx, ty float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f", tx, ty), "TD")
+	csw.writeOp(csw.nums(tx, ty), "TD")
 }
 
 // SetTextMatrix sets the text matrix (Tm operator).
@@ -129,7 +235,18 @@ func (csw *ContentStreamWriter) MoveTextPositionSetLeading(tx, ty float64) {
 //
 // Reference: PDF 1.7 Spec, Section 9.4.2 (Text-Positioning Operators).
 func (csw *ContentStreamWriter) SetTextMatrix(a, b, c, d, e, f float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f %.2f %.2f %.2f", a, b, c, d, e, f), "Tm")
+	csw.writeOp(csw.matrix(a, b, c, d, e, f), "Tm")
+}
+
+// SetTextRenderingMode sets the text rendering mode (Tr operator).
+//
+// Parameters:
+//   - mode: 0 = fill, 1 = stroke, 2 = fill then stroke, 3 = invisible,
+//     4-7 = modes 0-3 and add to clipping path
+//
+// Reference: PDF 1.7 Spec, Section 9.3.6 (Text Rendering Mode).
+func (csw *ContentStreamWriter) SetTextRenderingMode(mode int) {
+	csw.writeOp(fmt.Sprintf("%d", mode), "Tr")
 }
 
 // ShowText shows a text string (Tj operator).
@@ -157,6 +274,44 @@ func (csw *ContentStreamWriter) ShowTextEncoded(encodedText string) {
 	csw.writeOp(encodedText, "Tj")
 }
 
+// TextSpan is an element of a TJ array: text preceded by a positioning
+// adjustment.
+type TextSpan struct {
+	// Adjust is subtracted from the horizontal position before the text is
+	// shown, in thousandths of a text space unit (positive moves left).
+	Adjust float64
+
+	// Text is the text to display (will be escaped).
+	Text string
+}
+
+// ShowTextArray shows text strings with individual positioning (TJ operator).
+//
+// Example:
+//
+//	csw.ShowTextArray([]TextSpan{{Text: "Name"}, {Adjust: -5000, Text: "Price"}})
+//	// Output: [(Name) -5000.00 (Price)] TJ
+//
+// Reference: PDF 1.7 Spec, Section 9.4.3 (Text-Showing Operators).
+func (csw *ContentStreamWriter) ShowTextArray(spans []TextSpan) {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, span := range spans {
+		if span.Adjust != 0 {
+			sb.WriteString(csw.num(span.Adjust))
+			sb.WriteString(" ")
+		}
+		sb.WriteString("(")
+		sb.WriteString(EscapePDFString(span.Text))
+		sb.WriteString(")")
+		if i < len(spans)-1 {
+			sb.WriteString(" ")
+		}
+	}
+	sb.WriteString("]")
+	csw.writeOp(sb.String(), "TJ")
+}
+
 // ShowTextNextLine moves to next line and shows text (' operator).
 //
 // Equivalent to: T* followed by Tj.
@@ -179,7 +334,7 @@ func (csw *ContentStreamWriter) ShowTextNextLine(text string) {
 //
 // Reference: PDF 1.7 Spec, Section 9.3.5 (Text State Parameters).
 func (csw *ContentStreamWriter) SetLeading(leading float64) {
-	csw.writeOp(fmt.Sprintf("%.2f", leading), "TL")
+	csw.writeOp(csw.nums(leading), "TL")
 }
 
 // MoveToNextLine moves to the start of the next line (T* operator).
@@ -198,7 +353,7 @@ func (csw *ContentStreamWriter) MoveToNextLine() {
 //
 // Reference: PDF 1.7 Spec, Section 8.5.2 (Path Construction Operators).
 func (csw *ContentStreamWriter) MoveTo(x, y float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f", x, y), "m")
+	csw.writeOp(csw.nums(x, y), "m")
 }
 
 // LineTo appends a straight line segment (l operator).
@@ -208,7 +363,7 @@ func (csw *ContentStreamWriter) MoveTo(x, y float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.5.2 (Path Construction Operators).
 func (csw *ContentStreamWriter) LineTo(x, y float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f", x, y), "l")
+	csw.writeOp(csw.nums(x, y), "l")
 }
 
 // CurveTo appends a cubic Bezier curve (c operator).
@@ -220,7 +375,7 @@ func (csw *ContentStreamWriter) LineTo(x, y float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.5.2 (Path Construction Operators).
 func (csw *ContentStreamWriter) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f %.2f %.2f %.2f", x1, y1, x2, y2, x3, y3), "c")
+	csw.writeOp(csw.nums(x1, y1, x2, y2, x3, y3), "c")
 }
 
 // Rectangle appends a rectangle (re operator).
@@ -231,7 +386,7 @@ func (csw *ContentStreamWriter) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.5.2 (Path Construction Operators).
 func (csw *ContentStreamWriter) Rectangle(x, y, width, height float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f %.2f", x, y, width, height), "re")
+	csw.writeOp(csw.nums(x, y, width, height), "re")
 }
 
 // ClosePath closes the current subpath (h operator).
@@ -343,7 +498,31 @@ func (csw *ContentStreamWriter) RestoreState() {
 //
 // Reference: PDF 1.7 Spec, Section 8.4.4 (Coordinate Systems).
 func (csw *ContentStreamWriter) ConcatMatrix(a, b, c, d, e, f float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f %.2f %.2f %.2f", a, b, c, d, e, f), "cm")
+	csw.writeOp(csw.matrix(a, b, c, d, e, f), "cm")
+}
+
+// DrawXObject paints an XObject, such as an image (Do operator).
+//
+// Parameters:
+//   - name: XObject resource name (e.g., "Im1")
+//
+// An image is painted into the unit square; use ConcatMatrix to scale
+// and position it first.
+//
+// Reference: PDF 1.7 Spec, Section 8.8 (External Objects).
+func (csw *ContentStreamWriter) DrawXObject(name string) {
+	csw.writeOp("/"+name, "Do")
+}
+
+// PaintShading paints a shading over the current clipping path (sh
+// operator).
+//
+// Parameters:
+//   - name: Shading resource name (e.g., "Sh1")
+//
+// Reference: PDF 1.7 Spec, Section 8.7.4.2 (Shading Operator).
+func (csw *ContentStreamWriter) PaintShading(name string) {
+	csw.writeOp("/"+name, "sh")
 }
 
 // SetLineWidth sets the line width (w operator).
@@ -353,7 +532,7 @@ func (csw *ContentStreamWriter) ConcatMatrix(a, b, c, d, e, f float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.4.3 (Graphics State Parameters).
 func (csw *ContentStreamWriter) SetLineWidth(width float64) {
-	csw.writeOp(fmt.Sprintf("%.2f", width), "w")
+	csw.writeOp(csw.nums(width), "w")
 }
 
 // SetLineCap sets the line cap style (J operator).
@@ -383,7 +562,7 @@ func (csw *ContentStreamWriter) SetLineJoin(style int) {
 //
 // Reference: PDF 1.7 Spec, Section 8.4.3 (Graphics State Parameters).
 func (csw *ContentStreamWriter) SetMiterLimit(limit float64) {
-	csw.writeOp(fmt.Sprintf("%.2f", limit), "M")
+	csw.writeOp(csw.nums(limit), "M")
 }
 
 // SetDashPattern sets the line dash pattern (d operator).
@@ -396,9 +575,9 @@ func (csw *ContentStreamWriter) SetMiterLimit(limit float64) {
 func (csw *ContentStreamWriter) SetDashPattern(dashArray []float64, dashPhase float64) {
 	parts := make([]string, 0, len(dashArray))
 	for _, v := range dashArray {
-		parts = append(parts, fmt.Sprintf("%.2f", v))
+		parts = append(parts, csw.nums(v))
 	}
-	csw.writeOp(fmt.Sprintf("[%s] %.2f", strings.Join(parts, " "), dashPhase), "d")
+	csw.writeOp("["+strings.Join(parts, " ")+"] "+csw.num(dashPhase), "d")
 }
 
 // SetStrokeColorRGB sets the stroke color in RGB (RG operator).
@@ -408,7 +587,7 @@ func (csw *ContentStreamWriter) SetDashPattern(dashArray []float64, dashPhase fl
 //
 // Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
 func (csw *ContentStreamWriter) SetStrokeColorRGB(r, g, b float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f", r, g, b), "RG")
+	csw.writeOp(csw.fines(r, g, b), "RG")
 }
 
 // SetFillColorRGB sets the fill color in RGB (rg operator).
@@ -418,7 +597,7 @@ func (csw *ContentStreamWriter) SetStrokeColorRGB(r, g, b float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
 func (csw *ContentStreamWriter) SetFillColorRGB(r, g, b float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f", r, g, b), "rg")
+	csw.writeOp(csw.fines(r, g, b), "rg")
 }
 
 // SetStrokeColorGray sets the stroke color in grayscale (G operator).
@@ -428,7 +607,7 @@ func (csw *ContentStreamWriter) SetFillColorRGB(r, g, b float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
 func (csw *ContentStreamWriter) SetStrokeColorGray(gray float64) {
-	csw.writeOp(fmt.Sprintf("%.2f", gray), "G")
+	csw.writeOp(csw.fines(gray), "G")
 }
 
 // SetFillColorGray sets the fill color in grayscale (g operator).
@@ -438,7 +617,7 @@ func (csw *ContentStreamWriter) SetStrokeColorGray(gray float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
 func (csw *ContentStreamWriter) SetFillColorGray(gray float64) {
-	csw.writeOp(fmt.Sprintf("%.2f", gray), "g")
+	csw.writeOp(csw.fines(gray), "g")
 }
 
 // SetStrokeColorCMYK sets the stroke color in CMYK (K operator).
@@ -448,7 +627,7 @@ func (csw *ContentStreamWriter) SetFillColorGray(gray float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
 func (csw *ContentStreamWriter) SetStrokeColorCMYK(c, m, y, k float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f %.2f", c, m, y, k), "K")
+	csw.writeOp(csw.fines(c, m, y, k), "K")
 }
 
 // SetFillColorCMYK sets the fill color in CMYK (k operator).
@@ -458,7 +637,26 @@ func (csw *ContentStreamWriter) SetStrokeColorCMYK(c, m, y, k float64) {
 //
 // Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
 func (csw *ContentStreamWriter) SetFillColorCMYK(c, m, y, k float64) {
-	csw.writeOp(fmt.Sprintf("%.2f %.2f %.2f %.2f", c, m, y, k), "k")
+	csw.writeOp(csw.fines(c, m, y, k), "k")
+}
+
+// SetFillColorSpace sets the fill color space to a color space resource
+// (cs operator).
+//
+// Parameters:
+//   - name: Color space resource name (e.g., "CS1")
+//
+// Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
+func (csw *ContentStreamWriter) SetFillColorSpace(name string) {
+	csw.writeOp("/"+name, "cs")
+}
+
+// SetFillColorIndex sets the fill color to a palette entry of the current
+// Indexed fill color space (sc operator).
+//
+// Reference: PDF 1.7 Spec, Section 8.6.8 (Color Operators).
+func (csw *ContentStreamWriter) SetFillColorIndex(index int) {
+	csw.writeOp(strconv.Itoa(index), "sc")
 }
 
 // SetGraphicsState applies an extended graphics state (gs operator).
@@ -1,6 +1,8 @@
 package writer
 
 import (
+	"fmt"
+	"math"
 	"strings"
 	"testing"
 
@@ -50,6 +52,13 @@ func TestContentStreamWriter_TextOperators(t *testing.T) {
 			},
 			expected: "1.00 0.00 0.00 1.00 50.00 750.00 Tm\n",
 		},
+		{
+			name: "SetTextRenderingMode",
+			build: func(csw *ContentStreamWriter) {
+				csw.SetTextRenderingMode(2)
+			},
+			expected: "2 Tr\n",
+		},
 		{
 			name: "ShowText",
 			build: func(csw *ContentStreamWriter) {
@@ -64,6 +73,13 @@ func TestContentStreamWriter_TextOperators(t *testing.T) {
 			},
 			expected: "(Text with \\(parentheses\\) and \\\\backslash) Tj\n",
 		},
+		{
+			name: "ShowTextArray",
+			build: func(csw *ContentStreamWriter) {
+				csw.ShowTextArray([]TextSpan{{Text: "Name"}, {Adjust: -5000, Text: "(Price)"}})
+			},
+			expected: "[(Name) -5000.00 (\\(Price\\))] TJ\n",
+		},
 		{
 			name: "ShowTextNextLine",
 			build: func(csw *ContentStreamWriter) {
@@ -256,6 +272,13 @@ func TestContentStreamWriter_GraphicsStateOperators(t *testing.T) {
 			},
 			expected: "2.00 0.00 0.00 2.00 0.00 0.00 cm\n",
 		},
+		{
+			name: "DrawXObject",
+			build: func(csw *ContentStreamWriter) {
+				csw.DrawXObject("Im1")
+			},
+			expected: "/Im1 Do\n",
+		},
 		{
 			name: "SetLineWidth",
 			build: func(csw *ContentStreamWriter) {
@@ -698,3 +721,76 @@ func BenchmarkContentStreamWriter_Compression(b *testing.B) {
 		}
 	}
 }
+
+// TestContentStreamWriter_Precision tests numeric operand precision.
+func TestContentStreamWriter_Precision(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		expected  string
+	}{
+		{"default", DefaultPrecision, "123.46 -0.50 l\n"},
+		{"zero", 0, "123 0 l\n"},
+		{"four", 4, "123.4560 -0.5000 l\n"},
+		{"clamped high", 20, "123.456000 -0.500000 l\n"},
+		{"clamped low", -3, "123 0 l\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csw := NewContentStreamWriter()
+			csw.SetPrecision(tt.precision)
+			csw.LineTo(123.45600000001, -0.5)
+			if got := csw.String(); got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestContentStreamWriter_NegativeZero tests that values rounding to zero
+// are written without a sign.
+func TestContentStreamWriter_NegativeZero(t *testing.T) {
+	csw := NewContentStreamWriter()
+	csw.MoveTo(-0.001, -1e-12)
+	csw.SetDashPattern([]float64{-0.0001}, -0.004)
+
+	expected := "0.00 0.00 m\n[0.00] 0.00 d\n"
+	if got := csw.String(); got != expected {
+		t.Errorf("got %q, want %q", got, expected)
+	}
+}
+
+// TestContentStreamWriter_PrecisionColorsAndMatrices tests that low
+// precision rounds coordinates only, not color components or the scale
+// and skew terms of matrices.
+func TestContentStreamWriter_PrecisionColorsAndMatrices(t *testing.T) {
+	cos, sin := math.Cos(math.Pi/6), math.Sin(math.Pi/6)
+	tests := []struct {
+		precision int
+		expected  string
+	}{
+		{0, "0.5 0.25 0.75 rg\n0.3 0.6 0.9 RG\n0.1 0.2 0.3 0.4 k\n0.55 g\n" +
+			"86.6025 50 -50 86.6025 100 501 cm\n1 0 0.2126 1 72 721 Tm\n"},
+		{1, "0.5 0.25 0.75 rg\n0.3 0.6 0.9 RG\n0.1 0.2 0.3 0.4 k\n0.55 g\n" +
+			"86.6025 50.0 -50.0 86.6025 100.0 500.6 cm\n1.0 0.0 0.2126 1.0 72.0 720.6 Tm\n"},
+		{DefaultPrecision, "0.50 0.25 0.75 rg\n0.30 0.60 0.90 RG\n0.10 0.20 0.30 0.40 k\n0.55 g\n" +
+			"86.6025 50.00 -50.00 86.6025 100.00 500.56 cm\n1.00 0.00 0.2126 1.00 72.00 720.56 Tm\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("precision %d", tt.precision), func(t *testing.T) {
+			csw := NewContentStreamWriter()
+			csw.SetPrecision(tt.precision)
+			csw.SetFillColorRGB(0.5, 0.25, 0.75)
+			csw.SetStrokeColorRGB(0.3, 0.6, 0.9)
+			csw.SetFillColorCMYK(0.1, 0.2, 0.3, 0.4)
+			csw.SetFillColorGray(0.55)
+			csw.ConcatMatrix(100*cos, 100*sin, -100*sin, 100*cos, 100, 500.56)
+			csw.SetTextMatrix(1, 0, 0.2126, 1, 72, 720.56)
+			if got := csw.String(); got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package writer
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// EmbeddedImage represents an image to be embedded as an image XObject.
+type EmbeddedImage struct {
+	// ID identifies the image; images with the same ID are written once
+	// per document.
+	ID string
+
+	// Data is the encoded image data, written as-is.
+	Data []byte
+
+	// Filter is the filter that decodes Data ("DCTDecode" for JPEG,
+	// "FlateDecode" for compressed pixels).
+	Filter string
+
+	// Width and Height are the image dimensions in pixels.
+	Width  int
+	Height int
+
+	// ColorSpace is the image color space (e.g., "DeviceRGB").
+	ColorSpace string
+
+	// Palette makes the image an Indexed image over ColorSpace; samples
+	// are palette indices. It holds the color components of each entry.
+	Palette []byte
+
+	// BitsPerComponent is the number of bits per color component.
+	BitsPerComponent int
+
+	// Decode maps sample values to the color space range (nil = default).
+	// Adobe CMYK JPEGs store inverted values and need [1 0 1 0 1 0 1 0].
+	Decode []float64
+
+	// AlphaMask is the FlateDecode-compressed 8-bit alpha channel, written
+	// as a soft mask (nil = opaque).
+	AlphaMask []byte
+}
+
+// ImageBox is the box an image is placed in.
+type ImageBox struct {
+	X, Y          float64
+	Width, Height float64
+
+	// Angle rotates the box and the image in degrees, counter-clockwise,
+	// around the box's lower-left corner (X, Y).
+	Angle float64
+
+	// Clip clips the image to the box.
+	Clip bool
+}
+
+// renderImage draws an image scaled to the op's rectangle, rotated and
+// clipped by the op's image box.
+//
+// The image XObject is added to the resources under the image ID; its
+// object number is set when the image is written.
+func renderImage(csw *ContentStreamWriter, gop GraphicsOp, resources *ResourceDictionary) error {
+	if gop.Image == nil {
+		return fmt.Errorf("image is required for image operation")
+	}
+
+	// Image space maps to the op's rectangle, rotated around the box
+	// corner. The clip path corners and the image matrix are rotated
+	// with the same function.
+	rotate := func(x, y float64) (float64, float64) { return x, y }
+	cos, sin := 1.0, 0.0
+	if box := gop.ImageBox; box != nil && box.Angle != 0 {
+		rad := box.Angle * math.Pi / 180
+		cos, sin = math.Cos(rad), math.Sin(rad)
+		rotate = func(x, y float64) (float64, float64) {
+			dx, dy := x-box.X, y-box.Y
+			return box.X + dx*cos - dy*sin, box.Y + dx*sin + dy*cos
+		}
+	}
+
+	if box := gop.ImageBox; box != nil && box.Clip {
+		if box.Angle == 0 {
+			csw.Rectangle(box.X, box.Y, box.Width, box.Height)
+		} else {
+			corners := [4][2]float64{
+				{box.X, box.Y},
+				{box.X + box.Width, box.Y},
+				{box.X + box.Width, box.Y + box.Height},
+				{box.X, box.Y + box.Height},
+			}
+			for i, corner := range corners {
+				x, y := rotate(corner[0], corner[1])
+				if i == 0 {
+					csw.MoveTo(x, y)
+				} else {
+					csw.LineTo(x, y)
+				}
+			}
+			csw.ClosePath()
+		}
+		csw.Clip()
+		csw.EndPath()
+	}
+
+	name := resources.AddImageWithID(0, "img:"+gop.Image.ID)
+	x, y := rotate(gop.X, gop.Y)
+	csw.ConcatMatrix(gop.Width*cos, gop.Width*sin, -gop.Height*sin, gop.Height*cos, x, y)
+	csw.DrawXObject(name)
+
+	csw.RestoreState()
+	return nil
+}
+
+// collectImages returns the images drawn by graphicsOps, by ID.
+func collectImages(graphicsOps []GraphicsOp) map[string]*EmbeddedImage {
+	images := make(map[string]*EmbeddedImage)
+	for _, gop := range graphicsOps {
+		if gop.Image != nil {
+			images[gop.Image.ID] = gop.Image
+		}
+	}
+	return images
+}
+
+// createImageObjects creates the image XObject for img and, if it has an
+// alpha mask, its soft mask. The image XObject is the first object.
+//
+// Reference: PDF 1.7 specification, Section 8.9.5 (Image Dictionaries)
+// and Section 11.6.5.3 (Soft-Mask Images).
+func createImageObjects(img *EmbeddedImage, allocate func() int) []*IndirectObject {
+	imageObjNum := allocate()
+
+	var objects []*IndirectObject
+	smaskRef := ""
+	if img.AlphaMask != nil {
+		smaskObjNum := allocate()
+		smask := &EmbeddedImage{
+			Data:             img.AlphaMask,
+			Filter:           "FlateDecode",
+			Width:            img.Width,
+			Height:           img.Height,
+			ColorSpace:       "DeviceGray",
+			BitsPerComponent: 8,
+		}
+		objects = append(objects, createImageXObject(smaskObjNum, smask, ""))
+		smaskRef = fmt.Sprintf("%d 0 R", smaskObjNum)
+	}
+
+	image := createImageXObject(imageObjNum, img, smaskRef)
+	return append([]*IndirectObject{image}, objects...)
+}
+
+// createImageXObject creates an image XObject stream.
+func createImageXObject(objNum int, img *EmbeddedImage, smaskRef string) *IndirectObject {
+	var buf bytes.Buffer
+	buf.WriteString("<< /Type /XObject /Subtype /Image")
+	buf.WriteString(fmt.Sprintf(" /Width %d /Height %d", img.Width, img.Height))
+	if img.Palette != nil {
+		buf.WriteString(" /ColorSpace " + indexedColorSpaceArray(img.ColorSpace, img.Palette))
+	} else {
+		buf.WriteString(" /ColorSpace /" + img.ColorSpace)
+	}
+	buf.WriteString(fmt.Sprintf(" /BitsPerComponent %d", img.BitsPerComponent))
+	if len(img.Decode) > 0 {
+		values := make([]string, len(img.Decode))
+		for i, v := range img.Decode {
+			values[i] = formatDecodeValue(v)
+		}
+		buf.WriteString(" /Decode [" + strings.Join(values, " ") + "]")
+	}
+	if smaskRef != "" {
+		buf.WriteString(" /SMask " + smaskRef)
+	}
+	if img.Filter != "" {
+		buf.WriteString(" /Filter /" + img.Filter)
+	}
+	buf.WriteString(fmt.Sprintf(" /Length %d >>\n", len(img.Data)))
+	buf.WriteString("stream\n")
+	buf.Write(img.Data)
+	buf.WriteString("\nendstream")
+
+	return NewIndirectObject(objNum, 0, buf.Bytes())
+}
+
+// colorSpaceComponents returns the number of components of a device color
+// space.
+func colorSpaceComponents(colorSpace string) int {
+	switch colorSpace {
+	case "DeviceGray":
+		return 1
+	case "DeviceCMYK":
+		return 4
+	default:
+		return 3
+	}
+}
+
+// formatDecodeValue formats a Decode array value, e.g. "1" or "0.5".
+func formatDecodeValue(v float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.4f", v), "0"), ".")
+}
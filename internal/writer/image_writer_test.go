@@ -0,0 +1,86 @@
+package writer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreateImageObjects(t *testing.T) {
+	next := 10
+	allocate := func() int {
+		next++
+		return next
+	}
+
+	img := &EmbeddedImage{
+		ID:               "logo",
+		Data:             []byte("pixels"),
+		Filter:           "FlateDecode",
+		Width:            4,
+		Height:           2,
+		ColorSpace:       "DeviceRGB",
+		BitsPerComponent: 8,
+		AlphaMask:        []byte("alpha"),
+	}
+	objs := createImageObjects(img, allocate)
+	if len(objs) != 2 {
+		t.Fatalf("got %d objects, want image and soft mask", len(objs))
+	}
+
+	image, smask := string(objs[0].Data), string(objs[1].Data)
+	if objs[0].Number != 11 || objs[1].Number != 12 {
+		t.Errorf("object numbers = %d, %d, want 11, 12", objs[0].Number, objs[1].Number)
+	}
+	wantImage := "<< /Type /XObject /Subtype /Image /Width 4 /Height 2 /ColorSpace /DeviceRGB /BitsPerComponent 8" +
+		" /SMask 12 0 R /Filter /FlateDecode /Length 6 >>\nstream\npixels\nendstream"
+	if image != wantImage {
+		t.Errorf("image =\n%s\nwant\n%s", image, wantImage)
+	}
+	if !strings.Contains(smask, "/ColorSpace /DeviceGray /BitsPerComponent 8") || !strings.Contains(smask, "\nalpha\n") {
+		t.Errorf("soft mask = %s", smask)
+	}
+}
+
+func TestCreateImageObjects_Decode(t *testing.T) {
+	img := &EmbeddedImage{
+		Data:             []byte("jpeg"),
+		Filter:           "DCTDecode",
+		Width:            1,
+		Height:           1,
+		ColorSpace:       "DeviceCMYK",
+		BitsPerComponent: 8,
+		Decode:           []float64{1, 0, 1, 0, 1, 0, 0.5, 0},
+	}
+	objs := createImageObjects(img, func() int { return 1 })
+	if len(objs) != 1 {
+		t.Fatalf("got %d objects, want 1", len(objs))
+	}
+	if want := "/Decode [1 0 1 0 1 0 0.5 0]"; !strings.Contains(string(objs[0].Data), want) {
+		t.Errorf("image = %s, want %s", objs[0].Data, want)
+	}
+}
+
+func TestRenderImage_MissingImage(t *testing.T) {
+	_, _, err := GenerateContentStreamWithGraphics(nil, []GraphicsOp{{Type: 3, Width: 10, Height: 10}})
+	if err == nil {
+		t.Error("expected error for image operation without image")
+	}
+}
+
+func TestCreateImageObjects_Indexed(t *testing.T) {
+	img := &EmbeddedImage{
+		Data:             []byte{0x1B},
+		Filter:           "FlateDecode",
+		Width:            4,
+		Height:           1,
+		ColorSpace:       "DeviceRGB",
+		BitsPerComponent: 2,
+		Palette:          []byte{0, 0, 0, 255, 255, 255, 255, 0, 0, 0, 0, 255},
+	}
+	objs := createImageObjects(img, func() int { return 1 })
+
+	want := "/ColorSpace [/Indexed /DeviceRGB 3 <000000FFFFFFFF00000000FF>] /BitsPerComponent 2"
+	if !strings.Contains(string(objs[0].Data), want) {
+		t.Errorf("image = %s, want %s", objs[0].Data, want)
+	}
+}
@@ -39,6 +39,10 @@ type TextOp struct {
 	// When set, this takes precedence over the Font field.
 	// The font must be registered with the document before use.
 	CustomFont *EmbeddedFont
+
+	// Matrix is a transformation applied to the text (optional), as the
+	// coefficients [a b c d e f] of a cm operator.
+	Matrix *[6]float64
 }
 
 // EmbeddedFont represents a custom TrueType/OpenType font for embedding.
@@ -53,6 +57,49 @@ type EmbeddedFont struct {
 
 	// ID is a unique identifier for this font instance.
 	ID string
+
+	// Full embeds the complete font under its own name instead of a
+	// tagged subset, so documents can be edited with any character.
+	Full bool
+
+	// FauxBold and FauxItalic draw the text with synthetic bold (glyph
+	// outlines stroked) and italic (skewed text matrix), for fonts
+	// without a real bold or italic face.
+	FauxBold   bool
+	FauxItalic bool
+}
+
+// Synthetic style parameters.
+const (
+	// fauxBoldStrokeRatio is the outline stroke width of synthetic bold
+	// text, relative to the font size.
+	fauxBoldStrokeRatio = 0.03
+
+	// fauxItalicSkew is the horizontal skew of synthetic italic text
+	// (tan 12°, a common oblique angle).
+	fauxItalicSkew = 0.2126
+)
+
+// hasFauxStyle reports whether text in font needs synthetic styles.
+func (f *EmbeddedFont) hasFauxStyle() bool {
+	return f != nil && (f.FauxBold || f.FauxItalic)
+}
+
+// positionText moves to the start of the text, applying the synthetic
+// styles of font (nil for standard fonts).
+//
+// Synthetic bold changes the text rendering mode and line width, so text
+// with a synthetic style must be wrapped in SaveState/RestoreState.
+func positionText(csw *ContentStreamWriter, font *EmbeddedFont, x, y, size float64) {
+	if font != nil && font.FauxBold {
+		csw.SetTextRenderingMode(2) // Fill, then stroke.
+		csw.SetLineWidth(size * fauxBoldStrokeRatio)
+	}
+	if font != nil && font.FauxItalic {
+		csw.SetTextMatrix(1, 0, fauxItalicSkew, 1, x, y)
+		return
+	}
+	csw.MoveTextPosition(x, y)
 }
 
 // RGB represents an RGB color (0.0 to 1.0 range).
@@ -84,12 +131,18 @@ type BezierSegment struct {
 	End   Point
 }
 
+// PathSegment is a path construction operator with its points.
+type PathSegment struct {
+	Op     byte    // 'm' (move), 'l' (line), 'c' (cubic curve) or 'h' (close)
+	Points []Point // 1 point for m/l, 3 points for c, none for h
+}
+
 // GraphicsOp represents a graphics drawing operation.
 //
 // This is an infrastructure-level representation of graphics operations
 // from the creator package.
 type GraphicsOp struct {
-	Type int // 0=line, 1=rect, 2=circle, 5=polygon, 6=polyline, 7=ellipse, 8=bezier
+	Type int // 0=line, 1=rect, 2=circle, 3=image, 5=polygon, 6=polyline, 7=ellipse, 8=bezier, 9=path
 
 	// Common fields
 	X float64
@@ -117,20 +170,41 @@ type GraphicsOp struct {
 	BezierSegs []BezierSegment
 	Closed     bool // For Bezier curves
 
+	// Path fields
+	PathSegments []PathSegment
+	EvenOdd      bool // Even-odd fill rule (default: non-zero)
+
 	// Appearance
-	StrokeColor     *RGB
-	StrokeColorCMYK *CMYK // If set, takes precedence over StrokeColor
-	FillColor       *RGB
-	FillColorCMYK   *CMYK       // If set, takes precedence over FillColor
-	FillGradient    *GradientOp // Gradient fill
-	StrokeWidth     float64
-	Dashed          bool
-	DashArray       []float64
-	DashPhase       float64
+	StrokeColor      *RGB
+	StrokeColorCMYK  *CMYK // If set, takes precedence over StrokeColor
+	FillColor        *RGB
+	FillColorCMYK    *CMYK         // If set, takes precedence over FillColor
+	FillColorIndexed *IndexedColor // If set, takes precedence over FillColorCMYK and FillColor
+	FillGradient     *GradientOp   // Gradient fill
+	StrokeWidth      float64
+	Dashed           bool
+	DashArray        []float64
+	DashPhase        float64
 
 	// Clipping
 	IsClipPath bool // If true, this shape defines a clipping path (not drawn)
 
+	// Matrix is a transformation applied to the operation (optional), as
+	// the coefficients [a b c d e f] of a cm operator.
+	Matrix *[6]float64
+
+	// fillColorSpace is the resource name of FillColorIndexed's color space,
+	// set when the op is rendered.
+	fillColorSpace string
+
+	// fillShading is the resource name of FillGradient's shading, set when
+	// the op is rendered.
+	fillShading string
+
+	// Image fields (for Type == 3), drawn into X, Y, Width, Height
+	Image    *EmbeddedImage
+	ImageBox *ImageBox // Box the image is rotated around and clipped to (nil = none)
+
 	// TextBlock fields (for Type == 22)
 	Text       string
 	TextFont   *EmbeddedFont
@@ -213,12 +287,19 @@ func GenerateContentStream(textOps []TextOp) (content []byte, resources *Resourc
 //   - resources: The resource dictionary for fonts used
 //   - error: Any error that occurred
 func GenerateContentStreamWithGraphics(textOps []TextOp, graphicsOps []GraphicsOp) (content []byte, resources *ResourceDictionary, err error) {
+	return generateContentStream(textOps, graphicsOps, DefaultPrecision)
+}
+
+// generateContentStream generates a content stream, writing numbers with
+// the given number of decimal places.
+func generateContentStream(textOps []TextOp, graphicsOps []GraphicsOp, precision int) (content []byte, resources *ResourceDictionary, err error) {
 	if len(textOps) == 0 && len(graphicsOps) == 0 {
 		// Empty content stream
 		return []byte{}, NewResourceDictionary(), nil
 	}
 
 	csw := NewContentStreamWriter()
+	csw.SetPrecision(precision)
 	resources = NewResourceDictionary()
 
 	// STEP 1: Draw graphics FIRST (so text appears on top)
@@ -233,6 +314,14 @@ func GenerateContentStreamWithGraphics(textOps []TextOp, graphicsOps []GraphicsO
 	// Key is either standard font name or custom font ID.
 	usedFonts := make(map[string]string) // font key -> resource name
 
+	// Fill color and font persist between text objects; skip unchanged ones.
+	// Adjacent ops with the same font, size and color, or on the same
+	// baseline, share one text object, moving between them with relative
+	// Td offsets. Same-style text on one baseline is shown with one TJ.
+	var current textState
+	var run *textRun // Pending text of the open text object
+	inText := false
+
 	for _, op := range textOps {
 		// Determine font key (custom font ID or standard font name).
 		var fontKey string
@@ -253,36 +342,230 @@ func GenerateContentStreamWithGraphics(textOps []TextOp, graphicsOps []GraphicsO
 			usedFonts[fontKey] = fontResName
 		}
 
+		// Synthetic styles and transformations change the graphics state;
+		// isolate them. State set inside q/Q does not carry over, so it is
+		// always written.
+		styled := op.CustomFont.hasFauxStyle()
+		isolated := styled || op.Matrix != nil
+
+		if inText {
+			x, y := csw.round(op.X), csw.round(op.Y)
+			sameLine := !isolated && y == current.lineY
+			matches := !isolated && current.matches(csw, fontResName, op)
+			if sameLine && matches && run.extend(csw, op, x) {
+				continue
+			}
+			if sameLine || matches {
+				// Continue the open text object from the previous line start.
+				run.flush(csw)
+				current.setFillColor(csw, op.Color, op.ColorCMYK)
+				current.setFont(csw, fontResName, op.Size)
+				csw.MoveTextPosition(x-current.lineX, y-current.lineY)
+				current.lineX, current.lineY = x, y
+				run = startText(csw, op, x)
+				continue
+			}
+			run.flush(csw)
+			csw.EndText()
+			inText = false
+		}
+
+		state := &current
+		if isolated {
+			csw.SaveState()
+			state = &textState{}
+			concatMatrix(csw, op.Matrix)
+		}
+
 		// Begin text object
 		csw.BeginText()
 
-		// Set color (CMYK takes precedence over RGB)
-		if op.ColorCMYK != nil {
-			csw.SetFillColorCMYK(op.ColorCMYK.C, op.ColorCMYK.M, op.ColorCMYK.Y, op.ColorCMYK.K)
-		} else {
-			csw.SetFillColorRGB(op.Color.R, op.Color.G, op.Color.B)
+		// Set color (CMYK takes precedence over RGB). Synthetic bold
+		// strokes the outlines in the text color.
+		state.setFillColor(csw, op.Color, op.ColorCMYK)
+		if styled {
+			if op.ColorCMYK != nil {
+				csw.SetStrokeColorCMYK(op.ColorCMYK.C, op.ColorCMYK.M, op.ColorCMYK.Y, op.ColorCMYK.K)
+			} else {
+				csw.SetStrokeColorRGB(op.Color.R, op.Color.G, op.Color.B)
+			}
 		}
 
 		// Set font and size
-		csw.SetFont(fontResName, op.Size)
+		state.setFont(csw, fontResName, op.Size)
 
 		// Set position
-		csw.MoveTextPosition(op.X, op.Y)
+		positionText(csw, op.CustomFont, op.X, op.Y, op.Size)
 
-		// Show text (for custom fonts, encode using glyph IDs)
-		if op.CustomFont != nil {
-			csw.ShowTextEncoded(encodeTextForEmbeddedFont(op.Text, op.CustomFont))
-		} else {
-			csw.ShowText(op.Text)
+		if isolated {
+			showText(csw, op)
+			csw.EndText()
+			csw.RestoreState()
+			continue
 		}
 
-		// End text object
+		// Leave the text object open for the following ops.
+		inText = true
+		current.lineX, current.lineY = csw.round(op.X), csw.round(op.Y)
+		run = startText(csw, op, current.lineX)
+	}
+
+	if inText {
+		run.flush(csw)
 		csw.EndText()
 	}
 
 	return csw.Bytes(), resources, nil
 }
 
+// showText shows the text of op (for custom fonts, encoded as glyph IDs).
+func showText(csw *ContentStreamWriter, op TextOp) {
+	if op.CustomFont != nil {
+		csw.ShowTextEncoded(encodeTextForEmbeddedFont(op.Text, op.CustomFont))
+	} else {
+		csw.ShowText(encodeStandardText(op.Font, op.Text))
+	}
+}
+
+// startText shows the text of op at x, or starts a run for it if text on
+// the same baseline can be added with TJ.
+func startText(csw *ContentStreamWriter, op TextOp, x float64) *textRun {
+	if run := newTextRun(op, x); run != nil {
+		return run
+	}
+	showText(csw, op)
+	return nil
+}
+
+// textRun collects text on one baseline in one font, size and color, to be
+// shown with a single TJ operator.
+//
+// Runs are limited to standard text fonts, whose AFM widths are the widths
+// viewers use; the gap to the next text is a TJ adjustment computed from
+// them.
+type textRun struct {
+	spans   []TextSpan
+	metrics *fonts.FontMetrics
+	end     float64 // Horizontal position where the shown text ends
+}
+
+// newTextRun starts a run with the text of op at x. It returns nil if the
+// text cannot be measured exactly.
+func newTextRun(op TextOp, x float64) *textRun {
+	metrics := standardTextMetrics(op)
+	if metrics == nil {
+		return nil
+	}
+	return &textRun{
+		spans:   []TextSpan{{Text: fonts.EncodeWinAnsi(op.Text)}},
+		metrics: metrics,
+		end:     x + metrics.MeasureString(op.Text, op.Size),
+	}
+}
+
+// standardTextMetrics returns the metrics for the text of op, or nil if it
+// is not WinAnsi text in a standard text font.
+func standardTextMetrics(op TextOp) *fonts.FontMetrics {
+	if op.CustomFont != nil || !fonts.CanEncodeWinAnsi(op.Text) {
+		return nil
+	}
+	if font, err := getStandard14Font(op.Font); err != nil || font.IsSymbolic {
+		return nil
+	}
+	return fonts.GetMetrics(op.Font)
+}
+
+// extend adds the text of op at x to the run. The caller ensures op is on
+// the run's baseline with the same font, size and color. It reports false
+// if there is no run or the text cannot be added.
+func (r *textRun) extend(csw *ContentStreamWriter, op TextOp, x float64) bool {
+	if r == nil || standardTextMetrics(op) == nil {
+		return false
+	}
+
+	adjust := csw.round((r.end - x) * 1000 / op.Size)
+	r.spans = append(r.spans, TextSpan{Adjust: adjust, Text: fonts.EncodeWinAnsi(op.Text)})
+	r.end += -adjust*op.Size/1000 + r.metrics.MeasureString(op.Text, op.Size)
+	return true
+}
+
+// flush shows the text of the run, if any.
+func (r *textRun) flush(csw *ContentStreamWriter) {
+	if r == nil {
+		return
+	}
+	if len(r.spans) == 1 {
+		csw.ShowText(r.spans[0].Text)
+		return
+	}
+	csw.ShowTextArray(r.spans)
+}
+
+// textState tracks the fill color and font left in the graphics state by
+// previous text objects, so unchanged values are not written again.
+//
+// The zero value knows nothing about the current state.
+type textState struct {
+	fill     string  // Fill color operator as written ("" = unknown)
+	font     string  // Font resource name ("" = unknown)
+	fontSize float64 // Font size
+
+	// Start of the current line in the open text object, as written.
+	lineX, lineY float64
+}
+
+// fillKey returns the fill color operator (CMYK takes precedence over RGB)
+// as it is written.
+func fillKey(csw *ContentStreamWriter, rgb RGB, cmyk *CMYK) string {
+	if cmyk != nil {
+		return csw.fines(cmyk.C, cmyk.M, cmyk.Y, cmyk.K) + " k"
+	}
+	return csw.fines(rgb.R, rgb.G, rgb.B) + " rg"
+}
+
+// matches reports whether op uses the current font, size and fill color.
+func (s *textState) matches(csw *ContentStreamWriter, fontResName string, op TextOp) bool {
+	return fontResName == s.font && op.Size == s.fontSize &&
+		fillKey(csw, op.Color, op.ColorCMYK) == s.fill
+}
+
+// setFillColor sets the fill color (CMYK takes precedence over RGB) unless
+// it is already current as written.
+func (s *textState) setFillColor(csw *ContentStreamWriter, rgb RGB, cmyk *CMYK) {
+	fill := fillKey(csw, rgb, cmyk)
+	if fill == s.fill {
+		return
+	}
+
+	if cmyk != nil {
+		csw.SetFillColorCMYK(cmyk.C, cmyk.M, cmyk.Y, cmyk.K)
+	} else {
+		csw.SetFillColorRGB(rgb.R, rgb.G, rgb.B)
+	}
+	s.fill = fill
+}
+
+// setFont sets the font and size unless they are already current.
+func (s *textState) setFont(csw *ContentStreamWriter, fontResName string, size float64) {
+	if fontResName == s.font && size == s.fontSize {
+		return
+	}
+
+	csw.SetFont(fontResName, size)
+	s.font = fontResName
+	s.fontSize = size
+}
+
+// encodeStandardText encodes text for a standard 14 font: WinAnsiEncoding
+// for the text fonts, unchanged for the symbolic fonts, which use their
+// built-in encodings.
+func encodeStandardText(fontName, text string) string {
+	if font, err := getStandard14Font(fontName); err == nil && font.IsSymbolic {
+		return text
+	}
+	return fonts.EncodeWinAnsi(text)
+}
+
 // renderGraphicsOp renders a single graphics operation to the content stream.
 func renderGraphicsOp(csw *ContentStreamWriter, gop GraphicsOp, resources *ResourceDictionary) error {
 	// Clipping and text operations manage their own state - don't wrap them.
@@ -293,12 +576,33 @@ func renderGraphicsOp(csw *ContentStreamWriter, gop GraphicsOp, resources *Resou
 		case 21: // EndClip - ends clipping region
 			return renderEndClip(csw)
 		case 22: // TextBlock - text rendered inline with graphics
-			return renderTextBlock(csw, gop, resources)
+			if gop.Matrix == nil {
+				return renderTextBlock(csw, gop, resources)
+			}
+			csw.SaveState()
+			concatMatrix(csw, gop.Matrix)
+			if err := renderTextBlock(csw, gop, resources); err != nil {
+				return err
+			}
+			csw.RestoreState()
+			return nil
 		}
 	}
 
+	// Indexed fills refer to a color space resource by name.
+	if gop.FillColorIndexed != nil {
+		space := gop.FillColorIndexed.Space
+		gop.fillColorSpace = resources.AddColorSpace("idx:"+space.ID, space.Array())
+	}
+
+	// Gradient fills paint a shading resource.
+	if gop.FillGradient != nil {
+		gop.fillShading = resources.AddShading(gop.FillGradient.shadingID(), gop.FillGradient.ShadingDict())
+	}
+
 	// Save graphics state for regular drawing operations.
 	csw.SaveState()
+	concatMatrix(csw, gop.Matrix)
 
 	switch gop.Type {
 	case 0: // Line
@@ -307,6 +611,8 @@ func renderGraphicsOp(csw *ContentStreamWriter, gop GraphicsOp, resources *Resou
 		return renderRect(csw, gop)
 	case 2: // Circle
 		return renderCircle(csw, gop)
+	case 3: // Image
+		return renderImage(csw, gop, resources)
 	case 5: // Polygon
 		return renderPolygon(csw, gop)
 	case 6: // Polyline
@@ -315,6 +621,8 @@ func renderGraphicsOp(csw *ContentStreamWriter, gop GraphicsOp, resources *Resou
 		return renderEllipse(csw, gop)
 	case 8: // Bezier
 		return renderBezier(csw, gop)
+	case 9: // Path
+		return renderPath(csw, gop)
 	default:
 		return fmt.Errorf("unknown graphics operation type: %d", gop.Type)
 	}
@@ -338,6 +646,17 @@ func setFillColor(csw *ContentStreamWriter, rgb *RGB, cmyk *CMYK) {
 	}
 }
 
+// setShapeFillColor sets the fill color of a shape (indexed takes
+// precedence over CMYK and RGB).
+func setShapeFillColor(csw *ContentStreamWriter, gop GraphicsOp) {
+	if gop.FillColorIndexed != nil {
+		csw.SetFillColorSpace(gop.fillColorSpace)
+		csw.SetFillColorIndex(gop.FillColorIndexed.Index)
+		return
+	}
+	setFillColor(csw, gop.FillColor, gop.FillColorCMYK)
+}
+
 // renderLine renders a line to the content stream.
 func renderLine(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Set line width
@@ -382,31 +701,13 @@ func renderRect(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Set stroke color
 	setStrokeColor(csw, gop.StrokeColor, gop.StrokeColorCMYK)
 
-	// Draw rectangle path
-	csw.Rectangle(gop.X, gop.Y, gop.Width, gop.Height)
-
-	// Handle fill (gradient or solid color)
-	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillGradient != nil
+	// Fill (gradient or solid color) and/or stroke the rectangle path
+	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillColorIndexed != nil || gop.FillGradient != nil
 	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
 
-	if gop.FillGradient != nil {
-		// Use gradient fill
-		// Note: Full gradient implementation requires shading pattern resource
-		// For now, use a simplified approach with color interpolation
-		renderGradientFill(csw, gop.FillGradient)
-	} else {
-		// Use solid color fill
-		setFillColor(csw, gop.FillColor, gop.FillColorCMYK)
-	}
-
-	// Fill and/or stroke
-	if hasStroke && hasFill {
-		csw.FillAndStroke()
-	} else if hasFill {
-		csw.Fill()
-	} else {
-		csw.Stroke()
-	}
+	fillShape(csw, gop, hasFill, hasStroke, func() {
+		csw.Rectangle(gop.X, gop.Y, gop.Width, gop.Height)
+	})
 
 	// Restore graphics state
 	csw.RestoreState()
@@ -427,18 +728,45 @@ func renderBeginClipRect(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Save graphics state (so we can restore after clipping).
 	csw.SaveState()
 
-	// Define rectangle path.
+	// Define rectangle path, transformed if requested.
+	concatMatrix(csw, gop.Matrix)
 	csw.Rectangle(gop.X, gop.Y, gop.Width, gop.Height)
 
 	// Set clipping path and end path (W n).
 	csw.Clip()
 	csw.EndPath()
 
+	// The clip stays in device space; undo the transformation, which the
+	// clipped operations apply themselves.
+	if gop.Matrix != nil {
+		if inverse, ok := invertMatrix(*gop.Matrix); ok {
+			concatMatrix(csw, &inverse)
+		}
+	}
+
 	// Note: We do NOT restore state here - clipping remains active.
 	// The caller must call EndClip (type 21) to restore state.
 	return nil
 }
 
+// concatMatrix writes a cm operator for m, if set.
+func concatMatrix(csw *ContentStreamWriter, m *[6]float64) {
+	if m != nil {
+		csw.ConcatMatrix(m[0], m[1], m[2], m[3], m[4], m[5])
+	}
+}
+
+// invertMatrix returns the inverse of the transformation m. It reports
+// false if m is not invertible.
+func invertMatrix(m [6]float64) ([6]float64, bool) {
+	det := m[0]*m[3] - m[1]*m[2]
+	if det == 0 {
+		return [6]float64{}, false
+	}
+	a, b, c, d := m[3]/det, -m[1]/det, -m[2]/det, m[0]/det
+	return [6]float64{a, b, c, d, -(m[4]*a + m[5]*c), -(m[4]*b + m[5]*d)}, true
+}
+
 // renderEndClip ends a clipping region by restoring the graphics state.
 func renderEndClip(csw *ContentStreamWriter) error {
 	csw.RestoreState()
@@ -463,17 +791,26 @@ func renderTextBlock(csw *ContentStreamWriter, gop GraphicsOp, resources *Resour
 		fontResName = resources.AddFontWithID(fontObjNum, fontKey)
 	}
 
+	// Synthetic styles change the graphics state; isolate them.
+	styled := gop.TextFont.hasFauxStyle()
+	if styled {
+		csw.SaveState()
+	}
+
 	// Begin text object.
 	csw.BeginText()
 
-	// Set fill color.
+	// Set fill color (and stroke color for synthetic bold).
 	csw.SetFillColorRGB(gop.TextColorR, gop.TextColorG, gop.TextColorB)
+	if styled {
+		csw.SetStrokeColorRGB(gop.TextColorR, gop.TextColorG, gop.TextColorB)
+	}
 
 	// Set font and size.
 	csw.SetFont(fontResName, gop.TextSize)
 
 	// Set position.
-	csw.MoveTextPosition(gop.X, gop.Y)
+	positionText(csw, gop.TextFont, gop.X, gop.Y, gop.TextSize)
 
 	// Show text (encode using glyph IDs for embedded font).
 	csw.ShowTextEncoded(encodeTextForEmbeddedFont(gop.Text, gop.TextFont))
@@ -481,6 +818,10 @@ func renderTextBlock(csw *ContentStreamWriter, gop GraphicsOp, resources *Resour
 	// End text object.
 	csw.EndText()
 
+	if styled {
+		csw.RestoreState()
+	}
+
 	return nil
 }
 
@@ -496,48 +837,35 @@ func renderCircle(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Set stroke color
 	setStrokeColor(csw, gop.StrokeColor, gop.StrokeColorCMYK)
 
-	// Draw circle using 4 Bézier curves
-	// kappa = 4/3 * (sqrt(2) - 1) ≈ 0.5522847498
-	const kappa = 0.5522847498
-	cx, cy, r := gop.X, gop.Y, gop.Radius
-	k := r * kappa
-
-	// Start at right (3 o'clock)
-	csw.MoveTo(cx+r, cy)
-
-	// Top-right quarter
-	csw.CurveTo(cx+r, cy+k, cx+k, cy+r, cx, cy+r)
+	// Fill (gradient or solid color) and/or stroke the circle path
+	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillColorIndexed != nil || gop.FillGradient != nil
+	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
 
-	// Top-left quarter
-	csw.CurveTo(cx-k, cy+r, cx-r, cy+k, cx-r, cy)
+	fillShape(csw, gop, hasFill, hasStroke, func() {
+		// Draw circle using 4 Bézier curves
+		// kappa = 4/3 * (sqrt(2) - 1) ≈ 0.5522847498
+		const kappa = 0.5522847498
+		cx, cy, r := gop.X, gop.Y, gop.Radius
+		k := r * kappa
 
-	// Bottom-left quarter
-	csw.CurveTo(cx-r, cy-k, cx-k, cy-r, cx, cy-r)
+		// Start at right (3 o'clock)
+		csw.MoveTo(cx+r, cy)
 
-	// Bottom-right quarter (back to start)
-	csw.CurveTo(cx+k, cy-r, cx+r, cy-k, cx+r, cy)
+		// Top-right quarter
+		csw.CurveTo(cx+r, cy+k, cx+k, cy+r, cx, cy+r)
 
-	// Close path
-	csw.ClosePath()
+		// Top-left quarter
+		csw.CurveTo(cx-k, cy+r, cx-r, cy+k, cx-r, cy)
 
-	// Handle fill (gradient or solid color)
-	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillGradient != nil
-	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
+		// Bottom-left quarter
+		csw.CurveTo(cx-r, cy-k, cx-k, cy-r, cx, cy-r)
 
-	if gop.FillGradient != nil {
-		renderGradientFill(csw, gop.FillGradient)
-	} else {
-		setFillColor(csw, gop.FillColor, gop.FillColorCMYK)
-	}
+		// Bottom-right quarter (back to start)
+		csw.CurveTo(cx+k, cy-r, cx+r, cy-k, cx+r, cy)
 
-	// Fill and/or stroke
-	if hasStroke && hasFill {
-		csw.FillAndStroke()
-	} else if hasFill {
-		csw.Fill()
-	} else {
-		csw.Stroke()
-	}
+		// Close path
+		csw.ClosePath()
+	})
 
 	// Restore graphics state
 	csw.RestoreState()
@@ -565,36 +893,23 @@ func renderPolygon(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Set stroke color
 	setStrokeColor(csw, gop.StrokeColor, gop.StrokeColorCMYK)
 
-	// Draw polygon path
-	// Start at first vertex
-	csw.MoveTo(gop.Vertices[0].X, gop.Vertices[0].Y)
-
-	// Draw lines to remaining vertices
-	for i := 1; i < len(gop.Vertices); i++ {
-		csw.LineTo(gop.Vertices[i].X, gop.Vertices[i].Y)
-	}
-
-	// Close path (back to first vertex)
-	csw.ClosePath()
-
-	// Handle fill (gradient or solid color)
-	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillGradient != nil
+	// Fill (gradient or solid color) and/or stroke the polygon path
+	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillColorIndexed != nil || gop.FillGradient != nil
 	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
 
-	if gop.FillGradient != nil {
-		renderGradientFill(csw, gop.FillGradient)
-	} else {
-		setFillColor(csw, gop.FillColor, gop.FillColorCMYK)
-	}
+	fillShape(csw, gop, hasFill, hasStroke, func() {
+		// Draw polygon path
+		// Start at first vertex
+		csw.MoveTo(gop.Vertices[0].X, gop.Vertices[0].Y)
 
-	// Fill and/or stroke
-	if hasStroke && hasFill {
-		csw.FillAndStroke()
-	} else if hasFill {
-		csw.Fill()
-	} else {
-		csw.Stroke()
-	}
+		// Draw lines to remaining vertices
+		for i := 1; i < len(gop.Vertices); i++ {
+			csw.LineTo(gop.Vertices[i].X, gop.Vertices[i].Y)
+		}
+
+		// Close path (back to first vertex)
+		csw.ClosePath()
+	})
 
 	// Restore graphics state
 	csw.RestoreState()
@@ -651,75 +966,87 @@ func renderEllipse(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Set stroke color
 	setStrokeColor(csw, gop.StrokeColor, gop.StrokeColorCMYK)
 
-	// Draw ellipse using 4 Bézier curves
-	// kappa = 4/3 * (sqrt(2) - 1) ≈ 0.5522847498
-	const kappa = 0.5522847498
-	cx, cy, rx, ry := gop.X, gop.Y, gop.RX, gop.RY
-	kx := rx * kappa
-	ky := ry * kappa
-
-	// Start at right (3 o'clock)
-	csw.MoveTo(cx+rx, cy)
+	// Fill (gradient or solid color) and/or stroke the ellipse path
+	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillColorIndexed != nil || gop.FillGradient != nil
+	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
 
-	// Top-right quarter
-	csw.CurveTo(cx+rx, cy+ky, cx+kx, cy+ry, cx, cy+ry)
+	fillShape(csw, gop, hasFill, hasStroke, func() {
+		// Draw ellipse using 4 Bézier curves
+		// kappa = 4/3 * (sqrt(2) - 1) ≈ 0.5522847498
+		const kappa = 0.5522847498
+		cx, cy, rx, ry := gop.X, gop.Y, gop.RX, gop.RY
+		kx := rx * kappa
+		ky := ry * kappa
 
-	// Top-left quarter
-	csw.CurveTo(cx-kx, cy+ry, cx-rx, cy+ky, cx-rx, cy)
+		// Start at right (3 o'clock)
+		csw.MoveTo(cx+rx, cy)
 
-	// Bottom-left quarter
-	csw.CurveTo(cx-rx, cy-ky, cx-kx, cy-ry, cx, cy-ry)
+		// Top-right quarter
+		csw.CurveTo(cx+rx, cy+ky, cx+kx, cy+ry, cx, cy+ry)
 
-	// Bottom-right quarter (back to start)
-	csw.CurveTo(cx+kx, cy-ry, cx+rx, cy-ky, cx+rx, cy)
+		// Top-left quarter
+		csw.CurveTo(cx-kx, cy+ry, cx-rx, cy+ky, cx-rx, cy)
 
-	// Close path
-	csw.ClosePath()
+		// Bottom-left quarter
+		csw.CurveTo(cx-rx, cy-ky, cx-kx, cy-ry, cx, cy-ry)
 
-	// Handle fill (gradient or solid color)
-	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillGradient != nil
-	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
+		// Bottom-right quarter (back to start)
+		csw.CurveTo(cx+kx, cy-ry, cx+rx, cy-ky, cx+rx, cy)
 
-	if gop.FillGradient != nil {
-		renderGradientFill(csw, gop.FillGradient)
-	} else {
-		setFillColor(csw, gop.FillColor, gop.FillColorCMYK)
-	}
-
-	// Fill and/or stroke
-	if hasStroke && hasFill {
-		csw.FillAndStroke()
-	} else if hasFill {
-		csw.Fill()
-	} else {
-		csw.Stroke()
-	}
+		// Close path
+		csw.ClosePath()
+	})
 
 	// Restore graphics state
 	csw.RestoreState()
 	return nil
 }
 
-// renderGradientFill applies a gradient fill to the current path.
+// fillShape fills and/or strokes the shape whose path is drawn by path,
+// with the even-odd rule if gop.EvenOdd is set. Shapes with neither fill
+// nor stroke are stroked.
 //
-// TODO: Full gradient implementation requires:
-// 1. Creating shading dictionary with Function objects
-// 2. Adding shading to resource dictionary
-// 3. Using 'sh' operator to apply shading
-//
-// For now, this function uses a fallback: the middle color of the gradient.
-// This allows the API to work while we build the full infrastructure.
-func renderGradientFill(csw *ContentStreamWriter, grad *GradientOp) {
-	if grad == nil || len(grad.ColorStops) == 0 {
+// A gradient fill cannot be used as a fill color: the gradient's shading is
+// painted with the path as the clipping path, and the path is drawn again
+// to be stroked.
+func fillShape(csw *ContentStreamWriter, gop GraphicsOp, hasFill, hasStroke bool, path func()) {
+	if hasFill && gop.FillGradient != nil {
+		csw.SaveState()
+		path()
+		if gop.EvenOdd {
+			csw.ClipEvenOdd()
+		} else {
+			csw.Clip()
+		}
+		csw.EndPath()
+		csw.PaintShading(gop.fillShading)
+		csw.RestoreState()
+
+		if hasStroke {
+			path()
+			csw.Stroke()
+		}
 		return
 	}
 
-	// Fallback: use middle color stop
-	// In the future, this will create a proper PDF shading pattern
-	midIdx := len(grad.ColorStops) / 2
-	midColor := grad.ColorStops[midIdx].Color
+	// Colors must be set before the path is constructed.
+	if hasFill {
+		setShapeFillColor(csw, gop)
+	}
+	path()
 
-	csw.SetFillColorRGB(midColor.R, midColor.G, midColor.B)
+	switch {
+	case hasStroke && hasFill && gop.EvenOdd:
+		csw.FillAndStrokeEvenOdd()
+	case hasStroke && hasFill:
+		csw.FillAndStroke()
+	case hasFill && gop.EvenOdd:
+		csw.FillEvenOdd()
+	case hasFill:
+		csw.Fill()
+	default:
+		csw.Stroke()
+	}
 }
 
 // renderBezier renders a Bézier curve to the content stream.
@@ -743,40 +1070,80 @@ func renderBezier(csw *ContentStreamWriter, gop GraphicsOp) error {
 	// Set stroke color
 	setStrokeColor(csw, gop.StrokeColor, gop.StrokeColorCMYK)
 
-	// Draw Bézier curve path
-	// Start at first segment's start point
-	firstSeg := gop.BezierSegs[0]
-	csw.MoveTo(firstSeg.Start.X, firstSeg.Start.Y)
+	// Fill (gradient or solid color) and/or stroke the Bézier curve path;
+	// only closed curves are filled
+	hasFill := (gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillColorIndexed != nil || gop.FillGradient != nil) && gop.Closed
+	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
 
-	// Draw each segment
-	for _, seg := range gop.BezierSegs {
-		csw.CurveTo(seg.C1.X, seg.C1.Y, seg.C2.X, seg.C2.Y, seg.End.X, seg.End.Y)
-	}
+	fillShape(csw, gop, hasFill, hasStroke, func() {
+		// Start at first segment's start point
+		firstSeg := gop.BezierSegs[0]
+		csw.MoveTo(firstSeg.Start.X, firstSeg.Start.Y)
 
-	// Close path if requested
-	if gop.Closed {
-		csw.ClosePath()
-	}
+		// Draw each segment
+		for _, seg := range gop.BezierSegs {
+			csw.CurveTo(seg.C1.X, seg.C1.Y, seg.C2.X, seg.C2.Y, seg.End.X, seg.End.Y)
+		}
 
-	// Handle fill (gradient or solid color)
-	hasFill := (gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillGradient != nil) && gop.Closed
-	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
+		// Close path if requested
+		if gop.Closed {
+			csw.ClosePath()
+		}
+	})
 
-	if gop.FillGradient != nil && gop.Closed {
-		renderGradientFill(csw, gop.FillGradient)
-	} else if gop.Closed {
-		setFillColor(csw, gop.FillColor, gop.FillColorCMYK)
+	// Restore graphics state
+	csw.RestoreState()
+	return nil
+}
+
+// renderPath renders an arbitrary path to the content stream.
+func renderPath(csw *ContentStreamWriter, gop GraphicsOp) error {
+	if len(gop.PathSegments) == 0 {
+		return fmt.Errorf("path must have at least 1 segment")
 	}
 
-	// Fill and/or stroke
-	if hasStroke && hasFill {
-		csw.FillAndStroke()
-	} else if hasFill {
-		csw.Fill()
+	// Set line width
+	if gop.StrokeWidth > 0 {
+		csw.SetLineWidth(gop.StrokeWidth)
 	} else {
-		csw.Stroke()
+		csw.SetLineWidth(1.0) // Default
 	}
 
+	// Set dash pattern if dashed
+	if gop.Dashed && len(gop.DashArray) > 0 {
+		csw.SetDashPattern(gop.DashArray, gop.DashPhase)
+	}
+
+	// Set stroke color
+	setStrokeColor(csw, gop.StrokeColor, gop.StrokeColorCMYK)
+
+	for _, seg := range gop.PathSegments {
+		switch seg.Op {
+		case 'm', 'l', 'c', 'h':
+		default:
+			return fmt.Errorf("unknown path operator: %q", seg.Op)
+		}
+	}
+
+	// Fill (gradient or solid color) and/or stroke the path
+	hasFill := gop.FillColor != nil || gop.FillColorCMYK != nil || gop.FillColorIndexed != nil || gop.FillGradient != nil
+	hasStroke := gop.StrokeColor != nil || gop.StrokeColorCMYK != nil
+
+	fillShape(csw, gop, hasFill, hasStroke, func() {
+		for _, seg := range gop.PathSegments {
+			switch seg.Op {
+			case 'm':
+				csw.MoveTo(seg.Points[0].X, seg.Points[0].Y)
+			case 'l':
+				csw.LineTo(seg.Points[0].X, seg.Points[0].Y)
+			case 'c':
+				csw.CurveTo(seg.Points[0].X, seg.Points[0].Y, seg.Points[1].X, seg.Points[1].Y, seg.Points[2].X, seg.Points[2].Y)
+			case 'h':
+				csw.ClosePath()
+			}
+		}
+	})
+
 	// Restore graphics state
 	csw.RestoreState()
 	return nil
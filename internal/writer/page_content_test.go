@@ -0,0 +1,297 @@
+package writer
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGenerateContentStream_SkipsUnchangedTextState(t *testing.T) {
+	black := RGB{}
+	red := RGB{R: 1}
+	ops := []TextOp{
+		{Text: "a", X: 10, Y: 100, Font: "Helvetica", Size: 12, Color: black},
+		{Text: "b", X: 10, Y: 90, Font: "Helvetica", Size: 12, Color: black},
+		{Text: "c", X: 10, Y: 80, Font: "Helvetica", Size: 10, Color: black},
+		{Text: "d", X: 10, Y: 70, Font: "Helvetica", Size: 10, Color: red},
+		{Text: "e", X: 10, Y: 60, Font: "Times-Roman", Size: 10, Color: red},
+		{Text: "f", X: 10, Y: 50, Font: "Times-Roman", Size: 10, ColorCMYK: &CMYK{K: 1}},
+	}
+
+	content, _, err := GenerateContentStream(ops)
+	if err != nil {
+		t.Fatalf("GenerateContentStream failed: %v", err)
+	}
+	got := string(content)
+
+	counts := map[string]int{
+		"0.00 0.00 0.00 rg":     1,
+		"1.00 0.00 0.00 rg":     1,
+		"0.00 0.00 0.00 1.00 k": 1,
+		" 12.00 Tf":             1,
+		" 10.00 Tf":             2,
+		"BT":                    5,
+	}
+	for op, want := range counts {
+		if n := strings.Count(got, op); n != want {
+			t.Errorf("%q written %d times, want %d\n%s", op, n, want, got)
+		}
+	}
+}
+
+func TestGenerateContentStream_StyledTextStateNotCarried(t *testing.T) {
+	font := &EmbeddedFont{ID: "Test", FauxBold: true}
+	ops := []TextOp{
+		{Text: "a", X: 10, Y: 100, Font: "Helvetica", Size: 12},
+		{Text: "b", X: 10, Y: 90, CustomFont: font, Size: 12, Color: RGB{R: 1}},
+		{Text: "c", X: 10, Y: 80, Font: "Helvetica", Size: 12},
+	}
+
+	content, _, err := GenerateContentStream(ops)
+	if err != nil {
+		t.Fatalf("GenerateContentStream failed: %v", err)
+	}
+	got := string(content)
+
+	// The red fill is set inside q/Q, so black is still current afterwards.
+	if n := strings.Count(got, "0.00 0.00 0.00 rg"); n != 1 {
+		t.Errorf("black fill written %d times, want 1\n%s", n, got)
+	}
+	if n := strings.Count(got, "1.00 0.00 0.00 rg"); n != 1 {
+		t.Errorf("red fill written %d times, want 1\n%s", n, got)
+	}
+}
+
+func TestGenerateContentStream_Matrix(t *testing.T) {
+	m := &[6]float64{0.5, 0, 0, 0.5, 20, 30}
+	textOps := []TextOp{
+		{Text: "a", X: 10, Y: 100, Font: "Helvetica", Size: 12},
+		{Text: "b", X: 10, Y: 90, Font: "Helvetica", Size: 12, Matrix: m},
+		{Text: "c", X: 10, Y: 80, Font: "Helvetica", Size: 12},
+	}
+	graphicsOps := []GraphicsOp{
+		{Type: 20, X: 0, Y: 0, Width: 100, Height: 100, Matrix: m},
+		{Type: 1, X: 10, Y: 10, Width: 5, Height: 5, FillColor: &RGB{}, Matrix: m},
+		{Type: 21},
+	}
+
+	content, _, err := GenerateContentStreamWithGraphics(textOps, graphicsOps)
+	if err != nil {
+		t.Fatalf("GenerateContentStreamWithGraphics failed: %v", err)
+	}
+	got := string(content)
+
+	// Clip, rectangle and text "b" are transformed; the clip undoes its
+	// transformation after clipping.
+	if n := strings.Count(got, "0.50 0.00 0.00 0.50 20.00 30.00 cm"); n != 3 {
+		t.Errorf("matrix written %d times, want 3\n%s", n, got)
+	}
+	if !strings.Contains(got, "W\nn\n2.00 0.00 0.00 2.00 -40.00 -60.00 cm") {
+		t.Errorf("clip does not undo its matrix\n%s", got)
+	}
+
+	// Transformed text is isolated, so "a" and "c" share the black fill
+	// and font.
+	if n := strings.Count(got, " 12.00 Tf"); n != 2 {
+		t.Errorf("font set %d times, want 2\n%s", n, got)
+	}
+}
+
+func TestGenerateContentStream_GradientFill(t *testing.T) {
+	grad := &GradientOp{
+		Type:       GradientTypeLinear,
+		ColorStops: []ColorStopOp{{Position: 0, Color: RGB{R: 1}}, {Position: 1, Color: RGB{B: 1}}},
+		X1:         10, Y1: 10, X2: 60, Y2: 10,
+	}
+	graphicsOps := []GraphicsOp{
+		{Type: 1, X: 10, Y: 10, Width: 50, Height: 20, FillGradient: grad, StrokeColor: &RGB{}},
+		{Type: 2, X: 100, Y: 100, Radius: 10, FillGradient: grad},
+		{Type: 9, PathSegments: []PathSegment{
+			{Op: 'm', Points: []Point{{X: 0, Y: 0}}},
+			{Op: 'l', Points: []Point{{X: 10, Y: 0}}},
+			{Op: 'l', Points: []Point{{X: 0, Y: 10}}},
+			{Op: 'h'},
+		}, FillGradient: grad, EvenOdd: true},
+	}
+
+	content, resources, err := GenerateContentStreamWithGraphics(nil, graphicsOps)
+	if err != nil {
+		t.Fatalf("GenerateContentStreamWithGraphics failed: %v", err)
+	}
+	got := string(content)
+
+	// The shading is painted within each shape; the rectangle's path is
+	// drawn again to be stroked.
+	if n := strings.Count(got, "/Sh1 sh"); n != 3 {
+		t.Errorf("shading painted %d times, want 3\n%s", n, got)
+	}
+	if !strings.Contains(got, "10.00 10.00 50.00 20.00 re\nW\nn\n/Sh1 sh\nQ\n10.00 10.00 50.00 20.00 re\nS") {
+		t.Errorf("rectangle not clipped and stroked\n%s", got)
+	}
+	if !strings.Contains(got, "h\nW*\nn\n/Sh1 sh") {
+		t.Errorf("even-odd path not clipped with W*\n%s", got)
+	}
+	if strings.Contains(got, " rg") {
+		t.Errorf("gradient fill set a fill color\n%s", got)
+	}
+
+	// Shapes sharing a gradient share its shading.
+	res := resources.String()
+	if !strings.Contains(res, "/Shading << /Sh1 << /ShadingType 2 ") || strings.Contains(res, "/Sh2") {
+		t.Errorf("resources = %s, want a single shading", res)
+	}
+}
+
+func TestInvertMatrix(t *testing.T) {
+	m := [6]float64{2, 1, -1, 3, 10, -5}
+	inverse, ok := invertMatrix(m)
+	if !ok {
+		t.Fatal("invertMatrix reported a singular matrix")
+	}
+	// Mapping a point through m and its inverse returns the point.
+	x, y := 7.0, 4.0
+	tx, ty := m[0]*x+m[2]*y+m[4], m[1]*x+m[3]*y+m[5]
+	bx, by := inverse[0]*tx+inverse[2]*ty+inverse[4], inverse[1]*tx+inverse[3]*ty+inverse[5]
+	if math.Abs(bx-x) > 1e-9 || math.Abs(by-y) > 1e-9 {
+		t.Errorf("round trip of (%v, %v) = (%v, %v)", x, y, bx, by)
+	}
+
+	if _, ok := invertMatrix([6]float64{1, 2, 2, 4, 0, 0}); ok {
+		t.Error("invertMatrix inverted a singular matrix")
+	}
+}
+
+func TestGenerateContentStream_GroupsMatchingText(t *testing.T) {
+	ops := []TextOp{
+		{Text: "a", X: 50, Y: 700, Font: "Helvetica", Size: 12},
+		{Text: "b", X: 50, Y: 685.5, Font: "Helvetica", Size: 12},
+		// Same baseline: shown with TJ. Helvetica "b" is 556 units wide,
+		// so the gap is (200 - 50 - 6.672) * 1000 / 12 = 11944.
+		{Text: "c", X: 200.004, Y: 685.5, Font: "Helvetica", Size: 12},
+		{Text: "d", X: 50, Y: 671, Font: "Helvetica", Size: 14},
+		{Text: "e", X: 50, Y: 650, Font: "Helvetica", Size: 14},
+	}
+
+	content, _, err := GenerateContentStream(ops)
+	if err != nil {
+		t.Fatalf("GenerateContentStream failed: %v", err)
+	}
+
+	want := `BT
+0.00 0.00 0.00 rg
+/F1 12.00 Tf
+50.00 700.00 Td
+(a) Tj
+0.00 -14.50 Td
+[(b) -11944.00 (c)] TJ
+ET
+BT
+/F1 14.00 Tf
+50.00 671.00 Td
+(d) Tj
+0.00 -21.00 Td
+(e) Tj
+ET
+`
+	if got := string(content); got != want {
+		t.Errorf("content =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGenerateContentStream_GroupedOffsetsDoNotDrift(t *testing.T) {
+	// Offsets are computed from written positions, so rounding errors do
+	// not accumulate over many lines.
+	var ops []TextOp
+	for i := 0; i < 100; i++ {
+		ops = append(ops, TextOp{Text: "x", X: 10, Y: 700 - float64(i)*1.003, Font: "Helvetica", Size: 1})
+	}
+
+	content, _, err := GenerateContentStream(ops)
+	if err != nil {
+		t.Fatalf("GenerateContentStream failed: %v", err)
+	}
+
+	y := 0.0
+	for _, line := range strings.Split(string(content), "\n") {
+		var dx, dy float64
+		if n, _ := fmt.Sscanf(line, "%f %f Td", &dx, &dy); n == 2 {
+			y += dy
+		}
+	}
+	want := 700 - 99*1.003
+	if math.Abs(y-want) > 0.005 {
+		t.Errorf("last line at y = %.2f, want %.2f", y, want)
+	}
+}
+
+func TestGenerateContentStream_SameBaselineSharesTextObject(t *testing.T) {
+	red := RGB{R: 1}
+	// Helvetica-Bold "Item" is 2056 units wide: a 129.44pt gap to "Price".
+	ops := []TextOp{
+		{Text: "Item", X: 50, Y: 700, Font: "Helvetica-Bold", Size: 10},
+		{Text: "Price", X: 200, Y: 700, Font: "Helvetica-Bold", Size: 10},
+		{Text: "Tea", X: 50, Y: 685, Font: "Helvetica", Size: 10},
+		{Text: "1.50", X: 200, Y: 685, Font: "Helvetica", Size: 10, Color: red},
+		{Text: "Cake", X: 50, Y: 670, Font: "Helvetica", Size: 10},
+	}
+
+	content, _, err := GenerateContentStream(ops)
+	if err != nil {
+		t.Fatalf("GenerateContentStream failed: %v", err)
+	}
+
+	want := `BT
+0.00 0.00 0.00 rg
+/F1 10.00 Tf
+50.00 700.00 Td
+[(Item) -12944.00 (Price)] TJ
+ET
+BT
+/F2 10.00 Tf
+50.00 685.00 Td
+(Tea) Tj
+1.00 0.00 0.00 rg
+150.00 0.00 Td
+(1.50) Tj
+ET
+BT
+0.00 0.00 0.00 rg
+50.00 670.00 Td
+(Cake) Tj
+ET
+`
+	if got := string(content); got != want {
+		t.Errorf("content =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGenerateContentStream_TextRunFallsBackToTj(t *testing.T) {
+	font := &EmbeddedFont{ID: "Test"}
+	tests := []struct {
+		name string
+		op   TextOp
+	}{
+		{"custom font", TextOp{Text: "b", X: 100, Y: 700, CustomFont: font, Size: 12}},
+		{"symbolic font", TextOp{Text: "b", X: 100, Y: 700, Font: "Symbol", Size: 12}},
+		{"not WinAnsi", TextOp{Text: "€中", X: 100, Y: 700, Font: "Helvetica", Size: 12}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := tt.op
+			first.Text, first.X = "a", 50
+			content, _, err := GenerateContentStream([]TextOp{first, tt.op})
+			if err != nil {
+				t.Fatalf("GenerateContentStream failed: %v", err)
+			}
+			got := string(content)
+			if strings.Contains(got, "TJ") {
+				t.Errorf("unexpected TJ:\n%s", got)
+			}
+			if !strings.Contains(got, "50.00 0.00 Td") {
+				t.Errorf("second op not positioned relative to the first:\n%s", got)
+			}
+		})
+	}
+}
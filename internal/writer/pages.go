@@ -3,6 +3,7 @@ package writer
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/coregx/gxpdf/internal/document"
 )
@@ -221,7 +222,7 @@ func (w *PdfWriter) createPageWithContent(
 	// Generate content stream and resources
 	if len(textOps) > 0 {
 		// Generate content stream
-		content, resources, err := GenerateContentStream(textOps)
+		content, resources, err := generateContentStream(textOps, nil, w.precision)
 		if err != nil {
 			// For now, skip content on error
 			// TODO: Better error handling
@@ -340,7 +341,11 @@ func (w *PdfWriter) createPageWithAllContent(
 			}
 
 			// Build all embedded font subsets BEFORE generating content stream.
-			for _, embFont := range fontCollection.Embedded {
+			// Fonts already written for an earlier page are complete.
+			for fontID, embFont := range fontCollection.Embedded {
+				if _, written := w.embeddedFontNums[fontID]; written {
+					continue
+				}
 				if embFont.Subset != nil {
 					_ = embFont.Subset.Build() // Ignore errors for now, will handle below.
 				}
@@ -348,7 +353,7 @@ func (w *PdfWriter) createPageWithAllContent(
 		}
 
 		// STEP 2: Generate content stream (now subsets are built, GlyphMapping available).
-		content, resources, err := GenerateContentStreamWithGraphics(textOps, graphicsOps)
+		content, resources, err := generateContentStream(textOps, graphicsOps, w.precision)
 		if err != nil {
 			pageDict.WriteString(" /Resources << >>")
 			pageDict.WriteString(" >>")
@@ -357,8 +362,16 @@ func (w *PdfWriter) createPageWithAllContent(
 
 		// STEP 3: Create font objects and assign object numbers.
 		if fontCollection != nil {
-			// Process Standard14 fonts.
-			for fontName, fontDef := range fontCollection.Standard14 {
+			// Process Standard14 fonts (sorted for deterministic object numbers).
+			// Each font is written once per document and shared by all pages.
+			for _, fontName := range sortedKeys(fontCollection.Standard14) {
+				fontKey := "std:" + fontName
+				if fontObjNum, written := w.standardFontNums[fontName]; written {
+					resources.SetFontObjNumByID(fontKey, fontObjNum)
+					continue
+				}
+
+				fontDef := fontCollection.Standard14[fontName]
 				fontObjNum := w.allocateObjNum()
 
 				var fontBuf bytes.Buffer
@@ -374,14 +387,26 @@ func (w *PdfWriter) createPageWithAllContent(
 					fontDict := fontBytes[dictStart:dictEnd]
 					fontObjs = append(fontObjs, NewIndirectObject(fontObjNum, 0, fontDict))
 
-					fontKey := "std:" + fontName
+					if w.standardFontNums == nil {
+						w.standardFontNums = make(map[string]int)
+					}
+					w.standardFontNums[fontName] = fontObjNum
 					resources.SetFontObjNumByID(fontKey, fontObjNum)
 				}
 			}
 
 			// Process embedded TrueType fonts (subsets already built in STEP 1).
-			for fontID, embFont := range fontCollection.Embedded {
+			// Each font is written once per document and shared by all pages.
+			for _, fontID := range sortedKeys(fontCollection.Embedded) {
+				fontKey := "custom:" + fontID
+				if fontObjNum, written := w.embeddedFontNums[fontID]; written {
+					resources.SetFontObjNumByID(fontKey, fontObjNum)
+					continue
+				}
+
+				embFont := fontCollection.Embedded[fontID]
 				fontWriter := NewTrueTypeFontWriter(embFont.TTF, embFont.Subset, w.allocateObjNum)
+				fontWriter.SetFull(embFont.Full)
 				fontObjects, refs, err := fontWriter.WriteFont()
 				if err != nil {
 					continue
@@ -389,11 +414,32 @@ func (w *PdfWriter) createPageWithAllContent(
 
 				fontObjs = append(fontObjs, fontObjects...)
 
-				fontKey := "custom:" + fontID
+				if w.embeddedFontNums == nil {
+					w.embeddedFontNums = make(map[string]int)
+				}
+				w.embeddedFontNums[fontID] = refs.FontObjNum
 				resources.SetFontObjNumByID(fontKey, refs.FontObjNum)
 			}
 		}
 
+		// STEP 4: Create image XObjects. Each image is written once per
+		// document and shared by all pages.
+		images := collectImages(graphicsOps)
+		for _, imageID := range sortedKeys(images) {
+			imageObjNum, written := w.imageNums[imageID]
+			if !written {
+				imageObjs := createImageObjects(images[imageID], w.allocateObjNum)
+				fontObjs = append(fontObjs, imageObjs...)
+				imageObjNum = imageObjs[0].Number
+
+				if w.imageNums == nil {
+					w.imageNums = make(map[string]int)
+				}
+				w.imageNums[imageID] = imageObjNum
+			}
+			resources.SetImageObjNumByID("img:"+imageID, imageObjNum)
+		}
+
 		// Write resources dictionary
 		pageDict.WriteString(" /Resources ")
 		pageDict.Write(resources.Bytes())
@@ -434,6 +480,16 @@ func (w *PdfWriter) createPageWithAllContent(
 	return NewIndirectObject(objNum, 0, pageDict.Bytes()), contentObj, fontObjs
 }
 
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // createPage creates an individual Page object (backward compatibility).
 //
 // This is kept for existing code that doesn't have content operations.
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/coregx/gxpdf/internal/document"
+	"github.com/coregx/gxpdf/internal/parser"
 )
 
 // PdfWriter writes PDF documents to files.
@@ -36,6 +38,19 @@ type PdfWriter struct {
 	offsets     map[int]int64     // Byte offsets for each object number
 	nextObjNum  int               // Next available object number
 	closed      bool              // Whether Close() has been called
+	precision   int               // Decimal places in content streams
+
+	// Object numbers of written annotations, for reply (/IRT) references.
+	annotNums map[document.Annotation]int
+
+	// Font object numbers of standard 14 fonts already written, by name.
+	standardFontNums map[string]int
+
+	// Font object numbers of embedded fonts already written, by font ID.
+	embeddedFontNums map[string]int
+
+	// Object numbers of image XObjects already written, by image ID.
+	imageNums map[string]int
 }
 
 // countingWriter wraps an io.Writer and tracks bytes written.
@@ -68,6 +83,7 @@ func NewPdfWriter(path string) (*PdfWriter, error) {
 		offsets:    make(map[int]int64),
 		nextObjNum: 1, // Object numbering starts at 1
 		closed:     false,
+		precision:  DefaultPrecision,
 	}, nil
 }
 
@@ -94,9 +110,17 @@ func NewPdfWriterFromWriter(w io.Writer) *PdfWriter {
 		offsets:     make(map[int]int64),
 		nextObjNum:  1,
 		closed:      false,
+		precision:   DefaultPrecision,
 	}
 }
 
+// SetPrecision sets the number of decimal places written for coordinates
+// and lengths in page content streams (default: DefaultPrecision).
+// Values are clamped to [0, MaxPrecision].
+func (w *PdfWriter) SetPrecision(digits int) {
+	w.precision = clampPrecision(digits)
+}
+
 // WriteWithPageContent writes a document with page content operations to the PDF file.
 //
 // This is similar to Write() but accepts page-level content operations
@@ -140,6 +164,9 @@ func (w *PdfWriter) WriteWithPageContent(doc *document.Document, pageContents ma
 	catalogObj := w.createCatalog(pagesRootRef, doc)
 	w.objects = append([]*IndirectObject{catalogObj}, w.objects...)
 
+	// Create Info dictionary (document metadata)
+	infoRef := w.addInfo(doc)
+
 	// Write all objects and track their offsets
 	for _, obj := range w.objects {
 		// Get current offset
@@ -164,7 +191,7 @@ func (w *PdfWriter) WriteWithPageContent(doc *document.Document, pageContents ma
 	// Write trailer
 	catalogRef := catalogObj.Number
 	size := w.nextObjNum
-	if err := w.writeTrailer(catalogRef, size, xrefOffset, doc); err != nil {
+	if err := w.writeTrailer(catalogRef, infoRef, size, xrefOffset); err != nil {
 		return fmt.Errorf("failed to write trailer: %w", err)
 	}
 
@@ -223,6 +250,9 @@ func (w *PdfWriter) WriteWithAllContent(
 	catalogObj := w.createCatalog(pagesRootRef, doc)
 	w.objects = append([]*IndirectObject{catalogObj}, w.objects...)
 
+	// Create Info dictionary (document metadata)
+	infoRef := w.addInfo(doc)
+
 	// Write all objects and track their offsets
 	for _, obj := range w.objects {
 		// Get current offset
@@ -247,7 +277,7 @@ func (w *PdfWriter) WriteWithAllContent(
 	// Write trailer
 	catalogRef := catalogObj.Number
 	size := w.nextObjNum
-	if err := w.writeTrailer(catalogRef, size, xrefOffset, doc); err != nil {
+	if err := w.writeTrailer(catalogRef, infoRef, size, xrefOffset); err != nil {
 		return fmt.Errorf("failed to write trailer: %w", err)
 	}
 
@@ -305,6 +335,9 @@ func (w *PdfWriter) Write(doc *document.Document) error {
 	catalogObj := w.createCatalog(pagesRootRef, doc)
 	w.objects = append([]*IndirectObject{catalogObj}, w.objects...)
 
+	// Create Info dictionary (document metadata)
+	infoRef := w.addInfo(doc)
+
 	// Write all objects and track their offsets
 	for _, obj := range w.objects {
 		// Get current offset
@@ -329,7 +362,7 @@ func (w *PdfWriter) Write(doc *document.Document) error {
 	// Write trailer
 	catalogRef := catalogObj.Number
 	size := w.nextObjNum // Total number of objects + 1 (includes object 0)
-	if err := w.writeTrailer(catalogRef, size, xrefOffset, doc); err != nil {
+	if err := w.writeTrailer(catalogRef, infoRef, size, xrefOffset); err != nil {
 		return fmt.Errorf("failed to write trailer: %w", err)
 	}
 
@@ -477,11 +510,13 @@ func (w *PdfWriter) writeXRef() (int64, error) {
 // Format:
 //
 //	trailer
-//	<< /Size N /Root 1 0 R >>
+//	<< /Size N /Root 1 0 R /Info 2 0 R >>
 //	startxref
 //	<xref_offset>
 //	%%EOF
-func (w *PdfWriter) writeTrailer(catalogRef int, size int, xrefOffset int64, doc *document.Document) error {
+//
+// infoRef is the Info dictionary object number, or 0 if there is none.
+func (w *PdfWriter) writeTrailer(catalogRef, infoRef, size int, xrefOffset int64) error {
 	// Write trailer keyword
 	if _, err := w.writer.WriteString("trailer\n"); err != nil {
 		return fmt.Errorf("failed to write trailer keyword: %w", err)
@@ -493,23 +528,9 @@ func (w *PdfWriter) writeTrailer(catalogRef int, size int, xrefOffset int64, doc
 	trailerDict.WriteString(fmt.Sprintf(" /Size %d", size))
 	trailerDict.WriteString(fmt.Sprintf(" /Root %d 0 R", catalogRef))
 
-	// Add Info dictionary if metadata exists
-	if doc.Title() != "" || doc.Author() != "" || doc.Subject() != "" {
-		infoRef := w.allocateObjNum()
+	// Add Info dictionary reference if metadata exists
+	if infoRef != 0 {
 		trailerDict.WriteString(fmt.Sprintf(" /Info %d 0 R", infoRef))
-
-		// Create Info object
-		infoObj := w.createInfo(infoRef, doc)
-		w.objects = append(w.objects, infoObj)
-
-		// Write Info object immediately (before startxref)
-		offset := xrefOffset // Info comes after xref, so we track it
-		w.offsets[infoRef] = offset
-
-		// We need to write it to a temp buffer to calculate size,
-		// but for simplicity, we'll skip Info in this iteration
-		// TODO: Implement Info object writing in next iteration
-		_ = infoObj // Prevent unused variable error
 	}
 
 	trailerDict.WriteString(" >>")
@@ -548,25 +569,37 @@ func (w *PdfWriter) allocateObjNum() int {
 	return num
 }
 
+// addInfo queues the Info dictionary and returns its object number.
+//
+// The dictionary is always written since it carries the creation and
+// modification dates even when no other metadata is set.
+func (w *PdfWriter) addInfo(doc *document.Document) int {
+
+	infoObj := w.createInfo(w.allocateObjNum(), doc)
+	w.objects = append(w.objects, infoObj)
+	return infoObj.Number
+}
+
 // createInfo creates an Info dictionary object with document metadata.
 func (w *PdfWriter) createInfo(objNum int, doc *document.Document) *IndirectObject {
 	var info bytes.Buffer
 	info.WriteString("<<")
 
-	if doc.Title() != "" {
-		info.WriteString(fmt.Sprintf(" /Title (%s)", escapePDFString(doc.Title())))
-	}
-	if doc.Author() != "" {
-		info.WriteString(fmt.Sprintf(" /Author (%s)", escapePDFString(doc.Author())))
-	}
-	if doc.Subject() != "" {
-		info.WriteString(fmt.Sprintf(" /Subject (%s)", escapePDFString(doc.Subject())))
-	}
-	if doc.Creator() != "" {
-		info.WriteString(fmt.Sprintf(" /Creator (%s)", escapePDFString(doc.Creator())))
-	}
-	if doc.Producer() != "" {
-		info.WriteString(fmt.Sprintf(" /Producer (%s)", escapePDFString(doc.Producer())))
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"Title", doc.Title()},
+		{"Author", doc.Author()},
+		{"Subject", doc.Subject()},
+		{"Keywords", strings.Join(doc.Keywords(), ", ")},
+		{"Creator", doc.Creator()},
+		{"Producer", doc.Producer()},
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			info.WriteString(fmt.Sprintf(" /%s %s", f.key, EncodeTextString(f.value)))
+		}
 	}
 
 	// Creation date
@@ -580,32 +613,10 @@ func (w *PdfWriter) createInfo(objNum int, doc *document.Document) *IndirectObje
 	return NewIndirectObject(objNum, 0, info.Bytes())
 }
 
-// escapePDFString escapes special characters in PDF strings.
-func escapePDFString(s string) string {
-	// For now, simple implementation - just handle basic escaping
-	// TODO: Implement full PDF string escaping (parentheses, backslash, etc.)
-	return s
-}
-
 // formatPDFDate formats a time.Time as a PDF date string.
 //
 // Format: D:YYYYMMDDHHmmSSOHH'mm'.
 // Example: D:20250127123045+03'00'.
 func formatPDFDate(t time.Time) string {
-	// Format: D:YYYYMMDDHHmmSS+HH'mm'
-	_, offset := t.Zone()
-	offsetHours := offset / 3600
-	offsetMinutes := (offset % 3600) / 60
-
-	sign := "+"
-	if offset < 0 {
-		sign = "-"
-		offsetHours = -offsetHours
-		offsetMinutes = -offsetMinutes
-	}
-
-	return fmt.Sprintf("D:%04d%02d%02d%02d%02d%02d%s%02d'%02d'",
-		t.Year(), t.Month(), t.Day(),
-		t.Hour(), t.Minute(), t.Second(),
-		sign, offsetHours, offsetMinutes)
+	return parser.TimeToPdfDate(t)
 }
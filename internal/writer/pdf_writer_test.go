@@ -431,10 +431,16 @@ func TestPdfWriter_MetadataInTrailer(t *testing.T) {
 	contentStr := string(content)
 
 	// Metadata is written in Info dictionary referenced from trailer
-	// The trailer should contain /Info reference if metadata exists
-	if strings.Contains(contentStr, "Test Title") {
-		// If title is in file, check for proper Info dictionary structure
-		t.Log("Metadata found in file (Info dictionary)")
+	for _, want := range []string{
+		"/Info 4 0 R",
+		"4 0 obj",
+		"/Title (Test Title)",
+		"/Author (Test Author)",
+		"/Keywords (keyword1, keyword2)",
+	} {
+		if !strings.Contains(contentStr, want) {
+			t.Errorf("output missing %q", want)
+		}
 	}
 }
 
@@ -18,6 +18,8 @@ import (
 //	  /Font << /F1 5 0 R /F2 6 0 R >>
 //	  /XObject << /Im1 7 0 R >>
 //	  /ExtGState << /GS1 8 0 R >>
+//	  /ColorSpace << /CS1 [/Indexed /DeviceRGB 1 <FF00000000FF>] >>
+//	  /Shading << /Sh1 << /ShadingType 2 ... >> >>
 //	  /ProcSet [/PDF /Text /ImageB /ImageC /ImageI]
 //	>>
 //
@@ -26,9 +28,14 @@ type ResourceDictionary struct {
 	fonts           map[string]int     // Font resource name -> object number (e.g., "F1" -> 5)
 	fontIDs         map[string]string  // Font ID -> resource name (e.g., "custom:font_1" -> "F1")
 	xobjects        map[string]int     // XObject resource name -> object number (e.g., "Im1" -> 10)
+	imageIDs        map[string]string  // Image ID -> resource name (e.g., "img:3f2a" -> "Im1")
 	extgstates      map[string]int     // ExtGState resource name -> object number (e.g., "GS1" -> 15)
 	extgstateCache  map[float64]string // Opacity -> ExtGState name (for caching, e.g., 0.5 -> "GS1")
 	extgstateObjMap map[string]int     // ExtGState name -> object number (for later setting)
+	colorSpaces     map[string]string  // Color space resource name -> inline array (e.g., "CS1" -> "[/Indexed ...]")
+	colorSpaceIDs   map[string]string  // Color space ID -> resource name (e.g., "idx:3f2a" -> "CS1")
+	shadings        map[string]string  // Shading resource name -> inline dictionary (e.g., "Sh1" -> "<< /ShadingType 2 ... >>")
+	shadingIDs      map[string]string  // Shading ID -> resource name (e.g., "sh:3f2a" -> "Sh1")
 }
 
 // NewResourceDictionary creates a new empty resource dictionary.
@@ -37,9 +44,14 @@ func NewResourceDictionary() *ResourceDictionary {
 		fonts:           make(map[string]int),
 		fontIDs:         make(map[string]string),
 		xobjects:        make(map[string]int),
+		imageIDs:        make(map[string]string),
 		extgstates:      make(map[string]int),
 		extgstateCache:  make(map[float64]string),
 		extgstateObjMap: make(map[string]int),
+		colorSpaces:     make(map[string]string),
+		colorSpaceIDs:   make(map[string]string),
+		shadings:        make(map[string]string),
+		shadingIDs:      make(map[string]string),
 	}
 }
 
@@ -144,6 +156,77 @@ func (rd *ResourceDictionary) AddImage(objNum int) string {
 	return name
 }
 
+// AddImageWithID adds an image XObject resource with an associated ID and
+// returns its resource name.
+//
+// The imageID is used to later set the correct object number via
+// SetImageObjNumByID. If an image with the same ID already exists, returns
+// the existing resource name, so an image drawn several times on a page is
+// listed once.
+func (rd *ResourceDictionary) AddImageWithID(objNum int, imageID string) string {
+	if name, exists := rd.imageIDs[imageID]; exists {
+		return name
+	}
+
+	name := rd.AddImage(objNum)
+	rd.imageIDs[imageID] = name
+	return name
+}
+
+// SetImageObjNumByID sets the object number for an image identified by its ID.
+//
+// Returns true if the image was found and updated, false otherwise.
+func (rd *ResourceDictionary) SetImageObjNumByID(imageID string, objNum int) bool {
+	resName, ok := rd.imageIDs[imageID]
+	if !ok {
+		return false
+	}
+	rd.xobjects[resName] = objNum
+	return true
+}
+
+// AddColorSpace adds a color space resource, written inline as the given
+// array, and returns its resource name.
+//
+// Color spaces are named sequentially: CS1, CS2, CS3, etc. If a color space
+// with the same ID already exists, returns the existing resource name.
+//
+// Example:
+//
+//	name := rd.AddColorSpace("idx:3f2a", "[/Indexed /DeviceRGB 1 <FF00000000FF>]")  // Returns "CS1"
+//	// In content stream: /CS1 cs 1 sc (fill with palette entry 1)
+func (rd *ResourceDictionary) AddColorSpace(colorSpaceID, array string) string {
+	if name, exists := rd.colorSpaceIDs[colorSpaceID]; exists {
+		return name
+	}
+
+	name := fmt.Sprintf("CS%d", len(rd.colorSpaces)+1)
+	rd.colorSpaces[name] = array
+	rd.colorSpaceIDs[colorSpaceID] = name
+	return name
+}
+
+// AddShading adds a shading resource, written inline as the given
+// dictionary, and returns its resource name.
+//
+// Shadings are named sequentially: Sh1, Sh2, Sh3, etc. If a shading with
+// the same ID already exists, returns the existing resource name.
+//
+// Example:
+//
+//	name := rd.AddShading("sh:3f2a", "<< /ShadingType 2 ... >>")  // Returns "Sh1"
+//	// In content stream: /Sh1 sh (paint shading Sh1 within the clip)
+func (rd *ResourceDictionary) AddShading(shadingID, dict string) string {
+	if name, exists := rd.shadingIDs[shadingID]; exists {
+		return name
+	}
+
+	name := fmt.Sprintf("Sh%d", len(rd.shadings)+1)
+	rd.shadings[name] = dict
+	rd.shadingIDs[shadingID] = name
+	return name
+}
+
 // AddExtGState adds a graphics state resource and returns its resource name.
 //
 // Graphics states are named sequentially: GS1, GS2, GS3, etc.
@@ -242,7 +325,14 @@ func (rd *ResourceDictionary) GetExtGStateObjNum(name string) int {
 //
 // Use this to check if the resource dictionary is empty before writing.
 func (rd *ResourceDictionary) HasResources() bool {
-	return len(rd.fonts) > 0 || len(rd.xobjects) > 0 || len(rd.extgstates) > 0
+	return len(rd.fonts) > 0 || len(rd.xobjects) > 0 || len(rd.extgstates) > 0 ||
+		len(rd.colorSpaces) > 0 || len(rd.shadings) > 0
+}
+
+// FontsOnly returns true if fonts are the only registered resources.
+func (rd *ResourceDictionary) FontsOnly() bool {
+	return len(rd.xobjects) == 0 && len(rd.extgstates) == 0 && len(rd.colorSpaces) == 0 &&
+		len(rd.shadings) == 0
 }
 
 // Bytes returns the resource dictionary as PDF bytes.
@@ -281,6 +371,20 @@ func (rd *ResourceDictionary) Bytes() []byte {
 		buf.WriteString(" >>")
 	}
 
+	// ColorSpace resources, written inline.
+	if len(rd.colorSpaces) > 0 {
+		buf.WriteString(" /ColorSpace <<")
+		rd.writeSortedInline(&buf, rd.colorSpaces)
+		buf.WriteString(" >>")
+	}
+
+	// Shading resources, written inline.
+	if len(rd.shadings) > 0 {
+		buf.WriteString(" /Shading <<")
+		rd.writeSortedInline(&buf, rd.shadings)
+		buf.WriteString(" >>")
+	}
+
 	// ProcSet (procedure set) - required for compatibility with old PDF readers.
 	// Modern readers ignore this, but it's recommended for maximum compatibility.
 	if rd.HasResources() {
@@ -319,3 +423,19 @@ func (rd *ResourceDictionary) writeSortedResources(buf *bytes.Buffer, resources
 		fmt.Fprintf(buf, " /%s %d 0 R", name, objNum)
 	}
 }
+
+// writeSortedInline writes resources written inline (not as references) to
+// buffer in sorted order.
+//
+// Format: /Name Value.
+func (rd *ResourceDictionary) writeSortedInline(buf *bytes.Buffer, resources map[string]string) {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(buf, " /%s %s", name, resources[name])
+	}
+}
@@ -115,6 +115,69 @@ func TestResourceDictionary_AddImage(t *testing.T) {
 	}
 }
 
+func TestResourceDictionary_AddImageWithID(t *testing.T) {
+	rd := NewResourceDictionary()
+
+	logo := rd.AddImageWithID(0, "img:logo")
+	photo := rd.AddImageWithID(0, "img:photo")
+	if again := rd.AddImageWithID(0, "img:logo"); again != logo {
+		t.Errorf("AddImageWithID for the same ID = %q, want %q", again, logo)
+	}
+	if logo != "Im1" || photo != "Im2" {
+		t.Errorf("names = %q, %q, want Im1, Im2", logo, photo)
+	}
+
+	if !rd.SetImageObjNumByID("img:logo", 12) || !rd.SetImageObjNumByID("img:photo", 14) {
+		t.Fatal("SetImageObjNumByID failed for an added image")
+	}
+	if rd.SetImageObjNumByID("img:missing", 16) {
+		t.Error("SetImageObjNumByID succeeded for an unknown image")
+	}
+
+	want := "<< /XObject << /Im1 12 0 R /Im2 14 0 R >> /ProcSet [/PDF /Text /ImageB /ImageC /ImageI] >>"
+	if got := rd.String(); got != want {
+		t.Errorf("String() = %q\nwant: %q", got, want)
+	}
+}
+
+func TestResourceDictionary_AddColorSpace(t *testing.T) {
+	rd := NewResourceDictionary()
+
+	rgb := rd.AddColorSpace("idx:rgb", "[/Indexed /DeviceRGB 1 <FF00000000FF>]")
+	gray := rd.AddColorSpace("idx:gray", "[/Indexed /DeviceGray 1 <00FF>]")
+	if again := rd.AddColorSpace("idx:rgb", "[/Indexed /DeviceRGB 1 <FF00000000FF>]"); again != rgb {
+		t.Errorf("AddColorSpace for the same ID = %q, want %q", again, rgb)
+	}
+	if rgb != "CS1" || gray != "CS2" {
+		t.Errorf("names = %q, %q, want CS1, CS2", rgb, gray)
+	}
+
+	want := "<< /ColorSpace << /CS1 [/Indexed /DeviceRGB 1 <FF00000000FF>] /CS2 [/Indexed /DeviceGray 1 <00FF>] >>" +
+		" /ProcSet [/PDF /Text /ImageB /ImageC /ImageI] >>"
+	if got := rd.String(); got != want {
+		t.Errorf("String() = %q\nwant: %q", got, want)
+	}
+}
+
+func TestResourceDictionary_AddShading(t *testing.T) {
+	rd := NewResourceDictionary()
+
+	axial := rd.AddShading("sh:axial", "<< /ShadingType 2 >>")
+	radial := rd.AddShading("sh:radial", "<< /ShadingType 3 >>")
+	if again := rd.AddShading("sh:axial", "<< /ShadingType 2 >>"); again != axial {
+		t.Errorf("AddShading for the same ID = %q, want %q", again, axial)
+	}
+	if axial != "Sh1" || radial != "Sh2" {
+		t.Errorf("names = %q, %q, want Sh1, Sh2", axial, radial)
+	}
+
+	want := "<< /Shading << /Sh1 << /ShadingType 2 >> /Sh2 << /ShadingType 3 >> >>" +
+		" /ProcSet [/PDF /Text /ImageB /ImageC /ImageI] >>"
+	if got := rd.String(); got != want {
+		t.Errorf("String() = %q\nwant: %q", got, want)
+	}
+}
+
 //nolint:dupl // Table-driven tests have similar structure by design.
 func TestResourceDictionary_AddExtGState(t *testing.T) {
 	tests := []struct {
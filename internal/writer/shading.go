@@ -0,0 +1,109 @@
+package writer
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// ShadingDict returns the gradient as an inline shading dictionary: an axial
+// (ShadingType 2) or radial (ShadingType 3) shading in DeviceRGB, e.g.
+//
+//	<< /ShadingType 2 /ColorSpace /DeviceRGB /Coords [0 0 100 0]
+//	   /Function << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>
+//	   /Extend [false false] >>
+//
+// Two color stops are interpolated by an exponential function; more stops
+// by a stitching function of exponential functions, one per pair of
+// adjacent stops.
+//
+// Reference: PDF 1.7 specification, Sections 7.10 (Functions) and 8.7.4.5
+// (Shading Types).
+func (g *GradientOp) ShadingDict() string {
+	var coords []float64
+	if g.Type == GradientTypeRadial {
+		coords = []float64{g.X0, g.Y0, g.R0, g.X0, g.Y0, g.R1}
+	} else {
+		coords = []float64{g.X1, g.Y1, g.X2, g.Y2}
+	}
+
+	return fmt.Sprintf("<< /ShadingType %d /ColorSpace /DeviceRGB /Coords %s /Function %s /Extend [%t %t] >>",
+		shadingType(g.Type), shadingArray(coords...), gradientFunction(g.ColorStops), g.ExtendStart, g.ExtendEnd)
+}
+
+// shadingID returns an ID identifying the shading of the gradient, so that
+// a gradient used by several shapes is listed once in a page's resources.
+func (g *GradientOp) shadingID() string {
+	sum := sha256.Sum256([]byte(g.ShadingDict()))
+	return fmt.Sprintf("sh:%x", sum[:8])
+}
+
+// shadingType returns the ShadingType of a gradient type, axial for unknown
+// types.
+func shadingType(t GradientType) int {
+	if t == GradientTypeRadial {
+		return 3
+	}
+	return 2
+}
+
+// gradientFunction returns the function mapping the shading parameter t in
+// [0 1] to the colors of stops. Before the first stop and after the last,
+// the color of the nearest stop is used.
+func gradientFunction(stops []ColorStopOp) string {
+	switch len(stops) {
+	case 0:
+		return exponentialFunction(RGB{}, RGB{})
+	case 1:
+		return exponentialFunction(stops[0].Color, stops[0].Color)
+	}
+
+	// Pad the stops to cover the whole domain.
+	if first := stops[0]; first.Position > 0 {
+		stops = append([]ColorStopOp{{Position: 0, Color: first.Color}}, stops...)
+	}
+	if last := stops[len(stops)-1]; last.Position < 1 {
+		stops = append(stops[:len(stops):len(stops)], ColorStopOp{Position: 1, Color: last.Color})
+	}
+	if len(stops) == 2 {
+		return exponentialFunction(stops[0].Color, stops[1].Color)
+	}
+
+	functions := make([]string, 0, len(stops)-1)
+	bounds := make([]float64, 0, len(stops)-2)
+	encode := make([]float64, 0, 2*(len(stops)-1))
+	for i := 1; i < len(stops); i++ {
+		functions = append(functions, exponentialFunction(stops[i-1].Color, stops[i].Color))
+		if i < len(stops)-1 {
+			bounds = append(bounds, clampUnit(stops[i].Position))
+		}
+		encode = append(encode, 0, 1)
+	}
+
+	return fmt.Sprintf("<< /FunctionType 3 /Domain [0 1] /Functions [%s] /Bounds %s /Encode %s >>",
+		strings.Join(functions, " "), shadingArray(bounds...), shadingArray(encode...))
+}
+
+// exponentialFunction returns a linear interpolation from c0 to c1 as an
+// exponential (FunctionType 2) function.
+func exponentialFunction(c0, c1 RGB) string {
+	return fmt.Sprintf("<< /FunctionType 2 /Domain [0 1] /C0 %s /C1 %s /N 1 >>",
+		shadingArray(c0.R, c0.G, c0.B), shadingArray(c1.R, c1.G, c1.B))
+}
+
+// clampUnit limits v to [0, 1].
+func clampUnit(v float64) float64 {
+	return max(0, min(v, 1))
+}
+
+// shadingArray formats numbers as a PDF array, e.g. "[0 0.5 1]".
+func shadingArray(vs ...float64) string {
+	parts := make([]string, len(vs))
+	for i, v := range vs {
+		parts[i] = formatDecodeValue(v)
+		if parts[i] == "-0" {
+			parts[i] = "0"
+		}
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
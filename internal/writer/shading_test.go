@@ -0,0 +1,57 @@
+package writer
+
+import "testing"
+
+func TestGradientOp_ShadingDict(t *testing.T) {
+	red, blue, white := RGB{R: 1}, RGB{B: 1}, RGB{R: 1, G: 1, B: 1}
+
+	tests := []struct {
+		name string
+		grad GradientOp
+		want string
+	}{
+		{
+			name: "linear two stops",
+			grad: GradientOp{
+				Type:       GradientTypeLinear,
+				ColorStops: []ColorStopOp{{Position: 0, Color: red}, {Position: 1, Color: blue}},
+				X1:         10, Y1: 20, X2: 110, Y2: 20,
+			},
+			want: "<< /ShadingType 2 /ColorSpace /DeviceRGB /Coords [10 20 110 20]" +
+				" /Function << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >> /Extend [false false] >>",
+		},
+		{
+			name: "radial three stops",
+			grad: GradientOp{
+				Type:       GradientTypeRadial,
+				ColorStops: []ColorStopOp{{Position: 0, Color: white}, {Position: 0.25, Color: red}, {Position: 1, Color: blue}},
+				X0:         50, Y0: 60, R0: 0, R1: 40.5,
+				ExtendStart: true, ExtendEnd: true,
+			},
+			want: "<< /ShadingType 3 /ColorSpace /DeviceRGB /Coords [50 60 0 50 60 40.5] /Function << /FunctionType 3 /Domain [0 1]" +
+				" /Functions [<< /FunctionType 2 /Domain [0 1] /C0 [1 1 1] /C1 [1 0 0] /N 1 >>" +
+				" << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>]" +
+				" /Bounds [0.25] /Encode [0 1 0 1] >> /Extend [true true] >>",
+		},
+		{
+			name: "stops padded to the domain",
+			grad: GradientOp{
+				Type:       GradientTypeLinear,
+				ColorStops: []ColorStopOp{{Position: 0.5, Color: red}, {Position: 0.75, Color: blue}},
+				X2:         100,
+			},
+			want: "<< /ShadingType 2 /ColorSpace /DeviceRGB /Coords [0 0 100 0] /Function << /FunctionType 3 /Domain [0 1]" +
+				" /Functions [<< /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [1 0 0] /N 1 >>" +
+				" << /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>" +
+				" << /FunctionType 2 /Domain [0 1] /C0 [0 0 1] /C1 [0 0 1] /N 1 >>]" +
+				" /Bounds [0.5 0.75] /Encode [0 1 0 1 0 1] >> /Extend [false false] >>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.grad.ShadingDict(); got != tt.want {
+				t.Errorf("ShadingDict() = %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
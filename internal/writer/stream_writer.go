@@ -0,0 +1,158 @@
+package writer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/coregx/gxpdf/internal/document"
+)
+
+// StreamWriter writes a PDF one page at a time.
+//
+// Each page is written with its content stream, fonts, images and
+// annotations as soon as it is passed to WritePage, and is not retained
+// afterwards: memory use stays flat however many pages are written. The
+// page tree, catalog, Info dictionary and cross-reference table follow in
+// Close.
+//
+// Fonts and images shared by several pages are written once, with the
+// first page that uses them. Embedded fonts must therefore contain every
+// glyph used by later pages when that page is written (see
+// EmbeddedFont.Full).
+//
+// Example:
+//
+//	sw := NewStreamWriter(w, "1.7")
+//	for _, page := range pages {
+//	    if err := sw.WritePage(page, textOps, graphicsOps); err != nil {
+//	        return err
+//	    }
+//	}
+//	err := sw.Close(doc)
+type StreamWriter struct {
+	pw      *PdfWriter
+	version string
+
+	started      bool
+	closed       bool
+	pagesRootRef int   // Object number of the Pages root, written in Close
+	pageRefs     []int // Object numbers of the pages written so far
+}
+
+// NewStreamWriter creates a stream writer writing a PDF of the given
+// version (e.g. "1.7") to w. Nothing is written before the first page.
+func NewStreamWriter(w io.Writer, version string) *StreamWriter {
+	return &StreamWriter{
+		pw:      NewPdfWriterFromWriter(w),
+		version: version,
+	}
+}
+
+// SetPrecision sets the number of decimal places written for numbers in
+// page content streams (see PdfWriter.SetPrecision).
+func (sw *StreamWriter) SetPrecision(digits int) {
+	sw.pw.SetPrecision(digits)
+}
+
+// PageCount returns the number of pages written so far.
+func (sw *StreamWriter) PageCount() int {
+	return len(sw.pageRefs)
+}
+
+// WritePage writes a page with its content and resources.
+func (sw *StreamWriter) WritePage(page *document.Page, textOps []TextOp, graphicsOps []GraphicsOp) error {
+	if sw.closed {
+		return fmt.Errorf("writer is closed")
+	}
+	if err := sw.start(); err != nil {
+		return err
+	}
+
+	pageRef := sw.pw.allocateObjNum()
+	sw.pageRefs = append(sw.pageRefs, pageRef)
+
+	pageObj, contentObj, resourceObjs := sw.pw.createPageWithAllContent(page, pageRef, sw.pagesRootRef, textOps, graphicsOps)
+	objects := append([]*IndirectObject{pageObj}, resourceObjs...)
+	if contentObj != nil {
+		objects = append(objects, contentObj)
+	}
+	for _, obj := range objects {
+		if err := sw.writeObject(obj); err != nil {
+			return err
+		}
+	}
+
+	// Hand the page to the output, so that only the current page is ever
+	// buffered.
+	if err := sw.pw.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush page %d: %w", len(sw.pageRefs), err)
+	}
+	return nil
+}
+
+// Close writes the page tree, the catalog, the Info dictionary of doc and
+// the cross-reference table, completing the PDF. The pages of doc are
+// ignored; at least one page must have been written.
+//
+// Close does not close the underlying io.Writer.
+func (sw *StreamWriter) Close(doc *document.Document) error {
+	if sw.closed {
+		return fmt.Errorf("writer is closed")
+	}
+	if len(sw.pageRefs) == 0 {
+		return fmt.Errorf("document has no pages")
+	}
+	sw.closed = true
+	defer sw.pw.Close()
+
+	pagesRootObj := sw.pw.createPagesRoot(sw.pagesRootRef, sw.pageRefs, len(sw.pageRefs))
+	catalogObj := sw.pw.createCatalog(sw.pagesRootRef, doc)
+	infoObj := sw.pw.createInfo(sw.pw.allocateObjNum(), doc)
+	for _, obj := range []*IndirectObject{pagesRootObj, catalogObj, infoObj} {
+		if err := sw.writeObject(obj); err != nil {
+			return err
+		}
+	}
+
+	xrefOffset, err := sw.pw.writeXRef()
+	if err != nil {
+		return fmt.Errorf("failed to write xref: %w", err)
+	}
+	if err := sw.pw.writeTrailer(catalogObj.Number, infoObj.Number, sw.pw.nextObjNum, xrefOffset); err != nil {
+		return fmt.Errorf("failed to write trailer: %w", err)
+	}
+
+	if err := sw.pw.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush writer: %w", err)
+	}
+	return nil
+}
+
+// start writes the header and reserves the Pages root object number,
+// which every page refers to as its parent.
+func (sw *StreamWriter) start() error {
+	if sw.started {
+		return nil
+	}
+	sw.started = true
+
+	if err := sw.pw.writeHeader(sw.version); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+	sw.pagesRootRef = sw.pw.allocateObjNum()
+	return nil
+}
+
+// writeObject writes obj and records its offset for the xref table.
+func (sw *StreamWriter) writeObject(obj *IndirectObject) error {
+	pos, err := sw.pw.getCurrentOffset()
+	if err != nil {
+		return fmt.Errorf("failed to get file position: %w", err)
+	}
+	sw.pw.offsets[obj.Number] = pos
+
+	if _, err := obj.WriteTo(sw.pw.writer); err != nil {
+		return fmt.Errorf("failed to write object %d: %w", obj.Number, err)
+	}
+	return nil
+}
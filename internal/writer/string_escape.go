@@ -1,7 +1,11 @@
 // Package writer implements PDF writing infrastructure.
 package writer
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
 
 // EscapePDFString escapes a string for use in PDF literal strings.
 //
@@ -50,3 +54,41 @@ func EscapePDFString(s string) string {
 
 	return s
 }
+
+// EncodeTextString encodes a string as a complete PDF text string token,
+// including delimiters.
+//
+// ASCII strings are written as escaped literal strings, which read the
+// same in PDFDocEncoding. Strings with any other character are written as
+// hexadecimal UTF-16BE with a byte order mark, as required for text
+// strings outside PDFDocEncoding (Cyrillic, CJK, etc.).
+//
+// Example:
+//
+//	EncodeTextString("Report (2025)")  // "(Report \\(2025\\))"
+//	EncodeTextString("Отчёт")          // "<FEFF041E0442044704510442>"
+//
+// Reference: PDF 1.7 Spec, Section 7.9.2.2 (Text String Type).
+func EncodeTextString(s string) string {
+	if isASCII(s) {
+		return "(" + EscapePDFString(s) + ")"
+	}
+
+	var buf strings.Builder
+	buf.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&buf, "%04X", u)
+	}
+	buf.WriteString(">")
+	return buf.String()
+}
+
+// isASCII reports whether s contains only 7-bit ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
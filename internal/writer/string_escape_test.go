@@ -227,3 +227,28 @@ func BenchmarkEscapePDFString(b *testing.B) {
 		})
 	}
 }
+
+// TestEncodeTextString tests encoding of text strings for metadata.
+func TestEncodeTextString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"ascii literal", "Annual Report", "(Annual Report)"},
+		{"ascii escaped", "Report (draft)", "(Report \\(draft\\))"},
+		{"cyrillic utf16", "Отчёт", "<FEFF041E0442044704510442>"},
+		{"latin1 utf16", "Café", "<FEFF00430061006600E9>"},
+		{"surrogate pair", "😀", "<FEFFD83DDE00>"},
+		{"empty", "", "()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EncodeTextString(tt.input)
+			if got != tt.expected {
+				t.Errorf("EncodeTextString(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ type TrueTypeFontWriter struct {
 	subset     *fonts.FontSubset
 	objNumGen  func() int      // Function to generate next object number
 	cidFontObj *IndirectObject // CIDFont object (set during createFontObject)
+	full       bool            // Full embedding (no subset tag)
 }
 
 // NewTrueTypeFontWriter creates a new TrueType font writer.
@@ -50,6 +51,15 @@ func NewTrueTypeFontWriter(ttf *fonts.TTFFont, subset *fonts.FontSubset, objNumG
 	}
 }
 
+// SetFull sets whether the complete font is embedded.
+//
+// A fully embedded font is named without the subset tag (e.g. "ABCDEF+"),
+// which tells editing applications that all glyphs are available. The
+// subset should then contain all characters of the font.
+func (w *TrueTypeFontWriter) SetFull(full bool) {
+	w.full = full
+}
+
 // WriteFont generates all PDF objects for the embedded font.
 //
 // Returns:
@@ -151,11 +161,7 @@ func (w *TrueTypeFontWriter) createFontDescriptorObject(objNum, fontFileObjNum i
 	}
 
 	// Generate subset font name.
-	usedChars := make([]rune, 0, len(w.subset.UsedChars))
-	for ch := range w.subset.UsedChars {
-		usedChars = append(usedChars, ch)
-	}
-	subsetName := fonts.SubsetFontName(fd.FontName, usedChars)
+	subsetName := w.fontName(fd.FontName)
 
 	// Create descriptor dictionary.
 	var buf bytes.Buffer
@@ -222,11 +228,7 @@ func (w *TrueTypeFontWriter) createToUnicodeObject(objNum int) (*IndirectObject,
 func (w *TrueTypeFontWriter) createFontObject(objNum, descriptorObjNum, toUnicodeObjNum int) (*IndirectObject, error) {
 	// Generate subset font name.
 	fd := fonts.GenerateFontDescriptor(w.ttf)
-	usedChars := make([]rune, 0, len(w.subset.UsedChars))
-	for ch := range w.subset.UsedChars {
-		usedChars = append(usedChars, ch)
-	}
-	subsetName := fonts.SubsetFontName(fd.FontName, usedChars)
+	subsetName := w.fontName(fd.FontName)
 
 	// Allocate object number for CIDFont (descendant font).
 	cidFontObjNum := w.objNumGen()
@@ -278,6 +280,20 @@ func (w *TrueTypeFontWriter) createFontObject(objNum, descriptorObjNum, toUnicod
 	return fontObj, nil
 }
 
+// fontName returns the font name for the PDF: the subset-tagged name, or
+// the plain name for fully embedded fonts.
+func (w *TrueTypeFontWriter) fontName(baseName string) string {
+	if w.full {
+		return baseName
+	}
+
+	usedChars := make([]rune, 0, len(w.subset.UsedChars))
+	for ch := range w.subset.UsedChars {
+		usedChars = append(usedChars, ch)
+	}
+	return fonts.SubsetFontName(baseName, usedChars)
+}
+
 // getDefaultWidth returns the default glyph width in PDF units.
 func (w *TrueTypeFontWriter) getDefaultWidth() int {
 	// Use advance width of space character if available.
@@ -263,3 +263,43 @@ func TestTrueTypeFontWriter_ToUnicode(t *testing.T) {
 		t.Error("Missing stream keyword")
 	}
 }
+
+func TestTrueTypeFontWriter_SetFull(t *testing.T) {
+	ttf := &fonts.TTFFont{
+		PostScriptName: "FullFont",
+		UnitsPerEm:     1000,
+		GlyphWidths:    map[uint16]uint16{1: 600},
+		CharToGlyph:    map[rune]uint16{'A': 1},
+		FontData:       []byte("test"),
+	}
+
+	subset := fonts.NewFontSubset(ttf)
+	subset.UseString("A")
+
+	for _, full := range []bool{false, true} {
+		nextObjNum := 1
+		writer := NewTrueTypeFontWriter(ttf, subset, func() int {
+			num := nextObjNum
+			nextObjNum++
+			return num
+		})
+		writer.SetFull(full)
+
+		objects, _, err := writer.WriteFont()
+		if err != nil {
+			t.Fatalf("WriteFont failed: %v", err)
+		}
+
+		var all strings.Builder
+		for _, obj := range objects {
+			all.Write(obj.Data)
+		}
+		tagged := strings.Contains(all.String(), "+FullFont")
+		if tagged == full {
+			t.Errorf("full=%v: subset tag present = %v", full, tagged)
+		}
+		if full && !strings.Contains(all.String(), "/BaseFont /FullFont\n") {
+			t.Error("Missing untagged /BaseFont for full embedding")
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package gxpdf
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/coregx/gxpdf/internal/application/layers"
+)
+
+// Layer is an optional content group (layer) of a PDF, as used by CAD
+// drawings and maps to show or hide parts of the content.
+type Layer struct {
+	// Name is the layer name shown by viewers.
+	Name string
+
+	// Visible reports whether the layer is shown by default.
+	Visible bool
+}
+
+// Layers returns the layers of the document, or none if the document has
+// no optional content.
+func (d *Document) Layers() ([]Layer, error) {
+	internalLayers, err := layers.Read(d.reader)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to read layers: %w", err)
+	}
+
+	result := make([]Layer, len(internalLayers))
+	for i, l := range internalLayers {
+		result[i] = Layer{Name: l.Name, Visible: l.Visible}
+	}
+	return result, nil
+}
+
+// FlattenLayers writes a copy of the document without layers to w.
+//
+// The content of the named layers is removed; the content of all other
+// layers is kept and always drawn, regardless of its default visibility.
+// Removing all layers but one therefore leaves the base content plus that
+// layer, ready for extraction.
+//
+// Example:
+//
+//	f, _ := os.Create("walls.pdf")
+//	defer f.Close()
+//	err := doc.FlattenLayers(f, "Dimensions", "Hatching")
+func (d *Document) FlattenLayers(w io.Writer, remove ...string) error {
+	if err := layers.Flatten(d.reader, remove, w); err != nil {
+		return fmt.Errorf("gxpdf: failed to flatten layers: %w", err)
+	}
+	return nil
+}
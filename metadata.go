@@ -0,0 +1,92 @@
+package gxpdf
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/coregx/gxpdf/internal/application/rewrite"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// MetadataFields is a set of metadata fields kept by StripMetadata.
+type MetadataFields int
+
+// Metadata fields. Combine them with |.
+const (
+	// MetadataTitle is the document title (/Title).
+	MetadataTitle MetadataFields = 1 << iota
+	// MetadataAuthor is the document author (/Author).
+	MetadataAuthor
+	// MetadataSubject is the document subject (/Subject).
+	MetadataSubject
+	// MetadataKeywords are the document keywords (/Keywords).
+	MetadataKeywords
+	// MetadataCreator is the application that created the original
+	// document (/Creator).
+	MetadataCreator
+	// MetadataProducer is the application that produced the PDF (/Producer).
+	MetadataProducer
+	// MetadataCreationDate is the creation date (/CreationDate).
+	MetadataCreationDate
+	// MetadataModDate is the modification date (/ModDate).
+	MetadataModDate
+	// MetadataXMP is the XMP metadata of the document and of its pages,
+	// images and other objects, kept or removed as a whole.
+	MetadataXMP
+)
+
+// MetadataNone keeps no metadata.
+const MetadataNone MetadataFields = 0
+
+// metadataInfoKeys maps metadata fields to document information entries.
+var metadataInfoKeys = []struct {
+	field MetadataFields
+	key   string
+}{
+	{MetadataTitle, "Title"},
+	{MetadataAuthor, "Author"},
+	{MetadataSubject, "Subject"},
+	{MetadataKeywords, "Keywords"},
+	{MetadataCreator, "Creator"},
+	{MetadataProducer, "Producer"},
+	{MetadataCreationDate, "CreationDate"},
+	{MetadataModDate, "ModDate"},
+}
+
+// StripMetadata writes a copy of input without its metadata to output,
+// e.g. to scrub a document before publishing it.
+//
+// The document information dictionary is cleared, except for the fields in
+// keep; other entries, including custom ones, are always removed. XMP
+// metadata is removed unless keep contains MetadataXMP. Objects no longer
+// referenced, such as earlier revisions holding the old metadata, are not
+// written. Encrypted documents are not supported.
+//
+// input and output may be the same file.
+//
+// Example:
+//
+//	// Keep only the title.
+//	err := gxpdf.StripMetadata("draft.pdf", "public.pdf", gxpdf.MetadataTitle)
+func StripMetadata(input, output string, keep MetadataFields) error {
+	reader, err := parser.OpenPDF(input)
+	if err != nil {
+		return fmt.Errorf("gxpdf: failed to open %s: %w", input, err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	var keepInfo []string
+	for _, k := range metadataInfoKeys {
+		if keep&k.field != 0 {
+			keepInfo = append(keepInfo, k.key)
+		}
+	}
+
+	err = rewrite.WriteFile(output, func(w io.Writer) error {
+		return rewrite.StripMetadata(reader, w, keepInfo, keep&MetadataXMP != 0)
+	})
+	if err != nil {
+		return fmt.Errorf("gxpdf: failed to strip metadata from %s: %w", input, err)
+	}
+	return nil
+}
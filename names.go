@@ -0,0 +1,124 @@
+package gxpdf
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// EmbeddedFile is a file attached to a document via the /EmbeddedFiles
+// name tree.
+type EmbeddedFile struct {
+	// Name is the key of the file in the name tree.
+	Name string
+
+	// FileName is the file name given by the file specification.
+	FileName string
+
+	// Description is the description of the file, if any.
+	Description string
+
+	// MIMEType is the MIME type of the file, if given (e.g. "text/csv").
+	MIMEType string
+
+	// Data is the decoded file content.
+	Data []byte
+}
+
+// Script is a document-level JavaScript action from the /JavaScript name
+// tree, run by viewers when the document is opened.
+type Script struct {
+	// Name is the key of the script in the name tree.
+	Name string
+
+	// Source is the JavaScript source.
+	Source string
+}
+
+// EmbeddedFiles returns the files embedded in the document, or none if the
+// document has no attachments. Page-level file attachment annotations are
+// not included.
+func (d *Document) EmbeddedFiles() ([]EmbeddedFile, error) {
+	entries, err := d.reader.GetNameTree("EmbeddedFiles")
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to read embedded files: %w", err)
+	}
+
+	var files []EmbeddedFile
+	for _, entry := range entries {
+		spec, ok := resolve(d.reader, entry.Value).(*parser.Dictionary)
+		if !ok {
+			continue
+		}
+		file := EmbeddedFile{
+			Name:        entry.Name,
+			FileName:    spec.GetString("UF"),
+			Description: spec.GetString("Desc"),
+		}
+		if file.FileName == "" {
+			file.FileName = spec.GetString("F")
+		}
+
+		ef, _ := resolve(d.reader, spec.Get("EF")).(*parser.Dictionary)
+		if ef == nil {
+			continue
+		}
+		stream, ok := resolve(d.reader, ef.Get("F")).(*parser.Stream)
+		if !ok {
+			continue
+		}
+		if subtype := stream.Dictionary().GetName("Subtype"); subtype != nil {
+			file.MIMEType = subtype.Value()
+		}
+		if file.Data, err = d.reader.DecodeStream(stream); err != nil {
+			return nil, fmt.Errorf("gxpdf: failed to decode embedded file %q: %w", entry.Name, err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// JavaScript returns the document-level JavaScript of the document, or
+// none if it has no scripts.
+func (d *Document) JavaScript() ([]Script, error) {
+	entries, err := d.reader.GetNameTree("JavaScript")
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to read JavaScript: %w", err)
+	}
+
+	var scripts []Script
+	for _, entry := range entries {
+		action, ok := resolve(d.reader, entry.Value).(*parser.Dictionary)
+		if !ok {
+			continue
+		}
+		script := Script{Name: entry.Name}
+		switch js := resolve(d.reader, action.Get("JS")).(type) {
+		case *parser.String:
+			script.Source = js.Text()
+		case *parser.Stream:
+			data, err := d.reader.DecodeStream(js)
+			if err != nil {
+				return nil, fmt.Errorf("gxpdf: failed to decode script %q: %w", entry.Name, err)
+			}
+			script.Source = string(data)
+		default:
+			continue
+		}
+		scripts = append(scripts, script)
+	}
+	return scripts, nil
+}
+
+// resolve resolves an indirect reference, returning nil if it cannot be
+// resolved. Unlike Reader.ResolveReferences it leaves containers unchanged.
+func resolve(reader *parser.Reader, obj parser.PdfObject) parser.PdfObject {
+	if ref, ok := obj.(*parser.IndirectReference); ok {
+		resolved, err := reader.GetObject(ref.Number)
+		if err != nil {
+			return nil
+		}
+		return resolved
+	}
+	return obj
+}
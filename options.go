@@ -1,5 +1,10 @@
 package gxpdf
 
+import (
+	"github.com/coregx/gxpdf/internal/extractor"
+	"github.com/coregx/gxpdf/internal/tabledetect"
+)
+
 // ExtractionMethod specifies the table detection algorithm.
 type ExtractionMethod int
 
@@ -37,6 +42,152 @@ func (m ExtractionMethod) String() string {
 	}
 }
 
+// ColumnDetection selects the algorithm that finds column boundaries.
+//
+// Different document families favor different algorithms; use
+// Page.ScoreColumnDetection to compare them on a sample page.
+type ColumnDetection int
+
+const (
+	// ColumnDetectionDefault uses the detector's built-in choice for the
+	// extraction method.
+	ColumnDetectionDefault ColumnDetection = iota
+
+	// ColumnDetectionEdgeClustering clusters the left and right edges of
+	// text. Best for statements with aligned columns.
+	ColumnDetectionEdgeClustering
+
+	// ColumnDetectionWhitespace places boundaries in vertical whitespace.
+	// Best for loosely aligned reports.
+	ColumnDetectionWhitespace
+
+	// ColumnDetectionHeaderBased derives columns from the header rows.
+	ColumnDetectionHeaderBased
+
+	// ColumnDetectionLattice uses vertical ruling lines.
+	ColumnDetectionLattice
+
+	// ColumnDetectionHybrid snaps ruling lines to nearby text edges.
+	ColumnDetectionHybrid
+
+	// ColumnDetectionAuto runs every algorithm on each page and keeps the
+	// columns that split the text lines most consistently.
+	ColumnDetectionAuto
+)
+
+// String returns the name of the column detection algorithm.
+func (c ColumnDetection) String() string {
+	switch c {
+	case ColumnDetectionDefault:
+		return "Default"
+	case ColumnDetectionEdgeClustering:
+		return "EdgeClustering"
+	case ColumnDetectionWhitespace:
+		return "Whitespace"
+	case ColumnDetectionHeaderBased:
+		return "HeaderBased"
+	case ColumnDetectionLattice:
+		return "Lattice"
+	case ColumnDetectionHybrid:
+		return "Hybrid"
+	case ColumnDetectionAuto:
+		return "Auto"
+	default:
+		return "Unknown"
+	}
+}
+
+// strategy returns the internal detection strategy of c; ok is false for
+// ColumnDetectionDefault and unknown values.
+func (c ColumnDetection) strategy() (tabledetect.DetectionStrategy, bool) {
+	switch c {
+	case ColumnDetectionEdgeClustering:
+		return tabledetect.StrategyEdgeClustering, true
+	case ColumnDetectionWhitespace:
+		return tabledetect.StrategyWhitespace, true
+	case ColumnDetectionHeaderBased:
+		return tabledetect.StrategyHeaderBased, true
+	case ColumnDetectionLattice:
+		return tabledetect.StrategyLattice, true
+	case ColumnDetectionHybrid:
+		return tabledetect.StrategyHybrid, true
+	case ColumnDetectionAuto:
+		return tabledetect.StrategyAuto, true
+	default:
+		return 0, false
+	}
+}
+
+// columnDetectionOf returns the ColumnDetection of an internal strategy.
+func columnDetectionOf(s tabledetect.DetectionStrategy) ColumnDetection {
+	switch s {
+	case tabledetect.StrategyEdgeClustering:
+		return ColumnDetectionEdgeClustering
+	case tabledetect.StrategyWhitespace:
+		return ColumnDetectionWhitespace
+	case tabledetect.StrategyHeaderBased:
+		return ColumnDetectionHeaderBased
+	case tabledetect.StrategyLattice:
+		return ColumnDetectionLattice
+	case tabledetect.StrategyHybrid:
+		return ColumnDetectionHybrid
+	case tabledetect.StrategyAuto:
+		return ColumnDetectionAuto
+	default:
+		return ColumnDetectionDefault
+	}
+}
+
+// ColumnDetectionScore is the consistency score of a column detection
+// algorithm on a page.
+type ColumnDetectionScore struct {
+	// Detection is the scored algorithm.
+	Detection ColumnDetection
+
+	// Boundaries are the column boundaries it found, left to right.
+	Boundaries []float64
+
+	// ColumnCount is the number of columns (len(Boundaries) - 1).
+	ColumnCount int
+
+	// Consistency is the share of text lines whose filled cells match
+	// ColumnCount (0-1). Results with fewer than 2 columns score 0.
+	Consistency float64
+}
+
+// RowMergeStrategy specifies how the continuation lines of multi-line
+// cells are merged into one logical row.
+type RowMergeStrategy int
+
+const (
+	// RowMergeDefault keeps the rows found by the table detector, which
+	// already joins most multi-line cells.
+	RowMergeDefault RowMergeStrategy = iota
+
+	// RowMergeByGap merges a row into the previous one when the vertical
+	// gap between their text is at most RowMergeGap.
+	RowMergeByGap
+
+	// RowMergeUntilFullRow merges rows with few filled cells (such as
+	// wrapped description lines) into the previous full row, until the
+	// next row with most of its cells filled.
+	RowMergeUntilFullRow
+)
+
+// String returns the name of the row merge strategy.
+func (s RowMergeStrategy) String() string {
+	switch s {
+	case RowMergeDefault:
+		return "Default"
+	case RowMergeByGap:
+		return "ByGap"
+	case RowMergeUntilFullRow:
+		return "UntilFullRow"
+	default:
+		return "Unknown"
+	}
+}
+
 // ExtractionOptions configures table extraction behavior.
 type ExtractionOptions struct {
 	// Method specifies the table detection algorithm.
@@ -59,6 +210,56 @@ type ExtractionOptions struct {
 	// MergeMultilineRows merges cells that span multiple lines.
 	// Default: true
 	MergeMultilineRows bool
+
+	// ColumnDetection selects the algorithm that finds column boundaries.
+	// Other values than ColumnDetectionDefault apply to all tables, with
+	// or without ruling lines.
+	// Default: ColumnDetectionDefault
+	ColumnDetection ColumnDetection
+
+	// RowMerge selects how continuation lines of multi-line cells are
+	// merged into the row they continue. Merged cell text is joined with
+	// a space.
+	// Default: RowMergeDefault
+	RowMerge RowMergeStrategy
+
+	// RowMergeGap is the largest vertical gap in points between two rows
+	// that RowMergeByGap merges.
+	// Default: 0 (half the average text height)
+	RowMergeGap float64
+
+	// HeaderRows fixes the number of header lines at the top of each
+	// table instead of detecting them. Columns are then derived from the
+	// header text. Applies to tables without ruling lines.
+	// Default: 0 (detect)
+	HeaderRows int
+
+	// IsHeaderRow, if set, selects the header lines instead of HeaderRows:
+	// the header is the leading run of lines for which it returns true.
+	// index counts lines from the top of the table (0-based) and words are
+	// the texts of the line from left to right.
+	IsHeaderRow func(index int, words []string) bool
+
+	// NoHeader declares that tables have no header row, such as the
+	// continuation pages of a statement whose header is on a prior page.
+	// Columns are then detected from data rows only, so the first data
+	// row is never taken for a header. Overrides HeaderRows and
+	// IsHeaderRow.
+	// Default: false
+	NoHeader bool
+
+	// TableRegionOnly restricts detection to the tabular part of each page,
+	// found by row regularity, so that page headers, footers and prose do
+	// not pollute column detection. Pages without a tabular part yield no
+	// tables.
+	// Default: false
+	TableRegionOnly bool
+
+	// SplitTables detects each table of a page separately. Tables are
+	// separated by large vertical gaps or by a change of column structure,
+	// so stacked tables are not merged into one. Implies TableRegionOnly.
+	// Default: false
+	SplitTables bool
 }
 
 // DefaultExtractionOptions returns the default extraction options.
@@ -89,3 +290,145 @@ func (o *ExtractionOptions) WithMergeMultilineRows(merge bool) *ExtractionOption
 	o.MergeMultilineRows = merge
 	return o
 }
+
+// WithRowMerge sets the row merge strategy and, for RowMergeByGap, the
+// largest gap in points (0 for the default).
+func (o *ExtractionOptions) WithRowMerge(strategy RowMergeStrategy, gap float64) *ExtractionOptions {
+	o.RowMerge = strategy
+	o.RowMergeGap = gap
+	return o
+}
+
+// WithColumnDetection sets the column detection algorithm.
+func (o *ExtractionOptions) WithColumnDetection(detection ColumnDetection) *ExtractionOptions {
+	o.ColumnDetection = detection
+	return o
+}
+
+// WithHeaderRows sets the number of header lines.
+func (o *ExtractionOptions) WithHeaderRows(n int) *ExtractionOptions {
+	o.HeaderRows = n
+	return o
+}
+
+// WithNoHeader declares that tables have no header row.
+func (o *ExtractionOptions) WithNoHeader(noHeader bool) *ExtractionOptions {
+	o.NoHeader = noHeader
+	return o
+}
+
+// WithTableRegionOnly restricts detection to the tabular part of each page.
+func (o *ExtractionOptions) WithTableRegionOnly(only bool) *ExtractionOptions {
+	o.TableRegionOnly = only
+	return o
+}
+
+// WithSplitTables enables detecting each table of a page separately.
+func (o *ExtractionOptions) WithSplitTables(split bool) *ExtractionOptions {
+	o.SplitTables = split
+	return o
+}
+
+// extractPageTables detects and extracts the tables of the page with the
+// given text elements.
+func (o *ExtractionOptions) extractPageTables(textElements []*extractor.TextElement, pageIndex int) ([]*Table, error) {
+	tableDetector := o.tableDetector()
+
+	// Graphics are not extracted yet, so ruling lines are unavailable.
+	var graphicsElements []*extractor.GraphicsElement
+
+	var tables []*Table
+	for _, elements := range o.detectionGroups(textElements, graphicsElements) {
+		detectedTables, err := o.detectTables(tableDetector, elements, graphicsElements)
+		if err != nil {
+			return nil, err
+		}
+
+		tableExtractor := tabledetect.NewTableExtractor(elements)
+		for _, region := range detectedTables {
+			extracted, err := tableExtractor.ExtractTable(region)
+			if err != nil {
+				continue
+			}
+			extracted = tableExtractor.MergeRows(extracted, o.rowMergeOptions())
+			extracted.PageNum = pageIndex
+			tables = append(tables, &Table{internal: extracted})
+		}
+	}
+
+	return tables, nil
+}
+
+// detectionGroups returns the groups of text elements of a page that table
+// detection runs on separately.
+func (o *ExtractionOptions) detectionGroups(
+	elements []*extractor.TextElement,
+	graphicsElements []*extractor.GraphicsElement,
+) [][]*extractor.TextElement {
+	regionDetector := tabledetect.NewTableRegionDetector()
+	switch {
+	case o.SplitTables:
+		regions := regionDetector.DetectTableRegions(elements, graphicsElements)
+		groups := make([][]*extractor.TextElement, len(regions))
+		for i, region := range regions {
+			groups[i] = region.Elements
+		}
+		return groups
+	case o.TableRegionOnly:
+		if inside := regionDetector.TableElements(elements); inside != nil {
+			return [][]*extractor.TextElement{inside}
+		}
+		return nil
+	default:
+		return [][]*extractor.TextElement{elements}
+	}
+}
+
+// tableDetector returns the table detector configured by the options.
+func (o *ExtractionOptions) tableDetector() *tabledetect.DefaultTableDetector {
+	detector := tabledetect.NewDefaultTableDetector()
+	if o.HeaderRows <= 0 && o.IsHeaderRow == nil && !o.NoHeader {
+		return detector
+	}
+	analyzer := tabledetect.NewDefaultWhitespaceAnalyzer().
+		WithHeaderRows(o.HeaderRows).
+		WithNoHeader(o.NoHeader)
+	if o.IsHeaderRow != nil {
+		analyzer.WithHeaderRowFunc(o.IsHeaderRow)
+	}
+	return detector.WithWhitespaceAnalyzer(analyzer)
+}
+
+// detectTables detects the table regions of a page with detector.
+func (o *ExtractionOptions) detectTables(
+	detector *tabledetect.DefaultTableDetector,
+	textElements []*extractor.TextElement,
+	graphicsElements []*extractor.GraphicsElement,
+) ([]*tabledetect.TableRegion, error) {
+	if strategy, ok := o.ColumnDetection.strategy(); ok {
+		return detector.DetectTablesWithStrategy(textElements, graphicsElements, strategy)
+	}
+
+	switch o.Method {
+	case MethodLattice:
+		return detector.DetectTablesLattice(textElements, graphicsElements)
+	case MethodStream:
+		return detector.DetectTablesStream(textElements)
+	default:
+		return detector.DetectTables(textElements, graphicsElements)
+	}
+}
+
+// rowMergeOptions returns the row merge options for the table extractor.
+func (o *ExtractionOptions) rowMergeOptions() tabledetect.RowMergeOptions {
+	opts := tabledetect.RowMergeOptions{MaxGap: o.RowMergeGap}
+	switch o.RowMerge {
+	case RowMergeByGap:
+		opts.Strategy = tabledetect.RowMergeByGap
+	case RowMergeUntilFullRow:
+		opts.Strategy = tabledetect.RowMergeUntilFullRow
+	default:
+		opts.Strategy = tabledetect.RowMergeNone
+	}
+	return opts
+}
@@ -1,6 +1,10 @@
 package gxpdf
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/coregx/gxpdf/internal/extractor"
 	"github.com/coregx/gxpdf/internal/tabledetect"
 )
@@ -30,17 +34,28 @@ func (p *Page) Number() int {
 //	text := page.ExtractText()
 //	fmt.Println(text)
 func (p *Page) ExtractText() string {
+	text, _ := p.ExtractTextContext(context.Background())
+	return text
+}
+
+// ExtractTextContext extracts all text from the page, stopping early when
+// ctx is done.
+//
+// On cancellation it returns the context's error, so long extractions can
+// be aborted, e.g. when a client disconnects.
+func (p *Page) ExtractTextContext(ctx context.Context) (string, error) {
 	textExtractor := extractor.NewTextExtractor(p.doc.reader)
-	elements, err := textExtractor.ExtractFromPage(p.index)
+	elements, err := textExtractor.ExtractFromPageContext(ctx, p.index)
 	if err != nil {
-		return ""
+		return "", err
 	}
 
-	var result string
+	var result strings.Builder
 	for _, elem := range elements {
-		result += elem.Text + " "
+		result.WriteString(elem.Text)
+		result.WriteString(" ")
 	}
-	return result
+	return result.String(), nil
 }
 
 // ExtractTables extracts all tables from this page.
@@ -68,37 +83,90 @@ func (p *Page) ExtractTablesWithOptions(opts *ExtractionOptions) ([]*Table, erro
 		return nil, err
 	}
 
-	tableDetector := tabledetect.NewDefaultTableDetector()
+	return opts.extractPageTables(textElements, p.index)
+}
 
-	var detectedTables []*tabledetect.TableRegion
-	var graphicsElements []*extractor.GraphicsElement
+// ScoreColumnDetection runs column detection algorithms (all of them if
+// none are given) on the text of this page and scores how consistently
+// each one splits the text lines into columns.
+//
+// ColumnDetectionDefault and ColumnDetectionAuto are ignored. Scores are
+// sorted best first, as ColumnDetectionAuto ranks them. Use the winner as
+// ExtractionOptions.ColumnDetection for other pages of the same family.
+//
+// Example:
+//
+//	scores, err := page.ScoreColumnDetection()
+//	if err == nil && len(scores) > 0 {
+//	    opts := gxpdf.DefaultExtractionOptions().WithColumnDetection(scores[0].Detection)
+//	    tables, _ := doc.ExtractTablesWithOptions(opts)
+//	}
+func (p *Page) ScoreColumnDetection(detections ...ColumnDetection) ([]ColumnDetectionScore, error) {
+	textExtractor := extractor.NewTextExtractor(p.doc.reader)
+	textElements, err := textExtractor.ExtractFromPage(p.index)
+	if err != nil {
+		return nil, err
+	}
 
-	switch opts.Method {
-	case MethodLattice:
-		detectedTables, err = tableDetector.DetectTablesLattice(textElements, graphicsElements)
-	case MethodStream:
-		detectedTables, err = tableDetector.DetectTablesStream(textElements)
-	default:
-		detectedTables, err = tableDetector.DetectTables(textElements, graphicsElements)
+	var strategies []tabledetect.DetectionStrategy
+	for _, detection := range detections {
+		if strategy, ok := detection.strategy(); ok {
+			strategies = append(strategies, strategy)
+		}
+	}
+	if len(detections) > 0 && len(strategies) == 0 {
+		return nil, nil
 	}
 
+	results := tabledetect.NewColumnBoundaryDetector().ScoreStrategies(textElements, nil, strategies...)
+	scores := make([]ColumnDetectionScore, len(results))
+	for i, r := range results {
+		scores[i] = ColumnDetectionScore{
+			Detection:   columnDetectionOf(r.Strategy),
+			Boundaries:  r.Boundaries,
+			ColumnCount: r.ColumnCount,
+			Consistency: r.Consistency,
+		}
+	}
+	return scores, nil
+}
+
+// ExtractTablesWithBoundaries extracts the table between known column
+// boundaries, skipping column detection.
+//
+// boundaries are the X coordinates (in points) of the column edges, from
+// the left edge of the first column to the right edge of the last; N+1
+// boundaries give N columns. Text is assigned to the column in which it
+// starts, and text outside the boundaries is ignored. Rows are detected
+// as usual.
+//
+// Returns at most one table, or nil if no text lies between the boundaries.
+//
+// Example:
+//
+//	tables, err := page.ExtractTablesWithBoundaries([]float64{40, 110, 380, 460, 540})
+func (p *Page) ExtractTablesWithBoundaries(boundaries []float64) ([]*Table, error) {
+	textExtractor := extractor.NewTextExtractor(p.doc.reader)
+	textElements, err := textExtractor.ExtractFromPage(p.index)
 	if err != nil {
 		return nil, err
 	}
 
-	var tables []*Table
-	tableExtractor := tabledetect.NewTableExtractor(textElements)
+	region, err := tabledetect.NewDefaultTableDetector().DetectTableWithColumns(textElements, boundaries)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: %w", err)
+	}
+	if region == nil {
+		return nil, nil
+	}
 
-	for _, region := range detectedTables {
-		extracted, err := tableExtractor.ExtractTable(region)
-		if err != nil {
-			continue
-		}
-		extracted.PageNum = p.index
-		tables = append(tables, &Table{internal: extracted})
+	extracted, err := tabledetect.NewTableExtractor(textElements).ExtractTable(region)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to extract table on page %d: %w", p.index, err)
 	}
+	extracted.PageNum = p.index
 
-	return tables, nil
+	return []*Table{{internal: extracted}}, nil
 }
 
 // GetImages extracts all images from this page.
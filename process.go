@@ -0,0 +1,119 @@
+package gxpdf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ProcessDir opens each PDF file in dir and calls fn with it, processing up
+// to workers files concurrently.
+//
+// Files with a ".pdf" extension (in any case) directly in dir are
+// processed; subdirectories are not descended into. Symbolic links to PDF
+// files are followed; links to directories and broken links are skipped.
+// A workers value of 0 or less uses runtime.NumCPU(). Each Document is
+// closed after fn returns, so fn must not retain it.
+//
+// All files are processed even if some fail. The returned error joins the
+// errors of all failed files (opening or fn), each prefixed with the file
+// path, in file name order, and is nil if every file succeeded.
+//
+// Example:
+//
+//	err := gxpdf.ProcessDir("statements", 8, func(doc *gxpdf.Document) error {
+//	    tables := doc.ExtractTables()
+//	    return saveTables(doc.Path(), tables)
+//	})
+func ProcessDir(dir string, workers int, fn func(doc *Document) error) error {
+	return ProcessDirContext(context.Background(), dir, workers, fn)
+}
+
+// ProcessDirContext is like ProcessDir, but stops starting new files when
+// ctx is done.
+//
+// Files already being processed run to completion; their Documents are
+// opened with ctx (see OpenWithContext), so context-aware methods stop
+// early. On cancellation the returned error also matches ctx.Err().
+func ProcessDirContext(ctx context.Context, dir string, workers int, fn func(doc *Document) error) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("gxpdf: failed to read directory: %w", err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if isPDFFile(dir, entry) {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	workers = min(workers, len(paths))
+
+	// Errors are stored by file index so they are reported in file order
+	// regardless of completion order.
+	errs := make([]error, len(paths))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() == nil {
+					errs[i] = processFile(ctx, paths[i], fn)
+				}
+			}
+		}()
+	}
+feed:
+	for i := range paths {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errors.Join(append(errs, ctx.Err())...)
+}
+
+// isPDFFile reports whether a directory entry is a PDF file, following
+// symbolic links.
+func isPDFFile(dir string, entry os.DirEntry) bool {
+	if !strings.EqualFold(filepath.Ext(entry.Name()), ".pdf") {
+		return false
+	}
+	if entry.Type()&os.ModeSymlink != 0 {
+		info, err := os.Stat(filepath.Join(dir, entry.Name()))
+		return err == nil && info.Mode().IsRegular()
+	}
+	return entry.Type().IsRegular()
+}
+
+// processFile opens a PDF file, calls fn with it and closes it.
+func processFile(ctx context.Context, path string, fn func(doc *Document) error) (err error) {
+	doc, err := OpenWithContext(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := doc.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("gxpdf: failed to close %s: %w", path, closeErr)
+		}
+	}()
+
+	if err := fn(doc); err != nil {
+		return fmt.Errorf("gxpdf: %s: %w", path, err)
+	}
+	return nil
+}
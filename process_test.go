@@ -0,0 +1,144 @@
+package gxpdf
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// pdfDir creates a temporary directory holding copies of a small PDF
+// under the given names.
+func pdfDir(t *testing.T, names ...string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "pdfs", "minimal.pdf"))
+	if err != nil {
+		t.Fatalf("failed to read test PDF: %v", err)
+	}
+	dir := t.TempDir()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestProcessDir_BoundsConcurrency(t *testing.T) {
+	dir := pdfDir(t, "a.pdf", "b.pdf", "c.pdf", "d.pdf", "e.pdf", "f.pdf")
+
+	var running, peak, calls atomic.Int32
+	err := ProcessDir(dir, 2, func(_ *Document) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessDir() error = %v", err)
+	}
+	if got := calls.Load(); got != 6 {
+		t.Errorf("fn calls = %d, want 6", got)
+	}
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", got)
+	}
+}
+
+func TestProcessDir_JoinsErrorsInFileOrder(t *testing.T) {
+	dir := pdfDir(t, "a.pdf", "b.pdf", "c.pdf", "d.pdf")
+	errFailed := errors.New("failed")
+
+	// Later files finish first, so completion order is reversed.
+	delays := map[string]time.Duration{"a.pdf": 30, "b.pdf": 20, "c.pdf": 10}
+	err := ProcessDir(dir, 4, func(doc *Document) error {
+		name := filepath.Base(doc.Path())
+		time.Sleep(delays[name] * time.Millisecond)
+		if name == "d.pdf" {
+			return nil
+		}
+		return errFailed
+	})
+
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("ProcessDir() error = %v, want %v", err, errFailed)
+	}
+	lines := strings.Split(err.Error(), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("errors = %q, want 3", lines)
+	}
+	for i, name := range []string{"a.pdf", "b.pdf", "c.pdf"} {
+		if !strings.Contains(lines[i], name) {
+			t.Errorf("error %d = %q, want %s", i, lines[i], name)
+		}
+	}
+}
+
+func TestProcessDirContext_Canceled(t *testing.T) {
+	dir := pdfDir(t, "a.pdf", "b.pdf", "c.pdf")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	err := ProcessDirContext(ctx, dir, 1, func(_ *Document) error {
+		calls.Add(1)
+		cancel()
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ProcessDirContext() error = %v, want %v", err, context.Canceled)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fn calls = %d, want 1 (no new files after cancellation)", got)
+	}
+}
+
+func TestProcessDir_SelectsPDFFiles(t *testing.T) {
+	dir := pdfDir(t, "a.pdf", "B.PDF", "notes.txt")
+	if err := os.Mkdir(filepath.Join(dir, "sub.pdf"), 0o755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	links := map[string]string{
+		"link.pdf":    "a.pdf",
+		"dirlink.pdf": "sub.pdf",
+		"broken.pdf":  "missing.pdf",
+		"textlink":    "a.pdf",
+	}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(dir, name)); err != nil {
+			t.Skipf("symbolic links not supported: %v", err)
+		}
+	}
+
+	var mu sync.Mutex
+	var got []string
+	err := ProcessDir(dir, 0, func(doc *Document) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, filepath.Base(doc.Path()))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessDir() error = %v", err)
+	}
+
+	sort.Strings(got)
+	want := []string{"B.PDF", "a.pdf", "link.pdf"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("processed %q, want %q", got, want)
+	}
+}
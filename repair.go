@@ -0,0 +1,43 @@
+package gxpdf
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/application/rewrite"
+	"github.com/coregx/gxpdf/internal/parser"
+)
+
+// Repair reads a damaged or messy PDF tolerantly and writes a clean,
+// normalized copy to output.
+//
+// Reading recovers from common damage: garbage before the header, a
+// missing or corrupt cross-reference table (rebuilt by scanning the file
+// for objects), wrong stream lengths, and objects at wrong offsets.
+// Objects that cannot be recovered at all are written as null.
+//
+// The copy holds the objects reachable from the catalog and the document
+// information in a single revision with a fresh cross-reference table.
+// Objects from object streams are written as regular objects and stream
+// lengths as direct integers. Encrypted documents are not supported.
+//
+// input and output may be the same file.
+//
+// Example:
+//
+//	if err := gxpdf.Repair("broken.pdf", "fixed.pdf"); err != nil {
+//	    log.Fatal(err)
+//	}
+func Repair(input, output string) error {
+	reader, err := parser.OpenPDFRepair(input)
+	if err != nil {
+		return fmt.Errorf("gxpdf: failed to open %s: %w", input, err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	copier := rewrite.NewCopier(reader)
+	copier.MissingAsNull = true
+	if err := rewrite.WriteFile(output, copier.Write); err != nil {
+		return fmt.Errorf("gxpdf: failed to repair %s: %w", input, err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package gxpdf
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/coregx/gxpdf/internal/security"
+)
+
+// ErrNoSignaturePlaceholder is returned by PrepareSignature when the file
+// contains no signature placeholder.
+var ErrNoSignaturePlaceholder = security.ErrNoSignaturePlaceholder
+
+// SignatureRange describes the bytes of a PDF covered by a signature, as
+// returned by PrepareSignature.
+//
+// Its ByteRange field holds the /ByteRange written into the file. Digest
+// computes the SHA-256 digest to sign, Reader streams the signed bytes for
+// other digests, and Embed writes the signature returned by an external
+// signing service (e.g. an HSM) into the reserved /Contents.
+type SignatureRange = security.SignatureRange
+
+// SignatureFile is a written PDF opened for reading and writing, such as
+// an *os.File.
+type SignatureFile = security.SignatureFile
+
+// SignaturePlaceholder returns the /ByteRange and /Contents entries of a
+// signature dictionary with room for a signature of up to size bytes.
+//
+// Write them verbatim into the signature dictionary of the output, then
+// call PrepareSignature on the written file. To sign an existing document,
+// creator.Appender.AddSignatureField writes a signature field with the
+// placeholder in an incremental update.
+func SignaturePlaceholder(size int) string {
+	return security.SignaturePlaceholder(size)
+}
+
+// PrepareSignature prepares a written PDF of the given size for external
+// signing: it fills in the /ByteRange of the last signature placeholder
+// and returns the covered range.
+//
+// The file is processed in chunks and never read into memory as a whole.
+//
+// Example:
+//
+//	r, err := gxpdf.PrepareSignature(f, size)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	digest, err := r.Digest(f)
+//	// Send digest to the signing service...
+//	err = r.Embed(f, cmsSignature)
+func PrepareSignature(f SignatureFile, size int64) (*SignatureRange, error) {
+	r, err := security.PrepareSignature(f, size)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to prepare signature: %w", err)
+	}
+	return r, nil
+}
+
+// PrepareSignatureFile is like PrepareSignature for the file at path. It
+// returns the prepared range and the SHA-256 digest to sign; pass the
+// signature to EmbedSignatureFile.
+func PrepareSignatureFile(path string) (*SignatureRange, []byte, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gxpdf: failed to open %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("gxpdf: failed to stat %s: %w", path, err)
+	}
+	r, err := PrepareSignature(f, info.Size())
+	if err != nil {
+		return nil, nil, err
+	}
+	digest, err := r.Digest(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gxpdf: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, nil, fmt.Errorf("gxpdf: failed to write %s: %w", path, err)
+	}
+	return r, digest, nil
+}
+
+// EmbedSignatureFile writes a signature into the /Contents placeholder of
+// the file at path, prepared with PrepareSignatureFile.
+func EmbedSignatureFile(path string, r *SignatureRange, signature []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("gxpdf: failed to open %s: %w", path, err)
+	}
+	if err := r.Embed(f, signature); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("gxpdf: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("gxpdf: failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package gxpdf
+
+import (
+	"fmt"
+
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// StructElement is an element of a tagged PDF's logical structure tree,
+// such as a paragraph, heading, table or figure.
+//
+// Example:
+//
+//	root, _ := doc.StructureTree()
+//	var walk func(e *gxpdf.StructElement, depth int)
+//	walk = func(e *gxpdf.StructElement, depth int) {
+//	    fmt.Printf("%*s%s %q\n", depth*2, "", e.Type, e.Text)
+//	    for _, child := range e.Children {
+//	        walk(child, depth+1)
+//	    }
+//	}
+//	walk(root, 0)
+type StructElement struct {
+	// Type is the structure type, e.g. "P", "H1" or "Table". The root
+	// element has type "StructTreeRoot".
+	Type string
+
+	// Role is the standard type that a custom Type is mapped to by the
+	// document's role map, or "" if Type is not remapped.
+	Role string
+
+	// Title is the element title.
+	Title string
+
+	// Alt is the alternate description (e.g. of a figure).
+	Alt string
+
+	// ActualText is the replacement text of the element's content.
+	ActualText string
+
+	// Lang is the language of the element's content.
+	Lang string
+
+	// Page is the 0-based index of the element's page, or -1 if unknown.
+	Page int
+
+	// Text is the text of the element's own content, excluding children.
+	Text string
+
+	// Children are the child elements in logical order.
+	Children []*StructElement
+}
+
+// StructureTree returns the logical structure of a tagged PDF, read from
+// its structure tree root. It returns nil if the document is not tagged.
+func (d *Document) StructureTree() (*StructElement, error) {
+	root, err := extractor.ExtractStructureTree(d.reader)
+	if err != nil {
+		return nil, fmt.Errorf("gxpdf: failed to read structure tree: %w", err)
+	}
+	if root == nil {
+		return nil, nil
+	}
+	return newStructElement(root), nil
+}
+
+// newStructElement converts an internal structure element and its children.
+func newStructElement(e *extractor.StructElement) *StructElement {
+	elem := &StructElement{
+		Type:       e.Type,
+		Role:       e.Role,
+		Title:      e.Title,
+		Alt:        e.Alt,
+		ActualText: e.ActualText,
+		Lang:       e.Lang,
+		Page:       e.Page,
+		Text:       e.Text,
+		Children:   make([]*StructElement, len(e.Children)),
+	}
+	for i, child := range e.Children {
+		elem.Children[i] = newStructElement(child)
+	}
+	return elem
+}
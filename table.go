@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/coregx/gxpdf/export"
+	"github.com/coregx/gxpdf/extractor"
 	internaltable "github.com/coregx/gxpdf/internal/models/table"
 )
 
@@ -104,3 +105,23 @@ func (t *Table) ToJSON() (string, error) {
 func (t *Table) Internal() *internaltable.Table {
 	return t.internal
 }
+
+// ExtractTablesFromElements detects and extracts tables from text elements
+// supplied by the caller, such as elements from a custom extraction
+// pipeline or built with extractor.NewTextElement in tests. The tables
+// report page 0.
+//
+// Example:
+//
+//	elements := []*extractor.TextElement{
+//	    extractor.NewTextElement("Date", 50, 700, 25, 10),
+//	    extractor.NewTextElement("Amount", 300, 700, 35, 10),
+//	    // ...
+//	}
+//	tables, err := gxpdf.ExtractTablesFromElements(elements, nil)
+func ExtractTablesFromElements(elements []*extractor.TextElement, opts *ExtractionOptions) ([]*Table, error) {
+	if opts == nil {
+		opts = DefaultExtractionOptions()
+	}
+	return opts.extractPageTables(elements, 0)
+}
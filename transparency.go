@@ -0,0 +1,95 @@
+package gxpdf
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/coregx/gxpdf/internal/application/transparency"
+	"github.com/coregx/gxpdf/internal/extractor"
+)
+
+// Location is a place in a PDF where a feature is used, as reported by
+// UsesTransparency.
+type Location struct {
+	// Page is the 0-based page index.
+	Page int
+
+	// Kind is the kind of use: "Alpha", "BlendMode", "SoftMask" or "Group".
+	Kind string
+
+	// Resource is the resource path, e.g. "ExtGState/GS1" or
+	// "XObject/Fm1/XObject/Im2"; empty for a page-level group.
+	Resource string
+
+	// Detail describes the value found, e.g. "ca 0.5" or "BM Multiply".
+	Detail string
+}
+
+// UsesTransparency reports whether the document uses transparency, and
+// where: graphics states with constant alpha below 1, blend modes other
+// than Normal or soft masks, images with soft masks, and page or form
+// transparency groups.
+//
+// Example:
+//
+//	uses, locations, err := doc.UsesTransparency()
+//	if err == nil && uses {
+//	    for _, loc := range locations {
+//	        fmt.Printf("page %d: %s %s (%s)\n", loc.Page+1, loc.Kind, loc.Resource, loc.Detail)
+//	    }
+//	}
+func (d *Document) UsesTransparency() (bool, []Location, error) {
+	uses, err := extractor.DetectTransparency(d.reader)
+	if err != nil {
+		return false, nil, fmt.Errorf("gxpdf: failed to detect transparency: %w", err)
+	}
+
+	locations := make([]Location, len(uses))
+	for i, u := range uses {
+		locations[i] = Location{Page: u.Page, Kind: u.Kind, Resource: u.Resource, Detail: u.Detail}
+	}
+
+	return len(locations) > 0, locations, nil
+}
+
+// FlattenTransparencyOptions configures FlattenTransparency.
+type FlattenTransparencyOptions struct {
+	// Background is the opaque color translucent content is composited
+	// onto, as RGB components in the range 0-1.
+	// Default: white (1, 1, 1), the paper color
+	Background [3]float64
+}
+
+// DefaultFlattenTransparencyOptions returns the default options for
+// FlattenTransparency.
+func DefaultFlattenTransparencyOptions() *FlattenTransparencyOptions {
+	return &FlattenTransparencyOptions{Background: [3]float64{1, 1, 1}}
+}
+
+// FlattenTransparency writes a copy of the document without live
+// transparency to w, for print standards that forbid it, such as PDF/X-1a.
+// A nil opts uses DefaultFlattenTransparencyOptions.
+//
+// Gray, RGB and CMYK colors painted with constant alpha are replaced by
+// their blend with the background, which is exact for content on the
+// background and approximate where translucent content overlaps other
+// content. Images with 8-bit soft masks are composited onto the background.
+// Blend modes, soft masks and transparency groups are removed, so content
+// that cannot be blended, such as shadings and images painted with
+// constant alpha, is painted opaque. Images whose soft masks cannot be
+// composited keep them; call UsesTransparency on the result to find them.
+//
+// Example:
+//
+//	f, _ := os.Create("print.pdf")
+//	defer f.Close()
+//	err := doc.FlattenTransparency(f, nil)
+func (d *Document) FlattenTransparency(w io.Writer, opts *FlattenTransparencyOptions) error {
+	if opts == nil {
+		opts = DefaultFlattenTransparencyOptions()
+	}
+	if err := transparency.Flatten(d.reader, opts.Background, w); err != nil {
+		return fmt.Errorf("gxpdf: failed to flatten transparency: %w", err)
+	}
+	return nil
+}